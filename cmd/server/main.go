@@ -1,13 +1,19 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
-	"github.com/joho/godotenv"
 	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
+	"github.com/morinonusi421/cupid/internal/config"
 	"github.com/morinonusi421/cupid/internal/handler"
 	"github.com/morinonusi421/cupid/internal/liff"
 	"github.com/morinonusi421/cupid/internal/linebot"
@@ -15,98 +21,281 @@ import (
 	"github.com/morinonusi421/cupid/internal/repository"
 	"github.com/morinonusi421/cupid/internal/service"
 	"github.com/morinonusi421/cupid/pkg/database"
+	"github.com/morinonusi421/cupid/pkg/graceful"
+	"github.com/morinonusi421/cupid/pkg/workerpool"
+	"github.com/morinonusi421/cupid/static"
 )
 
+// maxHeaderBytes はリクエストヘッダーの上限（LINE Webhook と LIFF のリクエストには十分な大きさ）
+const maxHeaderBytes = 64 << 10
+
+// withdrawnHistoryPruneInterval は退会したユーザーの古い登録の履歴を削除する間隔
+const withdrawnHistoryPruneInterval = time.Hour
+
 func main() {
-	// .envファイルを読み込む
-	if err := godotenv.Load(); err != nil {
-		log.Println("Warning: .env file not found")
+	// サブコマンド: マイグレーションのみ実行して終了する
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:], os.Stdout, os.Stderr))
 	}
 
-	// === 環境変数の読み込み ===
-	channelSecret := os.Getenv("LINE_CHANNEL_SECRET")
-	channelToken := os.Getenv("LINE_CHANNEL_TOKEN")
-	userLiffChannelID := os.Getenv("LINE_LIFF_USER_CHANNEL_ID")
-	crushLiffChannelID := os.Getenv("LINE_LIFF_CRUSH_CHANNEL_ID")
-	userLiffURL := os.Getenv("LINE_LIFF_USER_URL")
-	crushLiffURL := os.Getenv("LINE_LIFF_CRUSH_URL")
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	// === 設定の読み込み ===
+	// 環境変数・.env・設定ファイル（-config、YAML/TOML）から読み込み、すべての項目を検証する
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML/TOML config file (optional)")
+	flag.Parse()
+	cfg, err := config.Load(*configFile)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	// 必須環境変数のチェック
-	if channelSecret == "" || channelToken == "" {
-		log.Fatal("LINE_CHANNEL_SECRET and LINE_CHANNEL_TOKEN must be set")
-	}
-	if userLiffChannelID == "" {
-		log.Fatal("LINE_LIFF_USER_CHANNEL_ID must be set")
-	}
-	if crushLiffChannelID == "" {
-		log.Fatal("LINE_LIFF_CRUSH_CHANNEL_ID must be set")
-	}
-	if userLiffURL == "" {
-		log.Fatal("LINE_LIFF_USER_URL must be set")
-	}
-	if crushLiffURL == "" {
-		log.Fatal("LINE_LIFF_CRUSH_URL must be set")
+	// SIGTERM（systemd の停止・再起動）と SIGINT で安全に停止する
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, cfg); err != nil {
+		log.Fatal(err)
 	}
+}
 
+// run はサーバーを起動し、ctx がキャンセルされるまで動かす
+// 停止時は処理中のリクエストとバックグラウンド処理を完了させてから DB を閉じる
+func run(ctx context.Context, cfg *config.Config) error {
 	// === 外部リソースの初期化 ===
 	// LINE Messaging APIクライアント
-	botAPI, err := messaging_api.NewMessagingApiAPI(channelToken)
+	botAPI, err := messaging_api.NewMessagingApiAPI(
+		cfg.LINE.ChannelToken,
+		messaging_api.WithHTTPClient(&http.Client{Timeout: cfg.LINE.APITimeout}),
+	)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	// データベース接続
-	db, err := database.InitDB("cupid.db")
+	// データベース接続（未適用のマイグレーションを適用し、DBがバイナリより新しい場合は起動しない）
+	db, err := database.InitDB(cfg.Database.Path, repository.Migrations()...)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
-	defer db.Close()
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Printf("Failed to close database: %v", err)
+		}
+		log.Println("Database closed")
+	}()
 
 	// === Repository層 ===
 	userRepo := repository.NewUserRepository(db)
+	crushRepo := repository.NewCrushRepository(db)
+	crushChangeRepo := repository.NewCrushChangeRepository(db)
+	webhookEventRepo := repository.NewWebhookEventRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	pushLedgerRepo := repository.NewPushLedgerRepository(db)
+	matchHistoryRepo := repository.NewMatchHistoryRepository(db)
+	adminAuditRepo := repository.NewAdminAuditRepository(db)
+	statsRepo := repository.NewStatsRepository(db)
 
 	// === LIFF Verifier ===
-	userLiffVerifier := liff.NewVerifier(userLiffChannelID)
-	crushLiffVerifier := liff.NewVerifier(crushLiffChannelID)
+	liffHTTPClient := &http.Client{Timeout: cfg.LIFF.VerifyTimeout}
+	liffRetryPolicy := liff.DefaultRetryPolicy
+	liffRetryPolicy.MaxAttempts = cfg.LIFF.VerifyMaxAttempts
+	userLiffVerifier := liff.NewVerifier(cfg.LIFF.UserChannelID, liff.WithHTTPClient(liffHTTPClient), liff.WithRetryPolicy(liffRetryPolicy))
+	crushLiffVerifier := liff.NewVerifier(cfg.LIFF.CrushChannelID, liff.WithHTTPClient(liffHTTPClient), liff.WithRetryPolicy(liffRetryPolicy))
 
 	// === Service層 ===
-	lineBotClient := linebot.NewClient(botAPI)
-	notificationService := service.NewNotificationService(lineBotClient)
-	matchingService := service.NewMatchingService(userRepo)
-	userService := service.NewUserService(userRepo, userLiffURL, crushLiffURL, matchingService, notificationService)
+	lineBotClient := linebot.NewClient(botAPI, pushLedgerRepo)
+	notificationService := service.NewNotificationService(lineBotClient, notificationRepo, cfg.Push.LowPriorityLimit())
+	notificationDispatcher := service.NewNotificationDispatcher(notificationRepo, lineBotClient, service.RetryPolicy{
+		MaxAttempts: cfg.Push.MaxAttempts,
+		BaseDelay:   cfg.Push.RetryBase,
+		MaxDelay:    cfg.Push.RetryMax,
+	})
+	crushChangePolicy := service.CrushChangePolicy{
+		DailyLimit:  cfg.Crush.DailyChangeLimit,
+		MaxDistinct: cfg.Crush.MaxDistinct,
+		Window:      cfg.Crush.HistoryWindow,
+		Cooldown:    cfg.Crush.ChangeCooldown,
+	}
+	agePolicy := service.AgePolicy{
+		MinAge:   cfg.Age.MinAge,
+		MaxGap:   cfg.Age.MaxGap,
+		AdultAge: cfg.Age.AdultAge,
+	}
+	matchingService := service.NewMatchingService(userRepo, matchHistoryRepo, agePolicy)
+	userService := service.NewUserService(userRepo, crushRepo, crushChangeRepo, cfg.LIFF.UserURL, cfg.LIFF.CrushURL, cfg.Crush.MaxPerUser, crushChangePolicy, agePolicy, matchingService, notificationService)
+	webhookEventService := service.NewWebhookEventService(webhookEventRepo, cfg.Webhook.EventTTL, cfg.Webhook.ProcessingLease)
+	adminService := service.NewAdminService(userRepo, crushRepo, adminAuditRepo, statsRepo, userService, notificationService)
 
 	// === Middleware層 ===
-	userAuthMiddleware := middleware.NewAuthMiddleware(userLiffVerifier)
-	crushAuthMiddleware := middleware.NewAuthMiddleware(crushLiffVerifier)
+	// 登録APIの再送防止用 nonce は両方の LIFF アプリで共有する
+	nonceIssuer := middleware.NewNonceIssuer(cfg.LIFF.NonceTTL)
+	replayCache := middleware.NewReplayCache()
+	userAuthMiddleware := middleware.NewAuthMiddleware(userLiffVerifier, nonceIssuer, replayCache)
+	crushAuthMiddleware := middleware.NewAuthMiddleware(crushLiffVerifier, nonceIssuer, replayCache)
+	// nonce の発行・登録状況の取得・マッチング解除はユーザー登録・好きな人登録の両方の画面から呼ばれる
+	anyAuthMiddleware := middleware.NewAuthMiddleware(liff.AnyOf(userLiffVerifier, crushLiffVerifier), nonceIssuer, replayCache)
+	// リクエスト数の制限はエンドポイントごとに数える
+	perUserLimit := middleware.Limit{Burst: cfg.RateLimit.UserBurst, Every: cfg.RateLimit.UserInterval}
+	perIPLimit := middleware.Limit{Burst: cfg.RateLimit.IPBurst, Every: cfg.RateLimit.IPInterval}
+	nonceRateLimiter := middleware.NewRateLimiter(perUserLimit, perIPLimit)
+	userRateLimiter := middleware.NewRateLimiter(perUserLimit, perIPLimit)
+	crushRateLimiter := middleware.NewRateLimiter(perUserLimit, perIPLimit)
+	accountRateLimiter := middleware.NewRateLimiter(perUserLimit, perIPLimit)
+	exportRateLimiter := middleware.NewRateLimiter(perUserLimit, perIPLimit)
+	meRateLimiter := middleware.NewRateLimiter(perUserLimit, perIPLimit)
+	unmatchRateLimiter := middleware.NewRateLimiter(perUserLimit, perIPLimit)
+	withdrawCrushRateLimiter := middleware.NewRateLimiter(perUserLimit, perIPLimit)
+	adminRateLimiter := middleware.NewRateLimiter(perUserLimit, perIPLimit)
 
 	// === Handler層 ===
-	webhookHandler := handler.NewWebhookHandler(channelSecret, lineBotClient, userService)
+	webhookPool := workerpool.New(cfg.Webhook.Workers, cfg.Webhook.QueueSize)
+	webhookHandler := handler.NewWebhookHandler(cfg.LINE.ChannelSecret, lineBotClient, userService, webhookEventService, webhookPool, cfg.Webhook.EnqueueTimeout)
 	userRegistrationAPIHandler := handler.NewUserRegistrationAPIHandler(userService)
-	crushRegistrationAPIHandler := handler.NewCrushRegistrationAPIHandler(userService, userLiffURL)
+	crushRegistrationAPIHandler := handler.NewCrushRegistrationAPIHandler(userService, cfg.LIFF.UserURL)
+	accountAPIHandler := handler.NewAccountAPIHandler(userService)
+	adminAPIHandler := handler.NewAdminAPIHandler(adminService, webhookPool)
+
+	// === バックグラウンド処理 ===
+	// 停止時は終了を待ってから DB を閉じる
+	var background sync.WaitGroup
+	defer background.Wait()
+
+	// 保持期間を過ぎた Webhook イベントIDを定期的に削除する
+	background.Go(func() {
+		runPeriodically(ctx, cfg.Webhook.CleanupInterval, func() {
+			if n, err := webhookEventService.Cleanup(ctx); err != nil {
+				log.Printf("[WARN] Failed to clean up webhook events: %v", err)
+			} else if n > 0 {
+				log.Printf("Cleaned up %d webhook events", n)
+			}
+		})
+	})
+
+	// 退会したユーザーの好きな人の登録の履歴のうち、登録の制限に使う期間を過ぎたものを削除する
+	background.Go(func() {
+		runPeriodically(ctx, withdrawnHistoryPruneInterval, func() {
+			if n, err := userService.PruneWithdrawnHistory(ctx); err != nil {
+				log.Printf("[WARN] Failed to prune crush history of withdrawn users: %v", err)
+			} else if n > 0 {
+				log.Printf("Pruned %d crush history entries of withdrawn users", n)
+			}
+		})
+	})
+
+	// LINE API から今月の送信数を取得する（台帳にない送信も月間の送信数に含めるため）
+	background.Go(func() {
+		runPeriodically(ctx, cfg.Push.QuotaSyncInterval, func() {
+			syncPushQuota(ctx, lineBotClient, cfg.Push)
+		})
+	})
+
+	// 送信待ちのPush通知（マッチング成立・解除）を送信する
+	background.Go(func() {
+		runPeriodically(ctx, cfg.Push.PollInterval, func() {
+			if _, err := notificationDispatcher.DispatchDue(ctx); err != nil {
+				log.Printf("[WARN] Failed to dispatch notifications: %v", err)
+			}
+		})
+	})
 
 	// === ルーティング設定 ===
+	mux := http.NewServeMux()
+
 	// ヘルスチェック
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "Cupid LINE Bot is running")
 	})
 
 	// LINE Webhook
-	http.HandleFunc("/webhook", webhookHandler.Handle)
+	mux.HandleFunc("/webhook", webhookHandler.Handle)
 
-	// Registration API（認証ミドルウェア適用）
-	http.HandleFunc("/api/register-user", userAuthMiddleware.Authenticate(userRegistrationAPIHandler.Register))
-	http.HandleFunc("/api/register-crush", crushAuthMiddleware.Authenticate(crushRegistrationAPIHandler.RegisterCrush))
+	// Registration API（認証ミドルウェア適用、送信ごとに /api/nonce で取得した nonce が必要）
+	// リクエスト数はクライアントIPごと（認証前）と LINE ユーザーごと（認証後）に制限する
+	// nonce はユーザー登録・好きな人登録の両方の画面から取得する（IDトークンを検証してから発行する）
+	mux.HandleFunc("/api/nonce", nonceRateLimiter.LimitByIP(anyAuthMiddleware.IssueNonce))
+	mux.HandleFunc("/api/register-user", userRateLimiter.LimitByIP(userAuthMiddleware.AuthenticateOnce(userRateLimiter.LimitByUser(userRegistrationAPIHandler.Register))))
+	mux.HandleFunc("/api/register-crush", crushRateLimiter.LimitByIP(crushAuthMiddleware.AuthenticateOnce(crushRateLimiter.LimitByUser(crushRegistrationAPIHandler.RegisterCrush))))
+	mux.HandleFunc("/api/withdraw", accountRateLimiter.LimitByIP(userAuthMiddleware.AuthenticateOnce(accountRateLimiter.LimitByUser(accountAPIHandler.Withdraw))))
+	mux.HandleFunc("/api/unmatch", unmatchRateLimiter.LimitByIP(anyAuthMiddleware.AuthenticateOnce(unmatchRateLimiter.LimitByUser(accountAPIHandler.Unmatch))))
+	mux.HandleFunc("/api/withdraw-crush", withdrawCrushRateLimiter.LimitByIP(crushAuthMiddleware.AuthenticateOnce(withdrawCrushRateLimiter.LimitByUser(crushRegistrationAPIHandler.WithdrawCrush))))
+	// 登録状況の取得・データのエクスポートは状態を変更しないため nonce は不要
+	mux.HandleFunc("/api/me", meRateLimiter.LimitByIP(anyAuthMiddleware.Authenticate(meRateLimiter.LimitByUser(accountAPIHandler.Me))))
+	mux.HandleFunc("/api/me/export", exportRateLimiter.LimitByIP(userAuthMiddleware.Authenticate(exportRateLimiter.LimitByUser(accountAPIHandler.Export))))
 
-	// 静的ファイル配信（/user/, /crush/）はNginxで直接処理されるため、ここでは設定しない
-	// 詳細: nginx/cupid.conf を参照
+	// 管理API（ADMIN_TOKEN または ADMIN_USERNAME/ADMIN_PASSWORD を設定した場合のみ）
+	// LIFF とは別の認証（Bearer トークン / Basic 認証）で、ADMIN_ALLOWED_IPS のIPアドレスからのみ受け付ける
+	if cfg.Admin.Enabled() {
+		allowlist, err := cfg.Admin.AllowedPrefixes()
+		if err != nil {
+			return err
+		}
+		adminAuth := middleware.NewAdminAuth(cfg.Admin.Token, cfg.Admin.Username, cfg.Admin.Password, allowlist)
+		admin := func(h http.HandlerFunc) http.HandlerFunc {
+			return adminRateLimiter.LimitByIP(adminAuth.Authenticate(h))
+		}
+		mux.HandleFunc("GET /admin/users", admin(adminAPIHandler.LookupUser))
+		mux.HandleFunc("DELETE /admin/users/{line_id}", admin(adminAPIHandler.DeleteUser))
+		mux.HandleFunc("POST /admin/users/{line_id}/unmatch", admin(adminAPIHandler.ForceUnmatch))
+		mux.HandleFunc("GET /admin/matches", admin(adminAPIHandler.ListMatches))
+		mux.HandleFunc("GET /admin/stats", admin(adminAPIHandler.Stats))
+		// それ以外のパス・メソッドはヘルスチェック（/）に渡さず、認証したうえで 404 を返す
+		mux.HandleFunc("/admin/", admin(http.NotFound))
+		log.Printf("Admin API enabled (allowed: %v)", cfg.Admin.AllowedIPs)
+	}
+
+	// 静的ファイル配信（/user/, /crush/, /common.js, /messages.js）
+	// 通常はNginxで直接処理される（詳細: nginx/cupid.conf）
+	// SERVE_STATIC=true の場合はバイナリに埋め込んだファイルを配信する
+	if cfg.Server.ServeStatic {
+		handler.NewStaticHandler(static.Files).Register(mux)
+		log.Println("Serving embedded static files")
+	}
 
 	// === サーバー起動 ===
-	log.Printf("Server starting on :%s", port)
-	if err := http.ListenAndServe(":"+port, nil); err != nil {
-		log.Fatal(err)
+	server := &http.Server{
+		Addr:              ":" + cfg.Server.Port,
+		Handler:           mux,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+		MaxHeaderBytes:    maxHeaderBytes,
+	}
+	log.Printf("Server starting on :%s", cfg.Server.Port)
+	// 停止時は HTTP サーバーの停止後、キューに残っている Webhook イベントを処理し終えてから DB を閉じる
+	drainWebhook := graceful.DrainFunc(func(ctx context.Context) error {
+		err := webhookPool.Drain(ctx)
+		log.Printf("Webhook worker pool drained: %+v", webhookPool.Stats())
+		return err
+	})
+	return graceful.ListenAndServe(ctx, server, cfg.Server.ShutdownTimeout, drainWebhook)
+}
+
+// syncPushQuota は LINE API から今月の送信数を取得し、台帳の送信数とあわせてログに出力する
+func syncPushQuota(ctx context.Context, client linebot.Client, cfg config.PushConfig) {
+	quota, err := client.Quota(ctx)
+	if err != nil {
+		log.Printf("[WARN] Failed to get push quota: %v", err)
+		return
+	}
+	usage, err := client.MonthlyUsage(ctx)
+	if err != nil {
+		log.Printf("[WARN] Failed to get monthly push usage: %v", err)
+		return
+	}
+	log.Printf("Push usage this month: %d (LINE: %d, limit: %d, low-priority budget: %d)", usage, quota.Consumed, quota.Limit, cfg.LowPriorityLimit())
+	if quota.Limit >= 0 && quota.Limit != int64(cfg.MonthlyQuota) {
+		log.Printf("[WARN] PUSH_MONTHLY_QUOTA (%d) differs from the limit reported by LINE (%d)", cfg.MonthlyQuota, quota.Limit)
+	}
+}
+
+// runPeriodically は ctx がキャンセルされるまで interval ごとに fn を実行する（起動直後にも1回実行する）
+func runPeriodically(ctx context.Context, interval time.Duration, fn func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		fn()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
 	}
 }
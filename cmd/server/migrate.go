@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/morinonusi421/cupid/internal/config"
+	"github.com/morinonusi421/cupid/internal/repository"
+	"github.com/morinonusi421/cupid/pkg/database"
+)
+
+// runMigrate は "cupid migrate" サブコマンドを実行し、終了コードを返す
+//
+//	cupid migrate [-config cupid.yaml] [-db cupid.db] [-dry-run]
+//
+// DBのパスは -db、設定（DB_PATH 等）の順で決まる
+func runMigrate(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	configPath := fs.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML/TOML config file (optional)")
+	dbPath := fs.String("db", "", "path to the SQLite database (overrides config)")
+	dryRun := fs.Bool("dry-run", false, "show pending migrations without applying them")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	// マイグレーションには LINE の設定等は不要なため、検証せずに読み込む
+	cfg, err := config.Read(*configPath)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	if *dbPath == "" {
+		*dbPath = cfg.Database.Path
+	}
+
+	migrations, err := database.LoadMigrations(repository.Migrations()...)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	db, err := database.Open(*dbPath)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	defer db.Close()
+
+	ids, err := database.Migrate(db, migrations, *dryRun)
+	for _, id := range ids {
+		if *dryRun {
+			fmt.Fprintf(stdout, "Would apply %s\n", id)
+		} else {
+			fmt.Fprintf(stdout, "Applied %s\n", id)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	if len(ids) == 0 {
+		fmt.Fprintln(stdout, "Database is up to date")
+	}
+	return 0
+}
@@ -0,0 +1,12 @@
+// Package db はデータベーススキーマをバイナリに埋め込む
+package db
+
+import _ "embed"
+
+// Schema は最新のスキーマ全体（db/schema.sql）
+//
+// 本番DBは pkg/database のマイグレーションで作成・更新されるため、
+// テスト用DBの作成など実行ディレクトリに依存せずスキーマが必要な場面で使用する
+//
+//go:embed schema.sql
+var Schema string
@@ -1,21 +1,160 @@
 -- Cupid LINE Bot Database Schema
 -- SQLite3
+--
+-- 最新のスキーマ全体（sqlboiler によるコード生成とテストで使用）
+-- 本番DBへの変更は pkg/database/migrations/ にマイグレーションを追加して行い、このファイルも合わせて更新する
+
+-- 適用済みマイグレーションの記録（sql-migrate 互換）
+CREATE TABLE schema_migrations (
+  id text not null primary key,
+  applied_at datetime
+);
 
 -- ユーザーテーブル
 CREATE TABLE users (
   line_user_id TEXT PRIMARY KEY,
   name TEXT NOT NULL,
   birthday TEXT NOT NULL,
-  crush_name TEXT,
-  crush_birthday TEXT,
   matched_with_user_id TEXT,
   registered_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
   updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  line_display_name TEXT NOT NULL DEFAULT '', -- LINE の表示名（管理者向けの参考情報、マッチングには使わない）
+  deleted_at TEXT, -- 退会した日時（NULL=利用中）。退会したユーザーは名前・誕生日などを消して残す
+  name_key TEXT NOT NULL DEFAULT '', -- マッチング用の名前のキー（model.NameKey）
+  alt_name TEXT NOT NULL DEFAULT '', -- 名前の別の表記（なければ空）
+  alt_name_key TEXT NOT NULL DEFAULT '', -- 別の表記のマッチング用のキー（model.NameKey、なければ空）
+  withdrawal_requested_at TEXT, -- 「退会」と送信した日時（NULL=未送信）。「退会する」はこの日時から一定時間内にだけ受け付ける
   FOREIGN KEY (matched_with_user_id) REFERENCES users(line_user_id)
 );
 
--- 名前と誕生日の組み合わせで検索するためのインデックス
-CREATE INDEX idx_users_name_birthday ON users(name, birthday);
+-- 名前（キー）と誕生日の組み合わせで検索するためのインデックス（別の表記のキーも検索する）
+CREATE INDEX idx_users_name_key_birthday ON users(name_key, birthday);
+CREATE INDEX idx_users_alt_name_key_birthday ON users(alt_name_key, birthday);
+
+-- 好きな人テーブル（1ユーザーにつき複数登録可能、上限はアプリケーション側で設定）
+CREATE TABLE crushes (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_line_id TEXT NOT NULL,
+  crush_name TEXT NOT NULL,
+  crush_birthday TEXT NOT NULL,
+  registered_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  crush_name_key TEXT NOT NULL DEFAULT '', -- マッチング用の名前のキー（model.NameKey）
+  crush_alt_name TEXT NOT NULL DEFAULT '', -- 好きな人の名前の別の表記（なければ空）
+  crush_alt_name_key TEXT NOT NULL DEFAULT '', -- 別の表記のマッチング用のキー（model.NameKey、なければ空）
+  FOREIGN KEY (user_line_id) REFERENCES users(line_user_id) ON DELETE CASCADE,
+  UNIQUE (user_line_id, crush_name, crush_birthday)
+);
+
+-- 好きな人の検索用インデックス（相互マッチングの判定に使用）
+CREATE INDEX idx_crushes_crush_key ON crushes(crush_name_key, crush_birthday);
+CREATE INDEX idx_crushes_crush_alt_key ON crushes(crush_alt_name_key, crush_birthday);
+
+-- 処理済みWebhookイベント（LINE の再送による重複処理を防ぐ）
+-- status: processing（処理中、claimed_at から一定時間が経てば再送時に再処理する）/ succeeded（成功）/ failed（失敗、再送時に再処理する）
+CREATE TABLE webhook_events (
+  webhook_event_id TEXT PRIMARY KEY,
+  event_type TEXT NOT NULL,
+  source_id TEXT,
+  is_redelivery INTEGER NOT NULL DEFAULT 0,
+  status TEXT NOT NULL,
+  error TEXT,
+  received_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  processed_at TEXT,
+  claimed_at TEXT -- 処理を始めた日時
+);
+
+-- 期限切れの行を削除するためのインデックス
+CREATE INDEX idx_webhook_events_received_at ON webhook_events(received_at);
+
+-- 送信待ちのPush通知（マッチング成立・解除と同じトランザクションで書き込み、バックグラウンドで送信する）
+-- status: pending（送信待ち・再送待ち）/ delivered（送信済み）/ dead（再送を諦めた）
+-- retry_key: LINE API の X-Line-Retry-Key（再送時に同じ値を使い、二重送信を防ぐ）
+CREATE TABLE notification_outbox (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  to_user_id TEXT NOT NULL,
+  kind TEXT NOT NULL,
+  text TEXT NOT NULL,
+  retry_key TEXT NOT NULL UNIQUE,
+  status TEXT NOT NULL DEFAULT 'pending',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_error TEXT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  delivered_at TEXT
+);
+
+-- 送信対象の行を探すためのインデックス
+CREATE INDEX idx_notification_outbox_due ON notification_outbox(status, next_attempt_at);
+
+-- 送信したPushメッセージの記録（月間の送信数を数え、無料プランの上限を超えないようにする）
+-- 1回の送信（送信先1人）につき1行。LINE の集計と同じく、1回の送信に含まれる吹き出しの数は数えない
+CREATE TABLE push_ledger (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  to_user_id TEXT NOT NULL,
+  retry_key TEXT,
+  sent_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 月間の送信数を数えるためのインデックス
+CREATE INDEX idx_push_ledger_sent_at ON push_ledger(sent_at);
+
+-- 好きな人の登録回数（日本時間の日ごと）。名前と誕生日の総当たりで登録状況を探られるのを防ぐため、1日の上限を設ける
+-- day: 日本時間の日付（YYYY-MM-DD）
+CREATE TABLE crush_change_counts (
+  user_line_id TEXT NOT NULL,
+  day TEXT NOT NULL,
+  changes INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (user_line_id, day),
+  FOREIGN KEY (user_line_id) REFERENCES users(line_user_id) ON DELETE CASCADE
+);
+
+-- 好きな人の登録の履歴（登録し直して総当たりで登録状況を探られないよう、一定期間に登録できる人数と登録の間隔を制限する）
+-- action: add（登録）/ remove（取り消し）
+CREATE TABLE crush_history (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_line_id TEXT NOT NULL,
+  crush_name TEXT NOT NULL,
+  crush_birthday TEXT NOT NULL,
+  action TEXT NOT NULL,
+  changed_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  crush_alt_name TEXT NOT NULL DEFAULT '', -- 好きな人の名前の別の表記（なければ空）
+  FOREIGN KEY (user_line_id) REFERENCES users(line_user_id) ON DELETE CASCADE
+);
+
+-- ユーザーごとに期間内の履歴を探すためのインデックス
+CREATE INDEX idx_crush_history_user_changed_at ON crush_history(user_line_id, changed_at);
+
+-- マッチングの成立・解除の履歴（ユーザー本人のデータとしてエクスポートできるよう、両方のユーザーに1行ずつ記録する）
+-- action: match（成立）/ unmatch（解除）
+-- partner_name はその時点の相手の名前（相手が名前を変更・退会しても残る）
+CREATE TABLE match_history (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_line_id TEXT NOT NULL,
+  partner_line_id TEXT NOT NULL,
+  partner_name TEXT NOT NULL,
+  action TEXT NOT NULL,
+  changed_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_line_id) REFERENCES users(line_user_id) ON DELETE CASCADE
+);
+
+-- ユーザーごとに履歴を探すためのインデックス
+CREATE INDEX idx_match_history_user_changed_at ON match_history(user_line_id, changed_at);
+
+-- 管理API（/admin/）の操作の記録（誰が・どこから・何をしたか）
+-- actor: 認証した管理者（token / basic:<ユーザー名>）
+-- action: user_lookup / match_list / force_unmatch / user_delete / stats
+-- result: 成功なら ok、失敗ならエラーの内容
+-- ユーザーを削除しても記録が残るよう、users は参照しない
+CREATE TABLE admin_audit_log (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  actor TEXT NOT NULL,
+  remote_ip TEXT NOT NULL,
+  action TEXT NOT NULL,
+  target TEXT NOT NULL DEFAULT '',
+  detail TEXT NOT NULL DEFAULT '',
+  result TEXT NOT NULL,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
 
--- 好きな人の検索用インデックス
-CREATE INDEX idx_users_crush ON users(crush_name, crush_birthday);
+-- 期間で記録を探すためのインデックス
+CREATE INDEX idx_admin_audit_log_created_at ON admin_audit_log(created_at);
@@ -0,0 +1,107 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/internal/repository"
+	"github.com/morinonusi421/cupid/internal/service"
+	"github.com/morinonusi421/cupid/pkg/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConcurrency_RegisterCrush は、お互いを好きな人として同時に登録した場合でも
+// 片側だけマッチした状態や、複数人とマッチした状態にならないことを確認する。
+//
+// LINE API は使用しないため、環境変数がなくても実行される。
+func TestConcurrency_RegisterCrush(t *testing.T) {
+	db := testutil.SetupTestDB(t, "cupid_concurrency_test.db")
+	defer db.Close()
+
+	userRepo := repository.NewUserRepository(db)
+	crushRepo := repository.NewCrushRepository(db)
+	notificationService := service.NewNotificationService(&mockLineBotClient{}, repository.NewNotificationRepository(db), pushLowPriorityLimit)
+	matchingService := service.NewMatchingService(userRepo, repository.NewMatchHistoryRepository(db), agePolicy)
+	userService := service.NewUserService(userRepo, crushRepo, repository.NewCrushChangeRepository(db), "https://liff.example.com/user", "https://liff.example.com/crush", maxCrushesPerUser, crushChangePolicy, agePolicy, matchingService, notificationService)
+
+	ctx := context.Background()
+
+	// カタカナのみの名前を作るため、番号をカタカナに変換する
+	digits := []rune("アイウエオカキクケコ")
+	katakanaNumber := func(n int) string {
+		var s []rune
+		for _, r := range fmt.Sprintf("%02d", n) {
+			s = append(s, digits[r-'0'])
+		}
+		return string(s)
+	}
+
+	type person struct {
+		lineID   string
+		name     string
+		birthday model.Birthday
+	}
+
+	const pairCount = 20
+	var pairs [][2]person
+	for i := 0; i < pairCount; i++ {
+		a := person{lineID: fmt.Sprintf("U-concurrent-a-%d", i), name: "アリス" + katakanaNumber(i), birthday: "1990-01-01"}
+		b := person{lineID: fmt.Sprintf("U-concurrent-b-%d", i), name: "ボブ" + katakanaNumber(i), birthday: "1995-05-05"}
+		for _, p := range []person{a, b} {
+			require.NoError(t, userRepo.Create(ctx, &model.User{LineID: p.lineID, Name: p.name, Birthday: p.birthday}))
+		}
+		pairs = append(pairs, [2]person{a, b})
+	}
+
+	// 各ペアがお互いを同時に登録する（同じ人が同じ内容を繰り返し送信するケースも含める）
+	const repeat = 3
+	var wg sync.WaitGroup
+	errCh := make(chan error, pairCount*2*repeat)
+	for _, pair := range pairs {
+		for i := 0; i < repeat; i++ {
+			for _, dir := range [][2]person{{pair[0], pair[1]}, {pair[1], pair[0]}} {
+				wg.Add(1)
+				go func(from, to person) {
+					defer wg.Done()
+					_, _, err := userService.RegisterCrush(ctx, from.lineID, to.name, "", to.birthday, false)
+					// マッチ成立後の再送信は matched_user_exists になるのが正しい挙動
+					if err != nil && !errors.Is(err, service.ErrMatchedUserExists) {
+						errCh <- fmt.Errorf("RegisterCrush(%s): %w", from.lineID, err)
+					}
+				}(dir[0], dir[1])
+			}
+		}
+	}
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		t.Error(err)
+	}
+
+	// 全ペアがお互いとだけマッチしていること
+	for _, pair := range pairs {
+		a, err := userRepo.FindByLineID(ctx, pair[0].lineID)
+		require.NoError(t, err)
+		b, err := userRepo.FindByLineID(ctx, pair[1].lineID)
+		require.NoError(t, err)
+
+		assert.Equal(t, b.LineID, a.MatchedWithUserID.String, "%s should be matched with %s", a.LineID, b.LineID)
+		assert.Equal(t, a.LineID, b.MatchedWithUserID.String, "%s should be matched with %s", b.LineID, a.LineID)
+
+		// 再送信しても好きな人は重複して登録されない
+		crushesA, err := crushRepo.ListByUserID(ctx, a.LineID)
+		require.NoError(t, err)
+		require.Len(t, crushesA, 1)
+		assert.Equal(t, b.Name, crushesA[0].Name)
+		crushesB, err := crushRepo.ListByUserID(ctx, b.LineID)
+		require.NoError(t, err)
+		require.Len(t, crushesB, 1)
+		assert.Equal(t, a.Name, crushesB[0].Name)
+	}
+}
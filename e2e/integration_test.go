@@ -22,25 +22,46 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
 	"github.com/morinonusi421/cupid/internal/handler"
 	"github.com/morinonusi421/cupid/internal/linebot"
 	"github.com/morinonusi421/cupid/internal/middleware"
+	"github.com/morinonusi421/cupid/internal/model"
 	"github.com/morinonusi421/cupid/internal/repository"
 	"github.com/morinonusi421/cupid/internal/service"
 	"github.com/morinonusi421/cupid/pkg/testutil"
+	"github.com/morinonusi421/cupid/pkg/workerpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 const (
-	testDBFile = "cupid_test.db"
+	testDBFile        = "cupid_test.db"
+	maxCrushesPerUser = 3
+	// pushLowPriorityLimit は低優先度のメッセージを送信する月間の上限（PUSH_MONTHLY_QUOTA=200 の 80%）
+	pushLowPriorityLimit = 160
 )
 
+// crushChangePolicy は好きな人の登録し直しの制限（CRUSH_* のデフォルト、連続で登録するため間隔の制限はなし）
+var crushChangePolicy = service.CrushChangePolicy{
+	DailyLimit:  10,
+	MaxDistinct: 6,
+	Window:      30 * 24 * time.Hour,
+}
+
+// agePolicy は年齢による登録の制限（AGE_* のデフォルト）
+var agePolicy = service.AgePolicy{
+	MinAge:   13,
+	MaxGap:   10,
+	AdultAge: 18,
+}
+
 var (
 	channelSecret string
 	channelToken  string
@@ -79,33 +100,65 @@ func (m *mockLineBotClient) PushMessage(request *messaging_api.PushMessageReques
 	return &messaging_api.PushMessageResponse{}, nil
 }
 
+func (m *mockLineBotClient) PushMessageWithRetryKey(request *messaging_api.PushMessageRequest, retryKey string) (*messaging_api.PushMessageResponse, error) {
+	return &messaging_api.PushMessageResponse{}, nil
+}
+
+func (m *mockLineBotClient) MonthlyUsage(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (m *mockLineBotClient) Quota(ctx context.Context) (*linebot.Quota, error) {
+	return &linebot.Quota{Limit: -1}, nil
+}
+
+var (
+	// webhookPool は setupTestEnvironment で作成した Webhook イベントのワーカープール
+	webhookPool *workerpool.Pool
+	// notificationDispatcher は setupTestEnvironment で作成した送信待ちPush通知の送信サービス
+	notificationDispatcher service.NotificationDispatcher
+	// accountAPIHandler は setupTestEnvironment で作成したアカウントAPI（退会・データのエクスポート）
+	accountAPIHandler *handler.AccountAPIHandler
+	// adminAPIHandler は setupTestEnvironment で作成した管理API
+	adminAPIHandler *handler.AdminAPIHandler
+)
+
 func setupTestEnvironment(t *testing.T) (*handler.WebhookHandler, *handler.UserRegistrationAPIHandler, *handler.CrushRegistrationAPIHandler, *sql.DB) {
 	// Initialize test database with schema
-	db := testutil.SetupTestDB(t, testDBFile, "../db/schema.sql")
+	db := testutil.SetupTestDB(t, testDBFile)
 
 	// Initialize LINE Bot client (real or mock)
 	var lineBotClient linebot.Client
 	if channelToken != "" && os.Getenv("SKIP_LINE_API") != "true" {
 		botAPI, err := messaging_api.NewMessagingApiAPI(channelToken)
 		require.NoError(t, err)
-		lineBotClient = linebot.NewClient(botAPI)
+		lineBotClient = linebot.NewClient(botAPI, repository.NewPushLedgerRepository(db))
 	} else {
 		lineBotClient = &mockLineBotClient{}
 	}
 
 	// Initialize real repositories
 	userRepo := repository.NewUserRepository(db)
+	crushRepo := repository.NewCrushRepository(db)
+	webhookEventRepo := repository.NewWebhookEventRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
 
 	// Initialize real services
-	notificationService := service.NewNotificationService(lineBotClient)
-	matchingService := service.NewMatchingService(userRepo)
+	notificationService := service.NewNotificationService(lineBotClient, notificationRepo, pushLowPriorityLimit)
+	notificationDispatcher = service.NewNotificationDispatcher(notificationRepo, lineBotClient, service.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute})
+	matchingService := service.NewMatchingService(userRepo, repository.NewMatchHistoryRepository(db), agePolicy)
 	// Use registerURL for both user and crush LIFF URLs in tests
-	userService := service.NewUserService(userRepo, registerURL, registerURL, matchingService, notificationService)
+	userService := service.NewUserService(userRepo, crushRepo, repository.NewCrushChangeRepository(db), registerURL, registerURL, maxCrushesPerUser, crushChangePolicy, agePolicy, matchingService, notificationService)
+	webhookEventService := service.NewWebhookEventService(webhookEventRepo, time.Hour, 10*time.Minute)
 
 	// Initialize real handlers
-	webhookHandler := handler.NewWebhookHandler(channelSecret, lineBotClient, userService)
+	webhookPool = workerpool.New(1, 10)
+	t.Cleanup(func() { webhookPool.Drain(context.Background()) })
+	webhookHandler := handler.NewWebhookHandler(channelSecret, lineBotClient, userService, webhookEventService, webhookPool, time.Second)
 	userRegistrationAPIHandler := handler.NewUserRegistrationAPIHandler(userService)
 	crushRegistrationAPIHandler := handler.NewCrushRegistrationAPIHandler(userService, registerURL)
+	accountAPIHandler = handler.NewAccountAPIHandler(userService)
+	adminAPIHandler = handler.NewAdminAPIHandler(service.NewAdminService(userRepo, crushRepo, repository.NewAdminAuditRepository(db), repository.NewStatsRepository(db), userService, notificationService), webhookPool)
 
 	return webhookHandler, userRegistrationAPIHandler, crushRegistrationAPIHandler, db
 }
@@ -130,15 +183,22 @@ func sendWebhook(t *testing.T, handler *handler.WebhookHandler, events []interfa
 	rec := httptest.NewRecorder()
 	handler.Handle(rec, req)
 
+	// イベントは非同期に処理されるため完了を待つ
+	webhookPool.Wait()
+
 	return rec
 }
 
 // registerUserViaAPI registers a user via API (true E2E)
 func registerUserViaAPI(t *testing.T, handler *handler.UserRegistrationAPIHandler, userID, name, birthday string) {
-	reqBody := map[string]interface{}{
+	registerUserBodyViaAPI(t, handler, userID, map[string]interface{}{
 		"name":     name,
 		"birthday": birthday,
-	}
+	})
+}
+
+// registerUserBodyViaAPI registers a user via API with the given request body (e.g. with alt_name)
+func registerUserBodyViaAPI(t *testing.T, handler *handler.UserRegistrationAPIHandler, userID string, reqBody map[string]interface{}) {
 	body, err := json.Marshal(reqBody)
 	require.NoError(t, err)
 
@@ -157,10 +217,14 @@ func registerUserViaAPI(t *testing.T, handler *handler.UserRegistrationAPIHandle
 
 // registerCrushViaAPI registers a crush via API (true E2E) and returns the response
 func registerCrushViaAPI(t *testing.T, handler *handler.CrushRegistrationAPIHandler, userID, crushName, crushBirthday string) map[string]interface{} {
-	reqBody := map[string]interface{}{
+	return registerCrushBodyViaAPI(t, handler, userID, map[string]interface{}{
 		"crush_name":     crushName,
 		"crush_birthday": crushBirthday,
-	}
+	})
+}
+
+// registerCrushBodyViaAPI registers a crush via API with the given request body (e.g. with crush_alt_name) and returns the response
+func registerCrushBodyViaAPI(t *testing.T, handler *handler.CrushRegistrationAPIHandler, userID string, reqBody map[string]interface{}) map[string]interface{} {
 	body, err := json.Marshal(reqBody)
 	require.NoError(t, err)
 
@@ -220,7 +284,7 @@ func TestIntegration_UserRegistrationFlow(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, user)
 	assert.Equal(t, "ヤマダタロウ", user.Name)
-	assert.Equal(t, "1990-01-01", user.Birthday)
+	assert.Equal(t, model.Birthday("1990-01-01"), user.Birthday)
 }
 
 func TestIntegration_CrushRegistrationNoMatch(t *testing.T) {
@@ -281,6 +345,50 @@ func TestIntegration_CrushRegistrationMatch(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, userB.MatchedWithUserID.Valid, "User B should have matched_with_user_id set")
 	assert.Equal(t, userAID, userB.MatchedWithUserID.String, "User B should be matched with User A")
+
+	// Step 6: Match notifications for both users were queued in the same transaction and are delivered by the dispatcher
+	var pending int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM notification_outbox WHERE kind = 'match' AND status = 'pending'").Scan(&pending))
+	assert.Equal(t, 2, pending, "Match notifications should be queued for both users")
+
+	dispatched, err := notificationDispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, dispatched)
+
+	var remaining int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM notification_outbox WHERE kind = 'match' AND status = 'pending' AND attempts = 0").Scan(&remaining))
+	assert.Equal(t, 0, remaining, "Every queued notification should have been attempted")
+}
+
+func TestIntegration_MultipleCrushesMatch(t *testing.T) {
+	if channelSecret == "" {
+		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
+	}
+
+	_, registrationAPIHandler, crushHandler, db := setupTestEnvironment(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	userRepo := repository.NewUserRepository(db)
+
+	userAID := "test-user-multi-a"
+	userBID := "test-user-multi-b"
+
+	// Step 1: User A registers two crushes (User B is the second one)
+	registerUserViaAPI(t, registrationAPIHandler, userAID, "マツモトジュン", "1989-04-04")
+	registerCrushViaAPI(t, crushHandler, userAID, "ナカムラエミ", "1991-01-01")
+	responseA := registerCrushViaAPI(t, crushHandler, userAID, "ハヤシミホ", "1993-03-03")
+	assert.False(t, responseA["matched"].(bool))
+	assert.False(t, responseA["is_first_registration"].(bool))
+
+	// Step 2: User B registers User A - should match via User A's second crush
+	registerUserViaAPI(t, registrationAPIHandler, userBID, "ハヤシミホ", "1993-03-03")
+	responseB := registerCrushViaAPI(t, crushHandler, userBID, "マツモトジュン", "1989-04-04")
+	assert.True(t, responseB["matched"].(bool), "User B should match with User A")
+
+	userA, err := userRepo.FindByLineID(ctx, userAID)
+	require.NoError(t, err)
+	assert.Equal(t, userBID, userA.MatchedWithUserID.String)
 }
 
 func TestIntegration_MatchedUserExistsError(t *testing.T) {
@@ -364,6 +472,69 @@ func TestIntegration_DuplicateUserError(t *testing.T) {
 	assert.Equal(t, "duplicate_user", response["error"])
 }
 
+func TestIntegration_AgePolicy(t *testing.T) {
+	if channelSecret == "" {
+		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
+	}
+
+	_, registrationAPIHandler, crushRegistrationAPIHandler, db := setupTestEnvironment(t)
+	defer db.Close()
+
+	// 誕生日は今日から数えた年齢で作る
+	birthdayAtAge := func(age int) string {
+		return time.Now().AddDate(-age, 0, 0).Format(model.BirthdayLayout)
+	}
+	post := func(userID, path string, reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, err := json.Marshal(reqBody)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, userID))
+
+		rec := httptest.NewRecorder()
+		if path == "/api/register-user" {
+			registrationAPIHandler.Register(rec, req)
+		} else {
+			crushRegistrationAPIHandler.RegisterCrush(rec, req)
+		}
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return rec.Code, response
+	}
+
+	// Step 1: 最低年齢未満はユーザー登録できない
+	code, response := post("test-user-age-child", "/api/register-user", map[string]interface{}{
+		"name":     "コドモタロウ",
+		"birthday": birthdayAtAge(10),
+	})
+	assert.Equal(t, http.StatusForbidden, code)
+	assert.Equal(t, "under_minimum_age", response["error"])
+
+	// Step 2: 成人は未成年を好きな人として登録できない
+	registerUserViaAPI(t, registrationAPIHandler, "test-user-age-adult", "オトナタロウ", birthdayAtAge(30))
+	code, response = post("test-user-age-adult", "/api/register-user-crush", map[string]interface{}{
+		"crush_name":     "ミセイネンハナコ",
+		"crush_birthday": birthdayAtAge(15),
+	})
+	assert.Equal(t, http.StatusForbidden, code)
+	assert.Equal(t, "minor_adult_crush", response["error"])
+
+	// Step 3: 年齢差が上限を超える相手は登録できない
+	code, response = post("test-user-age-adult", "/api/register-user-crush", map[string]interface{}{
+		"crush_name":     "トシウエハナコ",
+		"crush_birthday": birthdayAtAge(45),
+	})
+	assert.Equal(t, http.StatusForbidden, code)
+	assert.Equal(t, "age_gap_too_large", response["error"])
+
+	// Step 4: 未成年同士は登録できる
+	registerUserViaAPI(t, registrationAPIHandler, "test-user-age-minor", "ミセイネンタロウ", birthdayAtAge(15))
+	response = registerCrushViaAPI(t, crushRegistrationAPIHandler, "test-user-age-minor", "ミセイネンハナコ", birthdayAtAge(16))
+	assert.Equal(t, false, response["matched"])
+}
+
 func TestIntegration_UnmatchFlow(t *testing.T) {
 	if channelSecret == "" {
 		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
@@ -410,13 +581,323 @@ func TestIntegration_UnmatchFlow(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, userA.MatchedWithUserID.Valid, "User A should be unmatched")
 	assert.Equal(t, "ヨシダタカシ", userA.Name, "User A's name should be updated")
-	assert.Equal(t, "1986-06-07", userA.Birthday, "User A's birthday should be updated")
+	assert.Equal(t, model.Birthday("1986-06-07"), userA.Birthday, "User A's birthday should be updated")
 
 	userB, err := userRepo.FindByLineID(ctx, userBID)
 	require.NoError(t, err)
 	assert.False(t, userB.MatchedWithUserID.Valid, "User B should be unmatched")
 }
 
+func TestIntegration_ExplicitUnmatchAndWithdrawCrush(t *testing.T) {
+	if channelSecret == "" {
+		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
+	}
+
+	_, registrationAPIHandler, crushHandler, db := setupTestEnvironment(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	userRepo := repository.NewUserRepository(db)
+
+	userAID := "test-user-explicit-unmatch-a"
+	userBID := "test-user-explicit-unmatch-b"
+
+	countUnmatchNotifications := func(userID string) int {
+		var n int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM notification_outbox WHERE to_user_id = ? AND kind = 'unmatch'", userID).Scan(&n))
+		return n
+	}
+
+	// Step 1: Create matched users
+	registerUserViaAPI(t, registrationAPIHandler, userAID, "ヤマモトショウ", "1989-09-09")
+	registerCrushViaAPI(t, crushHandler, userAID, "イシカワアヤ", "1990-10-10")
+	registerUserViaAPI(t, registrationAPIHandler, userBID, "イシカワアヤ", "1990-10-10")
+	responseB := registerCrushViaAPI(t, crushHandler, userBID, "ヤマモトショウ", "1989-09-09")
+	require.True(t, responseB["matched"].(bool), "Users should be matched")
+
+	// Step 2: User A unmatches without changing their info
+	req := httptest.NewRequest(http.MethodPost, "/api/unmatch", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, userAID))
+	rec := httptest.NewRecorder()
+	accountAPIHandler.Unmatch(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	userA, err := userRepo.FindByLineID(ctx, userAID)
+	require.NoError(t, err)
+	assert.False(t, userA.MatchedWithUserID.Valid, "User A should be unmatched")
+	assert.Equal(t, "ヤマモトショウ", userA.Name, "User A's info should not change")
+
+	var crushes int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM crushes WHERE user_line_id = ?", userAID).Scan(&crushes))
+	assert.Equal(t, 0, crushes, "User A's crush on User B should be withdrawn")
+	assert.Equal(t, 1, countUnmatchNotifications(userBID), "User B should be notified")
+	assert.Equal(t, 0, countUnmatchNotifications(userAID), "User A should not be pushed")
+
+	// Step 3: Match again, then User B withdraws their crush on User A
+	responseA := registerCrushViaAPI(t, crushHandler, userAID, "イシカワアヤ", "1990-10-10")
+	require.True(t, responseA["matched"].(bool), "Users should be matched again")
+
+	var crushID int64
+	require.NoError(t, db.QueryRow("SELECT id FROM crushes WHERE user_line_id = ?", userBID).Scan(&crushID))
+
+	withdrawCrush := func(confirmUnmatch bool) int {
+		body, err := json.Marshal(map[string]interface{}{"crush_id": crushID, "confirm_unmatch": confirmUnmatch})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/api/withdraw-crush", bytes.NewReader(body))
+		req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, userBID))
+		rec := httptest.NewRecorder()
+		crushHandler.WithdrawCrush(rec, req)
+		return rec.Code
+	}
+
+	// Withdrawing the matched crush needs confirmation
+	assert.Equal(t, http.StatusConflict, withdrawCrush(false))
+	assert.Equal(t, http.StatusOK, withdrawCrush(true))
+
+	userB, err := userRepo.FindByLineID(ctx, userBID)
+	require.NoError(t, err)
+	assert.False(t, userB.MatchedWithUserID.Valid, "User B should be unmatched")
+	assert.Equal(t, 1, countUnmatchNotifications(userAID), "User A should be notified")
+
+	// The crush is already withdrawn
+	assert.Equal(t, http.StatusNotFound, withdrawCrush(false))
+}
+
+func TestIntegration_UnfollowDeletesUser(t *testing.T) {
+	if channelSecret == "" {
+		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
+	}
+
+	webhookHandler, registrationAPIHandler, crushHandler, db := setupTestEnvironment(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	userRepo := repository.NewUserRepository(db)
+
+	userAID := "test-user-unfollow-a"
+	userBID := "test-user-unfollow-b"
+
+	// Step 1: Create matched users
+	registerUserViaAPI(t, registrationAPIHandler, userAID, "ナカムラケンタ", "1991-01-11")
+	registerCrushViaAPI(t, crushHandler, userAID, "コバヤシミホ", "1993-03-13")
+	registerUserViaAPI(t, registrationAPIHandler, userBID, "コバヤシミホ", "1993-03-13")
+	responseB := registerCrushViaAPI(t, crushHandler, userBID, "ナカムラケンタ", "1991-01-11")
+	require.True(t, responseB["matched"].(bool), "Users should be matched")
+
+	// Step 2: User A blocks the bot
+	unfollowEvent := map[string]interface{}{
+		"type": "unfollow",
+		"source": map[string]interface{}{
+			"type":   "user",
+			"userId": userAID,
+		},
+		"mode": "active",
+	}
+	rec := sendWebhook(t, webhookHandler, []interface{}{unfollowEvent})
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Step 3: User A is deleted and User B is unmatched and notified
+	userA, err := userRepo.FindByLineID(ctx, userAID)
+	require.NoError(t, err)
+	assert.Nil(t, userA, "User A should be deleted")
+
+	userB, err := userRepo.FindByLineID(ctx, userBID)
+	require.NoError(t, err)
+	assert.False(t, userB.MatchedWithUserID.Valid, "User B should be unmatched")
+
+	var notified int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM notification_outbox WHERE to_user_id = ? AND kind = 'unmatch' AND status = 'pending'", userBID).Scan(&notified))
+	assert.Equal(t, 1, notified, "User B should be notified of the withdrawal")
+
+	var crushes int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM crushes WHERE user_line_id = ?", userAID).Scan(&crushes))
+	assert.Equal(t, 0, crushes, "User A's crushes should be removed")
+
+	// Step 4: User B's crush on the deleted user no longer matches anyone
+	responseB = registerCrushViaAPI(t, crushHandler, userBID, "ナカムラケンタ", "1991-01-11")
+	assert.False(t, responseB["matched"].(bool), "Deleted user should not be matched")
+
+	// Step 5: User A can register again after re-following
+	registerUserViaAPI(t, registrationAPIHandler, userAID, "ナカムラケンタ", "1991-01-11")
+	userA, err = userRepo.FindByLineID(ctx, userAID)
+	require.NoError(t, err)
+	require.NotNil(t, userA, "User A should be able to register again")
+	assert.False(t, userA.MatchedWithUserID.Valid, "User A should start unmatched")
+}
+
+func TestIntegration_WithdrawKeepsCrushChangeLimits(t *testing.T) {
+	if channelSecret == "" {
+		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
+	}
+
+	_, registrationAPIHandler, crushHandler, db := setupTestEnvironment(t)
+	defer db.Close()
+
+	userID := "test-user-withdraw-limits"
+	withdraw := func() {
+		req := httptest.NewRequest(http.MethodPost, "/api/withdraw", nil)
+		req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, userID))
+		rec := httptest.NewRecorder()
+		accountAPIHandler.Withdraw(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+	crushNames := []string{"アオキハナコ", "イノウエサチコ", "ウエダユミ", "エンドウマキ", "オカダナナ", "カトウリサ"}
+
+	// Step 1: 退会と登録し直しを挟みながら、期間内に登録できる人数（6人）まで好きな人を登録する
+	for i := 0; i < len(crushNames); i += maxCrushesPerUser {
+		registerUserViaAPI(t, registrationAPIHandler, userID, "サトウタロウ", "1990-01-01")
+		for _, name := range crushNames[i : i+maxCrushesPerUser] {
+			registerCrushViaAPI(t, crushHandler, userID, name, "1992-02-02")
+		}
+		withdraw()
+	}
+
+	// Step 2: 登録し直しても、登録の履歴と1日あたりの登録回数は残っている
+	registerUserViaAPI(t, registrationAPIHandler, userID, "サトウタロウ", "1990-01-01")
+	var histories, changes int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM crush_history WHERE user_line_id = ?", userID).Scan(&histories))
+	assert.Equal(t, len(crushNames), histories)
+	require.NoError(t, db.QueryRow("SELECT COALESCE(SUM(changes), 0) FROM crush_change_counts WHERE user_line_id = ?", userID).Scan(&changes))
+	assert.Equal(t, len(crushNames), changes)
+
+	// Step 3: 7人目は登録できない
+	body, err := json.Marshal(map[string]interface{}{"crush_name": "キムラミサキ", "crush_birthday": "1992-02-02"})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/api/register-crush", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, userID))
+	rec := httptest.NewRecorder()
+	crushHandler.RegisterCrush(rec, req)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Contains(t, rec.Body.String(), "crush_change_restricted")
+}
+
+func TestIntegration_DataExport(t *testing.T) {
+	if channelSecret == "" {
+		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
+	}
+
+	_, registrationAPIHandler, crushHandler, db := setupTestEnvironment(t)
+	defer db.Close()
+
+	userAID := "test-user-export-a"
+	userBID := "test-user-export-b"
+
+	// Step 1: Match users, then User A changes info and unmatches
+	registerUserViaAPI(t, registrationAPIHandler, userAID, "マツモトユウキ", "1992-02-12")
+	registerCrushViaAPI(t, crushHandler, userAID, "イノウエサキ", "1994-04-14")
+	registerUserViaAPI(t, registrationAPIHandler, userBID, "イノウエサキ", "1994-04-14")
+	responseB := registerCrushViaAPI(t, crushHandler, userBID, "マツモトユウキ", "1992-02-12")
+	require.True(t, responseB["matched"].(bool), "Users should be matched")
+
+	body, err := json.Marshal(map[string]interface{}{
+		"name":            "マツモトユウタ",
+		"birthday":        "1992-02-12",
+		"confirm_unmatch": true,
+	})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/api/register-user", bytes.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, userAID))
+	rec := httptest.NewRecorder()
+	registrationAPIHandler.Register(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Step 2: User A exports their data
+	req = httptest.NewRequest(http.MethodGet, "/api/me/export", nil)
+	req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, userAID))
+	rec = httptest.NewRecorder()
+	accountAPIHandler.Export(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	exported := rec.Body.String()
+	var export model.DataExport
+	require.NoError(t, json.Unmarshal([]byte(exported), &export))
+
+	// Step 3: Verify the export
+	assert.Equal(t, userAID, export.User.LineID)
+	assert.Equal(t, "マツモトユウタ", export.User.Name)
+	assert.False(t, export.User.Matched)
+	require.Len(t, export.Crushes, 1)
+	assert.Equal(t, "イノウエサキ", export.Crushes[0].Name)
+	require.Len(t, export.CrushHistory, 1)
+	assert.Equal(t, model.CrushAdded, export.CrushHistory[0].Action)
+
+	require.Len(t, export.MatchHistory, 2)
+	assert.Equal(t, model.MatchStarted, export.MatchHistory[0].Action)
+	assert.Equal(t, model.MatchEnded, export.MatchHistory[1].Action)
+	assert.Equal(t, "イノウエサキ", export.MatchHistory[0].PartnerName)
+
+	kinds := make([]model.NotificationKind, 0, len(export.Notifications))
+	for _, n := range export.Notifications {
+		kinds = append(kinds, n.Kind)
+	}
+	assert.Equal(t, []model.NotificationKind{model.NotificationMatch, model.NotificationUnmatch}, kinds)
+
+	// Other users' LINE IDs are not exported
+	assert.NotContains(t, exported, userBID)
+}
+
+func TestIntegration_NameVariantsMatch(t *testing.T) {
+	if channelSecret == "" {
+		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
+	}
+
+	_, registrationAPIHandler, crushHandler, db := setupTestEnvironment(t)
+	defer db.Close()
+
+	userAID := "test-user-variants-a"
+	userBID := "test-user-variants-b"
+
+	// ひらがな・半角カナ・ヴ/ブの表記ゆれがあってもマッチングする
+	registerUserViaAPI(t, registrationAPIHandler, userAID, "ヴィクトル", "1987-07-07")
+	registerCrushViaAPI(t, crushHandler, userAID, "きょうこ", "1989-09-19")
+	registerUserViaAPI(t, registrationAPIHandler, userBID, "キョウコ", "1989-09-19")
+	responseB := registerCrushViaAPI(t, crushHandler, userBID, "ﾋﾞｸﾄﾙ", "1987-07-07")
+	assert.True(t, responseB["matched"].(bool), "Name variants should match")
+
+	// 好きな人の名前はカタカナに揃えて保存される
+	var crushName string
+	require.NoError(t, db.QueryRow("SELECT crush_name FROM crushes WHERE user_line_id = ?", userAID).Scan(&crushName))
+	assert.Equal(t, "キョウコ", crushName)
+}
+
+func TestIntegration_MultiScriptNamesMatch(t *testing.T) {
+	if channelSecret == "" {
+		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
+	}
+
+	_, registrationAPIHandler, crushHandler, db := setupTestEnvironment(t)
+	defer db.Close()
+
+	userAID := "test-user-scripts-a"
+	userBID := "test-user-scripts-b"
+
+	// A は漢字の名前にローマ字の別の表記、B はローマ字の名前だけで登録する
+	registerUserBodyViaAPI(t, registrationAPIHandler, userAID, map[string]interface{}{
+		"name":     "山田太郎",
+		"alt_name": "Taro Yamada",
+		"birthday": "1990-01-01",
+	})
+	registerUserViaAPI(t, registrationAPIHandler, userBID, "Emma Brown", "1992-02-02")
+
+	// A は B をローマ字（大文字・小文字の違いあり）で登録する
+	responseA := registerCrushViaAPI(t, crushHandler, userAID, "EMMA BROWN", "1992-02-02")
+	assert.False(t, responseA["matched"].(bool))
+
+	// B は A をカタカナの名前で登録し、ローマ字を別の表記として添える（別の表記同士が一致する）
+	responseB := registerCrushBodyViaAPI(t, crushHandler, userBID, map[string]interface{}{
+		"crush_name":     "ヤマダタロウ",
+		"crush_alt_name": "taro yamada",
+		"crush_birthday": "1990-01-01",
+	})
+	assert.True(t, responseB["matched"].(bool), "Any registered spelling pair should match")
+
+	var altName, crushAltName string
+	require.NoError(t, db.QueryRow("SELECT alt_name FROM users WHERE line_user_id = ?", userAID).Scan(&altName))
+	assert.Equal(t, "Taro Yamada", altName)
+	require.NoError(t, db.QueryRow("SELECT crush_alt_name FROM crushes WHERE user_line_id = ?", userBID).Scan(&crushAltName))
+	assert.Equal(t, "taro yamada", crushAltName)
+}
+
 func TestIntegration_ValidationError(t *testing.T) {
 	if channelSecret == "" {
 		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
@@ -431,20 +912,37 @@ func TestIntegration_ValidationError(t *testing.T) {
 		expectedMsg string
 	}{
 		{
-			name: "漢字を含む名前",
+			name: "数字を含むカタカナの名前",
 			requestBody: map[string]interface{}{
-				"name":     "山田太郎",
+				"name":     "ヤマダ123",
 				"birthday": "1990-01-01",
 			},
 			expectedMsg: "名前は全角カタカナ2〜20文字で入力してください（スペース不可）",
 		},
 		{
-			name: "ひらがなを含む名前",
+			name: "記号を含む漢字の名前",
 			requestBody: map[string]interface{}{
-				"name":     "やまだたろう",
+				"name":     "山田★",
 				"birthday": "1990-01-01",
 			},
-			expectedMsg: "名前は全角カタカナ2〜20文字で入力してください（スペース不可）",
+			expectedMsg: "漢字の名前は漢字・ひらがな・カタカナで入力してください（スペース不可）",
+		},
+		{
+			name: "数字を含むローマ字の名前",
+			requestBody: map[string]interface{}{
+				"name":     "Yamada2",
+				"birthday": "1990-01-01",
+			},
+			expectedMsg: "ローマ字の名前は英字で入力してください",
+		},
+		{
+			name: "不正な別の表記",
+			requestBody: map[string]interface{}{
+				"name":     "ヤマダタロウ",
+				"alt_name": "T",
+				"birthday": "1990-01-01",
+			},
+			expectedMsg: "別の表記：ローマ字の名前は2〜40文字で入力してください",
 		},
 	}
 
@@ -472,3 +970,100 @@ func TestIntegration_ValidationError(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegration_AdminAPI(t *testing.T) {
+	if channelSecret == "" {
+		t.Skip("LINE_CHANNEL_SECRET not set, skipping integration test")
+	}
+
+	_, registrationAPIHandler, crushHandler, db := setupTestEnvironment(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	userRepo := repository.NewUserRepository(db)
+
+	// cmd/server と同じルーティング（Bearer トークン、127.0.0.1 からのみ）
+	const adminToken = "admin-token-0123456789"
+	adminAuth := middleware.NewAdminAuth(adminToken, "", "", []netip.Prefix{netip.MustParsePrefix("127.0.0.1/32")})
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/users", adminAuth.Authenticate(adminAPIHandler.LookupUser))
+	mux.HandleFunc("DELETE /admin/users/{line_id}", adminAuth.Authenticate(adminAPIHandler.DeleteUser))
+	mux.HandleFunc("POST /admin/users/{line_id}/unmatch", adminAuth.Authenticate(adminAPIHandler.ForceUnmatch))
+	mux.HandleFunc("GET /admin/matches", adminAuth.Authenticate(adminAPIHandler.ListMatches))
+	mux.HandleFunc("GET /admin/stats", adminAuth.Authenticate(adminAPIHandler.Stats))
+
+	callAdmin := func(method, target, remoteAddr string) (int, map[string]interface{}) {
+		req := httptest.NewRequest(method, target, nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return rec.Code, response
+	}
+	countRows := func(query string, args ...interface{}) int {
+		var n int
+		require.NoError(t, db.QueryRow(query, args...).Scan(&n))
+		return n
+	}
+
+	userAID := "test-user-admin-a"
+	userBID := "test-user-admin-b"
+
+	// Step 1: Create matched users
+	registerUserViaAPI(t, registrationAPIHandler, userAID, "モリタユウキ", "1992-06-16")
+	registerCrushViaAPI(t, crushHandler, userAID, "ハヤシマオ", "1994-04-14")
+	registerUserViaAPI(t, registrationAPIHandler, userBID, "ハヤシマオ", "1994-04-14")
+	responseB := registerCrushViaAPI(t, crushHandler, userBID, "モリタユウキ", "1992-06-16")
+	require.True(t, responseB["matched"].(bool), "Users should be matched")
+
+	// Step 2: Requests from outside the allowlist are rejected and not audited
+	code, _ := callAdmin(http.MethodGet, "/admin/stats", "203.0.113.1:5000")
+	assert.Equal(t, http.StatusForbidden, code)
+	assert.Equal(t, 0, countRows("SELECT COUNT(*) FROM admin_audit_log"))
+
+	// Step 3: Look up by name (hiragana is normalized) and birthday, and list matches
+	code, user := callAdmin(http.MethodGet, "/admin/users?name=%E3%81%AF%E3%82%84%E3%81%97%E3%81%BE%E3%81%8A&birthday=1994-04-14", "127.0.0.1:5000")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, userBID, user["line_id"])
+	assert.Equal(t, userAID, user["matched_with"].(map[string]interface{})["line_id"])
+
+	code, matches := callAdmin(http.MethodGet, "/admin/matches", "127.0.0.1:5000")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(1), matches["count"])
+
+	// Step 4: Force unmatch removes both crushes and notifies both users
+	code, _ = callAdmin(http.MethodPost, "/admin/users/"+userAID+"/unmatch", "127.0.0.1:5000")
+	require.Equal(t, http.StatusOK, code)
+
+	for _, id := range []string{userAID, userBID} {
+		u, err := userRepo.FindByLineID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, u.MatchedWithUserID.Valid, "%s should be unmatched", id)
+		assert.Equal(t, 0, countRows("SELECT COUNT(*) FROM crushes WHERE user_line_id = ?", id), "%s's crush should be withdrawn", id)
+		assert.Equal(t, 1, countRows("SELECT COUNT(*) FROM notification_outbox WHERE to_user_id = ? AND kind = 'unmatch'", id), "%s should be notified", id)
+	}
+
+	code, response := callAdmin(http.MethodPost, "/admin/users/"+userAID+"/unmatch", "127.0.0.1:5000")
+	assert.Equal(t, http.StatusConflict, code)
+	assert.Equal(t, "not_matched", response["error"])
+
+	// Step 5: Delete a user and check the stats
+	code, _ = callAdmin(http.MethodDelete, "/admin/users/"+userAID, "127.0.0.1:5000")
+	require.Equal(t, http.StatusOK, code)
+	userA, err := userRepo.FindByLineID(ctx, userAID)
+	require.NoError(t, err)
+	assert.Nil(t, userA, "User A should be deleted")
+
+	code, stats := callAdmin(http.MethodGet, "/admin/stats", "127.0.0.1:5000")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(1), stats["users"])
+	assert.Equal(t, float64(1), stats["withdrawn_users"])
+	assert.Equal(t, float64(0), stats["matched_pairs"])
+
+	// Step 6: Every admin action is audited, including the failed one
+	assert.Equal(t, 6, countRows("SELECT COUNT(*) FROM admin_audit_log WHERE actor = 'token' AND remote_ip = '127.0.0.1'"))
+	assert.Equal(t, 1, countRows("SELECT COUNT(*) FROM admin_audit_log WHERE action = 'force_unmatch' AND result = 'not matched'"))
+	assert.Equal(t, 1, countRows("SELECT COUNT(*) FROM admin_audit_log WHERE action = 'user_delete' AND target = ? AND result = 'ok'", userAID))
+}
@@ -0,0 +1,1028 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AdminAuditLog is an object representing the database table.
+type AdminAuditLog struct {
+	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Actor     string     `boil:"actor" json:"actor" toml:"actor" yaml:"actor"`
+	RemoteIP  string     `boil:"remote_ip" json:"remote_ip" toml:"remote_ip" yaml:"remote_ip"`
+	Action    string     `boil:"action" json:"action" toml:"action" yaml:"action"`
+	Target    string     `boil:"target" json:"target" toml:"target" yaml:"target"`
+	Detail    string     `boil:"detail" json:"detail" toml:"detail" yaml:"detail"`
+	Result    string     `boil:"result" json:"result" toml:"result" yaml:"result"`
+	CreatedAt string     `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *adminAuditLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L adminAuditLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AdminAuditLogColumns = struct {
+	ID        string
+	Actor     string
+	RemoteIP  string
+	Action    string
+	Target    string
+	Detail    string
+	Result    string
+	CreatedAt string
+}{
+	ID:        "id",
+	Actor:     "actor",
+	RemoteIP:  "remote_ip",
+	Action:    "action",
+	Target:    "target",
+	Detail:    "detail",
+	Result:    "result",
+	CreatedAt: "created_at",
+}
+
+var AdminAuditLogTableColumns = struct {
+	ID        string
+	Actor     string
+	RemoteIP  string
+	Action    string
+	Target    string
+	Detail    string
+	Result    string
+	CreatedAt string
+}{
+	ID:        "admin_audit_log.id",
+	Actor:     "admin_audit_log.actor",
+	RemoteIP:  "admin_audit_log.remote_ip",
+	Action:    "admin_audit_log.action",
+	Target:    "admin_audit_log.target",
+	Detail:    "admin_audit_log.detail",
+	Result:    "admin_audit_log.result",
+	CreatedAt: "admin_audit_log.created_at",
+}
+
+// Generated where
+
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var AdminAuditLogWhere = struct {
+	ID        whereHelpernull_Int64
+	Actor     whereHelperstring
+	RemoteIP  whereHelperstring
+	Action    whereHelperstring
+	Target    whereHelperstring
+	Detail    whereHelperstring
+	Result    whereHelperstring
+	CreatedAt whereHelperstring
+}{
+	ID:        whereHelpernull_Int64{field: "\"admin_audit_log\".\"id\""},
+	Actor:     whereHelperstring{field: "\"admin_audit_log\".\"actor\""},
+	RemoteIP:  whereHelperstring{field: "\"admin_audit_log\".\"remote_ip\""},
+	Action:    whereHelperstring{field: "\"admin_audit_log\".\"action\""},
+	Target:    whereHelperstring{field: "\"admin_audit_log\".\"target\""},
+	Detail:    whereHelperstring{field: "\"admin_audit_log\".\"detail\""},
+	Result:    whereHelperstring{field: "\"admin_audit_log\".\"result\""},
+	CreatedAt: whereHelperstring{field: "\"admin_audit_log\".\"created_at\""},
+}
+
+// AdminAuditLogRels is where relationship names are stored.
+var AdminAuditLogRels = struct {
+}{}
+
+// adminAuditLogR is where relationships are stored.
+type adminAuditLogR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*adminAuditLogR) NewStruct() *adminAuditLogR {
+	return &adminAuditLogR{}
+}
+
+// adminAuditLogL is where Load methods for each relationship are stored.
+type adminAuditLogL struct{}
+
+var (
+	adminAuditLogAllColumns            = []string{"id", "actor", "remote_ip", "action", "target", "detail", "result", "created_at"}
+	adminAuditLogColumnsWithoutDefault = []string{"actor", "remote_ip", "action", "result"}
+	adminAuditLogColumnsWithDefault    = []string{"id", "target", "detail", "created_at"}
+	adminAuditLogPrimaryKeyColumns     = []string{"id"}
+	adminAuditLogGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// AdminAuditLogSlice is an alias for a slice of pointers to AdminAuditLog.
+	// This should almost always be used instead of []AdminAuditLog.
+	AdminAuditLogSlice []*AdminAuditLog
+	// AdminAuditLogHook is the signature for custom AdminAuditLog hook methods
+	AdminAuditLogHook func(context.Context, boil.ContextExecutor, *AdminAuditLog) error
+
+	adminAuditLogQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	adminAuditLogType                 = reflect.TypeOf(&AdminAuditLog{})
+	adminAuditLogMapping              = queries.MakeStructMapping(adminAuditLogType)
+	adminAuditLogPrimaryKeyMapping, _ = queries.BindMapping(adminAuditLogType, adminAuditLogMapping, adminAuditLogPrimaryKeyColumns)
+	adminAuditLogInsertCacheMut       sync.RWMutex
+	adminAuditLogInsertCache          = make(map[string]insertCache)
+	adminAuditLogUpdateCacheMut       sync.RWMutex
+	adminAuditLogUpdateCache          = make(map[string]updateCache)
+	adminAuditLogUpsertCacheMut       sync.RWMutex
+	adminAuditLogUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var adminAuditLogAfterSelectMu sync.Mutex
+var adminAuditLogAfterSelectHooks []AdminAuditLogHook
+
+var adminAuditLogBeforeInsertMu sync.Mutex
+var adminAuditLogBeforeInsertHooks []AdminAuditLogHook
+var adminAuditLogAfterInsertMu sync.Mutex
+var adminAuditLogAfterInsertHooks []AdminAuditLogHook
+
+var adminAuditLogBeforeUpdateMu sync.Mutex
+var adminAuditLogBeforeUpdateHooks []AdminAuditLogHook
+var adminAuditLogAfterUpdateMu sync.Mutex
+var adminAuditLogAfterUpdateHooks []AdminAuditLogHook
+
+var adminAuditLogBeforeDeleteMu sync.Mutex
+var adminAuditLogBeforeDeleteHooks []AdminAuditLogHook
+var adminAuditLogAfterDeleteMu sync.Mutex
+var adminAuditLogAfterDeleteHooks []AdminAuditLogHook
+
+var adminAuditLogBeforeUpsertMu sync.Mutex
+var adminAuditLogBeforeUpsertHooks []AdminAuditLogHook
+var adminAuditLogAfterUpsertMu sync.Mutex
+var adminAuditLogAfterUpsertHooks []AdminAuditLogHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AdminAuditLog) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adminAuditLogAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AdminAuditLog) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adminAuditLogBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AdminAuditLog) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adminAuditLogAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AdminAuditLog) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adminAuditLogBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AdminAuditLog) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adminAuditLogAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AdminAuditLog) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adminAuditLogBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AdminAuditLog) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adminAuditLogAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AdminAuditLog) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adminAuditLogBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AdminAuditLog) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adminAuditLogAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAdminAuditLogHook registers your hook function for all future operations.
+func AddAdminAuditLogHook(hookPoint boil.HookPoint, adminAuditLogHook AdminAuditLogHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		adminAuditLogAfterSelectMu.Lock()
+		adminAuditLogAfterSelectHooks = append(adminAuditLogAfterSelectHooks, adminAuditLogHook)
+		adminAuditLogAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		adminAuditLogBeforeInsertMu.Lock()
+		adminAuditLogBeforeInsertHooks = append(adminAuditLogBeforeInsertHooks, adminAuditLogHook)
+		adminAuditLogBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		adminAuditLogAfterInsertMu.Lock()
+		adminAuditLogAfterInsertHooks = append(adminAuditLogAfterInsertHooks, adminAuditLogHook)
+		adminAuditLogAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		adminAuditLogBeforeUpdateMu.Lock()
+		adminAuditLogBeforeUpdateHooks = append(adminAuditLogBeforeUpdateHooks, adminAuditLogHook)
+		adminAuditLogBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		adminAuditLogAfterUpdateMu.Lock()
+		adminAuditLogAfterUpdateHooks = append(adminAuditLogAfterUpdateHooks, adminAuditLogHook)
+		adminAuditLogAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		adminAuditLogBeforeDeleteMu.Lock()
+		adminAuditLogBeforeDeleteHooks = append(adminAuditLogBeforeDeleteHooks, adminAuditLogHook)
+		adminAuditLogBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		adminAuditLogAfterDeleteMu.Lock()
+		adminAuditLogAfterDeleteHooks = append(adminAuditLogAfterDeleteHooks, adminAuditLogHook)
+		adminAuditLogAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		adminAuditLogBeforeUpsertMu.Lock()
+		adminAuditLogBeforeUpsertHooks = append(adminAuditLogBeforeUpsertHooks, adminAuditLogHook)
+		adminAuditLogBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		adminAuditLogAfterUpsertMu.Lock()
+		adminAuditLogAfterUpsertHooks = append(adminAuditLogAfterUpsertHooks, adminAuditLogHook)
+		adminAuditLogAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single adminAuditLog record from the query.
+func (q adminAuditLogQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AdminAuditLog, error) {
+	o := &AdminAuditLog{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: failed to execute a one query for admin_audit_log")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AdminAuditLog records from the query.
+func (q adminAuditLogQuery) All(ctx context.Context, exec boil.ContextExecutor) (AdminAuditLogSlice, error) {
+	var o []*AdminAuditLog
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entities: failed to assign all query results to AdminAuditLog slice")
+	}
+
+	if len(adminAuditLogAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AdminAuditLog records in the query.
+func (q adminAuditLogQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to count admin_audit_log rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q adminAuditLogQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: failed to check if admin_audit_log exists")
+	}
+
+	return count > 0, nil
+}
+
+// AdminAuditLogs retrieves all the records using an executor.
+func AdminAuditLogs(mods ...qm.QueryMod) adminAuditLogQuery {
+	mods = append(mods, qm.From("\"admin_audit_log\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"admin_audit_log\".*"})
+	}
+
+	return adminAuditLogQuery{q}
+}
+
+// FindAdminAuditLog retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAdminAuditLog(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*AdminAuditLog, error) {
+	adminAuditLogObj := &AdminAuditLog{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"admin_audit_log\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, adminAuditLogObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: unable to select from admin_audit_log")
+	}
+
+	if err = adminAuditLogObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return adminAuditLogObj, err
+	}
+
+	return adminAuditLogObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AdminAuditLog) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no admin_audit_log provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(adminAuditLogColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	adminAuditLogInsertCacheMut.RLock()
+	cache, cached := adminAuditLogInsertCache[key]
+	adminAuditLogInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			adminAuditLogAllColumns,
+			adminAuditLogColumnsWithDefault,
+			adminAuditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, adminAuditLogGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(adminAuditLogType, adminAuditLogMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(adminAuditLogType, adminAuditLogMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"admin_audit_log\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"admin_audit_log\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to insert into admin_audit_log")
+	}
+
+	if !cached {
+		adminAuditLogInsertCacheMut.Lock()
+		adminAuditLogInsertCache[key] = cache
+		adminAuditLogInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AdminAuditLog.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AdminAuditLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	adminAuditLogUpdateCacheMut.RLock()
+	cache, cached := adminAuditLogUpdateCache[key]
+	adminAuditLogUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			adminAuditLogAllColumns,
+			adminAuditLogPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, adminAuditLogGeneratedColumns)
+
+		if len(wl) == 0 {
+			return 0, errors.New("entities: unable to update admin_audit_log, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"admin_audit_log\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, adminAuditLogPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(adminAuditLogType, adminAuditLogMapping, append(wl, adminAuditLogPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update admin_audit_log row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by update for admin_audit_log")
+	}
+
+	if !cached {
+		adminAuditLogUpdateCacheMut.Lock()
+		adminAuditLogUpdateCache[key] = cache
+		adminAuditLogUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q adminAuditLogQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all for admin_audit_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected for admin_audit_log")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AdminAuditLogSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entities: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), adminAuditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"admin_audit_log\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, adminAuditLogPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all in adminAuditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected all in update all adminAuditLog")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AdminAuditLog) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no admin_audit_log provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(adminAuditLogColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	adminAuditLogUpsertCacheMut.RLock()
+	cache, cached := adminAuditLogUpsertCache[key]
+	adminAuditLogUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			adminAuditLogAllColumns,
+			adminAuditLogColumnsWithDefault,
+			adminAuditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			adminAuditLogAllColumns,
+			adminAuditLogPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entities: unable to upsert admin_audit_log, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(adminAuditLogAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(adminAuditLogPrimaryKeyColumns))
+			copy(conflict, adminAuditLogPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"admin_audit_log\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(adminAuditLogType, adminAuditLogMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(adminAuditLogType, adminAuditLogMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to upsert admin_audit_log")
+	}
+
+	if !cached {
+		adminAuditLogUpsertCacheMut.Lock()
+		adminAuditLogUpsertCache[key] = cache
+		adminAuditLogUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AdminAuditLog record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AdminAuditLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entities: no AdminAuditLog provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), adminAuditLogPrimaryKeyMapping)
+	sql := "DELETE FROM \"admin_audit_log\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete from admin_audit_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by delete for admin_audit_log")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q adminAuditLogQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entities: no adminAuditLogQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from admin_audit_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for admin_audit_log")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AdminAuditLogSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(adminAuditLogBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), adminAuditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"admin_audit_log\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, adminAuditLogPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from adminAuditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for admin_audit_log")
+	}
+
+	if len(adminAuditLogAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AdminAuditLog) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAdminAuditLog(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AdminAuditLogSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AdminAuditLogSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), adminAuditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"admin_audit_log\".* FROM \"admin_audit_log\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, adminAuditLogPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to reload all in AdminAuditLogSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AdminAuditLogExists checks if the AdminAuditLog row exists.
+func AdminAuditLogExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"admin_audit_log\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: unable to check if admin_audit_log exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AdminAuditLog row exists.
+func (o *AdminAuditLog) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AdminAuditLogExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAdminAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	query := AdminAuditLogs()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAdminAuditLogsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAdminAuditLogsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AdminAuditLogs().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAdminAuditLogsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AdminAuditLogSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAdminAuditLogsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AdminAuditLogExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AdminAuditLog exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AdminAuditLogExists to return true, but got false.")
+	}
+}
+
+func testAdminAuditLogsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	adminAuditLogFound, err := FindAdminAuditLog(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if adminAuditLogFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAdminAuditLogsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AdminAuditLogs().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAdminAuditLogsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AdminAuditLogs().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAdminAuditLogsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	adminAuditLogOne := &AdminAuditLog{}
+	adminAuditLogTwo := &AdminAuditLog{}
+	if err = randomize.Struct(seed, adminAuditLogOne, adminAuditLogDBTypes, false, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, adminAuditLogTwo, adminAuditLogDBTypes, false, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = adminAuditLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = adminAuditLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AdminAuditLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAdminAuditLogsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	adminAuditLogOne := &AdminAuditLog{}
+	adminAuditLogTwo := &AdminAuditLog{}
+	if err = randomize.Struct(seed, adminAuditLogOne, adminAuditLogDBTypes, false, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, adminAuditLogTwo, adminAuditLogDBTypes, false, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = adminAuditLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = adminAuditLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func adminAuditLogBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *AdminAuditLog) error {
+	*o = AdminAuditLog{}
+	return nil
+}
+
+func adminAuditLogAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *AdminAuditLog) error {
+	*o = AdminAuditLog{}
+	return nil
+}
+
+func adminAuditLogAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *AdminAuditLog) error {
+	*o = AdminAuditLog{}
+	return nil
+}
+
+func adminAuditLogBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AdminAuditLog) error {
+	*o = AdminAuditLog{}
+	return nil
+}
+
+func adminAuditLogAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AdminAuditLog) error {
+	*o = AdminAuditLog{}
+	return nil
+}
+
+func adminAuditLogBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AdminAuditLog) error {
+	*o = AdminAuditLog{}
+	return nil
+}
+
+func adminAuditLogAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AdminAuditLog) error {
+	*o = AdminAuditLog{}
+	return nil
+}
+
+func adminAuditLogBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AdminAuditLog) error {
+	*o = AdminAuditLog{}
+	return nil
+}
+
+func adminAuditLogAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AdminAuditLog) error {
+	*o = AdminAuditLog{}
+	return nil
+}
+
+func testAdminAuditLogsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &AdminAuditLog{}
+	o := &AdminAuditLog{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog object: %s", err)
+	}
+
+	AddAdminAuditLogHook(boil.BeforeInsertHook, adminAuditLogBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	adminAuditLogBeforeInsertHooks = []AdminAuditLogHook{}
+
+	AddAdminAuditLogHook(boil.AfterInsertHook, adminAuditLogAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	adminAuditLogAfterInsertHooks = []AdminAuditLogHook{}
+
+	AddAdminAuditLogHook(boil.AfterSelectHook, adminAuditLogAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	adminAuditLogAfterSelectHooks = []AdminAuditLogHook{}
+
+	AddAdminAuditLogHook(boil.BeforeUpdateHook, adminAuditLogBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	adminAuditLogBeforeUpdateHooks = []AdminAuditLogHook{}
+
+	AddAdminAuditLogHook(boil.AfterUpdateHook, adminAuditLogAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	adminAuditLogAfterUpdateHooks = []AdminAuditLogHook{}
+
+	AddAdminAuditLogHook(boil.BeforeDeleteHook, adminAuditLogBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	adminAuditLogBeforeDeleteHooks = []AdminAuditLogHook{}
+
+	AddAdminAuditLogHook(boil.AfterDeleteHook, adminAuditLogAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	adminAuditLogAfterDeleteHooks = []AdminAuditLogHook{}
+
+	AddAdminAuditLogHook(boil.BeforeUpsertHook, adminAuditLogBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	adminAuditLogBeforeUpsertHooks = []AdminAuditLogHook{}
+
+	AddAdminAuditLogHook(boil.AfterUpsertHook, adminAuditLogAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	adminAuditLogAfterUpsertHooks = []AdminAuditLogHook{}
+}
+
+func testAdminAuditLogsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAdminAuditLogsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(adminAuditLogPrimaryKeyColumns, adminAuditLogColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAdminAuditLogsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAdminAuditLogsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AdminAuditLogSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAdminAuditLogsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AdminAuditLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	adminAuditLogDBTypes = map[string]string{`ID`: `INTEGER`, `Actor`: `TEXT`, `RemoteIP`: `TEXT`, `Action`: `TEXT`, `Target`: `TEXT`, `Detail`: `TEXT`, `Result`: `TEXT`, `CreatedAt`: `TEXT`}
+	_                    = bytes.MinRead
+)
+
+func testAdminAuditLogsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(adminAuditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(adminAuditLogAllColumns) == len(adminAuditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAdminAuditLogsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(adminAuditLogAllColumns) == len(adminAuditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdminAuditLog{}
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, adminAuditLogDBTypes, true, adminAuditLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(adminAuditLogAllColumns, adminAuditLogPrimaryKeyColumns) {
+		fields = adminAuditLogAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			adminAuditLogAllColumns,
+			adminAuditLogPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, adminAuditLogGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AdminAuditLogSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAdminAuditLogsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(adminAuditLogAllColumns) == len(adminAuditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AdminAuditLog{}
+	if err = randomize.Struct(seed, &o, adminAuditLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AdminAuditLog: %s", err)
+	}
+
+	count, err := AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, adminAuditLogDBTypes, false, adminAuditLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AdminAuditLog struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AdminAuditLog: %s", err)
+	}
+
+	count, err = AdminAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -8,6 +8,10 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("CrushChangeCountToUserUsingUserLine", testCrushChangeCountToOneUserUsingUserLine)
+	t.Run("CrushHistoryToUserUsingUserLine", testCrushHistoryToOneUserUsingUserLine)
+	t.Run("CrushToUserUsingUserLine", testCrushToOneUserUsingUserLine)
+	t.Run("MatchHistoryToUserUsingUserLine", testMatchHistoryToOneUserUsingUserLine)
 	t.Run("UserToUserUsingMatchedWithUser", testUserToOneUserUsingMatchedWithUser)
 }
 
@@ -18,12 +22,20 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("UserToUserLineCrushChangeCounts", testUserToManyUserLineCrushChangeCounts)
+	t.Run("UserToUserLineCrushHistories", testUserToManyUserLineCrushHistories)
+	t.Run("UserToUserLineCrushes", testUserToManyUserLineCrushes)
+	t.Run("UserToUserLineMatchHistories", testUserToManyUserLineMatchHistories)
 	t.Run("UserToMatchedWithUserUsers", testUserToManyMatchedWithUserUsers)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("CrushChangeCountToUserUsingUserLineCrushChangeCounts", testCrushChangeCountToOneSetOpUserUsingUserLine)
+	t.Run("CrushHistoryToUserUsingUserLineCrushHistories", testCrushHistoryToOneSetOpUserUsingUserLine)
+	t.Run("CrushToUserUsingUserLineCrushes", testCrushToOneSetOpUserUsingUserLine)
+	t.Run("MatchHistoryToUserUsingUserLineMatchHistories", testMatchHistoryToOneSetOpUserUsingUserLine)
 	t.Run("UserToUserUsingMatchedWithUserUsers", testUserToOneSetOpUserUsingMatchedWithUser)
 }
 
@@ -44,6 +56,10 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("UserToUserLineCrushChangeCounts", testUserToManyAddOpUserLineCrushChangeCounts)
+	t.Run("UserToUserLineCrushHistories", testUserToManyAddOpUserLineCrushHistories)
+	t.Run("UserToUserLineCrushes", testUserToManyAddOpUserLineCrushes)
+	t.Run("UserToUserLineMatchHistories", testUserToManyAddOpUserLineMatchHistories)
 	t.Run("UserToMatchedWithUserUsers", testUserToManyAddOpMatchedWithUserUsers)
 }
 
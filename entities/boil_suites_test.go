@@ -12,88 +12,232 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogs)
+	t.Run("CrushChangeCounts", testCrushChangeCounts)
+	t.Run("CrushHistories", testCrushHistories)
+	t.Run("Crushes", testCrushes)
+	t.Run("MatchHistories", testMatchHistories)
+	t.Run("NotificationOutboxes", testNotificationOutboxes)
+	t.Run("PushLedgers", testPushLedgers)
 	t.Run("SchemaMigrations", testSchemaMigrations)
 	t.Run("Users", testUsers)
+	t.Run("WebhookEvents", testWebhookEvents)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsDelete)
+	t.Run("CrushChangeCounts", testCrushChangeCountsDelete)
+	t.Run("CrushHistories", testCrushHistoriesDelete)
+	t.Run("Crushes", testCrushesDelete)
+	t.Run("MatchHistories", testMatchHistoriesDelete)
+	t.Run("NotificationOutboxes", testNotificationOutboxesDelete)
+	t.Run("PushLedgers", testPushLedgersDelete)
 	t.Run("SchemaMigrations", testSchemaMigrationsDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("WebhookEvents", testWebhookEventsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsQueryDeleteAll)
+	t.Run("CrushChangeCounts", testCrushChangeCountsQueryDeleteAll)
+	t.Run("CrushHistories", testCrushHistoriesQueryDeleteAll)
+	t.Run("Crushes", testCrushesQueryDeleteAll)
+	t.Run("MatchHistories", testMatchHistoriesQueryDeleteAll)
+	t.Run("NotificationOutboxes", testNotificationOutboxesQueryDeleteAll)
+	t.Run("PushLedgers", testPushLedgersQueryDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("WebhookEvents", testWebhookEventsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsSliceDeleteAll)
+	t.Run("CrushChangeCounts", testCrushChangeCountsSliceDeleteAll)
+	t.Run("CrushHistories", testCrushHistoriesSliceDeleteAll)
+	t.Run("Crushes", testCrushesSliceDeleteAll)
+	t.Run("MatchHistories", testMatchHistoriesSliceDeleteAll)
+	t.Run("NotificationOutboxes", testNotificationOutboxesSliceDeleteAll)
+	t.Run("PushLedgers", testPushLedgersSliceDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("WebhookEvents", testWebhookEventsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsExists)
+	t.Run("CrushChangeCounts", testCrushChangeCountsExists)
+	t.Run("CrushHistories", testCrushHistoriesExists)
+	t.Run("Crushes", testCrushesExists)
+	t.Run("MatchHistories", testMatchHistoriesExists)
+	t.Run("NotificationOutboxes", testNotificationOutboxesExists)
+	t.Run("PushLedgers", testPushLedgersExists)
 	t.Run("SchemaMigrations", testSchemaMigrationsExists)
 	t.Run("Users", testUsersExists)
+	t.Run("WebhookEvents", testWebhookEventsExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsFind)
+	t.Run("CrushChangeCounts", testCrushChangeCountsFind)
+	t.Run("CrushHistories", testCrushHistoriesFind)
+	t.Run("Crushes", testCrushesFind)
+	t.Run("MatchHistories", testMatchHistoriesFind)
+	t.Run("NotificationOutboxes", testNotificationOutboxesFind)
+	t.Run("PushLedgers", testPushLedgersFind)
 	t.Run("SchemaMigrations", testSchemaMigrationsFind)
 	t.Run("Users", testUsersFind)
+	t.Run("WebhookEvents", testWebhookEventsFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsBind)
+	t.Run("CrushChangeCounts", testCrushChangeCountsBind)
+	t.Run("CrushHistories", testCrushHistoriesBind)
+	t.Run("Crushes", testCrushesBind)
+	t.Run("MatchHistories", testMatchHistoriesBind)
+	t.Run("NotificationOutboxes", testNotificationOutboxesBind)
+	t.Run("PushLedgers", testPushLedgersBind)
 	t.Run("SchemaMigrations", testSchemaMigrationsBind)
 	t.Run("Users", testUsersBind)
+	t.Run("WebhookEvents", testWebhookEventsBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsOne)
+	t.Run("CrushChangeCounts", testCrushChangeCountsOne)
+	t.Run("CrushHistories", testCrushHistoriesOne)
+	t.Run("Crushes", testCrushesOne)
+	t.Run("MatchHistories", testMatchHistoriesOne)
+	t.Run("NotificationOutboxes", testNotificationOutboxesOne)
+	t.Run("PushLedgers", testPushLedgersOne)
 	t.Run("SchemaMigrations", testSchemaMigrationsOne)
 	t.Run("Users", testUsersOne)
+	t.Run("WebhookEvents", testWebhookEventsOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsAll)
+	t.Run("CrushChangeCounts", testCrushChangeCountsAll)
+	t.Run("CrushHistories", testCrushHistoriesAll)
+	t.Run("Crushes", testCrushesAll)
+	t.Run("MatchHistories", testMatchHistoriesAll)
+	t.Run("NotificationOutboxes", testNotificationOutboxesAll)
+	t.Run("PushLedgers", testPushLedgersAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsAll)
 	t.Run("Users", testUsersAll)
+	t.Run("WebhookEvents", testWebhookEventsAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsCount)
+	t.Run("CrushChangeCounts", testCrushChangeCountsCount)
+	t.Run("CrushHistories", testCrushHistoriesCount)
+	t.Run("Crushes", testCrushesCount)
+	t.Run("MatchHistories", testMatchHistoriesCount)
+	t.Run("NotificationOutboxes", testNotificationOutboxesCount)
+	t.Run("PushLedgers", testPushLedgersCount)
 	t.Run("SchemaMigrations", testSchemaMigrationsCount)
 	t.Run("Users", testUsersCount)
+	t.Run("WebhookEvents", testWebhookEventsCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsHooks)
+	t.Run("CrushChangeCounts", testCrushChangeCountsHooks)
+	t.Run("CrushHistories", testCrushHistoriesHooks)
+	t.Run("Crushes", testCrushesHooks)
+	t.Run("MatchHistories", testMatchHistoriesHooks)
+	t.Run("NotificationOutboxes", testNotificationOutboxesHooks)
+	t.Run("PushLedgers", testPushLedgersHooks)
 	t.Run("SchemaMigrations", testSchemaMigrationsHooks)
 	t.Run("Users", testUsersHooks)
+	t.Run("WebhookEvents", testWebhookEventsHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsInsert)
+	t.Run("AdminAuditLogs", testAdminAuditLogsInsertWhitelist)
+	t.Run("CrushChangeCounts", testCrushChangeCountsInsert)
+	t.Run("CrushChangeCounts", testCrushChangeCountsInsertWhitelist)
+	t.Run("CrushHistories", testCrushHistoriesInsert)
+	t.Run("CrushHistories", testCrushHistoriesInsertWhitelist)
+	t.Run("Crushes", testCrushesInsert)
+	t.Run("Crushes", testCrushesInsertWhitelist)
+	t.Run("MatchHistories", testMatchHistoriesInsert)
+	t.Run("MatchHistories", testMatchHistoriesInsertWhitelist)
+	t.Run("NotificationOutboxes", testNotificationOutboxesInsert)
+	t.Run("NotificationOutboxes", testNotificationOutboxesInsertWhitelist)
+	t.Run("PushLedgers", testPushLedgersInsert)
+	t.Run("PushLedgers", testPushLedgersInsertWhitelist)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsert)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("WebhookEvents", testWebhookEventsInsert)
+	t.Run("WebhookEvents", testWebhookEventsInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsReload)
+	t.Run("CrushChangeCounts", testCrushChangeCountsReload)
+	t.Run("CrushHistories", testCrushHistoriesReload)
+	t.Run("Crushes", testCrushesReload)
+	t.Run("MatchHistories", testMatchHistoriesReload)
+	t.Run("NotificationOutboxes", testNotificationOutboxesReload)
+	t.Run("PushLedgers", testPushLedgersReload)
 	t.Run("SchemaMigrations", testSchemaMigrationsReload)
 	t.Run("Users", testUsersReload)
+	t.Run("WebhookEvents", testWebhookEventsReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsReloadAll)
+	t.Run("CrushChangeCounts", testCrushChangeCountsReloadAll)
+	t.Run("CrushHistories", testCrushHistoriesReloadAll)
+	t.Run("Crushes", testCrushesReloadAll)
+	t.Run("MatchHistories", testMatchHistoriesReloadAll)
+	t.Run("NotificationOutboxes", testNotificationOutboxesReloadAll)
+	t.Run("PushLedgers", testPushLedgersReloadAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("WebhookEvents", testWebhookEventsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsSelect)
+	t.Run("CrushChangeCounts", testCrushChangeCountsSelect)
+	t.Run("CrushHistories", testCrushHistoriesSelect)
+	t.Run("Crushes", testCrushesSelect)
+	t.Run("MatchHistories", testMatchHistoriesSelect)
+	t.Run("NotificationOutboxes", testNotificationOutboxesSelect)
+	t.Run("PushLedgers", testPushLedgersSelect)
 	t.Run("SchemaMigrations", testSchemaMigrationsSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("WebhookEvents", testWebhookEventsSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsUpdate)
+	t.Run("CrushChangeCounts", testCrushChangeCountsUpdate)
+	t.Run("CrushHistories", testCrushHistoriesUpdate)
+	t.Run("Crushes", testCrushesUpdate)
+	t.Run("MatchHistories", testMatchHistoriesUpdate)
+	t.Run("NotificationOutboxes", testNotificationOutboxesUpdate)
+	t.Run("PushLedgers", testPushLedgersUpdate)
 	t.Run("SchemaMigrations", testSchemaMigrationsUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("WebhookEvents", testWebhookEventsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsSliceUpdateAll)
+	t.Run("CrushChangeCounts", testCrushChangeCountsSliceUpdateAll)
+	t.Run("CrushHistories", testCrushHistoriesSliceUpdateAll)
+	t.Run("Crushes", testCrushesSliceUpdateAll)
+	t.Run("MatchHistories", testMatchHistoriesSliceUpdateAll)
+	t.Run("NotificationOutboxes", testNotificationOutboxesSliceUpdateAll)
+	t.Run("PushLedgers", testPushLedgersSliceUpdateAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("WebhookEvents", testWebhookEventsSliceUpdateAll)
 }
@@ -4,9 +4,25 @@
 package entities
 
 var TableNames = struct {
-	SchemaMigrations string
-	Users            string
+	AdminAuditLog      string
+	CrushChangeCounts  string
+	CrushHistory       string
+	Crushes            string
+	MatchHistory       string
+	NotificationOutbox string
+	PushLedger         string
+	SchemaMigrations   string
+	Users              string
+	WebhookEvents      string
 }{
-	SchemaMigrations: "schema_migrations",
-	Users:            "users",
+	AdminAuditLog:      "admin_audit_log",
+	CrushChangeCounts:  "crush_change_counts",
+	CrushHistory:       "crush_history",
+	Crushes:            "crushes",
+	MatchHistory:       "match_history",
+	NotificationOutbox: "notification_outbox",
+	PushLedger:         "push_ledger",
+	SchemaMigrations:   "schema_migrations",
+	Users:              "users",
+	WebhookEvents:      "webhook_events",
 }
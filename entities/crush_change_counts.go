@@ -0,0 +1,1151 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// CrushChangeCount is an object representing the database table.
+type CrushChangeCount struct {
+	UserLineID string `boil:"user_line_id" json:"user_line_id" toml:"user_line_id" yaml:"user_line_id"`
+	Day        string `boil:"day" json:"day" toml:"day" yaml:"day"`
+	Changes    int64  `boil:"changes" json:"changes" toml:"changes" yaml:"changes"`
+
+	R *crushChangeCountR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L crushChangeCountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CrushChangeCountColumns = struct {
+	UserLineID string
+	Day        string
+	Changes    string
+}{
+	UserLineID: "user_line_id",
+	Day:        "day",
+	Changes:    "changes",
+}
+
+var CrushChangeCountTableColumns = struct {
+	UserLineID string
+	Day        string
+	Changes    string
+}{
+	UserLineID: "crush_change_counts.user_line_id",
+	Day:        "crush_change_counts.day",
+	Changes:    "crush_change_counts.changes",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var CrushChangeCountWhere = struct {
+	UserLineID whereHelperstring
+	Day        whereHelperstring
+	Changes    whereHelperint64
+}{
+	UserLineID: whereHelperstring{field: "\"crush_change_counts\".\"user_line_id\""},
+	Day:        whereHelperstring{field: "\"crush_change_counts\".\"day\""},
+	Changes:    whereHelperint64{field: "\"crush_change_counts\".\"changes\""},
+}
+
+// CrushChangeCountRels is where relationship names are stored.
+var CrushChangeCountRels = struct {
+	UserLine string
+}{
+	UserLine: "UserLine",
+}
+
+// crushChangeCountR is where relationships are stored.
+type crushChangeCountR struct {
+	UserLine *User `boil:"UserLine" json:"UserLine" toml:"UserLine" yaml:"UserLine"`
+}
+
+// NewStruct creates a new relationship struct
+func (*crushChangeCountR) NewStruct() *crushChangeCountR {
+	return &crushChangeCountR{}
+}
+
+func (o *CrushChangeCount) GetUserLine() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserLine()
+}
+
+func (r *crushChangeCountR) GetUserLine() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserLine
+}
+
+// crushChangeCountL is where Load methods for each relationship are stored.
+type crushChangeCountL struct{}
+
+var (
+	crushChangeCountAllColumns            = []string{"user_line_id", "day", "changes"}
+	crushChangeCountColumnsWithoutDefault = []string{"user_line_id", "day"}
+	crushChangeCountColumnsWithDefault    = []string{"changes"}
+	crushChangeCountPrimaryKeyColumns     = []string{"user_line_id", "day"}
+	crushChangeCountGeneratedColumns      = []string{}
+)
+
+type (
+	// CrushChangeCountSlice is an alias for a slice of pointers to CrushChangeCount.
+	// This should almost always be used instead of []CrushChangeCount.
+	CrushChangeCountSlice []*CrushChangeCount
+	// CrushChangeCountHook is the signature for custom CrushChangeCount hook methods
+	CrushChangeCountHook func(context.Context, boil.ContextExecutor, *CrushChangeCount) error
+
+	crushChangeCountQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	crushChangeCountType                 = reflect.TypeOf(&CrushChangeCount{})
+	crushChangeCountMapping              = queries.MakeStructMapping(crushChangeCountType)
+	crushChangeCountPrimaryKeyMapping, _ = queries.BindMapping(crushChangeCountType, crushChangeCountMapping, crushChangeCountPrimaryKeyColumns)
+	crushChangeCountInsertCacheMut       sync.RWMutex
+	crushChangeCountInsertCache          = make(map[string]insertCache)
+	crushChangeCountUpdateCacheMut       sync.RWMutex
+	crushChangeCountUpdateCache          = make(map[string]updateCache)
+	crushChangeCountUpsertCacheMut       sync.RWMutex
+	crushChangeCountUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var crushChangeCountAfterSelectMu sync.Mutex
+var crushChangeCountAfterSelectHooks []CrushChangeCountHook
+
+var crushChangeCountBeforeInsertMu sync.Mutex
+var crushChangeCountBeforeInsertHooks []CrushChangeCountHook
+var crushChangeCountAfterInsertMu sync.Mutex
+var crushChangeCountAfterInsertHooks []CrushChangeCountHook
+
+var crushChangeCountBeforeUpdateMu sync.Mutex
+var crushChangeCountBeforeUpdateHooks []CrushChangeCountHook
+var crushChangeCountAfterUpdateMu sync.Mutex
+var crushChangeCountAfterUpdateHooks []CrushChangeCountHook
+
+var crushChangeCountBeforeDeleteMu sync.Mutex
+var crushChangeCountBeforeDeleteHooks []CrushChangeCountHook
+var crushChangeCountAfterDeleteMu sync.Mutex
+var crushChangeCountAfterDeleteHooks []CrushChangeCountHook
+
+var crushChangeCountBeforeUpsertMu sync.Mutex
+var crushChangeCountBeforeUpsertHooks []CrushChangeCountHook
+var crushChangeCountAfterUpsertMu sync.Mutex
+var crushChangeCountAfterUpsertHooks []CrushChangeCountHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CrushChangeCount) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushChangeCountAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CrushChangeCount) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushChangeCountBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CrushChangeCount) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushChangeCountAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CrushChangeCount) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushChangeCountBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CrushChangeCount) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushChangeCountAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CrushChangeCount) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushChangeCountBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CrushChangeCount) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushChangeCountAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CrushChangeCount) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushChangeCountBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CrushChangeCount) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushChangeCountAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCrushChangeCountHook registers your hook function for all future operations.
+func AddCrushChangeCountHook(hookPoint boil.HookPoint, crushChangeCountHook CrushChangeCountHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		crushChangeCountAfterSelectMu.Lock()
+		crushChangeCountAfterSelectHooks = append(crushChangeCountAfterSelectHooks, crushChangeCountHook)
+		crushChangeCountAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		crushChangeCountBeforeInsertMu.Lock()
+		crushChangeCountBeforeInsertHooks = append(crushChangeCountBeforeInsertHooks, crushChangeCountHook)
+		crushChangeCountBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		crushChangeCountAfterInsertMu.Lock()
+		crushChangeCountAfterInsertHooks = append(crushChangeCountAfterInsertHooks, crushChangeCountHook)
+		crushChangeCountAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		crushChangeCountBeforeUpdateMu.Lock()
+		crushChangeCountBeforeUpdateHooks = append(crushChangeCountBeforeUpdateHooks, crushChangeCountHook)
+		crushChangeCountBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		crushChangeCountAfterUpdateMu.Lock()
+		crushChangeCountAfterUpdateHooks = append(crushChangeCountAfterUpdateHooks, crushChangeCountHook)
+		crushChangeCountAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		crushChangeCountBeforeDeleteMu.Lock()
+		crushChangeCountBeforeDeleteHooks = append(crushChangeCountBeforeDeleteHooks, crushChangeCountHook)
+		crushChangeCountBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		crushChangeCountAfterDeleteMu.Lock()
+		crushChangeCountAfterDeleteHooks = append(crushChangeCountAfterDeleteHooks, crushChangeCountHook)
+		crushChangeCountAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		crushChangeCountBeforeUpsertMu.Lock()
+		crushChangeCountBeforeUpsertHooks = append(crushChangeCountBeforeUpsertHooks, crushChangeCountHook)
+		crushChangeCountBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		crushChangeCountAfterUpsertMu.Lock()
+		crushChangeCountAfterUpsertHooks = append(crushChangeCountAfterUpsertHooks, crushChangeCountHook)
+		crushChangeCountAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single crushChangeCount record from the query.
+func (q crushChangeCountQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CrushChangeCount, error) {
+	o := &CrushChangeCount{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: failed to execute a one query for crush_change_counts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CrushChangeCount records from the query.
+func (q crushChangeCountQuery) All(ctx context.Context, exec boil.ContextExecutor) (CrushChangeCountSlice, error) {
+	var o []*CrushChangeCount
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entities: failed to assign all query results to CrushChangeCount slice")
+	}
+
+	if len(crushChangeCountAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CrushChangeCount records in the query.
+func (q crushChangeCountQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to count crush_change_counts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q crushChangeCountQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: failed to check if crush_change_counts exists")
+	}
+
+	return count > 0, nil
+}
+
+// UserLine pointed to by the foreign key.
+func (o *CrushChangeCount) UserLine(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"line_user_id\" = ?", o.UserLineID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUserLine allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (crushChangeCountL) LoadUserLine(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCrushChangeCount any, mods queries.Applicator) error {
+	var slice []*CrushChangeCount
+	var object *CrushChangeCount
+
+	if singular {
+		var ok bool
+		object, ok = maybeCrushChangeCount.(*CrushChangeCount)
+		if !ok {
+			object = new(CrushChangeCount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCrushChangeCount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCrushChangeCount))
+			}
+		}
+	} else {
+		s, ok := maybeCrushChangeCount.(*[]*CrushChangeCount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCrushChangeCount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCrushChangeCount))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &crushChangeCountR{}
+		}
+		if !queries.IsNil(object.UserLineID) {
+			args[object.UserLineID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &crushChangeCountR{}
+			}
+
+			if !queries.IsNil(obj.UserLineID) {
+				args[obj.UserLineID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.line_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserLine = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserLineCrushChangeCounts = append(foreign.R.UserLineCrushChangeCounts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserLineID, foreign.LineUserID) {
+				local.R.UserLine = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserLineCrushChangeCounts = append(foreign.R.UserLineCrushChangeCounts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserLine of the crushChangeCount to the related item.
+// Sets o.R.UserLine to related.
+// Adds o to related.R.UserLineCrushChangeCounts.
+func (o *CrushChangeCount) SetUserLine(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crush_change_counts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_line_id"}),
+		strmangle.WhereClause("\"", "\"", 0, crushChangeCountPrimaryKeyColumns),
+	)
+	values := []any{related.LineUserID, o.UserLineID, o.Day}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserLineID, related.LineUserID)
+	if o.R == nil {
+		o.R = &crushChangeCountR{
+			UserLine: related,
+		}
+	} else {
+		o.R.UserLine = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserLineCrushChangeCounts: CrushChangeCountSlice{o},
+		}
+	} else {
+		related.R.UserLineCrushChangeCounts = append(related.R.UserLineCrushChangeCounts, o)
+	}
+
+	return nil
+}
+
+// CrushChangeCounts retrieves all the records using an executor.
+func CrushChangeCounts(mods ...qm.QueryMod) crushChangeCountQuery {
+	mods = append(mods, qm.From("\"crush_change_counts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"crush_change_counts\".*"})
+	}
+
+	return crushChangeCountQuery{q}
+}
+
+// FindCrushChangeCount retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCrushChangeCount(ctx context.Context, exec boil.ContextExecutor, userLineID string, day string, selectCols ...string) (*CrushChangeCount, error) {
+	crushChangeCountObj := &CrushChangeCount{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"crush_change_counts\" where \"user_line_id\"=? AND \"day\"=?", sel,
+	)
+
+	q := queries.Raw(query, userLineID, day)
+
+	err := q.Bind(ctx, exec, crushChangeCountObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: unable to select from crush_change_counts")
+	}
+
+	if err = crushChangeCountObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return crushChangeCountObj, err
+	}
+
+	return crushChangeCountObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CrushChangeCount) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no crush_change_counts provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(crushChangeCountColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	crushChangeCountInsertCacheMut.RLock()
+	cache, cached := crushChangeCountInsertCache[key]
+	crushChangeCountInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			crushChangeCountAllColumns,
+			crushChangeCountColumnsWithDefault,
+			crushChangeCountColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(crushChangeCountType, crushChangeCountMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(crushChangeCountType, crushChangeCountMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"crush_change_counts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"crush_change_counts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to insert into crush_change_counts")
+	}
+
+	if !cached {
+		crushChangeCountInsertCacheMut.Lock()
+		crushChangeCountInsertCache[key] = cache
+		crushChangeCountInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CrushChangeCount.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CrushChangeCount) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	crushChangeCountUpdateCacheMut.RLock()
+	cache, cached := crushChangeCountUpdateCache[key]
+	crushChangeCountUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			crushChangeCountAllColumns,
+			crushChangeCountPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("entities: unable to update crush_change_counts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"crush_change_counts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, crushChangeCountPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(crushChangeCountType, crushChangeCountMapping, append(wl, crushChangeCountPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update crush_change_counts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by update for crush_change_counts")
+	}
+
+	if !cached {
+		crushChangeCountUpdateCacheMut.Lock()
+		crushChangeCountUpdateCache[key] = cache
+		crushChangeCountUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q crushChangeCountQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all for crush_change_counts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected for crush_change_counts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CrushChangeCountSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entities: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), crushChangeCountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"crush_change_counts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, crushChangeCountPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all in crushChangeCount slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected all in update all crushChangeCount")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CrushChangeCount) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no crush_change_counts provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(crushChangeCountColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	crushChangeCountUpsertCacheMut.RLock()
+	cache, cached := crushChangeCountUpsertCache[key]
+	crushChangeCountUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			crushChangeCountAllColumns,
+			crushChangeCountColumnsWithDefault,
+			crushChangeCountColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			crushChangeCountAllColumns,
+			crushChangeCountPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entities: unable to upsert crush_change_counts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(crushChangeCountAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(crushChangeCountPrimaryKeyColumns))
+			copy(conflict, crushChangeCountPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"crush_change_counts\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(crushChangeCountType, crushChangeCountMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(crushChangeCountType, crushChangeCountMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to upsert crush_change_counts")
+	}
+
+	if !cached {
+		crushChangeCountUpsertCacheMut.Lock()
+		crushChangeCountUpsertCache[key] = cache
+		crushChangeCountUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CrushChangeCount record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CrushChangeCount) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entities: no CrushChangeCount provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), crushChangeCountPrimaryKeyMapping)
+	sql := "DELETE FROM \"crush_change_counts\" WHERE \"user_line_id\"=? AND \"day\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete from crush_change_counts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by delete for crush_change_counts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q crushChangeCountQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entities: no crushChangeCountQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from crush_change_counts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for crush_change_counts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CrushChangeCountSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(crushChangeCountBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), crushChangeCountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"crush_change_counts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, crushChangeCountPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from crushChangeCount slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for crush_change_counts")
+	}
+
+	if len(crushChangeCountAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CrushChangeCount) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCrushChangeCount(ctx, exec, o.UserLineID, o.Day)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CrushChangeCountSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CrushChangeCountSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), crushChangeCountPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"crush_change_counts\".* FROM \"crush_change_counts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, crushChangeCountPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to reload all in CrushChangeCountSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CrushChangeCountExists checks if the CrushChangeCount row exists.
+func CrushChangeCountExists(ctx context.Context, exec boil.ContextExecutor, userLineID string, day string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"crush_change_counts\" where \"user_line_id\"=? AND \"day\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userLineID, day)
+	}
+	row := exec.QueryRowContext(ctx, sql, userLineID, day)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: unable to check if crush_change_counts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the CrushChangeCount row exists.
+func (o *CrushChangeCount) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CrushChangeCountExists(ctx, exec, o.UserLineID, o.Day)
+}
@@ -0,0 +1,846 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCrushChangeCounts(t *testing.T) {
+	t.Parallel()
+
+	query := CrushChangeCounts()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCrushChangeCountsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCrushChangeCountsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CrushChangeCounts().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCrushChangeCountsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CrushChangeCountSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCrushChangeCountsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CrushChangeCountExists(ctx, tx, o.UserLineID, o.Day)
+	if err != nil {
+		t.Errorf("Unable to check if CrushChangeCount exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CrushChangeCountExists to return true, but got false.")
+	}
+}
+
+func testCrushChangeCountsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	crushChangeCountFound, err := FindCrushChangeCount(ctx, tx, o.UserLineID, o.Day)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if crushChangeCountFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCrushChangeCountsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CrushChangeCounts().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCrushChangeCountsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CrushChangeCounts().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCrushChangeCountsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	crushChangeCountOne := &CrushChangeCount{}
+	crushChangeCountTwo := &CrushChangeCount{}
+	if err = randomize.Struct(seed, crushChangeCountOne, crushChangeCountDBTypes, false, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+	if err = randomize.Struct(seed, crushChangeCountTwo, crushChangeCountDBTypes, false, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = crushChangeCountOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = crushChangeCountTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CrushChangeCounts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCrushChangeCountsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	crushChangeCountOne := &CrushChangeCount{}
+	crushChangeCountTwo := &CrushChangeCount{}
+	if err = randomize.Struct(seed, crushChangeCountOne, crushChangeCountDBTypes, false, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+	if err = randomize.Struct(seed, crushChangeCountTwo, crushChangeCountDBTypes, false, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = crushChangeCountOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = crushChangeCountTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func crushChangeCountBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CrushChangeCount) error {
+	*o = CrushChangeCount{}
+	return nil
+}
+
+func crushChangeCountAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CrushChangeCount) error {
+	*o = CrushChangeCount{}
+	return nil
+}
+
+func crushChangeCountAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CrushChangeCount) error {
+	*o = CrushChangeCount{}
+	return nil
+}
+
+func crushChangeCountBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CrushChangeCount) error {
+	*o = CrushChangeCount{}
+	return nil
+}
+
+func crushChangeCountAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CrushChangeCount) error {
+	*o = CrushChangeCount{}
+	return nil
+}
+
+func crushChangeCountBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CrushChangeCount) error {
+	*o = CrushChangeCount{}
+	return nil
+}
+
+func crushChangeCountAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CrushChangeCount) error {
+	*o = CrushChangeCount{}
+	return nil
+}
+
+func crushChangeCountBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CrushChangeCount) error {
+	*o = CrushChangeCount{}
+	return nil
+}
+
+func crushChangeCountAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CrushChangeCount) error {
+	*o = CrushChangeCount{}
+	return nil
+}
+
+func testCrushChangeCountsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CrushChangeCount{}
+	o := &CrushChangeCount{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount object: %s", err)
+	}
+
+	AddCrushChangeCountHook(boil.BeforeInsertHook, crushChangeCountBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	crushChangeCountBeforeInsertHooks = []CrushChangeCountHook{}
+
+	AddCrushChangeCountHook(boil.AfterInsertHook, crushChangeCountAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	crushChangeCountAfterInsertHooks = []CrushChangeCountHook{}
+
+	AddCrushChangeCountHook(boil.AfterSelectHook, crushChangeCountAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	crushChangeCountAfterSelectHooks = []CrushChangeCountHook{}
+
+	AddCrushChangeCountHook(boil.BeforeUpdateHook, crushChangeCountBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	crushChangeCountBeforeUpdateHooks = []CrushChangeCountHook{}
+
+	AddCrushChangeCountHook(boil.AfterUpdateHook, crushChangeCountAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	crushChangeCountAfterUpdateHooks = []CrushChangeCountHook{}
+
+	AddCrushChangeCountHook(boil.BeforeDeleteHook, crushChangeCountBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	crushChangeCountBeforeDeleteHooks = []CrushChangeCountHook{}
+
+	AddCrushChangeCountHook(boil.AfterDeleteHook, crushChangeCountAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	crushChangeCountAfterDeleteHooks = []CrushChangeCountHook{}
+
+	AddCrushChangeCountHook(boil.BeforeUpsertHook, crushChangeCountBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	crushChangeCountBeforeUpsertHooks = []CrushChangeCountHook{}
+
+	AddCrushChangeCountHook(boil.AfterUpsertHook, crushChangeCountAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	crushChangeCountAfterUpsertHooks = []CrushChangeCountHook{}
+}
+
+func testCrushChangeCountsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCrushChangeCountsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(crushChangeCountPrimaryKeyColumns, crushChangeCountColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCrushChangeCountToOneUserUsingUserLine(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CrushChangeCount
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, crushChangeCountDBTypes, false, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserLineID, foreign.LineUserID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.UserLine().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.LineUserID, foreign.LineUserID) {
+		t.Errorf("want: %v, got %v", foreign.LineUserID, check.LineUserID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := CrushChangeCountSlice{&local}
+	if err = local.L.LoadUserLine(ctx, tx, false, (*[]*CrushChangeCount)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.UserLine == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.UserLine = nil
+	if err = local.L.LoadUserLine(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.UserLine == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testCrushChangeCountToOneSetOpUserUsingUserLine(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CrushChangeCount
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, crushChangeCountDBTypes, false, strmangle.SetComplement(crushChangeCountPrimaryKeyColumns, crushChangeCountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUserLine(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.UserLine != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.UserLineCrushChangeCounts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserLineID, x.LineUserID) {
+			t.Error("foreign key was wrong value", a.UserLineID)
+		}
+
+		if exists, err := CrushChangeCountExists(ctx, tx, a.UserLineID, a.Day); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testCrushChangeCountsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCrushChangeCountsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CrushChangeCountSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCrushChangeCountsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CrushChangeCounts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	crushChangeCountDBTypes = map[string]string{`UserLineID`: `TEXT`, `Day`: `TEXT`, `Changes`: `INTEGER`}
+	_                       = bytes.MinRead
+)
+
+func testCrushChangeCountsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(crushChangeCountPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(crushChangeCountAllColumns) == len(crushChangeCountPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCrushChangeCountsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(crushChangeCountAllColumns) == len(crushChangeCountPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushChangeCount{}
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, crushChangeCountDBTypes, true, crushChangeCountPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(crushChangeCountAllColumns, crushChangeCountPrimaryKeyColumns) {
+		fields = crushChangeCountAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			crushChangeCountAllColumns,
+			crushChangeCountPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CrushChangeCountSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCrushChangeCountsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(crushChangeCountAllColumns) == len(crushChangeCountPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CrushChangeCount{}
+	if err = randomize.Struct(seed, &o, crushChangeCountDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CrushChangeCount: %s", err)
+	}
+
+	count, err := CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, crushChangeCountDBTypes, false, crushChangeCountPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CrushChangeCount struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CrushChangeCount: %s", err)
+	}
+
+	count, err = CrushChangeCounts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
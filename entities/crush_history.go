@@ -0,0 +1,1160 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// CrushHistory is an object representing the database table.
+type CrushHistory struct {
+	ID            null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	UserLineID    string     `boil:"user_line_id" json:"user_line_id" toml:"user_line_id" yaml:"user_line_id"`
+	CrushName     string     `boil:"crush_name" json:"crush_name" toml:"crush_name" yaml:"crush_name"`
+	CrushBirthday string     `boil:"crush_birthday" json:"crush_birthday" toml:"crush_birthday" yaml:"crush_birthday"`
+	Action        string     `boil:"action" json:"action" toml:"action" yaml:"action"`
+	ChangedAt     string     `boil:"changed_at" json:"changed_at" toml:"changed_at" yaml:"changed_at"`
+	CrushAltName  string     `boil:"crush_alt_name" json:"crush_alt_name" toml:"crush_alt_name" yaml:"crush_alt_name"`
+
+	R *crushHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L crushHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CrushHistoryColumns = struct {
+	ID            string
+	UserLineID    string
+	CrushName     string
+	CrushBirthday string
+	Action        string
+	ChangedAt     string
+	CrushAltName  string
+}{
+	ID:            "id",
+	UserLineID:    "user_line_id",
+	CrushName:     "crush_name",
+	CrushBirthday: "crush_birthday",
+	Action:        "action",
+	ChangedAt:     "changed_at",
+	CrushAltName:  "crush_alt_name",
+}
+
+var CrushHistoryTableColumns = struct {
+	ID            string
+	UserLineID    string
+	CrushName     string
+	CrushBirthday string
+	Action        string
+	ChangedAt     string
+	CrushAltName  string
+}{
+	ID:            "crush_history.id",
+	UserLineID:    "crush_history.user_line_id",
+	CrushName:     "crush_history.crush_name",
+	CrushBirthday: "crush_history.crush_birthday",
+	Action:        "crush_history.action",
+	ChangedAt:     "crush_history.changed_at",
+	CrushAltName:  "crush_history.crush_alt_name",
+}
+
+// Generated where
+
+var CrushHistoryWhere = struct {
+	ID            whereHelpernull_Int64
+	UserLineID    whereHelperstring
+	CrushName     whereHelperstring
+	CrushBirthday whereHelperstring
+	Action        whereHelperstring
+	ChangedAt     whereHelperstring
+	CrushAltName  whereHelperstring
+}{
+	ID:            whereHelpernull_Int64{field: "\"crush_history\".\"id\""},
+	UserLineID:    whereHelperstring{field: "\"crush_history\".\"user_line_id\""},
+	CrushName:     whereHelperstring{field: "\"crush_history\".\"crush_name\""},
+	CrushBirthday: whereHelperstring{field: "\"crush_history\".\"crush_birthday\""},
+	Action:        whereHelperstring{field: "\"crush_history\".\"action\""},
+	ChangedAt:     whereHelperstring{field: "\"crush_history\".\"changed_at\""},
+	CrushAltName:  whereHelperstring{field: "\"crush_history\".\"crush_alt_name\""},
+}
+
+// CrushHistoryRels is where relationship names are stored.
+var CrushHistoryRels = struct {
+	UserLine string
+}{
+	UserLine: "UserLine",
+}
+
+// crushHistoryR is where relationships are stored.
+type crushHistoryR struct {
+	UserLine *User `boil:"UserLine" json:"UserLine" toml:"UserLine" yaml:"UserLine"`
+}
+
+// NewStruct creates a new relationship struct
+func (*crushHistoryR) NewStruct() *crushHistoryR {
+	return &crushHistoryR{}
+}
+
+func (o *CrushHistory) GetUserLine() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserLine()
+}
+
+func (r *crushHistoryR) GetUserLine() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserLine
+}
+
+// crushHistoryL is where Load methods for each relationship are stored.
+type crushHistoryL struct{}
+
+var (
+	crushHistoryAllColumns            = []string{"id", "user_line_id", "crush_name", "crush_birthday", "action", "changed_at", "crush_alt_name"}
+	crushHistoryColumnsWithoutDefault = []string{"user_line_id", "crush_name", "crush_birthday", "action"}
+	crushHistoryColumnsWithDefault    = []string{"id", "changed_at", "crush_alt_name"}
+	crushHistoryPrimaryKeyColumns     = []string{"id"}
+	crushHistoryGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// CrushHistorySlice is an alias for a slice of pointers to CrushHistory.
+	// This should almost always be used instead of []CrushHistory.
+	CrushHistorySlice []*CrushHistory
+	// CrushHistoryHook is the signature for custom CrushHistory hook methods
+	CrushHistoryHook func(context.Context, boil.ContextExecutor, *CrushHistory) error
+
+	crushHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	crushHistoryType                 = reflect.TypeOf(&CrushHistory{})
+	crushHistoryMapping              = queries.MakeStructMapping(crushHistoryType)
+	crushHistoryPrimaryKeyMapping, _ = queries.BindMapping(crushHistoryType, crushHistoryMapping, crushHistoryPrimaryKeyColumns)
+	crushHistoryInsertCacheMut       sync.RWMutex
+	crushHistoryInsertCache          = make(map[string]insertCache)
+	crushHistoryUpdateCacheMut       sync.RWMutex
+	crushHistoryUpdateCache          = make(map[string]updateCache)
+	crushHistoryUpsertCacheMut       sync.RWMutex
+	crushHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var crushHistoryAfterSelectMu sync.Mutex
+var crushHistoryAfterSelectHooks []CrushHistoryHook
+
+var crushHistoryBeforeInsertMu sync.Mutex
+var crushHistoryBeforeInsertHooks []CrushHistoryHook
+var crushHistoryAfterInsertMu sync.Mutex
+var crushHistoryAfterInsertHooks []CrushHistoryHook
+
+var crushHistoryBeforeUpdateMu sync.Mutex
+var crushHistoryBeforeUpdateHooks []CrushHistoryHook
+var crushHistoryAfterUpdateMu sync.Mutex
+var crushHistoryAfterUpdateHooks []CrushHistoryHook
+
+var crushHistoryBeforeDeleteMu sync.Mutex
+var crushHistoryBeforeDeleteHooks []CrushHistoryHook
+var crushHistoryAfterDeleteMu sync.Mutex
+var crushHistoryAfterDeleteHooks []CrushHistoryHook
+
+var crushHistoryBeforeUpsertMu sync.Mutex
+var crushHistoryBeforeUpsertHooks []CrushHistoryHook
+var crushHistoryAfterUpsertMu sync.Mutex
+var crushHistoryAfterUpsertHooks []CrushHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CrushHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CrushHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CrushHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CrushHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CrushHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CrushHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CrushHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CrushHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CrushHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCrushHistoryHook registers your hook function for all future operations.
+func AddCrushHistoryHook(hookPoint boil.HookPoint, crushHistoryHook CrushHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		crushHistoryAfterSelectMu.Lock()
+		crushHistoryAfterSelectHooks = append(crushHistoryAfterSelectHooks, crushHistoryHook)
+		crushHistoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		crushHistoryBeforeInsertMu.Lock()
+		crushHistoryBeforeInsertHooks = append(crushHistoryBeforeInsertHooks, crushHistoryHook)
+		crushHistoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		crushHistoryAfterInsertMu.Lock()
+		crushHistoryAfterInsertHooks = append(crushHistoryAfterInsertHooks, crushHistoryHook)
+		crushHistoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		crushHistoryBeforeUpdateMu.Lock()
+		crushHistoryBeforeUpdateHooks = append(crushHistoryBeforeUpdateHooks, crushHistoryHook)
+		crushHistoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		crushHistoryAfterUpdateMu.Lock()
+		crushHistoryAfterUpdateHooks = append(crushHistoryAfterUpdateHooks, crushHistoryHook)
+		crushHistoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		crushHistoryBeforeDeleteMu.Lock()
+		crushHistoryBeforeDeleteHooks = append(crushHistoryBeforeDeleteHooks, crushHistoryHook)
+		crushHistoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		crushHistoryAfterDeleteMu.Lock()
+		crushHistoryAfterDeleteHooks = append(crushHistoryAfterDeleteHooks, crushHistoryHook)
+		crushHistoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		crushHistoryBeforeUpsertMu.Lock()
+		crushHistoryBeforeUpsertHooks = append(crushHistoryBeforeUpsertHooks, crushHistoryHook)
+		crushHistoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		crushHistoryAfterUpsertMu.Lock()
+		crushHistoryAfterUpsertHooks = append(crushHistoryAfterUpsertHooks, crushHistoryHook)
+		crushHistoryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single crushHistory record from the query.
+func (q crushHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CrushHistory, error) {
+	o := &CrushHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: failed to execute a one query for crush_history")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CrushHistory records from the query.
+func (q crushHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (CrushHistorySlice, error) {
+	var o []*CrushHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entities: failed to assign all query results to CrushHistory slice")
+	}
+
+	if len(crushHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CrushHistory records in the query.
+func (q crushHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to count crush_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q crushHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: failed to check if crush_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// UserLine pointed to by the foreign key.
+func (o *CrushHistory) UserLine(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"line_user_id\" = ?", o.UserLineID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUserLine allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (crushHistoryL) LoadUserLine(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCrushHistory any, mods queries.Applicator) error {
+	var slice []*CrushHistory
+	var object *CrushHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybeCrushHistory.(*CrushHistory)
+		if !ok {
+			object = new(CrushHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCrushHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCrushHistory))
+			}
+		}
+	} else {
+		s, ok := maybeCrushHistory.(*[]*CrushHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCrushHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCrushHistory))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &crushHistoryR{}
+		}
+		if !queries.IsNil(object.UserLineID) {
+			args[object.UserLineID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &crushHistoryR{}
+			}
+
+			if !queries.IsNil(obj.UserLineID) {
+				args[obj.UserLineID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.line_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserLine = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserLineCrushHistories = append(foreign.R.UserLineCrushHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserLineID, foreign.LineUserID) {
+				local.R.UserLine = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserLineCrushHistories = append(foreign.R.UserLineCrushHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserLine of the crushHistory to the related item.
+// Sets o.R.UserLine to related.
+// Adds o to related.R.UserLineCrushHistories.
+func (o *CrushHistory) SetUserLine(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crush_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_line_id"}),
+		strmangle.WhereClause("\"", "\"", 0, crushHistoryPrimaryKeyColumns),
+	)
+	values := []any{related.LineUserID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserLineID, related.LineUserID)
+	if o.R == nil {
+		o.R = &crushHistoryR{
+			UserLine: related,
+		}
+	} else {
+		o.R.UserLine = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserLineCrushHistories: CrushHistorySlice{o},
+		}
+	} else {
+		related.R.UserLineCrushHistories = append(related.R.UserLineCrushHistories, o)
+	}
+
+	return nil
+}
+
+// CrushHistories retrieves all the records using an executor.
+func CrushHistories(mods ...qm.QueryMod) crushHistoryQuery {
+	mods = append(mods, qm.From("\"crush_history\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"crush_history\".*"})
+	}
+
+	return crushHistoryQuery{q}
+}
+
+// FindCrushHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCrushHistory(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*CrushHistory, error) {
+	crushHistoryObj := &CrushHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"crush_history\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, crushHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: unable to select from crush_history")
+	}
+
+	if err = crushHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return crushHistoryObj, err
+	}
+
+	return crushHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CrushHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no crush_history provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(crushHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	crushHistoryInsertCacheMut.RLock()
+	cache, cached := crushHistoryInsertCache[key]
+	crushHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			crushHistoryAllColumns,
+			crushHistoryColumnsWithDefault,
+			crushHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, crushHistoryGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(crushHistoryType, crushHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(crushHistoryType, crushHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"crush_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"crush_history\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to insert into crush_history")
+	}
+
+	if !cached {
+		crushHistoryInsertCacheMut.Lock()
+		crushHistoryInsertCache[key] = cache
+		crushHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CrushHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CrushHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	crushHistoryUpdateCacheMut.RLock()
+	cache, cached := crushHistoryUpdateCache[key]
+	crushHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			crushHistoryAllColumns,
+			crushHistoryPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, crushHistoryGeneratedColumns)
+
+		if len(wl) == 0 {
+			return 0, errors.New("entities: unable to update crush_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"crush_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, crushHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(crushHistoryType, crushHistoryMapping, append(wl, crushHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update crush_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by update for crush_history")
+	}
+
+	if !cached {
+		crushHistoryUpdateCacheMut.Lock()
+		crushHistoryUpdateCache[key] = cache
+		crushHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q crushHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all for crush_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected for crush_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CrushHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entities: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), crushHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"crush_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, crushHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all in crushHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected all in update all crushHistory")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CrushHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no crush_history provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(crushHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	crushHistoryUpsertCacheMut.RLock()
+	cache, cached := crushHistoryUpsertCache[key]
+	crushHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			crushHistoryAllColumns,
+			crushHistoryColumnsWithDefault,
+			crushHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			crushHistoryAllColumns,
+			crushHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entities: unable to upsert crush_history, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(crushHistoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(crushHistoryPrimaryKeyColumns))
+			copy(conflict, crushHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"crush_history\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(crushHistoryType, crushHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(crushHistoryType, crushHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to upsert crush_history")
+	}
+
+	if !cached {
+		crushHistoryUpsertCacheMut.Lock()
+		crushHistoryUpsertCache[key] = cache
+		crushHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CrushHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CrushHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entities: no CrushHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), crushHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"crush_history\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete from crush_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by delete for crush_history")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q crushHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entities: no crushHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from crush_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for crush_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CrushHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(crushHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), crushHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"crush_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, crushHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from crushHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for crush_history")
+	}
+
+	if len(crushHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CrushHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCrushHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CrushHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CrushHistorySlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), crushHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"crush_history\".* FROM \"crush_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, crushHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to reload all in CrushHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CrushHistoryExists checks if the CrushHistory row exists.
+func CrushHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"crush_history\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: unable to check if crush_history exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the CrushHistory row exists.
+func (o *CrushHistory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CrushHistoryExists(ctx, exec, o.ID)
+}
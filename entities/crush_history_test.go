@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCrushHistories(t *testing.T) {
+	t.Parallel()
+
+	query := CrushHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCrushHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCrushHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CrushHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCrushHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CrushHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCrushHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CrushHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if CrushHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CrushHistoryExists to return true, but got false.")
+	}
+}
+
+func testCrushHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	crushHistoryFound, err := FindCrushHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if crushHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCrushHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CrushHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCrushHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CrushHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCrushHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	crushHistoryOne := &CrushHistory{}
+	crushHistoryTwo := &CrushHistory{}
+	if err = randomize.Struct(seed, crushHistoryOne, crushHistoryDBTypes, false, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, crushHistoryTwo, crushHistoryDBTypes, false, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = crushHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = crushHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CrushHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCrushHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	crushHistoryOne := &CrushHistory{}
+	crushHistoryTwo := &CrushHistory{}
+	if err = randomize.Struct(seed, crushHistoryOne, crushHistoryDBTypes, false, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, crushHistoryTwo, crushHistoryDBTypes, false, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = crushHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = crushHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func crushHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CrushHistory) error {
+	*o = CrushHistory{}
+	return nil
+}
+
+func crushHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CrushHistory) error {
+	*o = CrushHistory{}
+	return nil
+}
+
+func crushHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CrushHistory) error {
+	*o = CrushHistory{}
+	return nil
+}
+
+func crushHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CrushHistory) error {
+	*o = CrushHistory{}
+	return nil
+}
+
+func crushHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CrushHistory) error {
+	*o = CrushHistory{}
+	return nil
+}
+
+func crushHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CrushHistory) error {
+	*o = CrushHistory{}
+	return nil
+}
+
+func crushHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CrushHistory) error {
+	*o = CrushHistory{}
+	return nil
+}
+
+func crushHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CrushHistory) error {
+	*o = CrushHistory{}
+	return nil
+}
+
+func crushHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CrushHistory) error {
+	*o = CrushHistory{}
+	return nil
+}
+
+func testCrushHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CrushHistory{}
+	o := &CrushHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CrushHistory object: %s", err)
+	}
+
+	AddCrushHistoryHook(boil.BeforeInsertHook, crushHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	crushHistoryBeforeInsertHooks = []CrushHistoryHook{}
+
+	AddCrushHistoryHook(boil.AfterInsertHook, crushHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	crushHistoryAfterInsertHooks = []CrushHistoryHook{}
+
+	AddCrushHistoryHook(boil.AfterSelectHook, crushHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	crushHistoryAfterSelectHooks = []CrushHistoryHook{}
+
+	AddCrushHistoryHook(boil.BeforeUpdateHook, crushHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	crushHistoryBeforeUpdateHooks = []CrushHistoryHook{}
+
+	AddCrushHistoryHook(boil.AfterUpdateHook, crushHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	crushHistoryAfterUpdateHooks = []CrushHistoryHook{}
+
+	AddCrushHistoryHook(boil.BeforeDeleteHook, crushHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	crushHistoryBeforeDeleteHooks = []CrushHistoryHook{}
+
+	AddCrushHistoryHook(boil.AfterDeleteHook, crushHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	crushHistoryAfterDeleteHooks = []CrushHistoryHook{}
+
+	AddCrushHistoryHook(boil.BeforeUpsertHook, crushHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	crushHistoryBeforeUpsertHooks = []CrushHistoryHook{}
+
+	AddCrushHistoryHook(boil.AfterUpsertHook, crushHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	crushHistoryAfterUpsertHooks = []CrushHistoryHook{}
+}
+
+func testCrushHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCrushHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(crushHistoryPrimaryKeyColumns, crushHistoryColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCrushHistoryToOneUserUsingUserLine(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CrushHistory
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, crushHistoryDBTypes, false, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserLineID, foreign.LineUserID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.UserLine().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.LineUserID, foreign.LineUserID) {
+		t.Errorf("want: %v, got %v", foreign.LineUserID, check.LineUserID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := CrushHistorySlice{&local}
+	if err = local.L.LoadUserLine(ctx, tx, false, (*[]*CrushHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.UserLine == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.UserLine = nil
+	if err = local.L.LoadUserLine(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.UserLine == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testCrushHistoryToOneSetOpUserUsingUserLine(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CrushHistory
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, crushHistoryDBTypes, false, strmangle.SetComplement(crushHistoryPrimaryKeyColumns, crushHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUserLine(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.UserLine != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.UserLineCrushHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserLineID, x.LineUserID) {
+			t.Error("foreign key was wrong value", a.UserLineID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserLineID))
+		reflect.Indirect(reflect.ValueOf(&a.UserLineID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserLineID, x.LineUserID) {
+			t.Error("foreign key was wrong value", a.UserLineID, x.LineUserID)
+		}
+	}
+}
+
+func testCrushHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCrushHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CrushHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCrushHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CrushHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	crushHistoryDBTypes = map[string]string{`ID`: `INTEGER`, `UserLineID`: `TEXT`, `CrushName`: `TEXT`, `CrushBirthday`: `TEXT`, `Action`: `TEXT`, `ChangedAt`: `TEXT`, `CrushAltName`: `TEXT`}
+	_                   = bytes.MinRead
+)
+
+func testCrushHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(crushHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(crushHistoryAllColumns) == len(crushHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCrushHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(crushHistoryAllColumns) == len(crushHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CrushHistory{}
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, crushHistoryDBTypes, true, crushHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(crushHistoryAllColumns, crushHistoryPrimaryKeyColumns) {
+		fields = crushHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			crushHistoryAllColumns,
+			crushHistoryPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, crushHistoryGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CrushHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCrushHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(crushHistoryAllColumns) == len(crushHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CrushHistory{}
+	if err = randomize.Struct(seed, &o, crushHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CrushHistory: %s", err)
+	}
+
+	count, err := CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, crushHistoryDBTypes, false, crushHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CrushHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CrushHistory: %s", err)
+	}
+
+	count, err = CrushHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
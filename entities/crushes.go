@@ -0,0 +1,1167 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Crush is an object representing the database table.
+type Crush struct {
+	ID              null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	UserLineID      string     `boil:"user_line_id" json:"user_line_id" toml:"user_line_id" yaml:"user_line_id"`
+	CrushName       string     `boil:"crush_name" json:"crush_name" toml:"crush_name" yaml:"crush_name"`
+	CrushBirthday   string     `boil:"crush_birthday" json:"crush_birthday" toml:"crush_birthday" yaml:"crush_birthday"`
+	RegisteredAt    string     `boil:"registered_at" json:"registered_at" toml:"registered_at" yaml:"registered_at"`
+	CrushNameKey    string     `boil:"crush_name_key" json:"crush_name_key" toml:"crush_name_key" yaml:"crush_name_key"`
+	CrushAltName    string     `boil:"crush_alt_name" json:"crush_alt_name" toml:"crush_alt_name" yaml:"crush_alt_name"`
+	CrushAltNameKey string     `boil:"crush_alt_name_key" json:"crush_alt_name_key" toml:"crush_alt_name_key" yaml:"crush_alt_name_key"`
+
+	R *crushR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L crushL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CrushColumns = struct {
+	ID              string
+	UserLineID      string
+	CrushName       string
+	CrushBirthday   string
+	RegisteredAt    string
+	CrushNameKey    string
+	CrushAltName    string
+	CrushAltNameKey string
+}{
+	ID:              "id",
+	UserLineID:      "user_line_id",
+	CrushName:       "crush_name",
+	CrushBirthday:   "crush_birthday",
+	RegisteredAt:    "registered_at",
+	CrushNameKey:    "crush_name_key",
+	CrushAltName:    "crush_alt_name",
+	CrushAltNameKey: "crush_alt_name_key",
+}
+
+var CrushTableColumns = struct {
+	ID              string
+	UserLineID      string
+	CrushName       string
+	CrushBirthday   string
+	RegisteredAt    string
+	CrushNameKey    string
+	CrushAltName    string
+	CrushAltNameKey string
+}{
+	ID:              "crushes.id",
+	UserLineID:      "crushes.user_line_id",
+	CrushName:       "crushes.crush_name",
+	CrushBirthday:   "crushes.crush_birthday",
+	RegisteredAt:    "crushes.registered_at",
+	CrushNameKey:    "crushes.crush_name_key",
+	CrushAltName:    "crushes.crush_alt_name",
+	CrushAltNameKey: "crushes.crush_alt_name_key",
+}
+
+// Generated where
+
+var CrushWhere = struct {
+	ID              whereHelpernull_Int64
+	UserLineID      whereHelperstring
+	CrushName       whereHelperstring
+	CrushBirthday   whereHelperstring
+	RegisteredAt    whereHelperstring
+	CrushNameKey    whereHelperstring
+	CrushAltName    whereHelperstring
+	CrushAltNameKey whereHelperstring
+}{
+	ID:              whereHelpernull_Int64{field: "\"crushes\".\"id\""},
+	UserLineID:      whereHelperstring{field: "\"crushes\".\"user_line_id\""},
+	CrushName:       whereHelperstring{field: "\"crushes\".\"crush_name\""},
+	CrushBirthday:   whereHelperstring{field: "\"crushes\".\"crush_birthday\""},
+	RegisteredAt:    whereHelperstring{field: "\"crushes\".\"registered_at\""},
+	CrushNameKey:    whereHelperstring{field: "\"crushes\".\"crush_name_key\""},
+	CrushAltName:    whereHelperstring{field: "\"crushes\".\"crush_alt_name\""},
+	CrushAltNameKey: whereHelperstring{field: "\"crushes\".\"crush_alt_name_key\""},
+}
+
+// CrushRels is where relationship names are stored.
+var CrushRels = struct {
+	UserLine string
+}{
+	UserLine: "UserLine",
+}
+
+// crushR is where relationships are stored.
+type crushR struct {
+	UserLine *User `boil:"UserLine" json:"UserLine" toml:"UserLine" yaml:"UserLine"`
+}
+
+// NewStruct creates a new relationship struct
+func (*crushR) NewStruct() *crushR {
+	return &crushR{}
+}
+
+func (o *Crush) GetUserLine() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserLine()
+}
+
+func (r *crushR) GetUserLine() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserLine
+}
+
+// crushL is where Load methods for each relationship are stored.
+type crushL struct{}
+
+var (
+	crushAllColumns            = []string{"id", "user_line_id", "crush_name", "crush_birthday", "registered_at", "crush_name_key", "crush_alt_name", "crush_alt_name_key"}
+	crushColumnsWithoutDefault = []string{"user_line_id", "crush_name", "crush_birthday"}
+	crushColumnsWithDefault    = []string{"id", "registered_at", "crush_name_key", "crush_alt_name", "crush_alt_name_key"}
+	crushPrimaryKeyColumns     = []string{"id"}
+	crushGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// CrushSlice is an alias for a slice of pointers to Crush.
+	// This should almost always be used instead of []Crush.
+	CrushSlice []*Crush
+	// CrushHook is the signature for custom Crush hook methods
+	CrushHook func(context.Context, boil.ContextExecutor, *Crush) error
+
+	crushQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	crushType                 = reflect.TypeOf(&Crush{})
+	crushMapping              = queries.MakeStructMapping(crushType)
+	crushPrimaryKeyMapping, _ = queries.BindMapping(crushType, crushMapping, crushPrimaryKeyColumns)
+	crushInsertCacheMut       sync.RWMutex
+	crushInsertCache          = make(map[string]insertCache)
+	crushUpdateCacheMut       sync.RWMutex
+	crushUpdateCache          = make(map[string]updateCache)
+	crushUpsertCacheMut       sync.RWMutex
+	crushUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var crushAfterSelectMu sync.Mutex
+var crushAfterSelectHooks []CrushHook
+
+var crushBeforeInsertMu sync.Mutex
+var crushBeforeInsertHooks []CrushHook
+var crushAfterInsertMu sync.Mutex
+var crushAfterInsertHooks []CrushHook
+
+var crushBeforeUpdateMu sync.Mutex
+var crushBeforeUpdateHooks []CrushHook
+var crushAfterUpdateMu sync.Mutex
+var crushAfterUpdateHooks []CrushHook
+
+var crushBeforeDeleteMu sync.Mutex
+var crushBeforeDeleteHooks []CrushHook
+var crushAfterDeleteMu sync.Mutex
+var crushAfterDeleteHooks []CrushHook
+
+var crushBeforeUpsertMu sync.Mutex
+var crushBeforeUpsertHooks []CrushHook
+var crushAfterUpsertMu sync.Mutex
+var crushAfterUpsertHooks []CrushHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Crush) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Crush) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Crush) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Crush) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Crush) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Crush) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Crush) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Crush) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Crush) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range crushAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCrushHook registers your hook function for all future operations.
+func AddCrushHook(hookPoint boil.HookPoint, crushHook CrushHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		crushAfterSelectMu.Lock()
+		crushAfterSelectHooks = append(crushAfterSelectHooks, crushHook)
+		crushAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		crushBeforeInsertMu.Lock()
+		crushBeforeInsertHooks = append(crushBeforeInsertHooks, crushHook)
+		crushBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		crushAfterInsertMu.Lock()
+		crushAfterInsertHooks = append(crushAfterInsertHooks, crushHook)
+		crushAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		crushBeforeUpdateMu.Lock()
+		crushBeforeUpdateHooks = append(crushBeforeUpdateHooks, crushHook)
+		crushBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		crushAfterUpdateMu.Lock()
+		crushAfterUpdateHooks = append(crushAfterUpdateHooks, crushHook)
+		crushAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		crushBeforeDeleteMu.Lock()
+		crushBeforeDeleteHooks = append(crushBeforeDeleteHooks, crushHook)
+		crushBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		crushAfterDeleteMu.Lock()
+		crushAfterDeleteHooks = append(crushAfterDeleteHooks, crushHook)
+		crushAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		crushBeforeUpsertMu.Lock()
+		crushBeforeUpsertHooks = append(crushBeforeUpsertHooks, crushHook)
+		crushBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		crushAfterUpsertMu.Lock()
+		crushAfterUpsertHooks = append(crushAfterUpsertHooks, crushHook)
+		crushAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single crush record from the query.
+func (q crushQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Crush, error) {
+	o := &Crush{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: failed to execute a one query for crushes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Crush records from the query.
+func (q crushQuery) All(ctx context.Context, exec boil.ContextExecutor) (CrushSlice, error) {
+	var o []*Crush
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entities: failed to assign all query results to Crush slice")
+	}
+
+	if len(crushAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Crush records in the query.
+func (q crushQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to count crushes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q crushQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: failed to check if crushes exists")
+	}
+
+	return count > 0, nil
+}
+
+// UserLine pointed to by the foreign key.
+func (o *Crush) UserLine(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"line_user_id\" = ?", o.UserLineID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUserLine allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (crushL) LoadUserLine(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCrush any, mods queries.Applicator) error {
+	var slice []*Crush
+	var object *Crush
+
+	if singular {
+		var ok bool
+		object, ok = maybeCrush.(*Crush)
+		if !ok {
+			object = new(Crush)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCrush)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCrush))
+			}
+		}
+	} else {
+		s, ok := maybeCrush.(*[]*Crush)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCrush)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCrush))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &crushR{}
+		}
+		if !queries.IsNil(object.UserLineID) {
+			args[object.UserLineID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &crushR{}
+			}
+
+			if !queries.IsNil(obj.UserLineID) {
+				args[obj.UserLineID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.line_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserLine = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserLineCrushes = append(foreign.R.UserLineCrushes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserLineID, foreign.LineUserID) {
+				local.R.UserLine = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserLineCrushes = append(foreign.R.UserLineCrushes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserLine of the crush to the related item.
+// Sets o.R.UserLine to related.
+// Adds o to related.R.UserLineCrushes.
+func (o *Crush) SetUserLine(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crushes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_line_id"}),
+		strmangle.WhereClause("\"", "\"", 0, crushPrimaryKeyColumns),
+	)
+	values := []any{related.LineUserID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserLineID, related.LineUserID)
+	if o.R == nil {
+		o.R = &crushR{
+			UserLine: related,
+		}
+	} else {
+		o.R.UserLine = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserLineCrushes: CrushSlice{o},
+		}
+	} else {
+		related.R.UserLineCrushes = append(related.R.UserLineCrushes, o)
+	}
+
+	return nil
+}
+
+// Crushes retrieves all the records using an executor.
+func Crushes(mods ...qm.QueryMod) crushQuery {
+	mods = append(mods, qm.From("\"crushes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"crushes\".*"})
+	}
+
+	return crushQuery{q}
+}
+
+// FindCrush retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCrush(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Crush, error) {
+	crushObj := &Crush{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"crushes\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, crushObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: unable to select from crushes")
+	}
+
+	if err = crushObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return crushObj, err
+	}
+
+	return crushObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Crush) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no crushes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(crushColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	crushInsertCacheMut.RLock()
+	cache, cached := crushInsertCache[key]
+	crushInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			crushAllColumns,
+			crushColumnsWithDefault,
+			crushColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, crushGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(crushType, crushMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(crushType, crushMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"crushes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"crushes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to insert into crushes")
+	}
+
+	if !cached {
+		crushInsertCacheMut.Lock()
+		crushInsertCache[key] = cache
+		crushInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Crush.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Crush) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	crushUpdateCacheMut.RLock()
+	cache, cached := crushUpdateCache[key]
+	crushUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			crushAllColumns,
+			crushPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, crushGeneratedColumns)
+
+		if len(wl) == 0 {
+			return 0, errors.New("entities: unable to update crushes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"crushes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, crushPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(crushType, crushMapping, append(wl, crushPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update crushes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by update for crushes")
+	}
+
+	if !cached {
+		crushUpdateCacheMut.Lock()
+		crushUpdateCache[key] = cache
+		crushUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q crushQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all for crushes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected for crushes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CrushSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entities: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), crushPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"crushes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, crushPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all in crush slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected all in update all crush")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Crush) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no crushes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(crushColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	crushUpsertCacheMut.RLock()
+	cache, cached := crushUpsertCache[key]
+	crushUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			crushAllColumns,
+			crushColumnsWithDefault,
+			crushColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			crushAllColumns,
+			crushPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entities: unable to upsert crushes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(crushAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(crushPrimaryKeyColumns))
+			copy(conflict, crushPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"crushes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(crushType, crushMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(crushType, crushMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to upsert crushes")
+	}
+
+	if !cached {
+		crushUpsertCacheMut.Lock()
+		crushUpsertCache[key] = cache
+		crushUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Crush record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Crush) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entities: no Crush provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), crushPrimaryKeyMapping)
+	sql := "DELETE FROM \"crushes\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete from crushes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by delete for crushes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q crushQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entities: no crushQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from crushes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for crushes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CrushSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(crushBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), crushPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"crushes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, crushPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from crush slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for crushes")
+	}
+
+	if len(crushAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Crush) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCrush(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CrushSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CrushSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), crushPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"crushes\".* FROM \"crushes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, crushPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to reload all in CrushSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CrushExists checks if the Crush row exists.
+func CrushExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"crushes\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: unable to check if crushes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Crush row exists.
+func (o *Crush) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CrushExists(ctx, exec, o.ID)
+}
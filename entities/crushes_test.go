@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCrushes(t *testing.T) {
+	t.Parallel()
+
+	query := Crushes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCrushesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCrushesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Crushes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCrushesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CrushSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCrushesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CrushExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Crush exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CrushExists to return true, but got false.")
+	}
+}
+
+func testCrushesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	crushFound, err := FindCrush(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if crushFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCrushesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Crushes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCrushesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Crushes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCrushesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	crushOne := &Crush{}
+	crushTwo := &Crush{}
+	if err = randomize.Struct(seed, crushOne, crushDBTypes, false, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+	if err = randomize.Struct(seed, crushTwo, crushDBTypes, false, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = crushOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = crushTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Crushes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCrushesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	crushOne := &Crush{}
+	crushTwo := &Crush{}
+	if err = randomize.Struct(seed, crushOne, crushDBTypes, false, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+	if err = randomize.Struct(seed, crushTwo, crushDBTypes, false, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = crushOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = crushTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func crushBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Crush) error {
+	*o = Crush{}
+	return nil
+}
+
+func crushAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Crush) error {
+	*o = Crush{}
+	return nil
+}
+
+func crushAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Crush) error {
+	*o = Crush{}
+	return nil
+}
+
+func crushBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Crush) error {
+	*o = Crush{}
+	return nil
+}
+
+func crushAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Crush) error {
+	*o = Crush{}
+	return nil
+}
+
+func crushBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Crush) error {
+	*o = Crush{}
+	return nil
+}
+
+func crushAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Crush) error {
+	*o = Crush{}
+	return nil
+}
+
+func crushBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Crush) error {
+	*o = Crush{}
+	return nil
+}
+
+func crushAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Crush) error {
+	*o = Crush{}
+	return nil
+}
+
+func testCrushesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Crush{}
+	o := &Crush{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, crushDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Crush object: %s", err)
+	}
+
+	AddCrushHook(boil.BeforeInsertHook, crushBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	crushBeforeInsertHooks = []CrushHook{}
+
+	AddCrushHook(boil.AfterInsertHook, crushAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	crushAfterInsertHooks = []CrushHook{}
+
+	AddCrushHook(boil.AfterSelectHook, crushAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	crushAfterSelectHooks = []CrushHook{}
+
+	AddCrushHook(boil.BeforeUpdateHook, crushBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	crushBeforeUpdateHooks = []CrushHook{}
+
+	AddCrushHook(boil.AfterUpdateHook, crushAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	crushAfterUpdateHooks = []CrushHook{}
+
+	AddCrushHook(boil.BeforeDeleteHook, crushBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	crushBeforeDeleteHooks = []CrushHook{}
+
+	AddCrushHook(boil.AfterDeleteHook, crushAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	crushAfterDeleteHooks = []CrushHook{}
+
+	AddCrushHook(boil.BeforeUpsertHook, crushBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	crushBeforeUpsertHooks = []CrushHook{}
+
+	AddCrushHook(boil.AfterUpsertHook, crushAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	crushAfterUpsertHooks = []CrushHook{}
+}
+
+func testCrushesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCrushesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(crushPrimaryKeyColumns, crushColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCrushToOneUserUsingUserLine(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Crush
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, crushDBTypes, false, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserLineID, foreign.LineUserID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.UserLine().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.LineUserID, foreign.LineUserID) {
+		t.Errorf("want: %v, got %v", foreign.LineUserID, check.LineUserID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := CrushSlice{&local}
+	if err = local.L.LoadUserLine(ctx, tx, false, (*[]*Crush)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.UserLine == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.UserLine = nil
+	if err = local.L.LoadUserLine(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.UserLine == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testCrushToOneSetOpUserUsingUserLine(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Crush
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, crushDBTypes, false, strmangle.SetComplement(crushPrimaryKeyColumns, crushColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUserLine(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.UserLine != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.UserLineCrushes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserLineID, x.LineUserID) {
+			t.Error("foreign key was wrong value", a.UserLineID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserLineID))
+		reflect.Indirect(reflect.ValueOf(&a.UserLineID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserLineID, x.LineUserID) {
+			t.Error("foreign key was wrong value", a.UserLineID, x.LineUserID)
+		}
+	}
+}
+
+func testCrushesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCrushesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CrushSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCrushesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Crushes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	crushDBTypes = map[string]string{`ID`: `INTEGER`, `UserLineID`: `TEXT`, `CrushName`: `TEXT`, `CrushBirthday`: `TEXT`, `RegisteredAt`: `TEXT`, `CrushNameKey`: `TEXT`, `CrushAltName`: `TEXT`, `CrushAltNameKey`: `TEXT`}
+	_            = bytes.MinRead
+)
+
+func testCrushesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(crushPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(crushAllColumns) == len(crushPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCrushesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(crushAllColumns) == len(crushPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Crush{}
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, crushDBTypes, true, crushPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(crushAllColumns, crushPrimaryKeyColumns) {
+		fields = crushAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			crushAllColumns,
+			crushPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, crushGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CrushSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCrushesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(crushAllColumns) == len(crushPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Crush{}
+	if err = randomize.Struct(seed, &o, crushDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Crush: %s", err)
+	}
+
+	count, err := Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, crushDBTypes, false, crushPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Crush struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Crush: %s", err)
+	}
+
+	count, err = Crushes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
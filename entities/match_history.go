@@ -0,0 +1,1153 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// MatchHistory is an object representing the database table.
+type MatchHistory struct {
+	ID            null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	UserLineID    string     `boil:"user_line_id" json:"user_line_id" toml:"user_line_id" yaml:"user_line_id"`
+	PartnerLineID string     `boil:"partner_line_id" json:"partner_line_id" toml:"partner_line_id" yaml:"partner_line_id"`
+	PartnerName   string     `boil:"partner_name" json:"partner_name" toml:"partner_name" yaml:"partner_name"`
+	Action        string     `boil:"action" json:"action" toml:"action" yaml:"action"`
+	ChangedAt     string     `boil:"changed_at" json:"changed_at" toml:"changed_at" yaml:"changed_at"`
+
+	R *matchHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L matchHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MatchHistoryColumns = struct {
+	ID            string
+	UserLineID    string
+	PartnerLineID string
+	PartnerName   string
+	Action        string
+	ChangedAt     string
+}{
+	ID:            "id",
+	UserLineID:    "user_line_id",
+	PartnerLineID: "partner_line_id",
+	PartnerName:   "partner_name",
+	Action:        "action",
+	ChangedAt:     "changed_at",
+}
+
+var MatchHistoryTableColumns = struct {
+	ID            string
+	UserLineID    string
+	PartnerLineID string
+	PartnerName   string
+	Action        string
+	ChangedAt     string
+}{
+	ID:            "match_history.id",
+	UserLineID:    "match_history.user_line_id",
+	PartnerLineID: "match_history.partner_line_id",
+	PartnerName:   "match_history.partner_name",
+	Action:        "match_history.action",
+	ChangedAt:     "match_history.changed_at",
+}
+
+// Generated where
+
+var MatchHistoryWhere = struct {
+	ID            whereHelpernull_Int64
+	UserLineID    whereHelperstring
+	PartnerLineID whereHelperstring
+	PartnerName   whereHelperstring
+	Action        whereHelperstring
+	ChangedAt     whereHelperstring
+}{
+	ID:            whereHelpernull_Int64{field: "\"match_history\".\"id\""},
+	UserLineID:    whereHelperstring{field: "\"match_history\".\"user_line_id\""},
+	PartnerLineID: whereHelperstring{field: "\"match_history\".\"partner_line_id\""},
+	PartnerName:   whereHelperstring{field: "\"match_history\".\"partner_name\""},
+	Action:        whereHelperstring{field: "\"match_history\".\"action\""},
+	ChangedAt:     whereHelperstring{field: "\"match_history\".\"changed_at\""},
+}
+
+// MatchHistoryRels is where relationship names are stored.
+var MatchHistoryRels = struct {
+	UserLine string
+}{
+	UserLine: "UserLine",
+}
+
+// matchHistoryR is where relationships are stored.
+type matchHistoryR struct {
+	UserLine *User `boil:"UserLine" json:"UserLine" toml:"UserLine" yaml:"UserLine"`
+}
+
+// NewStruct creates a new relationship struct
+func (*matchHistoryR) NewStruct() *matchHistoryR {
+	return &matchHistoryR{}
+}
+
+func (o *MatchHistory) GetUserLine() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserLine()
+}
+
+func (r *matchHistoryR) GetUserLine() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserLine
+}
+
+// matchHistoryL is where Load methods for each relationship are stored.
+type matchHistoryL struct{}
+
+var (
+	matchHistoryAllColumns            = []string{"id", "user_line_id", "partner_line_id", "partner_name", "action", "changed_at"}
+	matchHistoryColumnsWithoutDefault = []string{"user_line_id", "partner_line_id", "partner_name", "action"}
+	matchHistoryColumnsWithDefault    = []string{"id", "changed_at"}
+	matchHistoryPrimaryKeyColumns     = []string{"id"}
+	matchHistoryGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// MatchHistorySlice is an alias for a slice of pointers to MatchHistory.
+	// This should almost always be used instead of []MatchHistory.
+	MatchHistorySlice []*MatchHistory
+	// MatchHistoryHook is the signature for custom MatchHistory hook methods
+	MatchHistoryHook func(context.Context, boil.ContextExecutor, *MatchHistory) error
+
+	matchHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	matchHistoryType                 = reflect.TypeOf(&MatchHistory{})
+	matchHistoryMapping              = queries.MakeStructMapping(matchHistoryType)
+	matchHistoryPrimaryKeyMapping, _ = queries.BindMapping(matchHistoryType, matchHistoryMapping, matchHistoryPrimaryKeyColumns)
+	matchHistoryInsertCacheMut       sync.RWMutex
+	matchHistoryInsertCache          = make(map[string]insertCache)
+	matchHistoryUpdateCacheMut       sync.RWMutex
+	matchHistoryUpdateCache          = make(map[string]updateCache)
+	matchHistoryUpsertCacheMut       sync.RWMutex
+	matchHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var matchHistoryAfterSelectMu sync.Mutex
+var matchHistoryAfterSelectHooks []MatchHistoryHook
+
+var matchHistoryBeforeInsertMu sync.Mutex
+var matchHistoryBeforeInsertHooks []MatchHistoryHook
+var matchHistoryAfterInsertMu sync.Mutex
+var matchHistoryAfterInsertHooks []MatchHistoryHook
+
+var matchHistoryBeforeUpdateMu sync.Mutex
+var matchHistoryBeforeUpdateHooks []MatchHistoryHook
+var matchHistoryAfterUpdateMu sync.Mutex
+var matchHistoryAfterUpdateHooks []MatchHistoryHook
+
+var matchHistoryBeforeDeleteMu sync.Mutex
+var matchHistoryBeforeDeleteHooks []MatchHistoryHook
+var matchHistoryAfterDeleteMu sync.Mutex
+var matchHistoryAfterDeleteHooks []MatchHistoryHook
+
+var matchHistoryBeforeUpsertMu sync.Mutex
+var matchHistoryBeforeUpsertHooks []MatchHistoryHook
+var matchHistoryAfterUpsertMu sync.Mutex
+var matchHistoryAfterUpsertHooks []MatchHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MatchHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range matchHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MatchHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range matchHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MatchHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range matchHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MatchHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range matchHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MatchHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range matchHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MatchHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range matchHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MatchHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range matchHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MatchHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range matchHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MatchHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range matchHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMatchHistoryHook registers your hook function for all future operations.
+func AddMatchHistoryHook(hookPoint boil.HookPoint, matchHistoryHook MatchHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		matchHistoryAfterSelectMu.Lock()
+		matchHistoryAfterSelectHooks = append(matchHistoryAfterSelectHooks, matchHistoryHook)
+		matchHistoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		matchHistoryBeforeInsertMu.Lock()
+		matchHistoryBeforeInsertHooks = append(matchHistoryBeforeInsertHooks, matchHistoryHook)
+		matchHistoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		matchHistoryAfterInsertMu.Lock()
+		matchHistoryAfterInsertHooks = append(matchHistoryAfterInsertHooks, matchHistoryHook)
+		matchHistoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		matchHistoryBeforeUpdateMu.Lock()
+		matchHistoryBeforeUpdateHooks = append(matchHistoryBeforeUpdateHooks, matchHistoryHook)
+		matchHistoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		matchHistoryAfterUpdateMu.Lock()
+		matchHistoryAfterUpdateHooks = append(matchHistoryAfterUpdateHooks, matchHistoryHook)
+		matchHistoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		matchHistoryBeforeDeleteMu.Lock()
+		matchHistoryBeforeDeleteHooks = append(matchHistoryBeforeDeleteHooks, matchHistoryHook)
+		matchHistoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		matchHistoryAfterDeleteMu.Lock()
+		matchHistoryAfterDeleteHooks = append(matchHistoryAfterDeleteHooks, matchHistoryHook)
+		matchHistoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		matchHistoryBeforeUpsertMu.Lock()
+		matchHistoryBeforeUpsertHooks = append(matchHistoryBeforeUpsertHooks, matchHistoryHook)
+		matchHistoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		matchHistoryAfterUpsertMu.Lock()
+		matchHistoryAfterUpsertHooks = append(matchHistoryAfterUpsertHooks, matchHistoryHook)
+		matchHistoryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single matchHistory record from the query.
+func (q matchHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MatchHistory, error) {
+	o := &MatchHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: failed to execute a one query for match_history")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MatchHistory records from the query.
+func (q matchHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (MatchHistorySlice, error) {
+	var o []*MatchHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entities: failed to assign all query results to MatchHistory slice")
+	}
+
+	if len(matchHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MatchHistory records in the query.
+func (q matchHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to count match_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q matchHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: failed to check if match_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// UserLine pointed to by the foreign key.
+func (o *MatchHistory) UserLine(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"line_user_id\" = ?", o.UserLineID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUserLine allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (matchHistoryL) LoadUserLine(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMatchHistory any, mods queries.Applicator) error {
+	var slice []*MatchHistory
+	var object *MatchHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybeMatchHistory.(*MatchHistory)
+		if !ok {
+			object = new(MatchHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMatchHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMatchHistory))
+			}
+		}
+	} else {
+		s, ok := maybeMatchHistory.(*[]*MatchHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMatchHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMatchHistory))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &matchHistoryR{}
+		}
+		if !queries.IsNil(object.UserLineID) {
+			args[object.UserLineID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &matchHistoryR{}
+			}
+
+			if !queries.IsNil(obj.UserLineID) {
+				args[obj.UserLineID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.line_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserLine = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserLineMatchHistories = append(foreign.R.UserLineMatchHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserLineID, foreign.LineUserID) {
+				local.R.UserLine = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserLineMatchHistories = append(foreign.R.UserLineMatchHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserLine of the matchHistory to the related item.
+// Sets o.R.UserLine to related.
+// Adds o to related.R.UserLineMatchHistories.
+func (o *MatchHistory) SetUserLine(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"match_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"user_line_id"}),
+		strmangle.WhereClause("\"", "\"", 0, matchHistoryPrimaryKeyColumns),
+	)
+	values := []any{related.LineUserID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserLineID, related.LineUserID)
+	if o.R == nil {
+		o.R = &matchHistoryR{
+			UserLine: related,
+		}
+	} else {
+		o.R.UserLine = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserLineMatchHistories: MatchHistorySlice{o},
+		}
+	} else {
+		related.R.UserLineMatchHistories = append(related.R.UserLineMatchHistories, o)
+	}
+
+	return nil
+}
+
+// MatchHistories retrieves all the records using an executor.
+func MatchHistories(mods ...qm.QueryMod) matchHistoryQuery {
+	mods = append(mods, qm.From("\"match_history\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"match_history\".*"})
+	}
+
+	return matchHistoryQuery{q}
+}
+
+// FindMatchHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMatchHistory(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*MatchHistory, error) {
+	matchHistoryObj := &MatchHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"match_history\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, matchHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: unable to select from match_history")
+	}
+
+	if err = matchHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return matchHistoryObj, err
+	}
+
+	return matchHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MatchHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no match_history provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(matchHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	matchHistoryInsertCacheMut.RLock()
+	cache, cached := matchHistoryInsertCache[key]
+	matchHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			matchHistoryAllColumns,
+			matchHistoryColumnsWithDefault,
+			matchHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, matchHistoryGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(matchHistoryType, matchHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(matchHistoryType, matchHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"match_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"match_history\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to insert into match_history")
+	}
+
+	if !cached {
+		matchHistoryInsertCacheMut.Lock()
+		matchHistoryInsertCache[key] = cache
+		matchHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MatchHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MatchHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	matchHistoryUpdateCacheMut.RLock()
+	cache, cached := matchHistoryUpdateCache[key]
+	matchHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			matchHistoryAllColumns,
+			matchHistoryPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, matchHistoryGeneratedColumns)
+
+		if len(wl) == 0 {
+			return 0, errors.New("entities: unable to update match_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"match_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, matchHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(matchHistoryType, matchHistoryMapping, append(wl, matchHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update match_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by update for match_history")
+	}
+
+	if !cached {
+		matchHistoryUpdateCacheMut.Lock()
+		matchHistoryUpdateCache[key] = cache
+		matchHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q matchHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all for match_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected for match_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MatchHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entities: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), matchHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"match_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, matchHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all in matchHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected all in update all matchHistory")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MatchHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no match_history provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(matchHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	matchHistoryUpsertCacheMut.RLock()
+	cache, cached := matchHistoryUpsertCache[key]
+	matchHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			matchHistoryAllColumns,
+			matchHistoryColumnsWithDefault,
+			matchHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			matchHistoryAllColumns,
+			matchHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entities: unable to upsert match_history, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(matchHistoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(matchHistoryPrimaryKeyColumns))
+			copy(conflict, matchHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"match_history\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(matchHistoryType, matchHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(matchHistoryType, matchHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to upsert match_history")
+	}
+
+	if !cached {
+		matchHistoryUpsertCacheMut.Lock()
+		matchHistoryUpsertCache[key] = cache
+		matchHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MatchHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MatchHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entities: no MatchHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), matchHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"match_history\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete from match_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by delete for match_history")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q matchHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entities: no matchHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from match_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for match_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MatchHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(matchHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), matchHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"match_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, matchHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from matchHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for match_history")
+	}
+
+	if len(matchHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MatchHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMatchHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MatchHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MatchHistorySlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), matchHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"match_history\".* FROM \"match_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, matchHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to reload all in MatchHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MatchHistoryExists checks if the MatchHistory row exists.
+func MatchHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"match_history\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: unable to check if match_history exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MatchHistory row exists.
+func (o *MatchHistory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MatchHistoryExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testMatchHistories(t *testing.T) {
+	t.Parallel()
+
+	query := MatchHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testMatchHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMatchHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := MatchHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMatchHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MatchHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMatchHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := MatchHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if MatchHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected MatchHistoryExists to return true, but got false.")
+	}
+}
+
+func testMatchHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	matchHistoryFound, err := FindMatchHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if matchHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testMatchHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = MatchHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMatchHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := MatchHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testMatchHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	matchHistoryOne := &MatchHistory{}
+	matchHistoryTwo := &MatchHistory{}
+	if err = randomize.Struct(seed, matchHistoryOne, matchHistoryDBTypes, false, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, matchHistoryTwo, matchHistoryDBTypes, false, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = matchHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = matchHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MatchHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testMatchHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	matchHistoryOne := &MatchHistory{}
+	matchHistoryTwo := &MatchHistory{}
+	if err = randomize.Struct(seed, matchHistoryOne, matchHistoryDBTypes, false, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, matchHistoryTwo, matchHistoryDBTypes, false, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = matchHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = matchHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func matchHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *MatchHistory) error {
+	*o = MatchHistory{}
+	return nil
+}
+
+func matchHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *MatchHistory) error {
+	*o = MatchHistory{}
+	return nil
+}
+
+func matchHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *MatchHistory) error {
+	*o = MatchHistory{}
+	return nil
+}
+
+func matchHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MatchHistory) error {
+	*o = MatchHistory{}
+	return nil
+}
+
+func matchHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MatchHistory) error {
+	*o = MatchHistory{}
+	return nil
+}
+
+func matchHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MatchHistory) error {
+	*o = MatchHistory{}
+	return nil
+}
+
+func matchHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MatchHistory) error {
+	*o = MatchHistory{}
+	return nil
+}
+
+func matchHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MatchHistory) error {
+	*o = MatchHistory{}
+	return nil
+}
+
+func matchHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MatchHistory) error {
+	*o = MatchHistory{}
+	return nil
+}
+
+func testMatchHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &MatchHistory{}
+	o := &MatchHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize MatchHistory object: %s", err)
+	}
+
+	AddMatchHistoryHook(boil.BeforeInsertHook, matchHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	matchHistoryBeforeInsertHooks = []MatchHistoryHook{}
+
+	AddMatchHistoryHook(boil.AfterInsertHook, matchHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	matchHistoryAfterInsertHooks = []MatchHistoryHook{}
+
+	AddMatchHistoryHook(boil.AfterSelectHook, matchHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	matchHistoryAfterSelectHooks = []MatchHistoryHook{}
+
+	AddMatchHistoryHook(boil.BeforeUpdateHook, matchHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	matchHistoryBeforeUpdateHooks = []MatchHistoryHook{}
+
+	AddMatchHistoryHook(boil.AfterUpdateHook, matchHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	matchHistoryAfterUpdateHooks = []MatchHistoryHook{}
+
+	AddMatchHistoryHook(boil.BeforeDeleteHook, matchHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	matchHistoryBeforeDeleteHooks = []MatchHistoryHook{}
+
+	AddMatchHistoryHook(boil.AfterDeleteHook, matchHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	matchHistoryAfterDeleteHooks = []MatchHistoryHook{}
+
+	AddMatchHistoryHook(boil.BeforeUpsertHook, matchHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	matchHistoryBeforeUpsertHooks = []MatchHistoryHook{}
+
+	AddMatchHistoryHook(boil.AfterUpsertHook, matchHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	matchHistoryAfterUpsertHooks = []MatchHistoryHook{}
+}
+
+func testMatchHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMatchHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(matchHistoryPrimaryKeyColumns, matchHistoryColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMatchHistoryToOneUserUsingUserLine(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MatchHistory
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, matchHistoryDBTypes, false, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, true, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserLineID, foreign.LineUserID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.UserLine().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.LineUserID, foreign.LineUserID) {
+		t.Errorf("want: %v, got %v", foreign.LineUserID, check.LineUserID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := MatchHistorySlice{&local}
+	if err = local.L.LoadUserLine(ctx, tx, false, (*[]*MatchHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.UserLine == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.UserLine = nil
+	if err = local.L.LoadUserLine(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.UserLine == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testMatchHistoryToOneSetOpUserUsingUserLine(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MatchHistory
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, matchHistoryDBTypes, false, strmangle.SetComplement(matchHistoryPrimaryKeyColumns, matchHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUserLine(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.UserLine != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.UserLineMatchHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserLineID, x.LineUserID) {
+			t.Error("foreign key was wrong value", a.UserLineID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserLineID))
+		reflect.Indirect(reflect.ValueOf(&a.UserLineID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserLineID, x.LineUserID) {
+			t.Error("foreign key was wrong value", a.UserLineID, x.LineUserID)
+		}
+	}
+}
+
+func testMatchHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMatchHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MatchHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMatchHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MatchHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	matchHistoryDBTypes = map[string]string{`ID`: `INTEGER`, `UserLineID`: `TEXT`, `PartnerLineID`: `TEXT`, `PartnerName`: `TEXT`, `Action`: `TEXT`, `ChangedAt`: `TEXT`}
+	_                   = bytes.MinRead
+)
+
+func testMatchHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(matchHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(matchHistoryAllColumns) == len(matchHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testMatchHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(matchHistoryAllColumns) == len(matchHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MatchHistory{}
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, matchHistoryDBTypes, true, matchHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(matchHistoryAllColumns, matchHistoryPrimaryKeyColumns) {
+		fields = matchHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			matchHistoryAllColumns,
+			matchHistoryPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, matchHistoryGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := MatchHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testMatchHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(matchHistoryAllColumns) == len(matchHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := MatchHistory{}
+	if err = randomize.Struct(seed, &o, matchHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MatchHistory: %s", err)
+	}
+
+	count, err := MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, matchHistoryDBTypes, false, matchHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MatchHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MatchHistory: %s", err)
+	}
+
+	count, err = MatchHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1030 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// NotificationOutbox is an object representing the database table.
+type NotificationOutbox struct {
+	ID            null.Int64  `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	ToUserID      string      `boil:"to_user_id" json:"to_user_id" toml:"to_user_id" yaml:"to_user_id"`
+	Kind          string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Text          string      `boil:"text" json:"text" toml:"text" yaml:"text"`
+	RetryKey      string      `boil:"retry_key" json:"retry_key" toml:"retry_key" yaml:"retry_key"`
+	Status        string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts      int64       `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	NextAttemptAt string      `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	LastError     null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	CreatedAt     string      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DeliveredAt   null.String `boil:"delivered_at" json:"delivered_at,omitempty" toml:"delivered_at" yaml:"delivered_at,omitempty"`
+
+	R *notificationOutboxR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L notificationOutboxL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NotificationOutboxColumns = struct {
+	ID            string
+	ToUserID      string
+	Kind          string
+	Text          string
+	RetryKey      string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	CreatedAt     string
+	DeliveredAt   string
+}{
+	ID:            "id",
+	ToUserID:      "to_user_id",
+	Kind:          "kind",
+	Text:          "text",
+	RetryKey:      "retry_key",
+	Status:        "status",
+	Attempts:      "attempts",
+	NextAttemptAt: "next_attempt_at",
+	LastError:     "last_error",
+	CreatedAt:     "created_at",
+	DeliveredAt:   "delivered_at",
+}
+
+var NotificationOutboxTableColumns = struct {
+	ID            string
+	ToUserID      string
+	Kind          string
+	Text          string
+	RetryKey      string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	CreatedAt     string
+	DeliveredAt   string
+}{
+	ID:            "notification_outbox.id",
+	ToUserID:      "notification_outbox.to_user_id",
+	Kind:          "notification_outbox.kind",
+	Text:          "notification_outbox.text",
+	RetryKey:      "notification_outbox.retry_key",
+	Status:        "notification_outbox.status",
+	Attempts:      "notification_outbox.attempts",
+	NextAttemptAt: "notification_outbox.next_attempt_at",
+	LastError:     "notification_outbox.last_error",
+	CreatedAt:     "notification_outbox.created_at",
+	DeliveredAt:   "notification_outbox.delivered_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var NotificationOutboxWhere = struct {
+	ID            whereHelpernull_Int64
+	ToUserID      whereHelperstring
+	Kind          whereHelperstring
+	Text          whereHelperstring
+	RetryKey      whereHelperstring
+	Status        whereHelperstring
+	Attempts      whereHelperint64
+	NextAttemptAt whereHelperstring
+	LastError     whereHelpernull_String
+	CreatedAt     whereHelperstring
+	DeliveredAt   whereHelpernull_String
+}{
+	ID:            whereHelpernull_Int64{field: "\"notification_outbox\".\"id\""},
+	ToUserID:      whereHelperstring{field: "\"notification_outbox\".\"to_user_id\""},
+	Kind:          whereHelperstring{field: "\"notification_outbox\".\"kind\""},
+	Text:          whereHelperstring{field: "\"notification_outbox\".\"text\""},
+	RetryKey:      whereHelperstring{field: "\"notification_outbox\".\"retry_key\""},
+	Status:        whereHelperstring{field: "\"notification_outbox\".\"status\""},
+	Attempts:      whereHelperint64{field: "\"notification_outbox\".\"attempts\""},
+	NextAttemptAt: whereHelperstring{field: "\"notification_outbox\".\"next_attempt_at\""},
+	LastError:     whereHelpernull_String{field: "\"notification_outbox\".\"last_error\""},
+	CreatedAt:     whereHelperstring{field: "\"notification_outbox\".\"created_at\""},
+	DeliveredAt:   whereHelpernull_String{field: "\"notification_outbox\".\"delivered_at\""},
+}
+
+// NotificationOutboxRels is where relationship names are stored.
+var NotificationOutboxRels = struct {
+}{}
+
+// notificationOutboxR is where relationships are stored.
+type notificationOutboxR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*notificationOutboxR) NewStruct() *notificationOutboxR {
+	return &notificationOutboxR{}
+}
+
+// notificationOutboxL is where Load methods for each relationship are stored.
+type notificationOutboxL struct{}
+
+var (
+	notificationOutboxAllColumns            = []string{"id", "to_user_id", "kind", "text", "retry_key", "status", "attempts", "next_attempt_at", "last_error", "created_at", "delivered_at"}
+	notificationOutboxColumnsWithoutDefault = []string{"to_user_id", "kind", "text", "retry_key"}
+	notificationOutboxColumnsWithDefault    = []string{"id", "status", "attempts", "next_attempt_at", "last_error", "created_at", "delivered_at"}
+	notificationOutboxPrimaryKeyColumns     = []string{"id"}
+	notificationOutboxGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// NotificationOutboxSlice is an alias for a slice of pointers to NotificationOutbox.
+	// This should almost always be used instead of []NotificationOutbox.
+	NotificationOutboxSlice []*NotificationOutbox
+	// NotificationOutboxHook is the signature for custom NotificationOutbox hook methods
+	NotificationOutboxHook func(context.Context, boil.ContextExecutor, *NotificationOutbox) error
+
+	notificationOutboxQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	notificationOutboxType                 = reflect.TypeOf(&NotificationOutbox{})
+	notificationOutboxMapping              = queries.MakeStructMapping(notificationOutboxType)
+	notificationOutboxPrimaryKeyMapping, _ = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, notificationOutboxPrimaryKeyColumns)
+	notificationOutboxInsertCacheMut       sync.RWMutex
+	notificationOutboxInsertCache          = make(map[string]insertCache)
+	notificationOutboxUpdateCacheMut       sync.RWMutex
+	notificationOutboxUpdateCache          = make(map[string]updateCache)
+	notificationOutboxUpsertCacheMut       sync.RWMutex
+	notificationOutboxUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var notificationOutboxAfterSelectMu sync.Mutex
+var notificationOutboxAfterSelectHooks []NotificationOutboxHook
+
+var notificationOutboxBeforeInsertMu sync.Mutex
+var notificationOutboxBeforeInsertHooks []NotificationOutboxHook
+var notificationOutboxAfterInsertMu sync.Mutex
+var notificationOutboxAfterInsertHooks []NotificationOutboxHook
+
+var notificationOutboxBeforeUpdateMu sync.Mutex
+var notificationOutboxBeforeUpdateHooks []NotificationOutboxHook
+var notificationOutboxAfterUpdateMu sync.Mutex
+var notificationOutboxAfterUpdateHooks []NotificationOutboxHook
+
+var notificationOutboxBeforeDeleteMu sync.Mutex
+var notificationOutboxBeforeDeleteHooks []NotificationOutboxHook
+var notificationOutboxAfterDeleteMu sync.Mutex
+var notificationOutboxAfterDeleteHooks []NotificationOutboxHook
+
+var notificationOutboxBeforeUpsertMu sync.Mutex
+var notificationOutboxBeforeUpsertHooks []NotificationOutboxHook
+var notificationOutboxAfterUpsertMu sync.Mutex
+var notificationOutboxAfterUpsertHooks []NotificationOutboxHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *NotificationOutbox) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOutboxAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *NotificationOutbox) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOutboxBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *NotificationOutbox) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOutboxAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *NotificationOutbox) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOutboxBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *NotificationOutbox) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOutboxAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *NotificationOutbox) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOutboxBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *NotificationOutbox) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOutboxAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *NotificationOutbox) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOutboxBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *NotificationOutbox) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range notificationOutboxAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddNotificationOutboxHook registers your hook function for all future operations.
+func AddNotificationOutboxHook(hookPoint boil.HookPoint, notificationOutboxHook NotificationOutboxHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		notificationOutboxAfterSelectMu.Lock()
+		notificationOutboxAfterSelectHooks = append(notificationOutboxAfterSelectHooks, notificationOutboxHook)
+		notificationOutboxAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		notificationOutboxBeforeInsertMu.Lock()
+		notificationOutboxBeforeInsertHooks = append(notificationOutboxBeforeInsertHooks, notificationOutboxHook)
+		notificationOutboxBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		notificationOutboxAfterInsertMu.Lock()
+		notificationOutboxAfterInsertHooks = append(notificationOutboxAfterInsertHooks, notificationOutboxHook)
+		notificationOutboxAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		notificationOutboxBeforeUpdateMu.Lock()
+		notificationOutboxBeforeUpdateHooks = append(notificationOutboxBeforeUpdateHooks, notificationOutboxHook)
+		notificationOutboxBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		notificationOutboxAfterUpdateMu.Lock()
+		notificationOutboxAfterUpdateHooks = append(notificationOutboxAfterUpdateHooks, notificationOutboxHook)
+		notificationOutboxAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		notificationOutboxBeforeDeleteMu.Lock()
+		notificationOutboxBeforeDeleteHooks = append(notificationOutboxBeforeDeleteHooks, notificationOutboxHook)
+		notificationOutboxBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		notificationOutboxAfterDeleteMu.Lock()
+		notificationOutboxAfterDeleteHooks = append(notificationOutboxAfterDeleteHooks, notificationOutboxHook)
+		notificationOutboxAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		notificationOutboxBeforeUpsertMu.Lock()
+		notificationOutboxBeforeUpsertHooks = append(notificationOutboxBeforeUpsertHooks, notificationOutboxHook)
+		notificationOutboxBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		notificationOutboxAfterUpsertMu.Lock()
+		notificationOutboxAfterUpsertHooks = append(notificationOutboxAfterUpsertHooks, notificationOutboxHook)
+		notificationOutboxAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single notificationOutbox record from the query.
+func (q notificationOutboxQuery) One(ctx context.Context, exec boil.ContextExecutor) (*NotificationOutbox, error) {
+	o := &NotificationOutbox{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: failed to execute a one query for notification_outbox")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all NotificationOutbox records from the query.
+func (q notificationOutboxQuery) All(ctx context.Context, exec boil.ContextExecutor) (NotificationOutboxSlice, error) {
+	var o []*NotificationOutbox
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entities: failed to assign all query results to NotificationOutbox slice")
+	}
+
+	if len(notificationOutboxAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all NotificationOutbox records in the query.
+func (q notificationOutboxQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to count notification_outbox rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q notificationOutboxQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: failed to check if notification_outbox exists")
+	}
+
+	return count > 0, nil
+}
+
+// NotificationOutboxes retrieves all the records using an executor.
+func NotificationOutboxes(mods ...qm.QueryMod) notificationOutboxQuery {
+	mods = append(mods, qm.From("\"notification_outbox\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"notification_outbox\".*"})
+	}
+
+	return notificationOutboxQuery{q}
+}
+
+// FindNotificationOutbox retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNotificationOutbox(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*NotificationOutbox, error) {
+	notificationOutboxObj := &NotificationOutbox{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"notification_outbox\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, notificationOutboxObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: unable to select from notification_outbox")
+	}
+
+	if err = notificationOutboxObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return notificationOutboxObj, err
+	}
+
+	return notificationOutboxObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *NotificationOutbox) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no notification_outbox provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationOutboxColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	notificationOutboxInsertCacheMut.RLock()
+	cache, cached := notificationOutboxInsertCache[key]
+	notificationOutboxInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			notificationOutboxAllColumns,
+			notificationOutboxColumnsWithDefault,
+			notificationOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, notificationOutboxGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"notification_outbox\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"notification_outbox\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to insert into notification_outbox")
+	}
+
+	if !cached {
+		notificationOutboxInsertCacheMut.Lock()
+		notificationOutboxInsertCache[key] = cache
+		notificationOutboxInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the NotificationOutbox.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *NotificationOutbox) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	notificationOutboxUpdateCacheMut.RLock()
+	cache, cached := notificationOutboxUpdateCache[key]
+	notificationOutboxUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			notificationOutboxAllColumns,
+			notificationOutboxPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, notificationOutboxGeneratedColumns)
+
+		if len(wl) == 0 {
+			return 0, errors.New("entities: unable to update notification_outbox, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"notification_outbox\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, notificationOutboxPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, append(wl, notificationOutboxPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update notification_outbox row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by update for notification_outbox")
+	}
+
+	if !cached {
+		notificationOutboxUpdateCacheMut.Lock()
+		notificationOutboxUpdateCache[key] = cache
+		notificationOutboxUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q notificationOutboxQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all for notification_outbox")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected for notification_outbox")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NotificationOutboxSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entities: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"notification_outbox\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationOutboxPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all in notificationOutbox slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected all in update all notificationOutbox")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *NotificationOutbox) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no notification_outbox provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(notificationOutboxColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	notificationOutboxUpsertCacheMut.RLock()
+	cache, cached := notificationOutboxUpsertCache[key]
+	notificationOutboxUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			notificationOutboxAllColumns,
+			notificationOutboxColumnsWithDefault,
+			notificationOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			notificationOutboxAllColumns,
+			notificationOutboxPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entities: unable to upsert notification_outbox, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(notificationOutboxAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(notificationOutboxPrimaryKeyColumns))
+			copy(conflict, notificationOutboxPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"notification_outbox\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(notificationOutboxType, notificationOutboxMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to upsert notification_outbox")
+	}
+
+	if !cached {
+		notificationOutboxUpsertCacheMut.Lock()
+		notificationOutboxUpsertCache[key] = cache
+		notificationOutboxUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single NotificationOutbox record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *NotificationOutbox) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entities: no NotificationOutbox provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), notificationOutboxPrimaryKeyMapping)
+	sql := "DELETE FROM \"notification_outbox\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete from notification_outbox")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by delete for notification_outbox")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q notificationOutboxQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entities: no notificationOutboxQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from notification_outbox")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for notification_outbox")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NotificationOutboxSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(notificationOutboxBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"notification_outbox\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationOutboxPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from notificationOutbox slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for notification_outbox")
+	}
+
+	if len(notificationOutboxAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *NotificationOutbox) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindNotificationOutbox(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NotificationOutboxSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NotificationOutboxSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), notificationOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"notification_outbox\".* FROM \"notification_outbox\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, notificationOutboxPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to reload all in NotificationOutboxSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NotificationOutboxExists checks if the NotificationOutbox row exists.
+func NotificationOutboxExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"notification_outbox\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: unable to check if notification_outbox exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the NotificationOutbox row exists.
+func (o *NotificationOutbox) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return NotificationOutboxExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testNotificationOutboxes(t *testing.T) {
+	t.Parallel()
+
+	query := NotificationOutboxes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testNotificationOutboxesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testNotificationOutboxesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := NotificationOutboxes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testNotificationOutboxesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := NotificationOutboxSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testNotificationOutboxesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := NotificationOutboxExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if NotificationOutbox exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected NotificationOutboxExists to return true, but got false.")
+	}
+}
+
+func testNotificationOutboxesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	notificationOutboxFound, err := FindNotificationOutbox(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if notificationOutboxFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testNotificationOutboxesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = NotificationOutboxes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testNotificationOutboxesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := NotificationOutboxes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testNotificationOutboxesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	notificationOutboxOne := &NotificationOutbox{}
+	notificationOutboxTwo := &NotificationOutbox{}
+	if err = randomize.Struct(seed, notificationOutboxOne, notificationOutboxDBTypes, false, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+	if err = randomize.Struct(seed, notificationOutboxTwo, notificationOutboxDBTypes, false, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = notificationOutboxOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = notificationOutboxTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := NotificationOutboxes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testNotificationOutboxesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	notificationOutboxOne := &NotificationOutbox{}
+	notificationOutboxTwo := &NotificationOutbox{}
+	if err = randomize.Struct(seed, notificationOutboxOne, notificationOutboxDBTypes, false, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+	if err = randomize.Struct(seed, notificationOutboxTwo, notificationOutboxDBTypes, false, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = notificationOutboxOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = notificationOutboxTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func notificationOutboxBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *NotificationOutbox) error {
+	*o = NotificationOutbox{}
+	return nil
+}
+
+func notificationOutboxAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *NotificationOutbox) error {
+	*o = NotificationOutbox{}
+	return nil
+}
+
+func notificationOutboxAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *NotificationOutbox) error {
+	*o = NotificationOutbox{}
+	return nil
+}
+
+func notificationOutboxBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *NotificationOutbox) error {
+	*o = NotificationOutbox{}
+	return nil
+}
+
+func notificationOutboxAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *NotificationOutbox) error {
+	*o = NotificationOutbox{}
+	return nil
+}
+
+func notificationOutboxBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *NotificationOutbox) error {
+	*o = NotificationOutbox{}
+	return nil
+}
+
+func notificationOutboxAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *NotificationOutbox) error {
+	*o = NotificationOutbox{}
+	return nil
+}
+
+func notificationOutboxBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *NotificationOutbox) error {
+	*o = NotificationOutbox{}
+	return nil
+}
+
+func notificationOutboxAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *NotificationOutbox) error {
+	*o = NotificationOutbox{}
+	return nil
+}
+
+func testNotificationOutboxesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &NotificationOutbox{}
+	o := &NotificationOutbox{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox object: %s", err)
+	}
+
+	AddNotificationOutboxHook(boil.BeforeInsertHook, notificationOutboxBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	notificationOutboxBeforeInsertHooks = []NotificationOutboxHook{}
+
+	AddNotificationOutboxHook(boil.AfterInsertHook, notificationOutboxAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	notificationOutboxAfterInsertHooks = []NotificationOutboxHook{}
+
+	AddNotificationOutboxHook(boil.AfterSelectHook, notificationOutboxAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	notificationOutboxAfterSelectHooks = []NotificationOutboxHook{}
+
+	AddNotificationOutboxHook(boil.BeforeUpdateHook, notificationOutboxBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	notificationOutboxBeforeUpdateHooks = []NotificationOutboxHook{}
+
+	AddNotificationOutboxHook(boil.AfterUpdateHook, notificationOutboxAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	notificationOutboxAfterUpdateHooks = []NotificationOutboxHook{}
+
+	AddNotificationOutboxHook(boil.BeforeDeleteHook, notificationOutboxBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	notificationOutboxBeforeDeleteHooks = []NotificationOutboxHook{}
+
+	AddNotificationOutboxHook(boil.AfterDeleteHook, notificationOutboxAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	notificationOutboxAfterDeleteHooks = []NotificationOutboxHook{}
+
+	AddNotificationOutboxHook(boil.BeforeUpsertHook, notificationOutboxBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	notificationOutboxBeforeUpsertHooks = []NotificationOutboxHook{}
+
+	AddNotificationOutboxHook(boil.AfterUpsertHook, notificationOutboxAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	notificationOutboxAfterUpsertHooks = []NotificationOutboxHook{}
+}
+
+func testNotificationOutboxesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testNotificationOutboxesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(notificationOutboxPrimaryKeyColumns, notificationOutboxColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testNotificationOutboxesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testNotificationOutboxesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := NotificationOutboxSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testNotificationOutboxesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := NotificationOutboxes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	notificationOutboxDBTypes = map[string]string{`ID`: `INTEGER`, `ToUserID`: `TEXT`, `Kind`: `TEXT`, `Text`: `TEXT`, `RetryKey`: `TEXT`, `Status`: `TEXT`, `Attempts`: `INTEGER`, `NextAttemptAt`: `TEXT`, `LastError`: `TEXT`, `CreatedAt`: `TEXT`, `DeliveredAt`: `TEXT`}
+	_                         = bytes.MinRead
+)
+
+func testNotificationOutboxesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(notificationOutboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(notificationOutboxAllColumns) == len(notificationOutboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testNotificationOutboxesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(notificationOutboxAllColumns) == len(notificationOutboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &NotificationOutbox{}
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, notificationOutboxDBTypes, true, notificationOutboxPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(notificationOutboxAllColumns, notificationOutboxPrimaryKeyColumns) {
+		fields = notificationOutboxAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			notificationOutboxAllColumns,
+			notificationOutboxPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, notificationOutboxGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := NotificationOutboxSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testNotificationOutboxesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(notificationOutboxAllColumns) == len(notificationOutboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := NotificationOutbox{}
+	if err = randomize.Struct(seed, &o, notificationOutboxDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert NotificationOutbox: %s", err)
+	}
+
+	count, err := NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, notificationOutboxDBTypes, false, notificationOutboxPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize NotificationOutbox struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert NotificationOutbox: %s", err)
+	}
+
+	count, err = NotificationOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,937 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PushLedger is an object representing the database table.
+type PushLedger struct {
+	ID       null.Int64  `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	ToUserID string      `boil:"to_user_id" json:"to_user_id" toml:"to_user_id" yaml:"to_user_id"`
+	RetryKey null.String `boil:"retry_key" json:"retry_key,omitempty" toml:"retry_key" yaml:"retry_key,omitempty"`
+	SentAt   string      `boil:"sent_at" json:"sent_at" toml:"sent_at" yaml:"sent_at"`
+
+	R *pushLedgerR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pushLedgerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PushLedgerColumns = struct {
+	ID       string
+	ToUserID string
+	RetryKey string
+	SentAt   string
+}{
+	ID:       "id",
+	ToUserID: "to_user_id",
+	RetryKey: "retry_key",
+	SentAt:   "sent_at",
+}
+
+var PushLedgerTableColumns = struct {
+	ID       string
+	ToUserID string
+	RetryKey string
+	SentAt   string
+}{
+	ID:       "push_ledger.id",
+	ToUserID: "push_ledger.to_user_id",
+	RetryKey: "push_ledger.retry_key",
+	SentAt:   "push_ledger.sent_at",
+}
+
+// Generated where
+
+var PushLedgerWhere = struct {
+	ID       whereHelpernull_Int64
+	ToUserID whereHelperstring
+	RetryKey whereHelpernull_String
+	SentAt   whereHelperstring
+}{
+	ID:       whereHelpernull_Int64{field: "\"push_ledger\".\"id\""},
+	ToUserID: whereHelperstring{field: "\"push_ledger\".\"to_user_id\""},
+	RetryKey: whereHelpernull_String{field: "\"push_ledger\".\"retry_key\""},
+	SentAt:   whereHelperstring{field: "\"push_ledger\".\"sent_at\""},
+}
+
+// PushLedgerRels is where relationship names are stored.
+var PushLedgerRels = struct {
+}{}
+
+// pushLedgerR is where relationships are stored.
+type pushLedgerR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*pushLedgerR) NewStruct() *pushLedgerR {
+	return &pushLedgerR{}
+}
+
+// pushLedgerL is where Load methods for each relationship are stored.
+type pushLedgerL struct{}
+
+var (
+	pushLedgerAllColumns            = []string{"id", "to_user_id", "retry_key", "sent_at"}
+	pushLedgerColumnsWithoutDefault = []string{"to_user_id"}
+	pushLedgerColumnsWithDefault    = []string{"id", "retry_key", "sent_at"}
+	pushLedgerPrimaryKeyColumns     = []string{"id"}
+	pushLedgerGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// PushLedgerSlice is an alias for a slice of pointers to PushLedger.
+	// This should almost always be used instead of []PushLedger.
+	PushLedgerSlice []*PushLedger
+	// PushLedgerHook is the signature for custom PushLedger hook methods
+	PushLedgerHook func(context.Context, boil.ContextExecutor, *PushLedger) error
+
+	pushLedgerQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pushLedgerType                 = reflect.TypeOf(&PushLedger{})
+	pushLedgerMapping              = queries.MakeStructMapping(pushLedgerType)
+	pushLedgerPrimaryKeyMapping, _ = queries.BindMapping(pushLedgerType, pushLedgerMapping, pushLedgerPrimaryKeyColumns)
+	pushLedgerInsertCacheMut       sync.RWMutex
+	pushLedgerInsertCache          = make(map[string]insertCache)
+	pushLedgerUpdateCacheMut       sync.RWMutex
+	pushLedgerUpdateCache          = make(map[string]updateCache)
+	pushLedgerUpsertCacheMut       sync.RWMutex
+	pushLedgerUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var pushLedgerAfterSelectMu sync.Mutex
+var pushLedgerAfterSelectHooks []PushLedgerHook
+
+var pushLedgerBeforeInsertMu sync.Mutex
+var pushLedgerBeforeInsertHooks []PushLedgerHook
+var pushLedgerAfterInsertMu sync.Mutex
+var pushLedgerAfterInsertHooks []PushLedgerHook
+
+var pushLedgerBeforeUpdateMu sync.Mutex
+var pushLedgerBeforeUpdateHooks []PushLedgerHook
+var pushLedgerAfterUpdateMu sync.Mutex
+var pushLedgerAfterUpdateHooks []PushLedgerHook
+
+var pushLedgerBeforeDeleteMu sync.Mutex
+var pushLedgerBeforeDeleteHooks []PushLedgerHook
+var pushLedgerAfterDeleteMu sync.Mutex
+var pushLedgerAfterDeleteHooks []PushLedgerHook
+
+var pushLedgerBeforeUpsertMu sync.Mutex
+var pushLedgerBeforeUpsertHooks []PushLedgerHook
+var pushLedgerAfterUpsertMu sync.Mutex
+var pushLedgerAfterUpsertHooks []PushLedgerHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PushLedger) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pushLedgerAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PushLedger) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pushLedgerBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PushLedger) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pushLedgerAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PushLedger) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pushLedgerBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PushLedger) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pushLedgerAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PushLedger) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pushLedgerBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PushLedger) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pushLedgerAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PushLedger) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pushLedgerBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PushLedger) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pushLedgerAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPushLedgerHook registers your hook function for all future operations.
+func AddPushLedgerHook(hookPoint boil.HookPoint, pushLedgerHook PushLedgerHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		pushLedgerAfterSelectMu.Lock()
+		pushLedgerAfterSelectHooks = append(pushLedgerAfterSelectHooks, pushLedgerHook)
+		pushLedgerAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		pushLedgerBeforeInsertMu.Lock()
+		pushLedgerBeforeInsertHooks = append(pushLedgerBeforeInsertHooks, pushLedgerHook)
+		pushLedgerBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		pushLedgerAfterInsertMu.Lock()
+		pushLedgerAfterInsertHooks = append(pushLedgerAfterInsertHooks, pushLedgerHook)
+		pushLedgerAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		pushLedgerBeforeUpdateMu.Lock()
+		pushLedgerBeforeUpdateHooks = append(pushLedgerBeforeUpdateHooks, pushLedgerHook)
+		pushLedgerBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		pushLedgerAfterUpdateMu.Lock()
+		pushLedgerAfterUpdateHooks = append(pushLedgerAfterUpdateHooks, pushLedgerHook)
+		pushLedgerAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		pushLedgerBeforeDeleteMu.Lock()
+		pushLedgerBeforeDeleteHooks = append(pushLedgerBeforeDeleteHooks, pushLedgerHook)
+		pushLedgerBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		pushLedgerAfterDeleteMu.Lock()
+		pushLedgerAfterDeleteHooks = append(pushLedgerAfterDeleteHooks, pushLedgerHook)
+		pushLedgerAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		pushLedgerBeforeUpsertMu.Lock()
+		pushLedgerBeforeUpsertHooks = append(pushLedgerBeforeUpsertHooks, pushLedgerHook)
+		pushLedgerBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		pushLedgerAfterUpsertMu.Lock()
+		pushLedgerAfterUpsertHooks = append(pushLedgerAfterUpsertHooks, pushLedgerHook)
+		pushLedgerAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single pushLedger record from the query.
+func (q pushLedgerQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PushLedger, error) {
+	o := &PushLedger{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: failed to execute a one query for push_ledger")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PushLedger records from the query.
+func (q pushLedgerQuery) All(ctx context.Context, exec boil.ContextExecutor) (PushLedgerSlice, error) {
+	var o []*PushLedger
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entities: failed to assign all query results to PushLedger slice")
+	}
+
+	if len(pushLedgerAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PushLedger records in the query.
+func (q pushLedgerQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to count push_ledger rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pushLedgerQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: failed to check if push_ledger exists")
+	}
+
+	return count > 0, nil
+}
+
+// PushLedgers retrieves all the records using an executor.
+func PushLedgers(mods ...qm.QueryMod) pushLedgerQuery {
+	mods = append(mods, qm.From("\"push_ledger\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"push_ledger\".*"})
+	}
+
+	return pushLedgerQuery{q}
+}
+
+// FindPushLedger retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPushLedger(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*PushLedger, error) {
+	pushLedgerObj := &PushLedger{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"push_ledger\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, pushLedgerObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: unable to select from push_ledger")
+	}
+
+	if err = pushLedgerObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return pushLedgerObj, err
+	}
+
+	return pushLedgerObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PushLedger) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no push_ledger provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushLedgerColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pushLedgerInsertCacheMut.RLock()
+	cache, cached := pushLedgerInsertCache[key]
+	pushLedgerInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pushLedgerAllColumns,
+			pushLedgerColumnsWithDefault,
+			pushLedgerColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, pushLedgerGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(pushLedgerType, pushLedgerMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pushLedgerType, pushLedgerMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"push_ledger\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"push_ledger\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to insert into push_ledger")
+	}
+
+	if !cached {
+		pushLedgerInsertCacheMut.Lock()
+		pushLedgerInsertCache[key] = cache
+		pushLedgerInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PushLedger.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PushLedger) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	pushLedgerUpdateCacheMut.RLock()
+	cache, cached := pushLedgerUpdateCache[key]
+	pushLedgerUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pushLedgerAllColumns,
+			pushLedgerPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, pushLedgerGeneratedColumns)
+
+		if len(wl) == 0 {
+			return 0, errors.New("entities: unable to update push_ledger, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"push_ledger\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, pushLedgerPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pushLedgerType, pushLedgerMapping, append(wl, pushLedgerPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update push_ledger row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by update for push_ledger")
+	}
+
+	if !cached {
+		pushLedgerUpdateCacheMut.Lock()
+		pushLedgerUpdateCache[key] = cache
+		pushLedgerUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pushLedgerQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all for push_ledger")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected for push_ledger")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PushLedgerSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entities: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushLedgerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"push_ledger\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, pushLedgerPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all in pushLedger slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected all in update all pushLedger")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PushLedger) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no push_ledger provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushLedgerColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pushLedgerUpsertCacheMut.RLock()
+	cache, cached := pushLedgerUpsertCache[key]
+	pushLedgerUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pushLedgerAllColumns,
+			pushLedgerColumnsWithDefault,
+			pushLedgerColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			pushLedgerAllColumns,
+			pushLedgerPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entities: unable to upsert push_ledger, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pushLedgerAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(pushLedgerPrimaryKeyColumns))
+			copy(conflict, pushLedgerPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"push_ledger\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(pushLedgerType, pushLedgerMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pushLedgerType, pushLedgerMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to upsert push_ledger")
+	}
+
+	if !cached {
+		pushLedgerUpsertCacheMut.Lock()
+		pushLedgerUpsertCache[key] = cache
+		pushLedgerUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PushLedger record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PushLedger) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entities: no PushLedger provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pushLedgerPrimaryKeyMapping)
+	sql := "DELETE FROM \"push_ledger\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete from push_ledger")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by delete for push_ledger")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pushLedgerQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entities: no pushLedgerQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from push_ledger")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for push_ledger")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PushLedgerSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(pushLedgerBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushLedgerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"push_ledger\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, pushLedgerPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from pushLedger slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for push_ledger")
+	}
+
+	if len(pushLedgerAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PushLedger) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPushLedger(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PushLedgerSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PushLedgerSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushLedgerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"push_ledger\".* FROM \"push_ledger\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, pushLedgerPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to reload all in PushLedgerSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PushLedgerExists checks if the PushLedger row exists.
+func PushLedgerExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"push_ledger\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: unable to check if push_ledger exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PushLedger row exists.
+func (o *PushLedger) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PushLedgerExists(ctx, exec, o.ID)
+}
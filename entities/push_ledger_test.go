@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPushLedgers(t *testing.T) {
+	t.Parallel()
+
+	query := PushLedgers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPushLedgersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushLedgersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PushLedgers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushLedgersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushLedgerSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushLedgersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PushLedgerExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PushLedger exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PushLedgerExists to return true, but got false.")
+	}
+}
+
+func testPushLedgersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	pushLedgerFound, err := FindPushLedger(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pushLedgerFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPushLedgersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PushLedgers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushLedgersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PushLedgers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPushLedgersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	pushLedgerOne := &PushLedger{}
+	pushLedgerTwo := &PushLedger{}
+	if err = randomize.Struct(seed, pushLedgerOne, pushLedgerDBTypes, false, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushLedgerTwo, pushLedgerDBTypes, false, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushLedgerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushLedgerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushLedgers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPushLedgersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	pushLedgerOne := &PushLedger{}
+	pushLedgerTwo := &PushLedger{}
+	if err = randomize.Struct(seed, pushLedgerOne, pushLedgerDBTypes, false, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushLedgerTwo, pushLedgerDBTypes, false, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushLedgerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushLedgerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func pushLedgerBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PushLedger) error {
+	*o = PushLedger{}
+	return nil
+}
+
+func pushLedgerAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PushLedger) error {
+	*o = PushLedger{}
+	return nil
+}
+
+func pushLedgerAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PushLedger) error {
+	*o = PushLedger{}
+	return nil
+}
+
+func pushLedgerBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PushLedger) error {
+	*o = PushLedger{}
+	return nil
+}
+
+func pushLedgerAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PushLedger) error {
+	*o = PushLedger{}
+	return nil
+}
+
+func pushLedgerBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PushLedger) error {
+	*o = PushLedger{}
+	return nil
+}
+
+func pushLedgerAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PushLedger) error {
+	*o = PushLedger{}
+	return nil
+}
+
+func pushLedgerBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PushLedger) error {
+	*o = PushLedger{}
+	return nil
+}
+
+func pushLedgerAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PushLedger) error {
+	*o = PushLedger{}
+	return nil
+}
+
+func testPushLedgersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PushLedger{}
+	o := &PushLedger{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PushLedger object: %s", err)
+	}
+
+	AddPushLedgerHook(boil.BeforeInsertHook, pushLedgerBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	pushLedgerBeforeInsertHooks = []PushLedgerHook{}
+
+	AddPushLedgerHook(boil.AfterInsertHook, pushLedgerAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	pushLedgerAfterInsertHooks = []PushLedgerHook{}
+
+	AddPushLedgerHook(boil.AfterSelectHook, pushLedgerAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	pushLedgerAfterSelectHooks = []PushLedgerHook{}
+
+	AddPushLedgerHook(boil.BeforeUpdateHook, pushLedgerBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	pushLedgerBeforeUpdateHooks = []PushLedgerHook{}
+
+	AddPushLedgerHook(boil.AfterUpdateHook, pushLedgerAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	pushLedgerAfterUpdateHooks = []PushLedgerHook{}
+
+	AddPushLedgerHook(boil.BeforeDeleteHook, pushLedgerBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	pushLedgerBeforeDeleteHooks = []PushLedgerHook{}
+
+	AddPushLedgerHook(boil.AfterDeleteHook, pushLedgerAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	pushLedgerAfterDeleteHooks = []PushLedgerHook{}
+
+	AddPushLedgerHook(boil.BeforeUpsertHook, pushLedgerBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	pushLedgerBeforeUpsertHooks = []PushLedgerHook{}
+
+	AddPushLedgerHook(boil.AfterUpsertHook, pushLedgerAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	pushLedgerAfterUpsertHooks = []PushLedgerHook{}
+}
+
+func testPushLedgersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushLedgersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(pushLedgerPrimaryKeyColumns, pushLedgerColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushLedgersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushLedgersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushLedgerSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushLedgersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushLedgers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	pushLedgerDBTypes = map[string]string{`ID`: `INTEGER`, `ToUserID`: `TEXT`, `RetryKey`: `TEXT`, `SentAt`: `TEXT`}
+	_                 = bytes.MinRead
+)
+
+func testPushLedgersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(pushLedgerPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(pushLedgerAllColumns) == len(pushLedgerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPushLedgersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(pushLedgerAllColumns) == len(pushLedgerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushLedger{}
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushLedgerDBTypes, true, pushLedgerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(pushLedgerAllColumns, pushLedgerPrimaryKeyColumns) {
+		fields = pushLedgerAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			pushLedgerAllColumns,
+			pushLedgerPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, pushLedgerGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PushLedgerSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPushLedgersUpsert(t *testing.T) {
+	t.Parallel()
+	if len(pushLedgerAllColumns) == len(pushLedgerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PushLedger{}
+	if err = randomize.Struct(seed, &o, pushLedgerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushLedger: %s", err)
+	}
+
+	count, err := PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, pushLedgerDBTypes, false, pushLedgerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushLedger struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushLedger: %s", err)
+	}
+
+	count, err = PushLedgers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
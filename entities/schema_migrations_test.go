@@ -568,7 +568,7 @@ func testSchemaMigrationsSelect(t *testing.T) {
 }
 
 var (
-	schemaMigrationDBTypes = map[string]string{`ID`: `VARCHAR(255)`, `AppliedAt`: `DATETIME`}
+	schemaMigrationDBTypes = map[string]string{`ID`: `TEXT`, `AppliedAt`: `DATETIME`}
 	_                      = bytes.MinRead
 )
 
@@ -6,7 +6,23 @@ package entities
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("AdminAuditLogs", testAdminAuditLogsUpsert)
+
+	t.Run("CrushChangeCounts", testCrushChangeCountsUpsert)
+
+	t.Run("CrushHistories", testCrushHistoriesUpsert)
+
+	t.Run("Crushes", testCrushesUpsert)
+
+	t.Run("MatchHistories", testMatchHistoriesUpsert)
+
+	t.Run("NotificationOutboxes", testNotificationOutboxesUpsert)
+
+	t.Run("PushLedgers", testPushLedgersUpsert)
+
 	t.Run("SchemaMigrations", testSchemaMigrationsUpsert)
 
 	t.Run("Users", testUsersUpsert)
+
+	t.Run("WebhookEvents", testWebhookEventsUpsert)
 }
@@ -24,138 +24,134 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	LineUserID        null.String `boil:"line_user_id" json:"line_user_id,omitempty" toml:"line_user_id" yaml:"line_user_id,omitempty"`
-	Name              string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Birthday          string      `boil:"birthday" json:"birthday" toml:"birthday" yaml:"birthday"`
-	CrushName         null.String `boil:"crush_name" json:"crush_name,omitempty" toml:"crush_name" yaml:"crush_name,omitempty"`
-	CrushBirthday     null.String `boil:"crush_birthday" json:"crush_birthday,omitempty" toml:"crush_birthday" yaml:"crush_birthday,omitempty"`
-	MatchedWithUserID null.String `boil:"matched_with_user_id" json:"matched_with_user_id,omitempty" toml:"matched_with_user_id" yaml:"matched_with_user_id,omitempty"`
-	RegisteredAt      string      `boil:"registered_at" json:"registered_at" toml:"registered_at" yaml:"registered_at"`
-	UpdatedAt         string      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	LineUserID            null.String `boil:"line_user_id" json:"line_user_id,omitempty" toml:"line_user_id" yaml:"line_user_id,omitempty"`
+	Name                  string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Birthday              string      `boil:"birthday" json:"birthday" toml:"birthday" yaml:"birthday"`
+	MatchedWithUserID     null.String `boil:"matched_with_user_id" json:"matched_with_user_id,omitempty" toml:"matched_with_user_id" yaml:"matched_with_user_id,omitempty"`
+	RegisteredAt          string      `boil:"registered_at" json:"registered_at" toml:"registered_at" yaml:"registered_at"`
+	UpdatedAt             string      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	LineDisplayName       string      `boil:"line_display_name" json:"line_display_name" toml:"line_display_name" yaml:"line_display_name"`
+	DeletedAt             null.String `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	NameKey               string      `boil:"name_key" json:"name_key" toml:"name_key" yaml:"name_key"`
+	AltName               string      `boil:"alt_name" json:"alt_name" toml:"alt_name" yaml:"alt_name"`
+	AltNameKey            string      `boil:"alt_name_key" json:"alt_name_key" toml:"alt_name_key" yaml:"alt_name_key"`
+	WithdrawalRequestedAt null.String `boil:"withdrawal_requested_at" json:"withdrawal_requested_at,omitempty" toml:"withdrawal_requested_at" yaml:"withdrawal_requested_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	LineUserID        string
-	Name              string
-	Birthday          string
-	CrushName         string
-	CrushBirthday     string
-	MatchedWithUserID string
-	RegisteredAt      string
-	UpdatedAt         string
+	LineUserID            string
+	Name                  string
+	Birthday              string
+	MatchedWithUserID     string
+	RegisteredAt          string
+	UpdatedAt             string
+	LineDisplayName       string
+	DeletedAt             string
+	NameKey               string
+	AltName               string
+	AltNameKey            string
+	WithdrawalRequestedAt string
 }{
-	LineUserID:        "line_user_id",
-	Name:              "name",
-	Birthday:          "birthday",
-	CrushName:         "crush_name",
-	CrushBirthday:     "crush_birthday",
-	MatchedWithUserID: "matched_with_user_id",
-	RegisteredAt:      "registered_at",
-	UpdatedAt:         "updated_at",
+	LineUserID:            "line_user_id",
+	Name:                  "name",
+	Birthday:              "birthday",
+	MatchedWithUserID:     "matched_with_user_id",
+	RegisteredAt:          "registered_at",
+	UpdatedAt:             "updated_at",
+	LineDisplayName:       "line_display_name",
+	DeletedAt:             "deleted_at",
+	NameKey:               "name_key",
+	AltName:               "alt_name",
+	AltNameKey:            "alt_name_key",
+	WithdrawalRequestedAt: "withdrawal_requested_at",
 }
 
 var UserTableColumns = struct {
-	LineUserID        string
-	Name              string
-	Birthday          string
-	CrushName         string
-	CrushBirthday     string
-	MatchedWithUserID string
-	RegisteredAt      string
-	UpdatedAt         string
+	LineUserID            string
+	Name                  string
+	Birthday              string
+	MatchedWithUserID     string
+	RegisteredAt          string
+	UpdatedAt             string
+	LineDisplayName       string
+	DeletedAt             string
+	NameKey               string
+	AltName               string
+	AltNameKey            string
+	WithdrawalRequestedAt string
 }{
-	LineUserID:        "users.line_user_id",
-	Name:              "users.name",
-	Birthday:          "users.birthday",
-	CrushName:         "users.crush_name",
-	CrushBirthday:     "users.crush_birthday",
-	MatchedWithUserID: "users.matched_with_user_id",
-	RegisteredAt:      "users.registered_at",
-	UpdatedAt:         "users.updated_at",
+	LineUserID:            "users.line_user_id",
+	Name:                  "users.name",
+	Birthday:              "users.birthday",
+	MatchedWithUserID:     "users.matched_with_user_id",
+	RegisteredAt:          "users.registered_at",
+	UpdatedAt:             "users.updated_at",
+	LineDisplayName:       "users.line_display_name",
+	DeletedAt:             "users.deleted_at",
+	NameKey:               "users.name_key",
+	AltName:               "users.alt_name",
+	AltNameKey:            "users.alt_name_key",
+	WithdrawalRequestedAt: "users.withdrawal_requested_at",
 }
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
-}
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]any, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]any, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var UserWhere = struct {
-	LineUserID        whereHelpernull_String
-	Name              whereHelperstring
-	Birthday          whereHelperstring
-	CrushName         whereHelpernull_String
-	CrushBirthday     whereHelpernull_String
-	MatchedWithUserID whereHelpernull_String
-	RegisteredAt      whereHelperstring
-	UpdatedAt         whereHelperstring
+	LineUserID            whereHelpernull_String
+	Name                  whereHelperstring
+	Birthday              whereHelperstring
+	MatchedWithUserID     whereHelpernull_String
+	RegisteredAt          whereHelperstring
+	UpdatedAt             whereHelperstring
+	LineDisplayName       whereHelperstring
+	DeletedAt             whereHelpernull_String
+	NameKey               whereHelperstring
+	AltName               whereHelperstring
+	AltNameKey            whereHelperstring
+	WithdrawalRequestedAt whereHelpernull_String
 }{
-	LineUserID:        whereHelpernull_String{field: "\"users\".\"line_user_id\""},
-	Name:              whereHelperstring{field: "\"users\".\"name\""},
-	Birthday:          whereHelperstring{field: "\"users\".\"birthday\""},
-	CrushName:         whereHelpernull_String{field: "\"users\".\"crush_name\""},
-	CrushBirthday:     whereHelpernull_String{field: "\"users\".\"crush_birthday\""},
-	MatchedWithUserID: whereHelpernull_String{field: "\"users\".\"matched_with_user_id\""},
-	RegisteredAt:      whereHelperstring{field: "\"users\".\"registered_at\""},
-	UpdatedAt:         whereHelperstring{field: "\"users\".\"updated_at\""},
+	LineUserID:            whereHelpernull_String{field: "\"users\".\"line_user_id\""},
+	Name:                  whereHelperstring{field: "\"users\".\"name\""},
+	Birthday:              whereHelperstring{field: "\"users\".\"birthday\""},
+	MatchedWithUserID:     whereHelpernull_String{field: "\"users\".\"matched_with_user_id\""},
+	RegisteredAt:          whereHelperstring{field: "\"users\".\"registered_at\""},
+	UpdatedAt:             whereHelperstring{field: "\"users\".\"updated_at\""},
+	LineDisplayName:       whereHelperstring{field: "\"users\".\"line_display_name\""},
+	DeletedAt:             whereHelpernull_String{field: "\"users\".\"deleted_at\""},
+	NameKey:               whereHelperstring{field: "\"users\".\"name_key\""},
+	AltName:               whereHelperstring{field: "\"users\".\"alt_name\""},
+	AltNameKey:            whereHelperstring{field: "\"users\".\"alt_name_key\""},
+	WithdrawalRequestedAt: whereHelpernull_String{field: "\"users\".\"withdrawal_requested_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	MatchedWithUser      string
-	MatchedWithUserUsers string
+	MatchedWithUser           string
+	UserLineCrushChangeCounts string
+	UserLineCrushHistories    string
+	UserLineCrushes           string
+	UserLineMatchHistories    string
+	MatchedWithUserUsers      string
 }{
-	MatchedWithUser:      "MatchedWithUser",
-	MatchedWithUserUsers: "MatchedWithUserUsers",
+	MatchedWithUser:           "MatchedWithUser",
+	UserLineCrushChangeCounts: "UserLineCrushChangeCounts",
+	UserLineCrushHistories:    "UserLineCrushHistories",
+	UserLineCrushes:           "UserLineCrushes",
+	UserLineMatchHistories:    "UserLineMatchHistories",
+	MatchedWithUserUsers:      "MatchedWithUserUsers",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	MatchedWithUser      *User     `boil:"MatchedWithUser" json:"MatchedWithUser" toml:"MatchedWithUser" yaml:"MatchedWithUser"`
-	MatchedWithUserUsers UserSlice `boil:"MatchedWithUserUsers" json:"MatchedWithUserUsers" toml:"MatchedWithUserUsers" yaml:"MatchedWithUserUsers"`
+	MatchedWithUser           *User                 `boil:"MatchedWithUser" json:"MatchedWithUser" toml:"MatchedWithUser" yaml:"MatchedWithUser"`
+	UserLineCrushChangeCounts CrushChangeCountSlice `boil:"UserLineCrushChangeCounts" json:"UserLineCrushChangeCounts" toml:"UserLineCrushChangeCounts" yaml:"UserLineCrushChangeCounts"`
+	UserLineCrushHistories    CrushHistorySlice     `boil:"UserLineCrushHistories" json:"UserLineCrushHistories" toml:"UserLineCrushHistories" yaml:"UserLineCrushHistories"`
+	UserLineCrushes           CrushSlice            `boil:"UserLineCrushes" json:"UserLineCrushes" toml:"UserLineCrushes" yaml:"UserLineCrushes"`
+	UserLineMatchHistories    MatchHistorySlice     `boil:"UserLineMatchHistories" json:"UserLineMatchHistories" toml:"UserLineMatchHistories" yaml:"UserLineMatchHistories"`
+	MatchedWithUserUsers      UserSlice             `boil:"MatchedWithUserUsers" json:"MatchedWithUserUsers" toml:"MatchedWithUserUsers" yaml:"MatchedWithUserUsers"`
 }
 
 // NewStruct creates a new relationship struct
@@ -179,6 +175,70 @@ func (r *userR) GetMatchedWithUser() *User {
 	return r.MatchedWithUser
 }
 
+func (o *User) GetUserLineCrushChangeCounts() CrushChangeCountSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserLineCrushChangeCounts()
+}
+
+func (r *userR) GetUserLineCrushChangeCounts() CrushChangeCountSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserLineCrushChangeCounts
+}
+
+func (o *User) GetUserLineCrushHistories() CrushHistorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserLineCrushHistories()
+}
+
+func (r *userR) GetUserLineCrushHistories() CrushHistorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserLineCrushHistories
+}
+
+func (o *User) GetUserLineCrushes() CrushSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserLineCrushes()
+}
+
+func (r *userR) GetUserLineCrushes() CrushSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserLineCrushes
+}
+
+func (o *User) GetUserLineMatchHistories() MatchHistorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserLineMatchHistories()
+}
+
+func (r *userR) GetUserLineMatchHistories() MatchHistorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserLineMatchHistories
+}
+
 func (o *User) GetMatchedWithUserUsers() UserSlice {
 	if o == nil {
 		return nil
@@ -199,9 +259,9 @@ func (r *userR) GetMatchedWithUserUsers() UserSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"line_user_id", "name", "birthday", "crush_name", "crush_birthday", "matched_with_user_id", "registered_at", "updated_at"}
+	userAllColumns            = []string{"line_user_id", "name", "birthday", "matched_with_user_id", "registered_at", "updated_at", "line_display_name", "deleted_at", "name_key", "alt_name", "alt_name_key", "withdrawal_requested_at"}
 	userColumnsWithoutDefault = []string{"name", "birthday"}
-	userColumnsWithDefault    = []string{"line_user_id", "crush_name", "crush_birthday", "matched_with_user_id", "registered_at", "updated_at"}
+	userColumnsWithDefault    = []string{"line_user_id", "matched_with_user_id", "registered_at", "updated_at", "line_display_name", "deleted_at", "name_key", "alt_name", "alt_name_key", "withdrawal_requested_at"}
 	userPrimaryKeyColumns     = []string{"line_user_id"}
 	userGeneratedColumns      = []string{}
 )
@@ -522,6 +582,62 @@ func (o *User) MatchedWithUser(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// UserLineCrushChangeCounts retrieves all the crush_change_count's CrushChangeCounts with an executor via user_line_id column.
+func (o *User) UserLineCrushChangeCounts(mods ...qm.QueryMod) crushChangeCountQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"crush_change_counts\".\"user_line_id\"=?", o.LineUserID),
+	)
+
+	return CrushChangeCounts(queryMods...)
+}
+
+// UserLineCrushHistories retrieves all the crush_history's CrushHistories with an executor via user_line_id column.
+func (o *User) UserLineCrushHistories(mods ...qm.QueryMod) crushHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"crush_history\".\"user_line_id\"=?", o.LineUserID),
+	)
+
+	return CrushHistories(queryMods...)
+}
+
+// UserLineCrushes retrieves all the crush's Crushes with an executor via user_line_id column.
+func (o *User) UserLineCrushes(mods ...qm.QueryMod) crushQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"crushes\".\"user_line_id\"=?", o.LineUserID),
+	)
+
+	return Crushes(queryMods...)
+}
+
+// UserLineMatchHistories retrieves all the match_history's MatchHistories with an executor via user_line_id column.
+func (o *User) UserLineMatchHistories(mods ...qm.QueryMod) matchHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"match_history\".\"user_line_id\"=?", o.LineUserID),
+	)
+
+	return MatchHistories(queryMods...)
+}
+
 // MatchedWithUserUsers retrieves all the user's Users with an executor via matched_with_user_id column.
 func (o *User) MatchedWithUserUsers(mods ...qm.QueryMod) userQuery {
 	var queryMods []qm.QueryMod
@@ -660,9 +776,9 @@ func (userL) LoadMatchedWithUser(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// LoadMatchedWithUserUsers allows an eager lookup of values, cached into the
+// LoadUserLineCrushChangeCounts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadMatchedWithUserUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+func (userL) LoadUserLineCrushChangeCounts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -715,8 +831,8 @@ func (userL) LoadMatchedWithUserUsers(ctx context.Context, e boil.ContextExecuto
 	}
 
 	query := NewQuery(
-		qm.From(`users`),
-		qm.WhereIn(`users.matched_with_user_id in ?`, argsSlice...),
+		qm.From(`crush_change_counts`),
+		qm.WhereIn(`crush_change_counts.user_line_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -724,22 +840,22 @@ func (userL) LoadMatchedWithUserUsers(ctx context.Context, e boil.ContextExecuto
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load users")
+		return errors.Wrap(err, "failed to eager load crush_change_counts")
 	}
 
-	var resultSlice []*User
+	var resultSlice []*CrushChangeCount
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice users")
+		return errors.Wrap(err, "failed to bind eager loaded slice crush_change_counts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on users")
+		return errors.Wrap(err, "failed to close results in eager load on crush_change_counts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crush_change_counts")
 	}
 
-	if len(userAfterSelectHooks) != 0 {
+	if len(crushChangeCountAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -747,24 +863,24 @@ func (userL) LoadMatchedWithUserUsers(ctx context.Context, e boil.ContextExecuto
 		}
 	}
 	if singular {
-		object.R.MatchedWithUserUsers = resultSlice
+		object.R.UserLineCrushChangeCounts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userR{}
+				foreign.R = &crushChangeCountR{}
 			}
-			foreign.R.MatchedWithUser = object
+			foreign.R.UserLine = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.LineUserID, foreign.MatchedWithUserID) {
-				local.R.MatchedWithUserUsers = append(local.R.MatchedWithUserUsers, foreign)
+			if queries.Equal(local.LineUserID, foreign.UserLineID) {
+				local.R.UserLineCrushChangeCounts = append(local.R.UserLineCrushChangeCounts, foreign)
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &crushChangeCountR{}
 				}
-				foreign.R.MatchedWithUser = local
+				foreign.R.UserLine = local
 				break
 			}
 		}
@@ -773,82 +889,746 @@ func (userL) LoadMatchedWithUserUsers(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
-// SetMatchedWithUser of the user to the related item.
-// Sets o.R.MatchedWithUser to related.
-// Adds o to related.R.MatchedWithUserUsers.
-func (o *User) SetMatchedWithUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadUserLineCrushHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserLineCrushHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 0, []string{"matched_with_user_id"}),
-		strmangle.WhereClause("\"", "\"", 0, userPrimaryKeyColumns),
-	)
-	values := []any{related.LineUserID, o.LineUserID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.LineUserID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.LineUserID] = struct{}{}
+		}
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	if len(args) == 0 {
+		return nil
 	}
 
-	queries.Assign(&o.MatchedWithUserID, related.LineUserID)
-	if o.R == nil {
-		o.R = &userR{
-			MatchedWithUser: related,
-		}
-	} else {
-		o.R.MatchedWithUser = related
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			MatchedWithUserUsers: UserSlice{o},
-		}
-	} else {
-		related.R.MatchedWithUserUsers = append(related.R.MatchedWithUserUsers, o)
+	query := NewQuery(
+		qm.From(`crush_history`),
+		qm.WhereIn(`crush_history.user_line_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	return nil
-}
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load crush_history")
+	}
 
-// RemoveMatchedWithUser relationship.
-// Sets o.R.MatchedWithUser to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *User) RemoveMatchedWithUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
-	var err error
+	var resultSlice []*CrushHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice crush_history")
+	}
 
-	queries.SetScanner(&o.MatchedWithUserID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("matched_with_user_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on crush_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crush_history")
 	}
 
-	if o.R != nil {
-		o.R.MatchedWithUser = nil
+	if len(crushHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
 	}
-	if related == nil || related.R == nil {
+	if singular {
+		object.R.UserLineCrushHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &crushHistoryR{}
+			}
+			foreign.R.UserLine = object
+		}
 		return nil
 	}
 
-	for i, ri := range related.R.MatchedWithUserUsers {
-		if queries.Equal(o.MatchedWithUserID, ri.MatchedWithUserID) {
-			continue
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.LineUserID, foreign.UserLineID) {
+				local.R.UserLineCrushHistories = append(local.R.UserLineCrushHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &crushHistoryR{}
+				}
+				foreign.R.UserLine = local
+				break
+			}
 		}
+	}
 
-		ln := len(related.R.MatchedWithUserUsers)
-		if ln > 1 && i < ln-1 {
-			related.R.MatchedWithUserUsers[i] = related.R.MatchedWithUserUsers[ln-1]
-		}
-		related.R.MatchedWithUserUsers = related.R.MatchedWithUserUsers[:ln-1]
-		break
+	return nil
+}
+
+// LoadUserLineCrushes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserLineCrushes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.LineUserID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.LineUserID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`crushes`),
+		qm.WhereIn(`crushes.user_line_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load crushes")
+	}
+
+	var resultSlice []*Crush
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice crushes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on crushes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crushes")
+	}
+
+	if len(crushAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserLineCrushes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &crushR{}
+			}
+			foreign.R.UserLine = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.LineUserID, foreign.UserLineID) {
+				local.R.UserLineCrushes = append(local.R.UserLineCrushes, foreign)
+				if foreign.R == nil {
+					foreign.R = &crushR{}
+				}
+				foreign.R.UserLine = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserLineMatchHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserLineMatchHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.LineUserID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.LineUserID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`match_history`),
+		qm.WhereIn(`match_history.user_line_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load match_history")
+	}
+
+	var resultSlice []*MatchHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice match_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on match_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for match_history")
+	}
+
+	if len(matchHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserLineMatchHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &matchHistoryR{}
+			}
+			foreign.R.UserLine = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.LineUserID, foreign.UserLineID) {
+				local.R.UserLineMatchHistories = append(local.R.UserLineMatchHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &matchHistoryR{}
+				}
+				foreign.R.UserLine = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMatchedWithUserUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMatchedWithUserUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.LineUserID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.LineUserID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.matched_with_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load users")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice users")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MatchedWithUserUsers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userR{}
+			}
+			foreign.R.MatchedWithUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.LineUserID, foreign.MatchedWithUserID) {
+				local.R.MatchedWithUserUsers = append(local.R.MatchedWithUserUsers, foreign)
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.MatchedWithUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetMatchedWithUser of the user to the related item.
+// Sets o.R.MatchedWithUser to related.
+// Adds o to related.R.MatchedWithUserUsers.
+func (o *User) SetMatchedWithUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"matched_with_user_id"}),
+		strmangle.WhereClause("\"", "\"", 0, userPrimaryKeyColumns),
+	)
+	values := []any{related.LineUserID, o.LineUserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.MatchedWithUserID, related.LineUserID)
+	if o.R == nil {
+		o.R = &userR{
+			MatchedWithUser: related,
+		}
+	} else {
+		o.R.MatchedWithUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			MatchedWithUserUsers: UserSlice{o},
+		}
+	} else {
+		related.R.MatchedWithUserUsers = append(related.R.MatchedWithUserUsers, o)
+	}
+
+	return nil
+}
+
+// RemoveMatchedWithUser relationship.
+// Sets o.R.MatchedWithUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *User) RemoveMatchedWithUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.MatchedWithUserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("matched_with_user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.MatchedWithUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.MatchedWithUserUsers {
+		if queries.Equal(o.MatchedWithUserID, ri.MatchedWithUserID) {
+			continue
+		}
+
+		ln := len(related.R.MatchedWithUserUsers)
+		if ln > 1 && i < ln-1 {
+			related.R.MatchedWithUserUsers[i] = related.R.MatchedWithUserUsers[ln-1]
+		}
+		related.R.MatchedWithUserUsers = related.R.MatchedWithUserUsers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddUserLineCrushChangeCounts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserLineCrushChangeCounts.
+// Sets related.R.UserLine appropriately.
+func (o *User) AddUserLineCrushChangeCounts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CrushChangeCount) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserLineID, o.LineUserID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crush_change_counts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_line_id"}),
+				strmangle.WhereClause("\"", "\"", 0, crushChangeCountPrimaryKeyColumns),
+			)
+			values := []any{o.LineUserID, rel.UserLineID, rel.Day}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserLineID, o.LineUserID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserLineCrushChangeCounts: related,
+		}
+	} else {
+		o.R.UserLineCrushChangeCounts = append(o.R.UserLineCrushChangeCounts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &crushChangeCountR{
+				UserLine: o,
+			}
+		} else {
+			rel.R.UserLine = o
+		}
+	}
+	return nil
+}
+
+// AddUserLineCrushHistories adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserLineCrushHistories.
+// Sets related.R.UserLine appropriately.
+func (o *User) AddUserLineCrushHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CrushHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserLineID, o.LineUserID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crush_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_line_id"}),
+				strmangle.WhereClause("\"", "\"", 0, crushHistoryPrimaryKeyColumns),
+			)
+			values := []any{o.LineUserID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserLineID, o.LineUserID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserLineCrushHistories: related,
+		}
+	} else {
+		o.R.UserLineCrushHistories = append(o.R.UserLineCrushHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &crushHistoryR{
+				UserLine: o,
+			}
+		} else {
+			rel.R.UserLine = o
+		}
+	}
+	return nil
+}
+
+// AddUserLineCrushes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserLineCrushes.
+// Sets related.R.UserLine appropriately.
+func (o *User) AddUserLineCrushes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Crush) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserLineID, o.LineUserID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crushes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_line_id"}),
+				strmangle.WhereClause("\"", "\"", 0, crushPrimaryKeyColumns),
+			)
+			values := []any{o.LineUserID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserLineID, o.LineUserID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserLineCrushes: related,
+		}
+	} else {
+		o.R.UserLineCrushes = append(o.R.UserLineCrushes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &crushR{
+				UserLine: o,
+			}
+		} else {
+			rel.R.UserLine = o
+		}
+	}
+	return nil
+}
+
+// AddUserLineMatchHistories adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserLineMatchHistories.
+// Sets related.R.UserLine appropriately.
+func (o *User) AddUserLineMatchHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MatchHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserLineID, o.LineUserID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"match_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"user_line_id"}),
+				strmangle.WhereClause("\"", "\"", 0, matchHistoryPrimaryKeyColumns),
+			)
+			values := []any{o.LineUserID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserLineID, o.LineUserID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserLineMatchHistories: related,
+		}
+	} else {
+		o.R.UserLineMatchHistories = append(o.R.UserLineMatchHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &matchHistoryR{
+				UserLine: o,
+			}
+		} else {
+			rel.R.UserLine = o
+		}
 	}
 	return nil
 }
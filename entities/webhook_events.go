@@ -0,0 +1,969 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebhookEvent is an object representing the database table.
+type WebhookEvent struct {
+	WebhookEventID null.String `boil:"webhook_event_id" json:"webhook_event_id,omitempty" toml:"webhook_event_id" yaml:"webhook_event_id,omitempty"`
+	EventType      string      `boil:"event_type" json:"event_type" toml:"event_type" yaml:"event_type"`
+	SourceID       null.String `boil:"source_id" json:"source_id,omitempty" toml:"source_id" yaml:"source_id,omitempty"`
+	IsRedelivery   int64       `boil:"is_redelivery" json:"is_redelivery" toml:"is_redelivery" yaml:"is_redelivery"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Error          null.String `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	ReceivedAt     string      `boil:"received_at" json:"received_at" toml:"received_at" yaml:"received_at"`
+	ProcessedAt    null.String `boil:"processed_at" json:"processed_at,omitempty" toml:"processed_at" yaml:"processed_at,omitempty"`
+	ClaimedAt      null.String `boil:"claimed_at" json:"claimed_at,omitempty" toml:"claimed_at" yaml:"claimed_at,omitempty"`
+
+	R *webhookEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webhookEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebhookEventColumns = struct {
+	WebhookEventID string
+	EventType      string
+	SourceID       string
+	IsRedelivery   string
+	Status         string
+	Error          string
+	ReceivedAt     string
+	ProcessedAt    string
+	ClaimedAt      string
+}{
+	WebhookEventID: "webhook_event_id",
+	EventType:      "event_type",
+	SourceID:       "source_id",
+	IsRedelivery:   "is_redelivery",
+	Status:         "status",
+	Error:          "error",
+	ReceivedAt:     "received_at",
+	ProcessedAt:    "processed_at",
+	ClaimedAt:      "claimed_at",
+}
+
+var WebhookEventTableColumns = struct {
+	WebhookEventID string
+	EventType      string
+	SourceID       string
+	IsRedelivery   string
+	Status         string
+	Error          string
+	ReceivedAt     string
+	ProcessedAt    string
+	ClaimedAt      string
+}{
+	WebhookEventID: "webhook_events.webhook_event_id",
+	EventType:      "webhook_events.event_type",
+	SourceID:       "webhook_events.source_id",
+	IsRedelivery:   "webhook_events.is_redelivery",
+	Status:         "webhook_events.status",
+	Error:          "webhook_events.error",
+	ReceivedAt:     "webhook_events.received_at",
+	ProcessedAt:    "webhook_events.processed_at",
+	ClaimedAt:      "webhook_events.claimed_at",
+}
+
+// Generated where
+
+var WebhookEventWhere = struct {
+	WebhookEventID whereHelpernull_String
+	EventType      whereHelperstring
+	SourceID       whereHelpernull_String
+	IsRedelivery   whereHelperint64
+	Status         whereHelperstring
+	Error          whereHelpernull_String
+	ReceivedAt     whereHelperstring
+	ProcessedAt    whereHelpernull_String
+	ClaimedAt      whereHelpernull_String
+}{
+	WebhookEventID: whereHelpernull_String{field: "\"webhook_events\".\"webhook_event_id\""},
+	EventType:      whereHelperstring{field: "\"webhook_events\".\"event_type\""},
+	SourceID:       whereHelpernull_String{field: "\"webhook_events\".\"source_id\""},
+	IsRedelivery:   whereHelperint64{field: "\"webhook_events\".\"is_redelivery\""},
+	Status:         whereHelperstring{field: "\"webhook_events\".\"status\""},
+	Error:          whereHelpernull_String{field: "\"webhook_events\".\"error\""},
+	ReceivedAt:     whereHelperstring{field: "\"webhook_events\".\"received_at\""},
+	ProcessedAt:    whereHelpernull_String{field: "\"webhook_events\".\"processed_at\""},
+	ClaimedAt:      whereHelpernull_String{field: "\"webhook_events\".\"claimed_at\""},
+}
+
+// WebhookEventRels is where relationship names are stored.
+var WebhookEventRels = struct {
+}{}
+
+// webhookEventR is where relationships are stored.
+type webhookEventR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*webhookEventR) NewStruct() *webhookEventR {
+	return &webhookEventR{}
+}
+
+// webhookEventL is where Load methods for each relationship are stored.
+type webhookEventL struct{}
+
+var (
+	webhookEventAllColumns            = []string{"webhook_event_id", "event_type", "source_id", "is_redelivery", "status", "error", "received_at", "processed_at", "claimed_at"}
+	webhookEventColumnsWithoutDefault = []string{"event_type", "status"}
+	webhookEventColumnsWithDefault    = []string{"webhook_event_id", "source_id", "is_redelivery", "error", "received_at", "processed_at", "claimed_at"}
+	webhookEventPrimaryKeyColumns     = []string{"webhook_event_id"}
+	webhookEventGeneratedColumns      = []string{}
+)
+
+type (
+	// WebhookEventSlice is an alias for a slice of pointers to WebhookEvent.
+	// This should almost always be used instead of []WebhookEvent.
+	WebhookEventSlice []*WebhookEvent
+	// WebhookEventHook is the signature for custom WebhookEvent hook methods
+	WebhookEventHook func(context.Context, boil.ContextExecutor, *WebhookEvent) error
+
+	webhookEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webhookEventType                 = reflect.TypeOf(&WebhookEvent{})
+	webhookEventMapping              = queries.MakeStructMapping(webhookEventType)
+	webhookEventPrimaryKeyMapping, _ = queries.BindMapping(webhookEventType, webhookEventMapping, webhookEventPrimaryKeyColumns)
+	webhookEventInsertCacheMut       sync.RWMutex
+	webhookEventInsertCache          = make(map[string]insertCache)
+	webhookEventUpdateCacheMut       sync.RWMutex
+	webhookEventUpdateCache          = make(map[string]updateCache)
+	webhookEventUpsertCacheMut       sync.RWMutex
+	webhookEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webhookEventAfterSelectMu sync.Mutex
+var webhookEventAfterSelectHooks []WebhookEventHook
+
+var webhookEventBeforeInsertMu sync.Mutex
+var webhookEventBeforeInsertHooks []WebhookEventHook
+var webhookEventAfterInsertMu sync.Mutex
+var webhookEventAfterInsertHooks []WebhookEventHook
+
+var webhookEventBeforeUpdateMu sync.Mutex
+var webhookEventBeforeUpdateHooks []WebhookEventHook
+var webhookEventAfterUpdateMu sync.Mutex
+var webhookEventAfterUpdateHooks []WebhookEventHook
+
+var webhookEventBeforeDeleteMu sync.Mutex
+var webhookEventBeforeDeleteHooks []WebhookEventHook
+var webhookEventAfterDeleteMu sync.Mutex
+var webhookEventAfterDeleteHooks []WebhookEventHook
+
+var webhookEventBeforeUpsertMu sync.Mutex
+var webhookEventBeforeUpsertHooks []WebhookEventHook
+var webhookEventAfterUpsertMu sync.Mutex
+var webhookEventAfterUpsertHooks []WebhookEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebhookEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebhookEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebhookEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebhookEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebhookEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebhookEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebhookEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebhookEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebhookEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebhookEventHook registers your hook function for all future operations.
+func AddWebhookEventHook(hookPoint boil.HookPoint, webhookEventHook WebhookEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webhookEventAfterSelectMu.Lock()
+		webhookEventAfterSelectHooks = append(webhookEventAfterSelectHooks, webhookEventHook)
+		webhookEventAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webhookEventBeforeInsertMu.Lock()
+		webhookEventBeforeInsertHooks = append(webhookEventBeforeInsertHooks, webhookEventHook)
+		webhookEventBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webhookEventAfterInsertMu.Lock()
+		webhookEventAfterInsertHooks = append(webhookEventAfterInsertHooks, webhookEventHook)
+		webhookEventAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webhookEventBeforeUpdateMu.Lock()
+		webhookEventBeforeUpdateHooks = append(webhookEventBeforeUpdateHooks, webhookEventHook)
+		webhookEventBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webhookEventAfterUpdateMu.Lock()
+		webhookEventAfterUpdateHooks = append(webhookEventAfterUpdateHooks, webhookEventHook)
+		webhookEventAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webhookEventBeforeDeleteMu.Lock()
+		webhookEventBeforeDeleteHooks = append(webhookEventBeforeDeleteHooks, webhookEventHook)
+		webhookEventBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webhookEventAfterDeleteMu.Lock()
+		webhookEventAfterDeleteHooks = append(webhookEventAfterDeleteHooks, webhookEventHook)
+		webhookEventAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webhookEventBeforeUpsertMu.Lock()
+		webhookEventBeforeUpsertHooks = append(webhookEventBeforeUpsertHooks, webhookEventHook)
+		webhookEventBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webhookEventAfterUpsertMu.Lock()
+		webhookEventAfterUpsertHooks = append(webhookEventAfterUpsertHooks, webhookEventHook)
+		webhookEventAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single webhookEvent record from the query.
+func (q webhookEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebhookEvent, error) {
+	o := &WebhookEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: failed to execute a one query for webhook_events")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebhookEvent records from the query.
+func (q webhookEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebhookEventSlice, error) {
+	var o []*WebhookEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "entities: failed to assign all query results to WebhookEvent slice")
+	}
+
+	if len(webhookEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebhookEvent records in the query.
+func (q webhookEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to count webhook_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webhookEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: failed to check if webhook_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// WebhookEvents retrieves all the records using an executor.
+func WebhookEvents(mods ...qm.QueryMod) webhookEventQuery {
+	mods = append(mods, qm.From("\"webhook_events\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webhook_events\".*"})
+	}
+
+	return webhookEventQuery{q}
+}
+
+// FindWebhookEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebhookEvent(ctx context.Context, exec boil.ContextExecutor, webhookEventID null.String, selectCols ...string) (*WebhookEvent, error) {
+	webhookEventObj := &WebhookEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webhook_events\" where \"webhook_event_id\"=?", sel,
+	)
+
+	q := queries.Raw(query, webhookEventID)
+
+	err := q.Bind(ctx, exec, webhookEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "entities: unable to select from webhook_events")
+	}
+
+	if err = webhookEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return webhookEventObj, err
+	}
+
+	return webhookEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebhookEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no webhook_events provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webhookEventInsertCacheMut.RLock()
+	cache, cached := webhookEventInsertCache[key]
+	webhookEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webhookEventAllColumns,
+			webhookEventColumnsWithDefault,
+			webhookEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webhook_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webhook_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to insert into webhook_events")
+	}
+
+	if !cached {
+		webhookEventInsertCacheMut.Lock()
+		webhookEventInsertCache[key] = cache
+		webhookEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebhookEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebhookEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webhookEventUpdateCacheMut.RLock()
+	cache, cached := webhookEventUpdateCache[key]
+	webhookEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webhookEventAllColumns,
+			webhookEventPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("entities: unable to update webhook_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webhook_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, webhookEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, append(wl, webhookEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update webhook_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by update for webhook_events")
+	}
+
+	if !cached {
+		webhookEventUpdateCacheMut.Lock()
+		webhookEventUpdateCache[key] = cache
+		webhookEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webhookEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all for webhook_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected for webhook_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebhookEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("entities: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webhook_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, webhookEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to update all in webhookEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to retrieve rows affected all in update all webhookEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebhookEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("entities: no webhook_events provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webhookEventUpsertCacheMut.RLock()
+	cache, cached := webhookEventUpsertCache[key]
+	webhookEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webhookEventAllColumns,
+			webhookEventColumnsWithDefault,
+			webhookEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			webhookEventAllColumns,
+			webhookEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("entities: unable to upsert webhook_events, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webhookEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(webhookEventPrimaryKeyColumns))
+			copy(conflict, webhookEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"webhook_events\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webhookEventType, webhookEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to upsert webhook_events")
+	}
+
+	if !cached {
+		webhookEventUpsertCacheMut.Lock()
+		webhookEventUpsertCache[key] = cache
+		webhookEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebhookEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebhookEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("entities: no WebhookEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webhookEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"webhook_events\" WHERE \"webhook_event_id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete from webhook_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by delete for webhook_events")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webhookEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("entities: no webhookEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from webhook_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for webhook_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebhookEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webhookEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webhook_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, webhookEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: unable to delete all from webhookEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "entities: failed to get rows affected by deleteall for webhook_events")
+	}
+
+	if len(webhookEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebhookEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebhookEvent(ctx, exec, o.WebhookEventID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebhookEventSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webhook_events\".* FROM \"webhook_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, webhookEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "entities: unable to reload all in WebhookEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebhookEventExists checks if the WebhookEvent row exists.
+func WebhookEventExists(ctx context.Context, exec boil.ContextExecutor, webhookEventID null.String) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webhook_events\" where \"webhook_event_id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, webhookEventID)
+	}
+	row := exec.QueryRowContext(ctx, sql, webhookEventID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "entities: unable to check if webhook_events exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebhookEvent row exists.
+func (o *WebhookEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebhookEventExists(ctx, exec, o.WebhookEventID)
+}
@@ -0,0 +1,731 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package entities
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWebhookEvents(t *testing.T) {
+	t.Parallel()
+
+	query := WebhookEvents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWebhookEventsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookEventsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WebhookEvents().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookEventsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookEventSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookEventsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WebhookEventExists(ctx, tx, o.WebhookEventID)
+	if err != nil {
+		t.Errorf("Unable to check if WebhookEvent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WebhookEventExists to return true, but got false.")
+	}
+}
+
+func testWebhookEventsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	webhookEventFound, err := FindWebhookEvent(ctx, tx, o.WebhookEventID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if webhookEventFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWebhookEventsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WebhookEvents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookEventsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WebhookEvents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWebhookEventsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	webhookEventOne := &WebhookEvent{}
+	webhookEventTwo := &WebhookEvent{}
+	if err = randomize.Struct(seed, webhookEventOne, webhookEventDBTypes, false, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookEventTwo, webhookEventDBTypes, false, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebhookEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWebhookEventsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	webhookEventOne := &WebhookEvent{}
+	webhookEventTwo := &WebhookEvent{}
+	if err = randomize.Struct(seed, webhookEventOne, webhookEventDBTypes, false, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookEventTwo, webhookEventDBTypes, false, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func webhookEventBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEvent) error {
+	*o = WebhookEvent{}
+	return nil
+}
+
+func webhookEventAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEvent) error {
+	*o = WebhookEvent{}
+	return nil
+}
+
+func webhookEventAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEvent) error {
+	*o = WebhookEvent{}
+	return nil
+}
+
+func webhookEventBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEvent) error {
+	*o = WebhookEvent{}
+	return nil
+}
+
+func webhookEventAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEvent) error {
+	*o = WebhookEvent{}
+	return nil
+}
+
+func webhookEventBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEvent) error {
+	*o = WebhookEvent{}
+	return nil
+}
+
+func webhookEventAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEvent) error {
+	*o = WebhookEvent{}
+	return nil
+}
+
+func webhookEventBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEvent) error {
+	*o = WebhookEvent{}
+	return nil
+}
+
+func webhookEventAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookEvent) error {
+	*o = WebhookEvent{}
+	return nil
+}
+
+func testWebhookEventsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WebhookEvent{}
+	o := &WebhookEvent{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent object: %s", err)
+	}
+
+	AddWebhookEventHook(boil.BeforeInsertHook, webhookEventBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookEventBeforeInsertHooks = []WebhookEventHook{}
+
+	AddWebhookEventHook(boil.AfterInsertHook, webhookEventAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookEventAfterInsertHooks = []WebhookEventHook{}
+
+	AddWebhookEventHook(boil.AfterSelectHook, webhookEventAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	webhookEventAfterSelectHooks = []WebhookEventHook{}
+
+	AddWebhookEventHook(boil.BeforeUpdateHook, webhookEventBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookEventBeforeUpdateHooks = []WebhookEventHook{}
+
+	AddWebhookEventHook(boil.AfterUpdateHook, webhookEventAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookEventAfterUpdateHooks = []WebhookEventHook{}
+
+	AddWebhookEventHook(boil.BeforeDeleteHook, webhookEventBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookEventBeforeDeleteHooks = []WebhookEventHook{}
+
+	AddWebhookEventHook(boil.AfterDeleteHook, webhookEventAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookEventAfterDeleteHooks = []WebhookEventHook{}
+
+	AddWebhookEventHook(boil.BeforeUpsertHook, webhookEventBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookEventBeforeUpsertHooks = []WebhookEventHook{}
+
+	AddWebhookEventHook(boil.AfterUpsertHook, webhookEventAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookEventAfterUpsertHooks = []WebhookEventHook{}
+}
+
+func testWebhookEventsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhookEventsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(webhookEventPrimaryKeyColumns, webhookEventColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhookEventsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookEventsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookEventSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookEventsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebhookEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	webhookEventDBTypes = map[string]string{`WebhookEventID`: `TEXT`, `EventType`: `TEXT`, `SourceID`: `TEXT`, `IsRedelivery`: `INTEGER`, `Status`: `TEXT`, `Error`: `TEXT`, `ReceivedAt`: `TEXT`, `ProcessedAt`: `TEXT`, `ClaimedAt`: `TEXT`}
+	_                   = bytes.MinRead
+)
+
+func testWebhookEventsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(webhookEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(webhookEventAllColumns) == len(webhookEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWebhookEventsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(webhookEventAllColumns) == len(webhookEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookEvent{}
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookEventDBTypes, true, webhookEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(webhookEventAllColumns, webhookEventPrimaryKeyColumns) {
+		fields = webhookEventAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			webhookEventAllColumns,
+			webhookEventPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WebhookEventSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWebhookEventsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(webhookEventAllColumns) == len(webhookEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WebhookEvent{}
+	if err = randomize.Struct(seed, &o, webhookEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebhookEvent: %s", err)
+	}
+
+	count, err := WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, webhookEventDBTypes, false, webhookEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookEvent struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebhookEvent: %s", err)
+	}
+
+	count, err = WebhookEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,388 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/viper"
+)
+
+// Config はアプリケーション全体の設定
+//
+// 読み込みの優先順位: 環境変数 > .env > 設定ファイル（YAML/TOML、任意） > デフォルト値
+type Config struct {
+	Server    ServerConfig    `mapstructure:"server"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	LINE      LINEConfig      `mapstructure:"line"`
+	LIFF      LIFFConfig      `mapstructure:"liff"`
+	Webhook   WebhookConfig   `mapstructure:"webhook"`
+	Crush     CrushConfig     `mapstructure:"crush"`
+	Age       AgeConfig       `mapstructure:"age"`
+	Push      PushConfig      `mapstructure:"push"`
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+	Admin     AdminConfig     `mapstructure:"admin"`
+}
+
+// ServerConfig はHTTPサーバーの設定
+type ServerConfig struct {
+	Port              string        `mapstructure:"port"`
+	ServeStatic       bool          `mapstructure:"serve_static"` // Nginx を使わずに LIFF ページと共通JSを配信する
+	ReadHeaderTimeout time.Duration `mapstructure:"read_header_timeout"`
+	ReadTimeout       time.Duration `mapstructure:"read_timeout"`
+	WriteTimeout      time.Duration `mapstructure:"write_timeout"`
+	IdleTimeout       time.Duration `mapstructure:"idle_timeout"`
+	ShutdownTimeout   time.Duration `mapstructure:"shutdown_timeout"` // 停止時に処理中のリクエストを待つ上限
+}
+
+// DatabaseConfig はデータベースの設定
+type DatabaseConfig struct {
+	Path string `mapstructure:"path"`
+}
+
+// LINEConfig は LINE Messaging API の設定
+type LINEConfig struct {
+	ChannelSecret string        `mapstructure:"channel_secret"`
+	ChannelToken  string        `mapstructure:"channel_token"`
+	APITimeout    time.Duration `mapstructure:"api_timeout"`
+}
+
+// LIFFConfig は LIFF アプリの設定
+type LIFFConfig struct {
+	UserChannelID     string        `mapstructure:"user_channel_id"`
+	UserURL           string        `mapstructure:"user_url"`
+	CrushChannelID    string        `mapstructure:"crush_channel_id"`
+	CrushURL          string        `mapstructure:"crush_url"`
+	VerifyTimeout     time.Duration `mapstructure:"verify_timeout"`      // IDトークン検証APIのタイムアウト
+	VerifyMaxAttempts int           `mapstructure:"verify_max_attempts"` // IDトークン検証APIの試行回数（一時的な障害時に再試行する）
+	NonceTTL          time.Duration `mapstructure:"nonce_ttl"`           // 登録APIの再送防止用 nonce の有効期間
+}
+
+// WebhookConfig は Webhook イベントを非同期に処理するワーカープールの設定
+type WebhookConfig struct {
+	Workers         int           `mapstructure:"workers"`
+	QueueSize       int           `mapstructure:"queue_size"`       // ワーカー1つあたりのキューの上限
+	EnqueueTimeout  time.Duration `mapstructure:"enqueue_timeout"`  // キューが満杯の場合に空きを待つ上限（超えると503）
+	EventTTL        time.Duration `mapstructure:"event_ttl"`        // 重複排除のためにイベントIDを保持する期間
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval"` // 保持期間を過ぎたイベントIDを削除する間隔
+	ProcessingLease time.Duration `mapstructure:"processing_lease"` // 処理中のまま中断されたとみなし、再送時に再処理するまでの時間
+}
+
+// CrushConfig は好きな人の登録に関する設定
+type CrushConfig struct {
+	MaxPerUser       int           `mapstructure:"max_per_user"`
+	DailyChangeLimit int           `mapstructure:"daily_change_limit"` // 1日（日本時間）に好きな人を登録できる回数の上限
+	MaxDistinct      int           `mapstructure:"max_distinct"`       // HistoryWindow の間に登録できる人数の上限（同じ相手は1人と数え、別の表記は1人と数える）
+	HistoryWindow    time.Duration `mapstructure:"history_window"`     // MaxDistinct を数える期間
+	ChangeCooldown   time.Duration `mapstructure:"change_cooldown"`    // 好きな人を登録してから次に登録できるまでの間隔（0なら制限しない）
+}
+
+// AgeConfig は年齢による登録の制限の設定（0ならその制限を行わない）
+type AgeConfig struct {
+	MinAge   int `mapstructure:"min_age"`   // ユーザー登録できる最低年齢
+	MaxGap   int `mapstructure:"max_gap"`   // 自分と好きな人の年齢差の上限（歳）
+	AdultAge int `mapstructure:"adult_age"` // 成人とみなす年齢（未成年と成人の間では好きな人として登録できない）
+}
+
+// PushConfig は Push メッセージの設定
+type PushConfig struct {
+	MonthlyQuota      int           `mapstructure:"monthly_quota"`       // 月間の送信上限（無料プランは200通）
+	LowPriorityBudget int           `mapstructure:"low_priority_budget"` // 低優先度のメッセージを送信する上限（月間の送信上限に対する%）
+	QuotaSyncInterval time.Duration `mapstructure:"quota_sync_interval"` // LINE API から送信数を取得する間隔
+	PollInterval      time.Duration `mapstructure:"poll_interval"`       // 送信待ちの通知を確認する間隔
+	MaxAttempts       int           `mapstructure:"max_attempts"`        // 送信を試みる回数の上限（超えると dead）
+	RetryBase         time.Duration `mapstructure:"retry_base"`          // 1回目の再送までの待ち時間（以降は2倍ずつ延ばす）
+	RetryMax          time.Duration `mapstructure:"retry_max"`           // 再送までの待ち時間の上限
+}
+
+// RateLimitConfig は登録APIのリクエスト数の制限（トークンバケット、エンドポイントごと）
+// Burst 回まで連続で受け付け、その後は Interval ごとに1回分回復する
+type RateLimitConfig struct {
+	UserBurst    int           `mapstructure:"user_burst"`    // LINE ユーザーごと
+	UserInterval time.Duration `mapstructure:"user_interval"` // LINE ユーザーごと
+	IPBurst      int           `mapstructure:"ip_burst"`      // クライアントIPごと
+	IPInterval   time.Duration `mapstructure:"ip_interval"`   // クライアントIPごと
+}
+
+// AdminConfig は管理API（/admin/）の設定
+// トークンも Basic 認証も設定しない場合、管理APIは無効になる（ルーティングしない）
+type AdminConfig struct {
+	Token      string   `mapstructure:"token"`       // Bearer 認証のトークン
+	Username   string   `mapstructure:"username"`    // Basic 認証のユーザー名
+	Password   string   `mapstructure:"password"`    // Basic 認証のパスワード
+	AllowedIPs []string `mapstructure:"allowed_ips"` // 接続を許可するIPアドレス・CIDR（環境変数ではカンマ区切り）
+}
+
+// adminSecretMinLength は管理APIのトークン・パスワードの最低文字数
+const adminSecretMinLength = 16
+
+// setting は1つの設定項目（設定ファイルのキー、環境変数名、デフォルト値）
+type setting struct {
+	key          string
+	env          string
+	defaultValue any
+}
+
+// settings は全設定項目の一覧
+// 環境変数名は従来の .env との互換性を保つため、設定ファイルのキーとは独立して定義する
+var settings = []setting{
+	{"server.port", "PORT", "8080"},
+	{"server.serve_static", "SERVE_STATIC", false},
+	{"server.read_header_timeout", "SERVER_READ_HEADER_TIMEOUT", 5 * time.Second},
+	{"server.read_timeout", "SERVER_READ_TIMEOUT", 10 * time.Second},
+	{"server.write_timeout", "SERVER_WRITE_TIMEOUT", 30 * time.Second},
+	{"server.idle_timeout", "SERVER_IDLE_TIMEOUT", 60 * time.Second},
+	{"server.shutdown_timeout", "SERVER_SHUTDOWN_TIMEOUT", 30 * time.Second},
+	{"database.path", "DB_PATH", "cupid.db"},
+	{"line.channel_secret", "LINE_CHANNEL_SECRET", ""},
+	{"line.channel_token", "LINE_CHANNEL_TOKEN", ""},
+	{"line.api_timeout", "LINE_API_TIMEOUT", 10 * time.Second},
+	{"liff.user_channel_id", "LINE_LIFF_USER_CHANNEL_ID", ""},
+	{"liff.user_url", "LINE_LIFF_USER_URL", ""},
+	{"liff.crush_channel_id", "LINE_LIFF_CRUSH_CHANNEL_ID", ""},
+	{"liff.crush_url", "LINE_LIFF_CRUSH_URL", ""},
+	{"liff.verify_timeout", "LIFF_VERIFY_TIMEOUT", 10 * time.Second},
+	{"liff.verify_max_attempts", "LIFF_VERIFY_MAX_ATTEMPTS", 3},
+	{"liff.nonce_ttl", "LIFF_NONCE_TTL", 5 * time.Minute},
+	{"webhook.workers", "WEBHOOK_WORKERS", 4},
+	{"webhook.queue_size", "WEBHOOK_QUEUE_SIZE", 100},
+	{"webhook.enqueue_timeout", "WEBHOOK_ENQUEUE_TIMEOUT", 2 * time.Second},
+	{"webhook.event_ttl", "WEBHOOK_EVENT_TTL", 72 * time.Hour},
+	{"webhook.cleanup_interval", "WEBHOOK_EVENT_CLEANUP_INTERVAL", time.Hour},
+	{"webhook.processing_lease", "WEBHOOK_PROCESSING_LEASE", 10 * time.Minute},
+	{"crush.max_per_user", "MAX_CRUSHES_PER_USER", 3},
+	{"crush.daily_change_limit", "CRUSH_DAILY_CHANGE_LIMIT", 10},
+	{"crush.max_distinct", "CRUSH_MAX_DISTINCT", 6},
+	{"crush.history_window", "CRUSH_HISTORY_WINDOW", 30 * 24 * time.Hour},
+	{"crush.change_cooldown", "CRUSH_CHANGE_COOLDOWN", time.Minute},
+	{"age.min_age", "AGE_MIN", 13},
+	{"age.max_gap", "AGE_MAX_GAP", 10},
+	{"age.adult_age", "AGE_ADULT", 18},
+	{"push.monthly_quota", "PUSH_MONTHLY_QUOTA", 200},
+	{"push.low_priority_budget", "PUSH_LOW_PRIORITY_BUDGET", 80},
+	{"push.quota_sync_interval", "PUSH_QUOTA_SYNC_INTERVAL", 15 * time.Minute},
+	{"push.poll_interval", "PUSH_POLL_INTERVAL", 2 * time.Second},
+	{"push.max_attempts", "PUSH_MAX_ATTEMPTS", 10},
+	{"push.retry_base", "PUSH_RETRY_BASE", 30 * time.Second},
+	{"push.retry_max", "PUSH_RETRY_MAX", time.Hour},
+	{"rate_limit.user_burst", "RATE_LIMIT_USER_BURST", 5},
+	{"rate_limit.user_interval", "RATE_LIMIT_USER_INTERVAL", 10 * time.Second},
+	{"rate_limit.ip_burst", "RATE_LIMIT_IP_BURST", 30},
+	{"rate_limit.ip_interval", "RATE_LIMIT_IP_INTERVAL", 2 * time.Second},
+	{"admin.token", "ADMIN_TOKEN", ""},
+	{"admin.username", "ADMIN_USERNAME", ""},
+	{"admin.password", "ADMIN_PASSWORD", ""},
+	{"admin.allowed_ips", "ADMIN_ALLOWED_IPS", "127.0.0.1,::1"},
+}
+
+// ValidationError は設定値の検証エラーをまとめたもの
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid config:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// Load は設定を読み込み、すべての項目を検証する
+// configFile が空の場合は設定ファイルを使用しない
+func Load(configFile string) (*Config, error) {
+	cfg, err := Read(configFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Read は設定を読み込む（検証は行わない）
+// マイグレーションなど一部の設定だけを使う場合に使用する
+func Read(configFile string) (*Config, error) {
+	// .env は既存の環境変数を上書きしない
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load .env: %w", err)
+	}
+
+	v := viper.New()
+	for _, s := range settings {
+		v.SetDefault(s.key, s.defaultValue)
+		if err := v.BindEnv(s.key, s.env); err != nil {
+			return nil, err
+		}
+	}
+
+	if configFile != "" {
+		v.SetConfigFile(configFile)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read config file %s: %w", configFile, err)
+		}
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// Validate はすべての設定項目を検証し、問題をまとめて ValidationError として返す
+func (c *Config) Validate() error {
+	var problems []string
+	add := func(env, format string, args ...any) {
+		problems = append(problems, env+": "+fmt.Sprintf(format, args...))
+	}
+	required := func(env, value string) {
+		if value == "" {
+			add(env, "must be set")
+		}
+	}
+	positiveDuration := func(env string, d time.Duration) {
+		if d <= 0 {
+			add(env, "must be a positive duration (e.g. 10s), got %s", d)
+		}
+	}
+	positiveInt := func(env string, n int) {
+		if n < 1 {
+			add(env, "must be a positive integer, got %d", n)
+		}
+	}
+	nonNegativeInt := func(env string, n int) {
+		if n < 0 {
+			add(env, "must not be negative, got %d", n)
+		}
+	}
+	httpsURL := func(env, value string) {
+		if value == "" {
+			add(env, "must be set")
+			return
+		}
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			add(env, "must be an https URL, got %q", value)
+		}
+	}
+
+	if port, err := strconv.Atoi(c.Server.Port); err != nil || port < 1 || port > 65535 {
+		add("PORT", "must be a port number (1-65535), got %q", c.Server.Port)
+	}
+	positiveDuration("SERVER_READ_HEADER_TIMEOUT", c.Server.ReadHeaderTimeout)
+	positiveDuration("SERVER_READ_TIMEOUT", c.Server.ReadTimeout)
+	positiveDuration("SERVER_WRITE_TIMEOUT", c.Server.WriteTimeout)
+	positiveDuration("SERVER_IDLE_TIMEOUT", c.Server.IdleTimeout)
+	positiveDuration("SERVER_SHUTDOWN_TIMEOUT", c.Server.ShutdownTimeout)
+
+	required("DB_PATH", c.Database.Path)
+
+	required("LINE_CHANNEL_SECRET", c.LINE.ChannelSecret)
+	required("LINE_CHANNEL_TOKEN", c.LINE.ChannelToken)
+	positiveDuration("LINE_API_TIMEOUT", c.LINE.APITimeout)
+
+	required("LINE_LIFF_USER_CHANNEL_ID", c.LIFF.UserChannelID)
+	httpsURL("LINE_LIFF_USER_URL", c.LIFF.UserURL)
+	required("LINE_LIFF_CRUSH_CHANNEL_ID", c.LIFF.CrushChannelID)
+	httpsURL("LINE_LIFF_CRUSH_URL", c.LIFF.CrushURL)
+	positiveDuration("LIFF_VERIFY_TIMEOUT", c.LIFF.VerifyTimeout)
+	positiveInt("LIFF_VERIFY_MAX_ATTEMPTS", c.LIFF.VerifyMaxAttempts)
+	positiveDuration("LIFF_NONCE_TTL", c.LIFF.NonceTTL)
+
+	positiveInt("WEBHOOK_WORKERS", c.Webhook.Workers)
+	positiveInt("WEBHOOK_QUEUE_SIZE", c.Webhook.QueueSize)
+	positiveDuration("WEBHOOK_ENQUEUE_TIMEOUT", c.Webhook.EnqueueTimeout)
+	positiveDuration("WEBHOOK_EVENT_TTL", c.Webhook.EventTTL)
+	positiveDuration("WEBHOOK_EVENT_CLEANUP_INTERVAL", c.Webhook.CleanupInterval)
+	positiveDuration("WEBHOOK_PROCESSING_LEASE", c.Webhook.ProcessingLease)
+
+	positiveInt("MAX_CRUSHES_PER_USER", c.Crush.MaxPerUser)
+	positiveInt("CRUSH_DAILY_CHANGE_LIMIT", c.Crush.DailyChangeLimit)
+	positiveInt("CRUSH_MAX_DISTINCT", c.Crush.MaxDistinct)
+	positiveDuration("CRUSH_HISTORY_WINDOW", c.Crush.HistoryWindow)
+	if c.Crush.ChangeCooldown < 0 {
+		add("CRUSH_CHANGE_COOLDOWN", "must not be negative, got %s", c.Crush.ChangeCooldown)
+	}
+	nonNegativeInt("AGE_MIN", c.Age.MinAge)
+	nonNegativeInt("AGE_MAX_GAP", c.Age.MaxGap)
+	nonNegativeInt("AGE_ADULT", c.Age.AdultAge)
+	positiveInt("PUSH_MONTHLY_QUOTA", c.Push.MonthlyQuota)
+	if c.Push.LowPriorityBudget < 0 || c.Push.LowPriorityBudget > 100 {
+		add("PUSH_LOW_PRIORITY_BUDGET", "must be a percentage (0-100), got %d", c.Push.LowPriorityBudget)
+	}
+	positiveDuration("PUSH_QUOTA_SYNC_INTERVAL", c.Push.QuotaSyncInterval)
+	positiveDuration("PUSH_POLL_INTERVAL", c.Push.PollInterval)
+	positiveInt("PUSH_MAX_ATTEMPTS", c.Push.MaxAttempts)
+	positiveDuration("PUSH_RETRY_BASE", c.Push.RetryBase)
+	positiveDuration("PUSH_RETRY_MAX", c.Push.RetryMax)
+	if c.Push.RetryMax < c.Push.RetryBase {
+		add("PUSH_RETRY_MAX", "must not be shorter than PUSH_RETRY_BASE (%s), got %s", c.Push.RetryBase, c.Push.RetryMax)
+	}
+
+	positiveInt("RATE_LIMIT_USER_BURST", c.RateLimit.UserBurst)
+	positiveDuration("RATE_LIMIT_USER_INTERVAL", c.RateLimit.UserInterval)
+	positiveInt("RATE_LIMIT_IP_BURST", c.RateLimit.IPBurst)
+	positiveDuration("RATE_LIMIT_IP_INTERVAL", c.RateLimit.IPInterval)
+
+	if c.Admin.Enabled() {
+		if c.Admin.Token != "" && len(c.Admin.Token) < adminSecretMinLength {
+			add("ADMIN_TOKEN", "must be at least %d characters", adminSecretMinLength)
+		}
+		if c.Admin.Username != "" && len(c.Admin.Password) < adminSecretMinLength {
+			add("ADMIN_PASSWORD", "must be at least %d characters when ADMIN_USERNAME is set", adminSecretMinLength)
+		}
+		if c.Admin.Password != "" && c.Admin.Username == "" {
+			add("ADMIN_USERNAME", "must be set when ADMIN_PASSWORD is set")
+		}
+		if _, err := c.Admin.AllowedPrefixes(); err != nil {
+			add("ADMIN_ALLOWED_IPS", "%v", err)
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// LowPriorityLimit は低優先度のメッセージを送信する月間の上限（通数）を返す
+func (c PushConfig) LowPriorityLimit() int {
+	return c.MonthlyQuota * c.LowPriorityBudget / 100
+}
+
+// Enabled は管理APIの認証情報（トークンまたは Basic 認証）が設定されているかを返す
+func (c AdminConfig) Enabled() bool {
+	return c.Token != "" || c.Username != "" || c.Password != ""
+}
+
+// AllowedPrefixes は AllowedIPs をIPアドレスの範囲に変換する（IPアドレスは1つだけの範囲として扱う）
+func (c AdminConfig) AllowedPrefixes() ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, entry := range c.AllowedIPs {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR %q", entry)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP address %q", entry)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	if len(prefixes) == 0 {
+		return nil, errors.New("must list at least one IP address or CIDR")
+	}
+	return prefixes, nil
+}
@@ -0,0 +1,245 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setRequiredEnv は必須の環境変数を設定する
+func setRequiredEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("LINE_CHANNEL_SECRET", "secret")
+	t.Setenv("LINE_CHANNEL_TOKEN", "token")
+	t.Setenv("LINE_LIFF_USER_CHANNEL_ID", "user-channel")
+	t.Setenv("LINE_LIFF_USER_URL", "https://miniapp.line.me/user")
+	t.Setenv("LINE_LIFF_CRUSH_CHANNEL_ID", "crush-channel")
+	t.Setenv("LINE_LIFF_CRUSH_URL", "https://miniapp.line.me/crush")
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := Load("")
+	require.NoError(t, err)
+
+	assert.Equal(t, "8080", cfg.Server.Port)
+	assert.False(t, cfg.Server.ServeStatic)
+	assert.Equal(t, 5*time.Second, cfg.Server.ReadHeaderTimeout)
+	assert.Equal(t, "cupid.db", cfg.Database.Path)
+	assert.Equal(t, "secret", cfg.LINE.ChannelSecret)
+	assert.Equal(t, 10*time.Second, cfg.LINE.APITimeout)
+	assert.Equal(t, "https://miniapp.line.me/crush", cfg.LIFF.CrushURL)
+	assert.Equal(t, 3, cfg.Crush.MaxPerUser)
+	assert.Equal(t, 10, cfg.Crush.DailyChangeLimit)
+	assert.Equal(t, 6, cfg.Crush.MaxDistinct)
+	assert.Equal(t, 30*24*time.Hour, cfg.Crush.HistoryWindow)
+	assert.Equal(t, time.Minute, cfg.Crush.ChangeCooldown)
+	assert.Equal(t, 13, cfg.Age.MinAge)
+	assert.Equal(t, 10, cfg.Age.MaxGap)
+	assert.Equal(t, 18, cfg.Age.AdultAge)
+	assert.Equal(t, 5, cfg.RateLimit.UserBurst)
+	assert.Equal(t, 2*time.Second, cfg.RateLimit.IPInterval)
+	assert.Equal(t, 200, cfg.Push.MonthlyQuota)
+	assert.False(t, cfg.Admin.Enabled())
+	assert.Equal(t, []string{"127.0.0.1", "::1"}, cfg.Admin.AllowedIPs)
+}
+
+func TestLoad_ConfigFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+	}{
+		{
+			name:     "YAML",
+			fileName: "cupid.yaml",
+			content: `
+database:
+  path: /var/lib/cupid/cupid.db
+line:
+  api_timeout: 3s
+crush:
+  max_per_user: 5
+push:
+  monthly_quota: 1000
+admin:
+  token: admin-token-0123456789
+  allowed_ips:
+    - 10.0.0.0/8
+    - 192.0.2.1
+`,
+		},
+		{
+			name:     "TOML",
+			fileName: "cupid.toml",
+			content: `
+[database]
+path = "/var/lib/cupid/cupid.db"
+
+[line]
+api_timeout = "3s"
+
+[crush]
+max_per_user = 5
+
+[push]
+monthly_quota = 1000
+
+[admin]
+token = "admin-token-0123456789"
+allowed_ips = ["10.0.0.0/8", "192.0.2.1"]
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setRequiredEnv(t)
+			// 環境変数は設定ファイルより優先される
+			t.Setenv("PUSH_MONTHLY_QUOTA", "500")
+
+			path := filepath.Join(t.TempDir(), tt.fileName)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			cfg, err := Load(path)
+			require.NoError(t, err)
+
+			assert.Equal(t, "/var/lib/cupid/cupid.db", cfg.Database.Path)
+			assert.Equal(t, 3*time.Second, cfg.LINE.APITimeout)
+			assert.Equal(t, 5, cfg.Crush.MaxPerUser)
+			assert.Equal(t, 500, cfg.Push.MonthlyQuota)
+			assert.Equal(t, "secret", cfg.LINE.ChannelSecret)
+			assert.True(t, cfg.Admin.Enabled())
+			assert.Equal(t, []string{"10.0.0.0/8", "192.0.2.1"}, cfg.Admin.AllowedIPs)
+		})
+	}
+}
+
+func TestLoad_MissingConfigFile(t *testing.T) {
+	setRequiredEnv(t)
+
+	_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestLoad_ValidationError(t *testing.T) {
+	// 必須項目が未設定、かつ不正な値が複数ある場合はまとめて報告する
+	t.Setenv("LINE_CHANNEL_TOKEN", "token")
+	t.Setenv("LINE_LIFF_USER_CHANNEL_ID", "user-channel")
+	t.Setenv("LINE_LIFF_USER_URL", "http://insecure.example.com")
+	t.Setenv("LINE_LIFF_CRUSH_CHANNEL_ID", "crush-channel")
+	t.Setenv("LINE_LIFF_CRUSH_URL", "https://miniapp.line.me/crush")
+	t.Setenv("LINE_CHANNEL_SECRET", "")
+	t.Setenv("PORT", "http")
+	t.Setenv("MAX_CRUSHES_PER_USER", "0")
+	t.Setenv("SERVER_READ_TIMEOUT", "0s")
+	t.Setenv("CRUSH_CHANGE_COOLDOWN", "-1m")
+	t.Setenv("AGE_MAX_GAP", "-1")
+
+	_, err := Load("")
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr), "expected ValidationError, got %v", err)
+	assert.ElementsMatch(t, []string{
+		`PORT: must be a port number (1-65535), got "http"`,
+		"SERVER_READ_TIMEOUT: must be a positive duration (e.g. 10s), got 0s",
+		"LINE_CHANNEL_SECRET: must be set",
+		`LINE_LIFF_USER_URL: must be an https URL, got "http://insecure.example.com"`,
+		"MAX_CRUSHES_PER_USER: must be a positive integer, got 0",
+		"CRUSH_CHANGE_COOLDOWN: must not be negative, got -1m0s",
+		"AGE_MAX_GAP: must not be negative, got -1",
+	}, validationErr.Problems)
+	assert.Contains(t, err.Error(), "invalid config:\n  - ")
+}
+
+func TestLoad_Admin(t *testing.T) {
+	tests := []struct {
+		name             string
+		env              map[string]string
+		expectedPrefixes []string
+		expectedProblems []string
+	}{
+		{
+			name: "トークンと許可リスト（カンマ区切り）",
+			env: map[string]string{
+				"ADMIN_TOKEN":       "admin-token-0123456789",
+				"ADMIN_ALLOWED_IPS": "10.0.0.0/8, 192.0.2.1,::1",
+			},
+			expectedPrefixes: []string{"10.0.0.0/8", "192.0.2.1/32", "::1/128"},
+		},
+		{
+			name: "Basic 認証（許可リストはデフォルト）",
+			env: map[string]string{
+				"ADMIN_USERNAME": "ops",
+				"ADMIN_PASSWORD": "admin-password-0123456789",
+			},
+			expectedPrefixes: []string{"127.0.0.1/32", "::1/128"},
+		},
+		{
+			name: "トークン・パスワードが短い",
+			env: map[string]string{
+				"ADMIN_TOKEN":    "short",
+				"ADMIN_USERNAME": "ops",
+				"ADMIN_PASSWORD": "short",
+			},
+			expectedProblems: []string{
+				"ADMIN_TOKEN: must be at least 16 characters",
+				"ADMIN_PASSWORD: must be at least 16 characters when ADMIN_USERNAME is set",
+			},
+		},
+		{
+			name: "ユーザー名がない・許可リストが不正",
+			env: map[string]string{
+				"ADMIN_PASSWORD":    "admin-password-0123456789",
+				"ADMIN_ALLOWED_IPS": "10.0.0.0/33",
+			},
+			expectedProblems: []string{
+				"ADMIN_USERNAME: must be set when ADMIN_PASSWORD is set",
+				`ADMIN_ALLOWED_IPS: invalid CIDR "10.0.0.0/33"`,
+			},
+		},
+		{
+			name: "許可リストが空",
+			env: map[string]string{
+				"ADMIN_TOKEN":       "admin-token-0123456789",
+				"ADMIN_ALLOWED_IPS": ",",
+			},
+			expectedProblems: []string{
+				"ADMIN_ALLOWED_IPS: must list at least one IP address or CIDR",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setRequiredEnv(t)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			cfg, err := Load("")
+
+			if tt.expectedProblems != nil {
+				var validationErr *ValidationError
+				require.True(t, errors.As(err, &validationErr), "expected ValidationError, got %v", err)
+				assert.ElementsMatch(t, tt.expectedProblems, validationErr.Problems)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, cfg.Admin.Enabled())
+			prefixes, err := cfg.Admin.AllowedPrefixes()
+			require.NoError(t, err)
+			got := make([]string, 0, len(prefixes))
+			for _, p := range prefixes {
+				got = append(got, p.String())
+			}
+			assert.Equal(t, tt.expectedPrefixes, got)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/morinonusi421/cupid/internal/message"
+	"github.com/morinonusi421/cupid/internal/middleware"
+	"github.com/morinonusi421/cupid/internal/service"
+	"github.com/morinonusi421/cupid/pkg/httputil"
+)
+
+// AccountAPIHandler はログイン中のユーザー自身のアカウントを扱うAPI（登録状況の取得・退会・データのエクスポートなど）
+type AccountAPIHandler struct {
+	userService service.UserService
+}
+
+func NewAccountAPIHandler(userService service.UserService) *AccountAPIHandler {
+	return &AccountAPIHandler{
+		userService: userService,
+	}
+}
+
+// MeResponse はユーザーの登録・好きな人・マッチングの状況
+// マッチング相手の名前と警告メッセージはマッチング中のみ含める
+type MeResponse struct {
+	Registered      bool      `json:"registered"`
+	Name            string    `json:"name,omitempty"`
+	AltName         string    `json:"alt_name,omitempty"` // 名前の別の表記（登録していなければ含めない）
+	Birthday        string    `json:"birthday,omitempty"`
+	Crushes         []MeCrush `json:"crushes"`
+	Matched         bool      `json:"matched"`
+	MatchedUserName string    `json:"matched_user_name,omitempty"`
+	MatchedWarning  string    `json:"matched_warning,omitempty"` // 登録内容を変更するとマッチングが解除されることの警告
+}
+
+// MeCrush は登録中の好きな人
+// ID は登録の取り消し（POST /api/withdraw-crush）に使う
+type MeCrush struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	AltName  string `json:"alt_name,omitempty"`
+	Birthday string `json:"birthday"`
+}
+
+type WithdrawResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Me はユーザーの登録・好きな人・マッチングの状況を返す（GET /api/me）
+// LIFFの画面でフォームの初期値とマッチング中の警告の表示に使う
+func (h *AccountAPIHandler) Me(w http.ResponseWriter, r *http.Request) {
+	// context から user_id を取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		log.Printf("Failed to get user_id from context")
+		httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証に失敗しました"})
+		return
+	}
+
+	status, err := h.userService.GetStatus(r.Context(), userID)
+	if err != nil {
+		log.Printf("Failed to get status of %s: %v", userID, err)
+		httputil.WriteJSONError(w, http.StatusInternalServerError, map[string]string{
+			"error":   "internal_error",
+			"message": message.GeneralError,
+		})
+		return
+	}
+
+	resp := MeResponse{Crushes: make([]MeCrush, 0, len(status.Crushes))}
+	if status.User != nil {
+		resp.Registered = true
+		resp.Name = status.User.Name
+		resp.AltName = status.User.AltName
+		resp.Birthday = status.User.Birthday.String()
+		resp.Matched = status.User.IsMatched()
+	}
+	for _, c := range status.Crushes {
+		resp.Crushes = append(resp.Crushes, MeCrush{ID: c.ID, Name: c.Name, AltName: c.AltName, Birthday: c.Birthday.String()})
+	}
+	if resp.Matched {
+		resp.MatchedUserName = status.MatchedUserName
+		resp.MatchedWarning = message.MatchedUserExistsWarning(status.MatchedUserName)
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	httputil.WriteJSONResponse(w, http.StatusOK, resp)
+}
+
+// Withdraw はユーザーを退会させる（POST /api/withdraw）
+// マッチング中の場合は解除され、相手に通知される
+func (h *AccountAPIHandler) Withdraw(w http.ResponseWriter, r *http.Request) {
+	// context から user_id を取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		log.Printf("Failed to get user_id from context")
+		httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証に失敗しました"})
+		return
+	}
+
+	if err := h.userService.DeleteAccount(r.Context(), userID); err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			httputil.WriteJSONError(w, http.StatusNotFound, map[string]string{
+				"error":   "user_not_found",
+				"message": message.WithdrawNotRegistered,
+			})
+			return
+		}
+		log.Printf("Failed to delete account %s: %v", userID, err)
+		httputil.WriteJSONError(w, http.StatusInternalServerError, map[string]string{
+			"error":   "internal_error",
+			"message": message.GeneralError,
+		})
+		return
+	}
+
+	log.Printf("User %s withdrew", userID)
+	httputil.WriteJSONResponse(w, http.StatusOK, WithdrawResponse{
+		Status:  "ok",
+		Message: message.WithdrawComplete,
+	})
+}
+
+// UnmatchResponse はマッチング解除の結果
+type UnmatchResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Unmatch はマッチングを解除する（POST /api/unmatch）
+// マッチング相手の好きな人の登録も取り消され、相手に通知される
+func (h *AccountAPIHandler) Unmatch(w http.ResponseWriter, r *http.Request) {
+	// context から user_id を取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		log.Printf("Failed to get user_id from context")
+		httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証に失敗しました"})
+		return
+	}
+
+	partnerName, err := h.userService.Unmatch(r.Context(), userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			httputil.WriteJSONError(w, http.StatusNotFound, map[string]string{
+				"error":   "user_not_found",
+				"message": message.UnregisteredUserPrompt,
+			})
+		case errors.Is(err, service.ErrNotMatched):
+			httputil.WriteJSONError(w, http.StatusConflict, map[string]string{
+				"error":   "not_matched",
+				"message": message.NotMatched,
+			})
+		default:
+			log.Printf("Failed to unmatch %s: %v", userID, err)
+			httputil.WriteJSONError(w, http.StatusInternalServerError, map[string]string{
+				"error":   "internal_error",
+				"message": message.GeneralError,
+			})
+		}
+		return
+	}
+
+	log.Printf("User %s unmatched", userID)
+	httputil.WriteJSONResponse(w, http.StatusOK, UnmatchResponse{
+		Status:  "ok",
+		Message: message.UnmatchComplete(partnerName),
+	})
+}
+
+// Export はユーザー本人の登録データを JSON で返す（GET /api/me/export）
+// ユーザー情報・好きな人・登録の履歴・マッチングの履歴・Push通知を含む
+func (h *AccountAPIHandler) Export(w http.ResponseWriter, r *http.Request) {
+	// context から user_id を取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		log.Printf("Failed to get user_id from context")
+		httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証に失敗しました"})
+		return
+	}
+
+	export, err := h.userService.ExportData(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			httputil.WriteJSONError(w, http.StatusNotFound, map[string]string{
+				"error":   "user_not_found",
+				"message": message.DataExportNotRegistered,
+			})
+			return
+		}
+		log.Printf("Failed to export data of %s: %v", userID, err)
+		httputil.WriteJSONError(w, http.StatusInternalServerError, map[string]string{
+			"error":   "internal_error",
+			"message": message.GeneralError,
+		})
+		return
+	}
+
+	// 個人データのため、ブラウザやプロキシにキャッシュさせない
+	w.Header().Set("Cache-Control", "no-store")
+	httputil.WriteJSONResponse(w, http.StatusOK, export)
+}
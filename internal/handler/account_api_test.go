@@ -0,0 +1,383 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/morinonusi421/cupid/internal/message"
+	"github.com/morinonusi421/cupid/internal/middleware"
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/internal/service"
+	servicemocks "github.com/morinonusi421/cupid/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccountAPIHandler_Me(t *testing.T) {
+	tests := []struct {
+		name               string
+		hasUserID          bool
+		mockSetup          func(*servicemocks.MockUserService)
+		expectedStatusCode int
+		expectedBody       map[string]interface{}
+	}{
+		{
+			name:      "未登録",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().GetStatus(mock.Anything, "U-test-user").Return(&model.UserStatus{}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"registered": false,
+				"crushes":    []interface{}{},
+				"matched":    false,
+			},
+		},
+		{
+			name:      "登録済み - マッチングしていない",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().GetStatus(mock.Anything, "U-test-user").Return(&model.UserStatus{
+					User:    &model.User{LineID: "U-test-user", Name: "アリス", Birthday: "1990-01-01"},
+					Crushes: []*model.Crush{{ID: 3, Name: "ボブ", Birthday: "1995-05-05"}},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"registered": true,
+				"name":       "アリス",
+				"birthday":   "1990-01-01",
+				"crushes":    []interface{}{map[string]interface{}{"id": float64(3), "name": "ボブ", "birthday": "1995-05-05"}},
+				"matched":    false,
+			},
+		},
+		{
+			name:      "登録済み - 別の表記を含む",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().GetStatus(mock.Anything, "U-test-user").Return(&model.UserStatus{
+					User:    &model.User{LineID: "U-test-user", Name: "山田太郎", AltName: "Taro Yamada", Birthday: "1990-01-01"},
+					Crushes: []*model.Crush{{ID: 3, Name: "佐藤花子", AltName: "サトウハナコ", Birthday: "1995-05-05"}},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"registered": true,
+				"name":       "山田太郎",
+				"alt_name":   "Taro Yamada",
+				"birthday":   "1990-01-01",
+				"crushes":    []interface{}{map[string]interface{}{"id": float64(3), "name": "佐藤花子", "alt_name": "サトウハナコ", "birthday": "1995-05-05"}},
+				"matched":    false,
+			},
+		},
+		{
+			name:      "登録済み - マッチング中は相手の名前と警告を含む",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().GetStatus(mock.Anything, "U-test-user").Return(&model.UserStatus{
+					User:            &model.User{LineID: "U-test-user", Name: "アリス", Birthday: "1990-01-01", MatchedWithUserID: null.StringFrom("U-bob")},
+					Crushes:         []*model.Crush{{ID: 3, Name: "ボブ", Birthday: "1995-05-05"}},
+					MatchedUserName: "ボブ",
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"registered":        true,
+				"name":              "アリス",
+				"birthday":          "1990-01-01",
+				"crushes":           []interface{}{map[string]interface{}{"id": float64(3), "name": "ボブ", "birthday": "1995-05-05"}},
+				"matched":           true,
+				"matched_user_name": "ボブ",
+				"matched_warning":   message.MatchedUserExistsWarning("ボブ"),
+			},
+		},
+		{
+			name:      "異常系 - 取得に失敗",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().GetStatus(mock.Anything, "U-test-user").Return(nil, errors.New("db error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody: map[string]interface{}{
+				"error":   "internal_error",
+				"message": message.GeneralError,
+			},
+		},
+		{
+			name:               "異常系 - 認証情報なし",
+			mockSetup:          func(m *servicemocks.MockUserService) {},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       map[string]interface{}{"error": "認証に失敗しました"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserService := servicemocks.NewMockUserService(t)
+			tt.mockSetup(mockUserService)
+			handler := NewAccountAPIHandler(mockUserService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+			if tt.hasUserID {
+				req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, "U-test-user"))
+			}
+
+			rr := httptest.NewRecorder()
+			handler.Me(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+
+			var resp map[string]interface{}
+			assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			assert.Equal(t, tt.expectedBody, resp)
+		})
+	}
+}
+
+func TestAccountAPIHandler_Withdraw(t *testing.T) {
+	tests := []struct {
+		name               string
+		hasUserID          bool
+		mockSetup          func(*servicemocks.MockUserService)
+		expectedStatusCode int
+		expectedError      string
+		expectedMessage    string
+	}{
+		{
+			name:      "正常系 - 退会",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().DeleteAccount(mock.Anything, "U-test-user").Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    message.WithdrawComplete,
+		},
+		{
+			name:      "異常系 - 未登録",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().DeleteAccount(mock.Anything, "U-test-user").Return(service.ErrUserNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedError:      "user_not_found",
+			expectedMessage:    message.WithdrawNotRegistered,
+		},
+		{
+			name:      "異常系 - 退会処理に失敗",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().DeleteAccount(mock.Anything, "U-test-user").Return(errors.New("db error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "internal_error",
+		},
+		{
+			name:               "異常系 - 認証情報なし",
+			mockSetup:          func(m *servicemocks.MockUserService) {},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserService := servicemocks.NewMockUserService(t)
+			tt.mockSetup(mockUserService)
+			handler := NewAccountAPIHandler(mockUserService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/withdraw", nil)
+			if tt.hasUserID {
+				req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, "U-test-user"))
+			}
+
+			rr := httptest.NewRecorder()
+			handler.Withdraw(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+
+			var resp map[string]interface{}
+			assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp["error"])
+			}
+			if tt.expectedMessage != "" {
+				assert.Equal(t, tt.expectedMessage, resp["message"])
+			}
+		})
+	}
+}
+
+func TestAccountAPIHandler_Unmatch(t *testing.T) {
+	tests := []struct {
+		name               string
+		hasUserID          bool
+		mockSetup          func(*servicemocks.MockUserService)
+		expectedStatusCode int
+		expectedError      string
+		expectedMessage    string
+	}{
+		{
+			name:      "正常系 - マッチング解除",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().Unmatch(mock.Anything, "U-test-user").Return("ボブ", nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    message.UnmatchComplete("ボブ"),
+		},
+		{
+			name:      "異常系 - マッチングしていない",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().Unmatch(mock.Anything, "U-test-user").Return("", service.ErrNotMatched)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedError:      "not_matched",
+			expectedMessage:    message.NotMatched,
+		},
+		{
+			name:      "異常系 - 未登録",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().Unmatch(mock.Anything, "U-test-user").Return("", service.ErrUserNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedError:      "user_not_found",
+		},
+		{
+			name:      "異常系 - 解除に失敗",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().Unmatch(mock.Anything, "U-test-user").Return("", errors.New("db error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "internal_error",
+		},
+		{
+			name:               "異常系 - 認証情報なし",
+			mockSetup:          func(m *servicemocks.MockUserService) {},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserService := servicemocks.NewMockUserService(t)
+			tt.mockSetup(mockUserService)
+			handler := NewAccountAPIHandler(mockUserService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/unmatch", nil)
+			if tt.hasUserID {
+				req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, "U-test-user"))
+			}
+
+			rr := httptest.NewRecorder()
+			handler.Unmatch(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+
+			var resp map[string]interface{}
+			assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp["error"])
+			}
+			if tt.expectedMessage != "" {
+				assert.Equal(t, tt.expectedMessage, resp["message"])
+			}
+		})
+	}
+}
+
+func TestAccountAPIHandler_Export(t *testing.T) {
+	export := &model.DataExport{
+		User:          model.ExportedUser{LineID: "U-test-user", Name: "アリス", Birthday: "1990-01-01"},
+		Crushes:       []model.ExportedCrush{{Name: "ボブ", Birthday: "1995-05-05"}},
+		CrushHistory:  []model.ExportedCrushHistory{},
+		MatchHistory:  []model.ExportedMatchHistory{{PartnerName: "ボブ", Action: model.MatchStarted}},
+		Notifications: []model.ExportedNotification{},
+	}
+
+	tests := []struct {
+		name               string
+		hasUserID          bool
+		mockSetup          func(*servicemocks.MockUserService)
+		expectedStatusCode int
+		expectedError      string
+		expectedMessage    string
+	}{
+		{
+			name:      "正常系 - エクスポート",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().ExportData(mock.Anything, "U-test-user").Return(export, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:      "異常系 - 未登録",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().ExportData(mock.Anything, "U-test-user").Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedError:      "user_not_found",
+			expectedMessage:    message.DataExportNotRegistered,
+		},
+		{
+			name:      "異常系 - 読み出しに失敗",
+			hasUserID: true,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().ExportData(mock.Anything, "U-test-user").Return(nil, errors.New("db error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedError:      "internal_error",
+		},
+		{
+			name:               "異常系 - 認証情報なし",
+			mockSetup:          func(m *servicemocks.MockUserService) {},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserService := servicemocks.NewMockUserService(t)
+			tt.mockSetup(mockUserService)
+			handler := NewAccountAPIHandler(mockUserService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me/export", nil)
+			if tt.hasUserID {
+				req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, "U-test-user"))
+			}
+
+			rr := httptest.NewRecorder()
+			handler.Export(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+
+			if tt.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+				var got model.DataExport
+				assert.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				assert.Equal(t, "アリス", got.User.Name)
+				assert.Len(t, got.Crushes, 1)
+				assert.Equal(t, "ボブ", got.MatchHistory[0].PartnerName)
+				return
+			}
+
+			var resp map[string]interface{}
+			assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp["error"])
+			}
+			if tt.expectedMessage != "" {
+				assert.Equal(t, tt.expectedMessage, resp["message"])
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/middleware"
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/internal/service"
+	"github.com/morinonusi421/cupid/pkg/httputil"
+	"github.com/morinonusi421/cupid/pkg/workerpool"
+)
+
+// AdminAPIHandler は運営向けの管理API（/admin/）
+// 認証は middleware.AdminAuth で行う。操作はすべて AdminService が監査ログに記録する
+type AdminAPIHandler struct {
+	adminService service.AdminService
+	webhookQueue *workerpool.Pool
+}
+
+// NewAdminAPIHandler は管理APIのハンドラを作成する
+// webhookQueue は Webhook イベントのワーカープール（集計にキューの状態を含める）
+func NewAdminAPIHandler(adminService service.AdminService, webhookQueue *workerpool.Pool) *AdminAPIHandler {
+	return &AdminAPIHandler{
+		adminService: adminService,
+		webhookQueue: webhookQueue,
+	}
+}
+
+// AdminUserSummary はユーザーの LINE ID と名前
+type AdminUserSummary struct {
+	LineID string `json:"line_id"`
+	Name   string `json:"name"`
+}
+
+// AdminUserResponse はユーザーの登録内容・好きな人・マッチング相手
+type AdminUserResponse struct {
+	LineID          string            `json:"line_id"`
+	Name            string            `json:"name"`
+	AltName         string            `json:"alt_name,omitempty"`
+	Birthday        string            `json:"birthday"`
+	LineDisplayName string            `json:"line_display_name,omitempty"`
+	RegisteredAt    string            `json:"registered_at"`
+	UpdatedAt       string            `json:"updated_at"`
+	Crushes         []MeCrush         `json:"crushes"`
+	MatchedWith     *AdminUserSummary `json:"matched_with"` // マッチングしていなければ null
+}
+
+// AdminMatch はマッチング中のペア（相手が見つからない場合 partner は null）
+type AdminMatch struct {
+	User    AdminUserSummary  `json:"user"`
+	Partner *AdminUserSummary `json:"partner"`
+}
+
+// AdminMatchesResponse はマッチング中のペアの一覧
+type AdminMatchesResponse struct {
+	Count   int          `json:"count"`
+	Matches []AdminMatch `json:"matches"`
+}
+
+// AdminUnmatchResponse はマッチングの強制解除の結果
+type AdminUnmatchResponse struct {
+	Status  string           `json:"status"`
+	Partner AdminUserSummary `json:"partner"`
+}
+
+// AdminStatusResponse は結果を返さない操作の結果
+type AdminStatusResponse struct {
+	Status string `json:"status"`
+}
+
+// AdminStatsResponse は集計
+type AdminStatsResponse struct {
+	Users                int `json:"users"`
+	WithdrawnUsers       int `json:"withdrawn_users"`
+	MatchedPairs         int `json:"matched_pairs"`
+	Crushes              int `json:"crushes"`
+	PendingNotifications int `json:"pending_notifications"`
+	DeadNotifications    int `json:"dead_notifications"`
+	// 起動してから、月間の送信数が予算に達していたため捨てた低優先度のPushメッセージの数
+	SkippedLowPriorityPushes int64 `json:"skipped_low_priority_pushes"`
+	// Webhook イベントのキューの状態（待機中・実行中のイベント数、満杯で受け付けなかったイベントの累計など）
+	WebhookQueue workerpool.Stats `json:"webhook_queue"`
+	GeneratedAt  string           `json:"generated_at"`
+}
+
+// LookupUser はユーザーを検索する（GET /admin/users?line_id=... または GET /admin/users?name=...&birthday=...）
+func (h *AdminAPIHandler) LookupUser(w http.ResponseWriter, r *http.Request) {
+	actor, ok := adminActor(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	lineID, name, birthday := query.Get("line_id"), query.Get("name"), query.Get("birthday")
+
+	var detail *model.AdminUserDetail
+	var err error
+	switch {
+	case lineID != "" && name == "" && birthday == "":
+		detail, err = h.adminService.LookupUserByLineID(r.Context(), actor, lineID)
+	case lineID == "" && name != "" && birthday != "":
+		b, parseErr := model.ParseBirthday(birthday, time.Now())
+		if parseErr != nil {
+			writeAdminError(w, http.StatusBadRequest, "invalid_birthday", parseErr.Error())
+			return
+		}
+		detail, err = h.adminService.LookupUserByNameAndBirthday(r.Context(), actor, name, b)
+	default:
+		writeAdminError(w, http.StatusBadRequest, "invalid_query", "specify either line_id, or both name and birthday")
+		return
+	}
+	if err != nil {
+		h.writeServiceError(w, "look up user", err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	httputil.WriteJSONResponse(w, http.StatusOK, adminUserResponse(detail))
+}
+
+// ListMatches はマッチング中のペアを返す（GET /admin/matches）
+func (h *AdminAPIHandler) ListMatches(w http.ResponseWriter, r *http.Request) {
+	actor, ok := adminActor(w, r)
+	if !ok {
+		return
+	}
+
+	pairs, err := h.adminService.ListMatches(r.Context(), actor)
+	if err != nil {
+		h.writeServiceError(w, "list matches", err)
+		return
+	}
+
+	resp := AdminMatchesResponse{Count: len(pairs), Matches: make([]AdminMatch, 0, len(pairs))}
+	for _, p := range pairs {
+		match := AdminMatch{User: adminUserSummary(p.User)}
+		if p.Partner != nil {
+			partner := adminUserSummary(p.Partner)
+			match.Partner = &partner
+		}
+		resp.Matches = append(resp.Matches, match)
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	httputil.WriteJSONResponse(w, http.StatusOK, resp)
+}
+
+// ForceUnmatch はマッチングを強制的に解除する（POST /admin/users/{line_id}/unmatch）
+// 2人がお互いを登録した好きな人の登録も取り消され、両方に通知される
+func (h *AdminAPIHandler) ForceUnmatch(w http.ResponseWriter, r *http.Request) {
+	actor, ok := adminActor(w, r)
+	if !ok {
+		return
+	}
+	lineID := r.PathValue("line_id")
+
+	partner, err := h.adminService.ForceUnmatch(r.Context(), actor, lineID)
+	if err != nil {
+		h.writeServiceError(w, "force unmatch "+lineID, err)
+		return
+	}
+
+	log.Printf("Admin %s (%s) force-unmatched %s and %s", actor.Name, actor.RemoteIP, lineID, partner.LineID)
+	httputil.WriteJSONResponse(w, http.StatusOK, AdminUnmatchResponse{
+		Status:  "ok",
+		Partner: adminUserSummary(partner),
+	})
+}
+
+// DeleteUser はユーザーを退会済みにする（DELETE /admin/users/{line_id}）
+// 本人の退会と同じく、マッチング中の場合は解除され、相手に通知される
+func (h *AdminAPIHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	actor, ok := adminActor(w, r)
+	if !ok {
+		return
+	}
+	lineID := r.PathValue("line_id")
+
+	if err := h.adminService.DeleteUser(r.Context(), actor, lineID); err != nil {
+		h.writeServiceError(w, "delete user "+lineID, err)
+		return
+	}
+
+	log.Printf("Admin %s (%s) deleted user %s", actor.Name, actor.RemoteIP, lineID)
+	httputil.WriteJSONResponse(w, http.StatusOK, AdminStatusResponse{Status: "ok"})
+}
+
+// Stats はユーザー・好きな人・マッチング・Push通知の件数と、Webhook イベントのキューの状態を返す（GET /admin/stats）
+func (h *AdminAPIHandler) Stats(w http.ResponseWriter, r *http.Request) {
+	actor, ok := adminActor(w, r)
+	if !ok {
+		return
+	}
+
+	stats, err := h.adminService.Stats(r.Context(), actor)
+	if err != nil {
+		h.writeServiceError(w, "collect stats", err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	httputil.WriteJSONResponse(w, http.StatusOK, AdminStatsResponse{
+		Users:                    stats.Users,
+		WithdrawnUsers:           stats.WithdrawnUsers,
+		MatchedPairs:             stats.MatchedPairs,
+		Crushes:                  stats.Crushes,
+		PendingNotifications:     stats.PendingNotifications,
+		DeadNotifications:        stats.DeadNotifications,
+		SkippedLowPriorityPushes: stats.SkippedLowPriorityPushes,
+		WebhookQueue:             h.webhookQueue.Stats(),
+		GeneratedAt:              time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// adminActor は context から認証した管理者を取得する（取得できなければ 401 を返す）
+func adminActor(w http.ResponseWriter, r *http.Request) (model.AdminActor, bool) {
+	actor, ok := middleware.GetAdminActorFromContext(r.Context())
+	if !ok {
+		log.Printf("Failed to get admin actor from context")
+		writeAdminError(w, http.StatusUnauthorized, "unauthorized", "")
+		return model.AdminActor{}, false
+	}
+	return actor, true
+}
+
+// writeServiceError は AdminService のエラーをレスポンスに変換する
+func (h *AdminAPIHandler) writeServiceError(w http.ResponseWriter, operation string, err error) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		writeAdminError(w, http.StatusNotFound, "user_not_found", "")
+	case errors.Is(err, service.ErrNotMatched):
+		writeAdminError(w, http.StatusConflict, "not_matched", "")
+	default:
+		log.Printf("Admin API failed to %s: %v", operation, err)
+		writeAdminError(w, http.StatusInternalServerError, "internal_error", "")
+	}
+}
+
+// writeAdminError はエラーコードと詳細（空なら含めない）を返す
+// 管理APIは運営だけが使うため、ユーザー向けのメッセージ（message パッケージ）は使わない
+func writeAdminError(w http.ResponseWriter, status int, code, detail string) {
+	body := map[string]string{"error": code}
+	if detail != "" {
+		body["message"] = detail
+	}
+	httputil.WriteJSONError(w, status, body)
+}
+
+// adminUserSummary はユーザーの LINE ID と名前を返す
+func adminUserSummary(u *model.User) AdminUserSummary {
+	return AdminUserSummary{LineID: u.LineID, Name: u.Name}
+}
+
+// adminUserResponse は検索したユーザーの情報をレスポンスに変換する
+func adminUserResponse(detail *model.AdminUserDetail) AdminUserResponse {
+	u := detail.User
+	resp := AdminUserResponse{
+		LineID:          u.LineID,
+		Name:            u.Name,
+		AltName:         u.AltName,
+		Birthday:        u.Birthday.String(),
+		LineDisplayName: u.LineDisplayName,
+		RegisteredAt:    u.RegisteredAt,
+		UpdatedAt:       u.UpdatedAt,
+		Crushes:         make([]MeCrush, 0, len(detail.Crushes)),
+	}
+	for _, c := range detail.Crushes {
+		resp.Crushes = append(resp.Crushes, MeCrush{ID: c.ID, Name: c.Name, AltName: c.AltName, Birthday: c.Birthday.String()})
+	}
+	if detail.MatchedUser != nil {
+		matched := adminUserSummary(detail.MatchedUser)
+		resp.MatchedWith = &matched
+	} else if u.IsMatched() {
+		// 相手が見つからない（本来は起こらない）場合も、マッチング中であることは返す
+		resp.MatchedWith = &AdminUserSummary{LineID: u.MatchedWithUserID.String}
+	}
+	return resp
+}
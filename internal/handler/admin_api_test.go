@@ -0,0 +1,335 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/morinonusi421/cupid/internal/middleware"
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/internal/service"
+	servicemocks "github.com/morinonusi421/cupid/internal/service/mocks"
+	"github.com/morinonusi421/cupid/pkg/workerpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testAdminActor = model.AdminActor{Name: "token", RemoteIP: "127.0.0.1"}
+
+// newAdminAPIHandlerForTest は空の Webhook イベントのキューを持つ AdminAPIHandler を作る
+func newAdminAPIHandlerForTest(t *testing.T, adminService service.AdminService) *AdminAPIHandler {
+	pool := workerpool.New(1, 1)
+	t.Cleanup(func() { pool.Drain(context.Background()) })
+	return NewAdminAPIHandler(adminService, pool)
+}
+
+// newAdminRequest は認証済みの管理者（testAdminActor）のリクエストを作る
+func newAdminRequest(method, target string) *http.Request {
+	req := httptest.NewRequest(method, target, nil)
+	return req.WithContext(context.WithValue(req.Context(), middleware.AdminActorKey, testAdminActor))
+}
+
+// decodeBody はレスポンスの JSON を map に変換する
+func decodeBody(t *testing.T, rr *httptest.ResponseRecorder) map[string]interface{} {
+	t.Helper()
+	var resp map[string]interface{}
+	assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	return resp
+}
+
+func TestAdminAPIHandler_LookupUser(t *testing.T) {
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01", MatchedWithUserID: null.StringFrom("U-bob"), RegisteredAt: "2026-01-01 00:00:00", UpdatedAt: "2026-01-02 00:00:00"}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+
+	tests := []struct {
+		name               string
+		target             string
+		mockSetup          func(*servicemocks.MockAdminService)
+		expectedStatusCode int
+		expectedBody       map[string]interface{}
+	}{
+		{
+			name:   "正常系 - LINE ID で検索",
+			target: "/admin/users?line_id=U-alice",
+			mockSetup: func(m *servicemocks.MockAdminService) {
+				m.EXPECT().LookupUserByLineID(mock.Anything, testAdminActor, "U-alice").Return(&model.AdminUserDetail{
+					User:        alice,
+					Crushes:     []*model.Crush{{ID: 2, Name: "ボブ", Birthday: "1995-05-05"}},
+					MatchedUser: bob,
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"line_id":       "U-alice",
+				"name":          "アリス",
+				"birthday":      "1990-01-01",
+				"registered_at": "2026-01-01 00:00:00",
+				"updated_at":    "2026-01-02 00:00:00",
+				"crushes":       []interface{}{map[string]interface{}{"id": float64(2), "name": "ボブ", "birthday": "1995-05-05"}},
+				"matched_with":  map[string]interface{}{"line_id": "U-bob", "name": "ボブ"},
+			},
+		},
+		{
+			name:   "正常系 - 名前と誕生日で検索",
+			target: "/admin/users?name=%E3%83%9C%E3%83%96&birthday=1995-05-05",
+			mockSetup: func(m *servicemocks.MockAdminService) {
+				m.EXPECT().LookupUserByNameAndBirthday(mock.Anything, testAdminActor, "ボブ", model.Birthday("1995-05-05")).Return(&model.AdminUserDetail{User: bob}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"line_id":       "U-bob",
+				"name":          "ボブ",
+				"birthday":      "1995-05-05",
+				"registered_at": "",
+				"updated_at":    "",
+				"crushes":       []interface{}{},
+				"matched_with":  nil,
+			},
+		},
+		{
+			name:               "異常系 - 検索条件がない",
+			target:             "/admin/users",
+			mockSetup:          func(m *servicemocks.MockAdminService) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error":   "invalid_query",
+				"message": "specify either line_id, or both name and birthday",
+			},
+		},
+		{
+			name:               "異常系 - 誕生日がない",
+			target:             "/admin/users?name=%E3%83%9C%E3%83%96",
+			mockSetup:          func(m *servicemocks.MockAdminService) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error":   "invalid_query",
+				"message": "specify either line_id, or both name and birthday",
+			},
+		},
+		{
+			name:               "異常系 - 誕生日の形式が違う",
+			target:             "/admin/users?name=%E3%83%9C%E3%83%96&birthday=1995%2F05%2F05",
+			mockSetup:          func(m *servicemocks.MockAdminService) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error":   "invalid_birthday",
+				"message": `invalid birthday "1995/05/05": invalid_format`,
+			},
+		},
+		{
+			name:   "異常系 - 見つからない",
+			target: "/admin/users?line_id=U-nobody",
+			mockSetup: func(m *servicemocks.MockAdminService) {
+				m.EXPECT().LookupUserByLineID(mock.Anything, testAdminActor, "U-nobody").Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       map[string]interface{}{"error": "user_not_found"},
+		},
+		{
+			name:   "異常系 - 監査ログの記録に失敗",
+			target: "/admin/users?line_id=U-alice",
+			mockSetup: func(m *servicemocks.MockAdminService) {
+				m.EXPECT().LookupUserByLineID(mock.Anything, testAdminActor, "U-alice").Return(nil, errors.New("failed to record admin audit log"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       map[string]interface{}{"error": "internal_error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAdminService := servicemocks.NewMockAdminService(t)
+			tt.mockSetup(mockAdminService)
+			handler := newAdminAPIHandlerForTest(t, mockAdminService)
+
+			rr := httptest.NewRecorder()
+			handler.LookupUser(rr, newAdminRequest(http.MethodGet, tt.target))
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedBody, decodeBody(t, rr))
+		})
+	}
+}
+
+func TestAdminAPIHandler_ListMatches(t *testing.T) {
+	mockAdminService := servicemocks.NewMockAdminService(t)
+	mockAdminService.EXPECT().ListMatches(mock.Anything, testAdminActor).Return([]*model.MatchedPair{
+		{User: &model.User{LineID: "U-alice", Name: "アリス"}, Partner: &model.User{LineID: "U-bob", Name: "ボブ"}},
+		{User: &model.User{LineID: "U-carol", Name: "キャロル"}},
+	}, nil)
+	handler := newAdminAPIHandlerForTest(t, mockAdminService)
+
+	rr := httptest.NewRecorder()
+	handler.ListMatches(rr, newAdminRequest(http.MethodGet, "/admin/matches"))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, map[string]interface{}{
+		"count": float64(2),
+		"matches": []interface{}{
+			map[string]interface{}{
+				"user":    map[string]interface{}{"line_id": "U-alice", "name": "アリス"},
+				"partner": map[string]interface{}{"line_id": "U-bob", "name": "ボブ"},
+			},
+			map[string]interface{}{
+				"user":    map[string]interface{}{"line_id": "U-carol", "name": "キャロル"},
+				"partner": nil,
+			},
+		},
+	}, decodeBody(t, rr))
+}
+
+func TestAdminAPIHandler_ForceUnmatch(t *testing.T) {
+	tests := []struct {
+		name               string
+		mockSetup          func(*servicemocks.MockAdminService)
+		expectedStatusCode int
+		expectedBody       map[string]interface{}
+	}{
+		{
+			name: "正常系",
+			mockSetup: func(m *servicemocks.MockAdminService) {
+				m.EXPECT().ForceUnmatch(mock.Anything, testAdminActor, "U-alice").Return(&model.User{LineID: "U-bob", Name: "ボブ"}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status":  "ok",
+				"partner": map[string]interface{}{"line_id": "U-bob", "name": "ボブ"},
+			},
+		},
+		{
+			name: "異常系 - マッチングしていない",
+			mockSetup: func(m *servicemocks.MockAdminService) {
+				m.EXPECT().ForceUnmatch(mock.Anything, testAdminActor, "U-alice").Return(nil, service.ErrNotMatched)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       map[string]interface{}{"error": "not_matched"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAdminService := servicemocks.NewMockAdminService(t)
+			tt.mockSetup(mockAdminService)
+			handler := newAdminAPIHandlerForTest(t, mockAdminService)
+
+			req := newAdminRequest(http.MethodPost, "/admin/users/U-alice/unmatch")
+			req.SetPathValue("line_id", "U-alice")
+			rr := httptest.NewRecorder()
+			handler.ForceUnmatch(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedBody, decodeBody(t, rr))
+		})
+	}
+}
+
+func TestAdminAPIHandler_DeleteUser(t *testing.T) {
+	tests := []struct {
+		name               string
+		mockSetup          func(*servicemocks.MockAdminService)
+		expectedStatusCode int
+		expectedBody       map[string]interface{}
+	}{
+		{
+			name: "正常系",
+			mockSetup: func(m *servicemocks.MockAdminService) {
+				m.EXPECT().DeleteUser(mock.Anything, testAdminActor, "U-alice").Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       map[string]interface{}{"status": "ok"},
+		},
+		{
+			name: "異常系 - 見つからない",
+			mockSetup: func(m *servicemocks.MockAdminService) {
+				m.EXPECT().DeleteUser(mock.Anything, testAdminActor, "U-alice").Return(service.ErrUserNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       map[string]interface{}{"error": "user_not_found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAdminService := servicemocks.NewMockAdminService(t)
+			tt.mockSetup(mockAdminService)
+			handler := newAdminAPIHandlerForTest(t, mockAdminService)
+
+			req := newAdminRequest(http.MethodDelete, "/admin/users/U-alice")
+			req.SetPathValue("line_id", "U-alice")
+			rr := httptest.NewRecorder()
+			handler.DeleteUser(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedBody, decodeBody(t, rr))
+		})
+	}
+}
+
+func TestAdminAPIHandler_Stats(t *testing.T) {
+	mockAdminService := servicemocks.NewMockAdminService(t)
+	mockAdminService.EXPECT().Stats(mock.Anything, testAdminActor).Return(&model.AdminStats{
+		Users: 10, WithdrawnUsers: 2, MatchedPairs: 3, Crushes: 15, PendingNotifications: 1, DeadNotifications: 0,
+		SkippedLowPriorityPushes: 4,
+	}, nil)
+	pool := workerpool.New(1, 1)
+	defer pool.Drain(context.Background())
+	handler := NewAdminAPIHandler(mockAdminService, pool)
+
+	// ワーカーを塞ぎ（実行中1）、キューを満杯にして（待機中1）、1つ受け付けられないようにする
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.NoError(t, pool.Submit(context.Background(), "busy", func(ctx context.Context) {
+		close(started)
+		<-release
+	}))
+	<-started
+	require.NoError(t, pool.Submit(context.Background(), "busy", func(ctx context.Context) {}))
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, pool.Submit(canceled, "busy", func(ctx context.Context) {}))
+
+	rr := httptest.NewRecorder()
+	handler.Stats(rr, newAdminRequest(http.MethodGet, "/admin/stats"))
+	close(release)
+	pool.Wait()
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	resp := decodeBody(t, rr)
+	assert.NotEmpty(t, resp["generated_at"])
+	delete(resp, "generated_at")
+	assert.Equal(t, map[string]interface{}{
+		"users":                       float64(10),
+		"withdrawn_users":             float64(2),
+		"matched_pairs":               float64(3),
+		"crushes":                     float64(15),
+		"pending_notifications":       float64(1),
+		"dead_notifications":          float64(0),
+		"skipped_low_priority_pushes": float64(4),
+		"webhook_queue": map[string]interface{}{
+			"workers":   float64(1),
+			"capacity":  float64(1),
+			"queued":    float64(1),
+			"in_flight": float64(1),
+			"submitted": float64(2),
+			"processed": float64(0),
+			"rejected":  float64(1),
+			"panicked":  float64(0),
+		},
+	}, resp)
+}
+
+func TestAdminAPIHandler_NoActor(t *testing.T) {
+	// AdminAuth を通っていないリクエストは 401（サービスは呼ばない）
+	handler := newAdminAPIHandlerForTest(t, servicemocks.NewMockAdminService(t))
+
+	rr := httptest.NewRecorder()
+	handler.Stats(rr, httptest.NewRequest(http.MethodGet, "/admin/stats", nil))
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Equal(t, map[string]interface{}{"error": "unauthorized"}, decodeBody(t, rr))
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/morinonusi421/cupid/internal/message"
 	"github.com/morinonusi421/cupid/internal/middleware"
+	"github.com/morinonusi421/cupid/internal/model"
 	"github.com/morinonusi421/cupid/internal/service"
 	"github.com/morinonusi421/cupid/pkg/httputil"
 )
@@ -27,14 +28,16 @@ func NewCrushRegistrationAPIHandler(userService service.UserService, userLiffURL
 
 type RegisterCrushRequest struct {
 	CrushName      string `json:"crush_name"`
+	CrushAltName   string `json:"crush_alt_name"` // 好きな人の名前の別の表記（任意）
 	CrushBirthday  string `json:"crush_birthday"`
 	ConfirmUnmatch bool   `json:"confirm_unmatch"`
 }
 
 type RegisterCrushResponse struct {
-	Status              string `json:"status"`
-	Matched             bool   `json:"matched"`
-	IsFirstRegistration bool   `json:"is_first_registration"`
+	Status              string   `json:"status"`
+	Matched             bool     `json:"matched"`
+	IsFirstRegistration bool     `json:"is_first_registration"`
+	Suggestions         []string `json:"suggestions,omitempty"` // マッチングしなかった場合の、別の表記の候補（model.SuggestNames）
 }
 
 func (h *CrushRegistrationAPIHandler) RegisterCrush(w http.ResponseWriter, r *http.Request) {
@@ -54,26 +57,20 @@ func (h *CrushRegistrationAPIHandler) RegisterCrush(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// 日付のバリデーション（YYYY-MM-DD形式）
-	const birthdayLayout = "2006-01-02"
-	if _, err := time.Parse(birthdayLayout, req.CrushBirthday); err != nil {
-		log.Printf("Invalid birthday format: %s, error: %v", req.CrushBirthday, err)
-		httputil.WriteJSONError(w, http.StatusBadRequest, map[string]string{
-			"error":   "invalid_birthday",
-			"message": message.InvalidBirthdayError,
-		})
+	crushBirthday, ok := parseRequestBirthday(w, req.CrushBirthday)
+	if !ok {
 		return
 	}
 
 	// バリデーション
-	if req.CrushName == "" || req.CrushBirthday == "" {
-		log.Println("Missing crush_name or crush_birthday in request")
-		httputil.WriteJSONError(w, http.StatusBadRequest, map[string]string{"error": "crush_name and crush_birthday are required"})
+	if req.CrushName == "" {
+		log.Println("Missing crush_name in request")
+		httputil.WriteJSONError(w, http.StatusBadRequest, map[string]string{"error": "crush_name is required"})
 		return
 	}
 
 	// サービス呼び出し（user_idはcontextから取得したものを使用）
-	matched, isFirstCrushRegistration, err := h.userService.RegisterCrush(r.Context(), userID, req.CrushName, req.CrushBirthday, req.ConfirmUnmatch)
+	matched, isFirstCrushRegistration, err := h.userService.RegisterCrush(r.Context(), userID, req.CrushName, req.CrushAltName, crushBirthday, req.ConfirmUnmatch)
 	if err != nil {
 		log.Printf("Failed to register crush: %v", err)
 		log.Printf("[DEBUG] Error type: %T, ErrUserNotFound: %v, errors.Is result: %v", err, service.ErrUserNotFound, errors.Is(err, service.ErrUserNotFound))
@@ -100,6 +97,45 @@ func (h *CrushRegistrationAPIHandler) RegisterCrush(w http.ResponseWriter, r *ht
 			return
 		}
 
+		// crush_limit_reachedエラーの場合は特別なレスポンス
+		var limitErr *service.CrushLimitReachedError
+		if errors.As(err, &limitErr) {
+			httputil.WriteJSONError(w, http.StatusConflict, map[string]string{
+				"error":   "crush_limit_reached",
+				"message": message.CrushLimitReached(limitErr.Limit),
+			})
+			return
+		}
+
+		// 1日の登録回数の上限に達している場合は429を返す（日本時間の翌日0時まで）
+		var changeLimitErr *service.CrushChangeLimitReachedError
+		if errors.As(err, &changeLimitErr) {
+			middleware.WriteTooManyRequests(w, time.Until(changeLimitErr.RetryAt), "crush_change_limit_reached", message.CrushChangeLimitReached(changeLimitErr.Limit))
+			return
+		}
+
+		// 登録し直しが制限されている場合も429を返す（登録の間隔・期間内の人数）
+		var restrictedErr *service.CrushChangeRestrictedError
+		if errors.As(err, &restrictedErr) {
+			retryAfter := time.Until(restrictedErr.RetryAt)
+			msg := message.CrushChangeCooldown(retryAfter)
+			if restrictedErr.Reason == service.CrushChangeDistinctLimit {
+				msg = message.CrushDistinctLimitReached(restrictedErr.Limit, restrictedErr.Window)
+			}
+			middleware.WriteTooManyRequests(w, retryAfter, "crush_change_restricted", msg)
+			return
+		}
+
+		// 年齢による制限の場合は403を返す
+		var ageErr *service.AgeRestrictedError
+		if errors.As(err, &ageErr) {
+			httputil.WriteJSONError(w, http.StatusForbidden, map[string]string{
+				"error":   string(ageErr.Reason),
+				"message": ageRestrictionMessage(ageErr),
+			})
+			return
+		}
+
 		// 自己登録エラーの場合は400を返す
 		if errors.Is(err, service.ErrCannotRegisterYourself) {
 			httputil.WriteJSONError(w, http.StatusBadRequest, map[string]string{"error": "cannot_register_yourself"})
@@ -110,11 +146,19 @@ func (h *CrushRegistrationAPIHandler) RegisterCrush(w http.ResponseWriter, r *ht
 		return
 	}
 
+	recordLineDisplayName(r.Context(), h.userService, userID)
+
 	// レスポンス作成
+	// マッチングしなかった場合は、入力ミスの可能性がある表記を別の表記の候補として返す（別の表記が未入力の場合のみ）
+	var suggestions []string
+	if !matched && req.CrushAltName == "" {
+		suggestions = model.SuggestNames(req.CrushName)
+	}
 	httputil.WriteJSONResponse(w, http.StatusOK, RegisterCrushResponse{
 		Status:              "ok",
 		Matched:             matched,
 		IsFirstRegistration: isFirstCrushRegistration,
+		Suggestions:         suggestions,
 	})
 
 	if matched {
@@ -123,3 +167,68 @@ func (h *CrushRegistrationAPIHandler) RegisterCrush(w http.ResponseWriter, r *ht
 		log.Printf("Crush registration successful for user %s: crush=%s", userID, req.CrushName)
 	}
 }
+
+type WithdrawCrushRequest struct {
+	CrushID        int64 `json:"crush_id"`
+	ConfirmUnmatch bool  `json:"confirm_unmatch"`
+}
+
+type WithdrawCrushResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// WithdrawCrush は好きな人の登録を取り消す（POST /api/withdraw-crush）
+// マッチング中の相手の場合は confirm_unmatch が true の時だけマッチング解除して取り消す
+func (h *CrushRegistrationAPIHandler) WithdrawCrush(w http.ResponseWriter, r *http.Request) {
+	// context から user_id を取得
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		log.Printf("Failed to get user_id from context")
+		httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証に失敗しました"})
+		return
+	}
+
+	// リクエストボディをデコード
+	var req WithdrawCrushRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.CrushID <= 0 {
+		log.Printf("Invalid withdraw crush request: %v", err)
+		httputil.WriteJSONError(w, http.StatusBadRequest, map[string]string{"error": "invalid request"})
+		return
+	}
+
+	crushName, err := h.userService.WithdrawCrush(r.Context(), userID, req.CrushID, req.ConfirmUnmatch)
+	if err != nil {
+		var matchedErr *service.MatchedUserExistsError
+		switch {
+		case errors.As(err, &matchedErr):
+			httputil.WriteJSONError(w, http.StatusConflict, map[string]string{
+				"error":   "matched_user_exists",
+				"message": message.CrushWithdrawUnmatchWarning(matchedErr.MatchedUserName),
+			})
+		case errors.Is(err, service.ErrUserNotFound):
+			httputil.WriteJSONError(w, http.StatusNotFound, map[string]string{
+				"error":   "user_not_found",
+				"message": message.UnregisteredUserPrompt,
+			})
+		case errors.Is(err, service.ErrCrushNotFound):
+			httputil.WriteJSONError(w, http.StatusNotFound, map[string]string{
+				"error":   "crush_not_found",
+				"message": message.CrushNotFound,
+			})
+		default:
+			log.Printf("Failed to withdraw crush %d of %s: %v", req.CrushID, userID, err)
+			httputil.WriteJSONError(w, http.StatusInternalServerError, map[string]string{
+				"error":   "internal_error",
+				"message": message.GeneralError,
+			})
+		}
+		return
+	}
+
+	log.Printf("User %s withdrew crush %d", userID, req.CrushID)
+	httputil.WriteJSONResponse(w, http.StatusOK, WithdrawCrushResponse{
+		Status:  "ok",
+		Message: message.CrushWithdrawn(crushName),
+	})
+}
@@ -7,8 +7,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/morinonusi421/cupid/internal/message"
 	"github.com/morinonusi421/cupid/internal/middleware"
+	"github.com/morinonusi421/cupid/internal/model"
 	"github.com/morinonusi421/cupid/internal/service"
 	servicemocks "github.com/morinonusi421/cupid/internal/service/mocks"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +30,7 @@ func TestCrushRegistrationAPIHandler_RegisterCrush(t *testing.T) {
 		expectedFirstReg        *bool
 		expectedError           string
 		expectedStatus          string
+		expectedSuggestions     []interface{} // nil なら確認しない、空なら候補を返さない
 	}{
 		{
 			name: "正常系 - マッチなし（初回登録）",
@@ -37,7 +41,25 @@ func TestCrushRegistrationAPIHandler_RegisterCrush(t *testing.T) {
 			hasUserID: true,
 			userID:    "U-test-user",
 			mockSetup: func(m *servicemocks.MockUserService) {
-				m.EXPECT().RegisterCrush(mock.Anything, "U-test-user", "サトウハナコ", "1992-02-02", false).
+				m.EXPECT().RegisterCrush(mock.Anything, "U-test-user", "サトウハナコ", "", model.Birthday("1992-02-02"), false).
+					Return(false, true, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMatched:    boolPtr(false),
+			expectedFirstReg:   boolPtr(true),
+			expectedStatus:     "ok",
+		},
+		{
+			name: "正常系 - 別の表記も渡す",
+			requestBody: map[string]interface{}{
+				"crush_name":     "佐藤花子",
+				"crush_alt_name": "サトウハナコ",
+				"crush_birthday": "1992-02-02",
+			},
+			hasUserID: true,
+			userID:    "U-test-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterCrush(mock.Anything, "U-test-user", "佐藤花子", "サトウハナコ", model.Birthday("1992-02-02"), false).
 					Return(false, true, nil)
 			},
 			expectedStatusCode: http.StatusOK,
@@ -54,7 +76,7 @@ func TestCrushRegistrationAPIHandler_RegisterCrush(t *testing.T) {
 			hasUserID: true,
 			userID:    "U-existing-user",
 			mockSetup: func(m *servicemocks.MockUserService) {
-				m.EXPECT().RegisterCrush(mock.Anything, "U-existing-user", "タナカタロウ", "1990-01-01", false).
+				m.EXPECT().RegisterCrush(mock.Anything, "U-existing-user", "タナカタロウ", "", model.Birthday("1990-01-01"), false).
 					Return(false, false, nil)
 			},
 			expectedStatusCode: http.StatusOK,
@@ -71,7 +93,7 @@ func TestCrushRegistrationAPIHandler_RegisterCrush(t *testing.T) {
 			hasUserID: true,
 			userID:    "U-matched-user",
 			mockSetup: func(m *servicemocks.MockUserService) {
-				m.EXPECT().RegisterCrush(mock.Anything, "U-matched-user", "スズキイチロウ", "1988-08-08", false).
+				m.EXPECT().RegisterCrush(mock.Anything, "U-matched-user", "スズキイチロウ", "", model.Birthday("1988-08-08"), false).
 					Return(true, false, nil)
 			},
 			expectedStatusCode: http.StatusOK,
@@ -88,7 +110,7 @@ func TestCrushRegistrationAPIHandler_RegisterCrush(t *testing.T) {
 			hasUserID: true,
 			userID:    "U-self-user",
 			mockSetup: func(m *servicemocks.MockUserService) {
-				m.EXPECT().RegisterCrush(mock.Anything, "U-self-user", "ヤマダタロウ", "1990-01-01", false).
+				m.EXPECT().RegisterCrush(mock.Anything, "U-self-user", "ヤマダタロウ", "", model.Birthday("1990-01-01"), false).
 					Return(false, false, service.ErrCannotRegisterYourself)
 			},
 			expectedStatusCode: http.StatusBadRequest,
@@ -106,12 +128,96 @@ func TestCrushRegistrationAPIHandler_RegisterCrush(t *testing.T) {
 				validationErr := &service.ValidationError{
 					Message: "名前は全角カタカナ2〜20文字で入力してください（スペース不可）",
 				}
-				m.EXPECT().RegisterCrush(mock.Anything, "U-validation-user", "山田太郎", "1990-01-01", false).
+				m.EXPECT().RegisterCrush(mock.Anything, "U-validation-user", "山田太郎", "", model.Birthday("1990-01-01"), false).
 					Return(false, false, validationErr)
 			},
 			expectedStatusCode: http.StatusBadRequest,
 			expectedError:      "名前は全角カタカナ2〜20文字で入力してください（スペース不可）",
 		},
+		{
+			name: "異常系 - 好きな人の登録数が上限",
+			requestBody: map[string]interface{}{
+				"crush_name":     "サトウハナコ",
+				"crush_birthday": "1992-02-02",
+			},
+			hasUserID: true,
+			userID:    "U-limit-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterCrush(mock.Anything, "U-limit-user", "サトウハナコ", "", model.Birthday("1992-02-02"), false).
+					Return(false, false, &service.CrushLimitReachedError{Limit: 3})
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedError:      "crush_limit_reached",
+		},
+		{
+			name: "異常系 - 1日の登録回数が上限",
+			requestBody: map[string]interface{}{
+				"crush_name":     "サトウハナコ",
+				"crush_birthday": "1992-02-02",
+			},
+			hasUserID: true,
+			userID:    "U-limit-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterCrush(mock.Anything, "U-limit-user", "サトウハナコ", "", model.Birthday("1992-02-02"), false).
+					Return(false, false, &service.CrushChangeLimitReachedError{Limit: 10, RetryAt: time.Now().Add(time.Hour)})
+			},
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedError:      "crush_change_limit_reached",
+		},
+		{
+			name: "異常系 - 登録し直しの間隔が空いていない",
+			requestBody: map[string]interface{}{
+				"crush_name":     "サトウハナコ",
+				"crush_birthday": "1992-02-02",
+			},
+			hasUserID: true,
+			userID:    "U-cooldown-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterCrush(mock.Anything, "U-cooldown-user", "サトウハナコ", "", model.Birthday("1992-02-02"), false).
+					Return(false, false, &service.CrushChangeRestrictedError{Reason: service.CrushChangeCooldown, RetryAt: time.Now().Add(time.Minute)})
+			},
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedError:      "crush_change_restricted",
+		},
+		{
+			name: "異常系 - 古すぎる日付",
+			requestBody: map[string]interface{}{
+				"crush_name":     "サトウハナコ",
+				"crush_birthday": "1800-01-01",
+			},
+			hasUserID:          true,
+			userID:             "U-test-user",
+			mockSetup:          func(m *servicemocks.MockUserService) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "invalid_birthday",
+		},
+		{
+			name: "異常系 - 名前が空",
+			requestBody: map[string]interface{}{
+				"crush_name":     "",
+				"crush_birthday": "1992-02-02",
+			},
+			hasUserID:          true,
+			userID:             "U-test-user",
+			mockSetup:          func(m *servicemocks.MockUserService) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "crush_name is required",
+		},
+		{
+			name: "異常系 - 未成年と成人の組み合わせ",
+			requestBody: map[string]interface{}{
+				"crush_name":     "サトウハナコ",
+				"crush_birthday": "2012-02-02",
+			},
+			hasUserID: true,
+			userID:    "U-adult-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterCrush(mock.Anything, "U-adult-user", "サトウハナコ", "", model.Birthday("2012-02-02"), false).
+					Return(false, false, &service.AgeRestrictedError{Reason: service.AgeMinorAdultCrush, Limit: 18})
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedError:      "minor_adult_crush",
+		},
 		{
 			name: "異常系 - contextにUserIDがない",
 			requestBody: map[string]interface{}{
@@ -123,6 +229,55 @@ func TestCrushRegistrationAPIHandler_RegisterCrush(t *testing.T) {
 			expectedStatusCode: http.StatusUnauthorized,
 			expectedError:      "認証に失敗しました",
 		},
+		{
+			name: "正常系 - マッチなしの場合は名前の候補を返す",
+			requestBody: map[string]interface{}{
+				"crush_name":     "タナカタロ",
+				"crush_birthday": "1990-01-01",
+			},
+			hasUserID: true,
+			userID:    "U-test-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterCrush(mock.Anything, "U-test-user", "タナカタロ", "", model.Birthday("1990-01-01"), false).
+					Return(false, true, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedStatus:      "ok",
+			expectedSuggestions: []interface{}{"タナカタロウ"},
+		},
+		{
+			name: "正常系 - 別の表記が入力されていれば名前の候補を返さない",
+			requestBody: map[string]interface{}{
+				"crush_name":     "タナカタロ",
+				"crush_alt_name": "Taro Tanaka",
+				"crush_birthday": "1990-01-01",
+			},
+			hasUserID: true,
+			userID:    "U-test-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterCrush(mock.Anything, "U-test-user", "タナカタロ", "Taro Tanaka", model.Birthday("1990-01-01"), false).
+					Return(false, true, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedStatus:      "ok",
+			expectedSuggestions: []interface{}{},
+		},
+		{
+			name: "正常系 - マッチした場合は名前の候補を返さない",
+			requestBody: map[string]interface{}{
+				"crush_name":     "タナカタロ",
+				"crush_birthday": "1990-01-01",
+			},
+			hasUserID: true,
+			userID:    "U-test-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterCrush(mock.Anything, "U-test-user", "タナカタロ", "", model.Birthday("1990-01-01"), false).
+					Return(true, true, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedMatched:     boolPtr(true),
+			expectedSuggestions: []interface{}{},
+		},
 		{
 			name:               "異常系 - 不正なJSON",
 			requestBody:        "invalid json",
@@ -162,6 +317,9 @@ func TestCrushRegistrationAPIHandler_RegisterCrush(t *testing.T) {
 
 			// ステータスコード確認
 			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode == http.StatusTooManyRequests {
+				assert.NotEmpty(t, rr.Header().Get("Retry-After"))
+			}
 
 			// レスポンスボディ確認
 			var resp map[string]interface{}
@@ -180,12 +338,101 @@ func TestCrushRegistrationAPIHandler_RegisterCrush(t *testing.T) {
 			if tt.expectedFirstReg != nil {
 				assert.Equal(t, *tt.expectedFirstReg, resp["is_first_registration"])
 			}
+			if tt.expectedSuggestions != nil {
+				if len(tt.expectedSuggestions) == 0 {
+					assert.NotContains(t, resp, "suggestions")
+				} else {
+					assert.Equal(t, tt.expectedSuggestions, resp["suggestions"])
+				}
+			}
 
 			mockUserService.AssertExpectations(t)
 		})
 	}
 }
 
+func TestCrushRegistrationAPIHandler_WithdrawCrush(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        string
+		mockSetup          func(*servicemocks.MockUserService)
+		expectedStatusCode int
+		expectedError      string
+		expectedMessage    string
+	}{
+		{
+			name:        "正常系 - 取り消し",
+			requestBody: `{"crush_id": 3}`,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().WithdrawCrush(mock.Anything, "U-test-user", int64(3), false).Return("ボブ", nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    message.CrushWithdrawn("ボブ"),
+		},
+		{
+			name:        "正常系 - マッチング中の相手を確認して取り消し",
+			requestBody: `{"crush_id": 3, "confirm_unmatch": true}`,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().WithdrawCrush(mock.Anything, "U-test-user", int64(3), true).Return("ボブ", nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    message.CrushWithdrawn("ボブ"),
+		},
+		{
+			name:        "異常系 - マッチング中の相手（確認なし）",
+			requestBody: `{"crush_id": 3}`,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().WithdrawCrush(mock.Anything, "U-test-user", int64(3), false).
+					Return("", &service.MatchedUserExistsError{MatchedUserName: "ボブ"})
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedError:      "matched_user_exists",
+			expectedMessage:    message.CrushWithdrawUnmatchWarning("ボブ"),
+		},
+		{
+			name:        "異常系 - 好きな人が見つからない",
+			requestBody: `{"crush_id": 99}`,
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().WithdrawCrush(mock.Anything, "U-test-user", int64(99), false).Return("", service.ErrCrushNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedError:      "crush_not_found",
+		},
+		{
+			name:               "異常系 - crush_id なし",
+			requestBody:        `{}`,
+			mockSetup:          func(m *servicemocks.MockUserService) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "invalid request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserService := servicemocks.NewMockUserService(t)
+			tt.mockSetup(mockUserService)
+			handler := NewCrushRegistrationAPIHandler(mockUserService, "https://liff.line.me/user")
+
+			req := httptest.NewRequest(http.MethodPost, "/api/withdraw-crush", bytes.NewBufferString(tt.requestBody))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, "U-test-user"))
+
+			rr := httptest.NewRecorder()
+			handler.WithdrawCrush(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+
+			var resp map[string]interface{}
+			assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp["error"])
+			}
+			if tt.expectedMessage != "" {
+				assert.Equal(t, tt.expectedMessage, resp["message"])
+			}
+		})
+	}
+}
+
 // boolPtr returns a pointer to the given bool value
 func boolPtr(b bool) *bool {
 	return &b
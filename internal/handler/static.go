@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"io/fs"
+	"net/http"
+	"strings"
+)
+
+// StaticHandler は埋め込まれた LIFF ページと共通JavaScriptを配信する
+// Nginx を使わずに単体で動かす場合に使用する（nginx/cupid.conf と同じパスを配信）
+type StaticHandler struct {
+	fileServer http.Handler
+}
+
+func NewStaticHandler(files fs.FS) *StaticHandler {
+	return &StaticHandler{
+		fileServer: http.FileServer(http.FS(files)),
+	}
+}
+
+// Register は /user/, /crush/, /common.js, /messages.js を mux に登録する
+func (h *StaticHandler) Register(mux *http.ServeMux) {
+	for _, pattern := range []string{"/user/", "/crush/", "/common.js", "/messages.js"} {
+		mux.Handle(pattern, h)
+	}
+}
+
+func (h *StaticHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// ディレクトリの一覧は公開しない
+	if strings.HasSuffix(r.URL.Path, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	// LIFF は常に最新版を読み込ませるためキャッシュを無効化
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set("Pragma", "no-cache")
+	w.Header().Set("Expires", "0")
+
+	h.fileServer.ServeHTTP(w, r)
+}
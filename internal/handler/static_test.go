@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticHandler(t *testing.T) {
+	files := fstest.MapFS{
+		"common.js":          {Data: []byte("// common")},
+		"messages.js":        {Data: []byte("// messages")},
+		"user/register.html": {Data: []byte("<html>user</html>")},
+		"crush/register.js":  {Data: []byte("// crush")},
+		"secret.txt":         {Data: []byte("secret")},
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "ユーザー登録ページ", path: "/user/register.html", expectedStatus: http.StatusOK, expectedBody: "<html>user</html>"},
+		{name: "好きな人登録ページのJS", path: "/crush/register.js", expectedStatus: http.StatusOK, expectedBody: "// crush"},
+		{name: "共通JS", path: "/common.js", expectedStatus: http.StatusOK, expectedBody: "// common"},
+		{name: "メッセージJS", path: "/messages.js", expectedStatus: http.StatusOK, expectedBody: "// messages"},
+		{name: "存在しないファイル", path: "/user/missing.html", expectedStatus: http.StatusNotFound},
+		{name: "ディレクトリ一覧は返さない", path: "/user/", expectedStatus: http.StatusNotFound},
+		{name: "登録していないパスは配信しない", path: "/secret.txt", expectedStatus: http.StatusNotFound},
+	}
+
+	mux := http.NewServeMux()
+	NewStaticHandler(files).Register(mux)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, rr.Body.String())
+				assert.Equal(t, "no-cache, no-store, must-revalidate", rr.Header().Get("Cache-Control"))
+			}
+		})
+	}
+}
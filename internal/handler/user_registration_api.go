@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/morinonusi421/cupid/internal/message"
 	"github.com/morinonusi421/cupid/internal/middleware"
+	"github.com/morinonusi421/cupid/internal/model"
 	"github.com/morinonusi421/cupid/internal/service"
 	"github.com/morinonusi421/cupid/pkg/httputil"
 )
@@ -25,6 +27,7 @@ func NewUserRegistrationAPIHandler(userService service.UserService) *UserRegistr
 
 type RegisterUserRequest struct {
 	Name           string `json:"name"`
+	AltName        string `json:"alt_name"` // 名前の別の表記（任意）
 	Birthday       string `json:"birthday"`
 	ConfirmUnmatch bool   `json:"confirm_unmatch"`
 }
@@ -43,7 +46,7 @@ func (h *UserRegistrationAPIHandler) Register(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// リクエストボディからname, birthday, confirm_unmatchを取得
+	// リクエストボディからname, alt_name, birthday, confirm_unmatchを取得
 	var req RegisterUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		log.Printf("Failed to decode request: %v", err)
@@ -51,19 +54,13 @@ func (h *UserRegistrationAPIHandler) Register(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// 日付のバリデーション（YYYY-MM-DD形式）
-	const birthdayLayout = "2006-01-02"
-	if _, err := time.Parse(birthdayLayout, req.Birthday); err != nil {
-		log.Printf("Invalid birthday format: %s, error: %v", req.Birthday, err)
-		httputil.WriteJSONError(w, http.StatusBadRequest, map[string]string{
-			"error":   "invalid_birthday",
-			"message": message.InvalidBirthdayError,
-		})
+	birthday, ok := parseRequestBirthday(w, req.Birthday)
+	if !ok {
 		return
 	}
 
 	// user_idはcontextから取得したものを使用
-	isFirstRegistration, err := h.userService.RegisterUser(r.Context(), userID, req.Name, req.Birthday, req.ConfirmUnmatch)
+	isFirstRegistration, err := h.userService.RegisterUser(r.Context(), userID, req.Name, req.AltName, birthday, req.ConfirmUnmatch)
 	if err != nil {
 		log.Printf("Failed to register user: %v", err)
 
@@ -87,6 +84,16 @@ func (h *UserRegistrationAPIHandler) Register(w http.ResponseWriter, r *http.Req
 			return
 		}
 
+		// 年齢による制限の場合は403を返す
+		var ageErr *service.AgeRestrictedError
+		if errors.As(err, &ageErr) {
+			httputil.WriteJSONError(w, http.StatusForbidden, map[string]string{
+				"error":   string(ageErr.Reason),
+				"message": ageRestrictionMessage(ageErr),
+			})
+			return
+		}
+
 		// 自己登録エラーの場合は400を返す
 		if errors.Is(err, service.ErrCannotRegisterYourself) {
 			httputil.WriteJSONError(w, http.StatusBadRequest, map[string]string{"error": "cannot_register_yourself"})
@@ -98,9 +105,60 @@ func (h *UserRegistrationAPIHandler) Register(w http.ResponseWriter, r *http.Req
 	}
 
 	log.Printf("Registration successful for user %s: name=%s, birthday=%s", userID, req.Name, req.Birthday)
+	recordLineDisplayName(r.Context(), h.userService, userID)
 
 	httputil.WriteJSONResponse(w, http.StatusOK, RegisterUserResponse{
 		Status:              "ok",
 		IsFirstRegistration: isFirstRegistration,
 	})
 }
+
+// parseRequestBirthday はリクエストの誕生日を検証して model.Birthday に変換する
+// 無効な場合は 400（invalid_birthday）を書き込んで false を返す
+func parseRequestBirthday(w http.ResponseWriter, value string) (model.Birthday, bool) {
+	birthday, err := model.ParseBirthday(value, time.Now())
+	if err == nil {
+		return birthday, true
+	}
+	log.Printf("Invalid birthday: %v", err)
+
+	msg := message.InvalidBirthdayError
+	var birthdayErr *model.BirthdayError
+	if errors.As(err, &birthdayErr) {
+		switch birthdayErr.Reason {
+		case model.BirthdayInFuture:
+			msg = message.BirthdayInFutureError
+		case model.BirthdayTooOld:
+			msg = message.BirthdayTooOld(model.MaxAge)
+		}
+	}
+	httputil.WriteJSONError(w, http.StatusBadRequest, map[string]string{
+		"error":   "invalid_birthday",
+		"message": msg,
+	})
+	return "", false
+}
+
+// ageRestrictionMessage は年齢による制限の理由に合わせたエラーメッセージを返す
+func ageRestrictionMessage(err *service.AgeRestrictedError) string {
+	switch err.Reason {
+	case service.AgeBelowMinimum:
+		return message.UnderMinimumAge(err.Limit)
+	case service.AgeGapTooLarge:
+		return message.CrushAgeGapTooLarge(err.Limit)
+	default:
+		return message.CrushMinorAdult
+	}
+}
+
+// recordLineDisplayName は認証されたユーザーの LINE 表示名を管理者向けの参考情報として記録する
+// 失敗しても登録結果には影響させない
+func recordLineDisplayName(ctx context.Context, userService service.UserService, userID string) {
+	principal, ok := middleware.GetPrincipalFromContext(ctx)
+	if !ok {
+		return
+	}
+	if err := userService.UpdateLineDisplayName(ctx, userID, principal.DisplayName); err != nil {
+		log.Printf("[WARN] Failed to record LINE display name for user %s: %v", userID, err)
+	}
+}
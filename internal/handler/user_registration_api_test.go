@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/morinonusi421/cupid/internal/liff"
 	"github.com/morinonusi421/cupid/internal/middleware"
+	"github.com/morinonusi421/cupid/internal/model"
 	"github.com/morinonusi421/cupid/internal/service"
 	servicemocks "github.com/morinonusi421/cupid/internal/service/mocks"
 	"github.com/stretchr/testify/assert"
@@ -35,7 +38,23 @@ func TestUserRegistrationAPIHandler_Register(t *testing.T) {
 			hasUserID: true,
 			userID:    "U-test-user",
 			mockSetup: func(m *servicemocks.MockUserService) {
-				m.EXPECT().RegisterUser(mock.Anything, "U-test-user", "ヤマダタロウ", "2000-01-15", false).
+				m.EXPECT().RegisterUser(mock.Anything, "U-test-user", "ヤマダタロウ", "", model.Birthday("2000-01-15"), false).
+					Return(true, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedStatus:     "ok",
+		},
+		{
+			name: "正常系 - 別の表記も渡す",
+			requestBody: map[string]interface{}{
+				"name":     "山田太郎",
+				"alt_name": "Taro Yamada",
+				"birthday": "2000-01-15",
+			},
+			hasUserID: true,
+			userID:    "U-test-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterUser(mock.Anything, "U-test-user", "山田太郎", "Taro Yamada", model.Birthday("2000-01-15"), false).
 					Return(true, nil)
 			},
 			expectedStatusCode: http.StatusOK,
@@ -50,7 +69,7 @@ func TestUserRegistrationAPIHandler_Register(t *testing.T) {
 			hasUserID: true,
 			userID:    "U-existing-user",
 			mockSetup: func(m *servicemocks.MockUserService) {
-				m.EXPECT().RegisterUser(mock.Anything, "U-existing-user", "タナカハナコ", "1995-05-05", false).
+				m.EXPECT().RegisterUser(mock.Anything, "U-existing-user", "タナカハナコ", "", model.Birthday("1995-05-05"), false).
 					Return(false, nil)
 			},
 			expectedStatusCode: http.StatusOK,
@@ -69,7 +88,7 @@ func TestUserRegistrationAPIHandler_Register(t *testing.T) {
 				matchedErr := &service.MatchedUserExistsError{
 					MatchedUserName: "サトウハナコ",
 				}
-				m.EXPECT().RegisterUser(mock.Anything, "U-matched-user", "ヤマダタロウ", "2000-01-15", false).
+				m.EXPECT().RegisterUser(mock.Anything, "U-matched-user", "ヤマダタロウ", "", model.Birthday("2000-01-15"), false).
 					Return(false, matchedErr)
 			},
 			expectedStatusCode: http.StatusConflict,
@@ -84,7 +103,7 @@ func TestUserRegistrationAPIHandler_Register(t *testing.T) {
 			hasUserID: true,
 			userID:    "U-duplicate-user",
 			mockSetup: func(m *servicemocks.MockUserService) {
-				m.EXPECT().RegisterUser(mock.Anything, "U-duplicate-user", "スズキイチロウ", "1990-01-01", false).
+				m.EXPECT().RegisterUser(mock.Anything, "U-duplicate-user", "スズキイチロウ", "", model.Birthday("1990-01-01"), false).
 					Return(false, service.ErrDuplicateUser)
 			},
 			expectedStatusCode: http.StatusConflict,
@@ -102,12 +121,51 @@ func TestUserRegistrationAPIHandler_Register(t *testing.T) {
 				validationErr := &service.ValidationError{
 					Message: "名前は全角カタカナ2〜20文字で入力してください（スペース不可）",
 				}
-				m.EXPECT().RegisterUser(mock.Anything, "U-validation-user", "山田太郎", "2000-01-15", false).
+				m.EXPECT().RegisterUser(mock.Anything, "U-validation-user", "山田太郎", "", model.Birthday("2000-01-15"), false).
 					Return(false, validationErr)
 			},
 			expectedStatusCode: http.StatusBadRequest,
 			expectedError:      "名前は全角カタカナ2〜20文字で入力してください（スペース不可）",
 		},
+		{
+			name: "異常系 - 存在しない日付",
+			requestBody: map[string]interface{}{
+				"name":     "ヤマダタロウ",
+				"birthday": "2000-02-30",
+			},
+			hasUserID:          true,
+			userID:             "U-test-user",
+			mockSetup:          func(m *servicemocks.MockUserService) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "invalid_birthday",
+		},
+		{
+			name: "異常系 - 未来の日付",
+			requestBody: map[string]interface{}{
+				"name":     "ヤマダタロウ",
+				"birthday": "2999-01-01",
+			},
+			hasUserID:          true,
+			userID:             "U-test-user",
+			mockSetup:          func(m *servicemocks.MockUserService) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "invalid_birthday",
+		},
+		{
+			name: "異常系 - 最低年齢未満",
+			requestBody: map[string]interface{}{
+				"name":     "ヤマダタロウ",
+				"birthday": "2020-01-15",
+			},
+			hasUserID: true,
+			userID:    "U-child-user",
+			mockSetup: func(m *servicemocks.MockUserService) {
+				m.EXPECT().RegisterUser(mock.Anything, "U-child-user", "ヤマダタロウ", "", model.Birthday("2020-01-15"), false).
+					Return(false, &service.AgeRestrictedError{Reason: service.AgeBelowMinimum, Limit: 13})
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedError:      "under_minimum_age",
+		},
 		{
 			name: "異常系 - contextにUserIDがない",
 			requestBody: map[string]interface{}{
@@ -175,3 +233,33 @@ func TestUserRegistrationAPIHandler_Register(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRegistrationAPIHandler_Register_RecordsLineDisplayName(t *testing.T) {
+	tests := []struct {
+		name      string
+		updateErr error
+	}{
+		{name: "表示名を記録する"},
+		{name: "記録に失敗しても登録は成功", updateErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserService := servicemocks.NewMockUserService(t)
+			mockUserService.EXPECT().RegisterUser(mock.Anything, "U-test-user", "ヤマダタロウ", "", model.Birthday("2000-01-15"), false).Return(true, nil)
+			mockUserService.EXPECT().UpdateLineDisplayName(mock.Anything, "U-test-user", "たろう").Return(tt.updateErr)
+			handler := NewUserRegistrationAPIHandler(mockUserService)
+
+			body, _ := json.Marshal(map[string]string{"name": "ヤマダタロウ", "birthday": "2000-01-15"})
+			req := httptest.NewRequest(http.MethodPost, "/api/register-user", bytes.NewReader(body))
+			ctx := context.WithValue(req.Context(), middleware.UserIDKey, "U-test-user")
+			ctx = context.WithValue(ctx, middleware.PrincipalKey, &liff.Principal{UserID: "U-test-user", DisplayName: "たろう"})
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+			handler.Register(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+		})
+	}
+}
@@ -1,39 +1,65 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
 	"github.com/line/line-bot-sdk-go/v8/linebot/webhook"
 	"github.com/morinonusi421/cupid/internal/linebot"
 	"github.com/morinonusi421/cupid/internal/message"
+	"github.com/morinonusi421/cupid/internal/model"
 	"github.com/morinonusi421/cupid/internal/service"
+	"github.com/morinonusi421/cupid/pkg/workerpool"
 )
 
+// webhookCompleteTimeout はイベントの処理結果を記録する上限
+// 停止時に処理が打ち切られても（ctx がキャンセルされても）結果は記録する
+const webhookCompleteTimeout = 5 * time.Second
+
 // WebhookHandler はLINE Webhookを処理するハンドラー
+//
+// 署名を検証したイベントはワーカープールに追加して即座に 200 を返し、処理は非同期で行う
+// 同じユーザーのイベントは受信した順に1つずつ処理される
+// 再送されたイベント（webhookEventId が処理済みのもの）は処理しない
 type WebhookHandler struct {
-	channelSecret string
-	bot           linebot.Client
-	userService   service.UserService
+	channelSecret       string
+	bot                 linebot.Client
+	userService         service.UserService
+	webhookEventService service.WebhookEventService
+	queue               *workerpool.Pool
+	enqueueTimeout      time.Duration
 }
 
 // NewWebhookHandler は WebhookHandler の新しいインスタンスを作成する
+// enqueueTimeout はキューが満杯の場合に空きを待つ上限
 func NewWebhookHandler(
 	channelSecret string,
 	bot linebot.Client,
 	userService service.UserService,
+	webhookEventService service.WebhookEventService,
+	queue *workerpool.Pool,
+	enqueueTimeout time.Duration,
 ) *WebhookHandler {
 	return &WebhookHandler{
-		channelSecret: channelSecret,
-		bot:           bot,
-		userService:   userService,
+		channelSecret:       channelSecret,
+		bot:                 bot,
+		userService:         userService,
+		webhookEventService: webhookEventService,
+		queue:               queue,
+		enqueueTimeout:      enqueueTimeout,
 	}
 }
 
-// Handle はLINE Webhookのリクエストを処理する
+// Handle はLINE Webhookのリクエストを受け付け、イベントをキューに追加する
+//
+// キューが満杯で追加できなかった場合は 503 を返す（LINE の再送を期待する）
 func (h *WebhookHandler) Handle(w http.ResponseWriter, r *http.Request) {
-	// Webhookイベントをパース
+	// Webhookイベントをパース（署名検証を含む）
 	callbackRequest, err := webhook.ParseRequest(h.channelSecret, r)
 	if err != nil {
 		log.Println("Failed to parse request:", err)
@@ -41,86 +67,251 @@ func (h *WebhookHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 各イベントを処理
+	ctx, cancel := context.WithTimeout(r.Context(), h.enqueueTimeout)
+	defer cancel()
 	for _, event := range callbackRequest.Events {
-		switch e := event.(type) {
-		case webhook.FollowEvent:
-			// UserServiceで挨拶メッセージを送信
-			err = h.userService.ProcessFollowEvent(r.Context(), e.ReplyToken)
-			if err != nil {
-				log.Println("Failed to handle follow event:", err)
-			} else {
-				log.Printf("Sent greeting message to new follower")
+		if err := h.queue.Submit(ctx, eventKey(event), func(ctx context.Context) {
+			h.processEvent(ctx, event)
+		}); err != nil {
+			log.Printf("[WARN] Failed to enqueue webhook event: %v (stats: %+v)", err, h.queue.Stats())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// eventKey はイベントの処理順を保証する単位（送信元のユーザー・グループ・トークルーム）を返す
+func eventKey(event webhook.EventInterface) string {
+	_, key, _ := eventMeta(event)
+	return key
+}
+
+// eventMeta はイベントの webhookEventId、送信元ID、再送かどうかを返す
+func eventMeta(event webhook.EventInterface) (eventID, sourceID string, isRedelivery bool) {
+	var source webhook.SourceInterface
+	var deliveryContext *webhook.DeliveryContext
+	switch e := event.(type) {
+	case webhook.MessageEvent:
+		eventID, source, deliveryContext = e.WebhookEventId, e.Source, e.DeliveryContext
+	case webhook.FollowEvent:
+		eventID, source, deliveryContext = e.WebhookEventId, e.Source, e.DeliveryContext
+	case webhook.UnfollowEvent:
+		eventID, source, deliveryContext = e.WebhookEventId, e.Source, e.DeliveryContext
+	case webhook.JoinEvent:
+		eventID, source, deliveryContext = e.WebhookEventId, e.Source, e.DeliveryContext
+	case webhook.PostbackEvent:
+		eventID, source, deliveryContext = e.WebhookEventId, e.Source, e.DeliveryContext
+	}
+	switch s := source.(type) {
+	case webhook.UserSource:
+		sourceID = s.UserId
+	case webhook.GroupSource:
+		sourceID = s.GroupId
+	case webhook.RoomSource:
+		sourceID = s.RoomId
+	}
+	if deliveryContext != nil {
+		isRedelivery = deliveryContext.IsRedelivery
+	}
+	return eventID, sourceID, isRedelivery
+}
+
+// processEvent は重複を確認してからイベントを処理し、結果を記録する（ワーカーで実行される）
+//
+// 重複確認・結果の記録に失敗した場合でもイベントは処理する（取りこぼしより重複処理を許容する）
+func (h *WebhookHandler) processEvent(ctx context.Context, event webhook.EventInterface) {
+	eventID, sourceID, isRedelivery := eventMeta(event)
+	if eventID == "" {
+		h.handleEvent(ctx, event)
+		return
+	}
+
+	ok, err := h.webhookEventService.Begin(ctx, &model.WebhookEvent{
+		ID:           eventID,
+		EventType:    event.GetType(),
+		SourceID:     sourceID,
+		IsRedelivery: isRedelivery,
+	})
+	if err != nil {
+		log.Printf("[WARN] Failed to record webhook event %s: %v", eventID, err)
+	} else if !ok {
+		log.Printf("Skipped duplicate webhook event %s (redelivery: %t)", eventID, isRedelivery)
+		return
+	}
+
+	processErr := h.handleEvent(ctx, event)
+	completeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), webhookCompleteTimeout)
+	defer cancel()
+	if err := h.webhookEventService.Complete(completeCtx, eventID, processErr); err != nil {
+		log.Printf("[WARN] Failed to record webhook event result %s: %v", eventID, err)
+	}
+}
+
+// sourceUserID はイベントの送信元がユーザー（1対1のトーク）の場合に LINE ユーザーID を返す
+func sourceUserID(source webhook.SourceInterface) (string, bool) {
+	if s, ok := source.(webhook.UserSource); ok {
+		return s.UserId, true
+	}
+	return "", false
+}
+
+// handleEvent は1つのWebhookイベントを処理し、失敗した場合はエラーを返す
+func (h *WebhookHandler) handleEvent(ctx context.Context, event webhook.EventInterface) error {
+	switch e := event.(type) {
+	case webhook.FollowEvent:
+		// UserServiceで挨拶メッセージを送信（退会したユーザーには登録し直すよう案内する）
+		userID, _ := sourceUserID(e.Source)
+		err := h.userService.ProcessFollowEvent(ctx, userID, e.ReplyToken)
+		if err != nil {
+			log.Println("Failed to handle follow event:", err)
+			return fmt.Errorf("follow event: %w", err)
+		}
+		log.Printf("Sent greeting message to new follower")
+
+	case webhook.UnfollowEvent:
+		// ブロックされた場合は退会として扱う（以降のマッチング・Push通知の対象にしない）
+		userID, ok := sourceUserID(e.Source)
+		if !ok {
+			return nil
+		}
+		err := h.userService.DeleteAccount(ctx, userID)
+		if errors.Is(err, service.ErrUserNotFound) {
+			log.Printf("Unfollowed by unregistered user %s", userID)
+			return nil
+		}
+		if err != nil {
+			log.Println("Failed to handle unfollow event:", err)
+			return fmt.Errorf("unfollow event: %w", err)
+		}
+		log.Printf("Deleted user %s after unfollow", userID)
+
+	case webhook.JoinEvent:
+		// UserServiceでグループ招待時の挨拶メッセージを送信
+		err := h.userService.ProcessJoinEvent(ctx, e.ReplyToken)
+		if err != nil {
+			log.Println("Failed to handle join event:", err)
+			return fmt.Errorf("join event: %w", err)
+		}
+		log.Printf("Sent join message to group")
+
+	case webhook.PostbackEvent:
+		// リッチメニュー・クイックリプライのポストバック（マッチング解除・好きな人の取り消し）
+		userID, ok := sourceUserID(e.Source)
+		if !ok || e.Postback == nil {
+			return nil
+		}
+
+		// 処理に失敗した場合もエラーメッセージを返信し、失敗として記録する
+		var processErr error
+		reply, err := h.userService.ProcessPostback(ctx, userID, e.Postback.Data)
+		if err != nil {
+			log.Printf("Failed to process postback: %v", err)
+			processErr = fmt.Errorf("process postback: %w", err)
+			reply = &model.Reply{Text: message.GeneralError}
+		}
+		if reply == nil {
+			return nil
+		}
+
+		_, err = h.bot.ReplyMessage(
+			&messaging_api.ReplyMessageRequest{
+				ReplyToken: e.ReplyToken,
+				Messages: []messaging_api.MessageInterface{
+					replyTextMessage(reply),
+				},
+			},
+		)
+		if err != nil {
+			log.Println("Failed to reply message:", err)
+			return fmt.Errorf("reply message: %w", err)
+		}
+		log.Printf("Replied: %s", reply.Text)
+		return processErr
+
+	case webhook.MessageEvent:
+		// テキストメッセージの場合
+		switch content := e.Message.(type) {
+		case webhook.TextMessageContent:
+			// userIDを取得
+			userID, ok := sourceUserID(e.Source)
+			if !ok {
+				log.Println("Unsupported source type")
+				return nil
 			}
 
-		case webhook.JoinEvent:
-			// UserServiceでグループ招待時の挨拶メッセージを送信
-			err = h.userService.ProcessJoinEvent(r.Context(), e.ReplyToken)
+			// UserServiceで処理
+			// 処理に失敗した場合もエラーメッセージを返信し、失敗として記録する
+			var processErr error
+			replyText, quickReplyURL, quickReplyLabel, err := h.userService.ProcessTextMessage(ctx, userID, content.Text)
 			if err != nil {
-				log.Println("Failed to handle join event:", err)
-			} else {
-				log.Printf("Sent join message to group")
+				log.Printf("Failed to process message: %v", err)
+				processErr = fmt.Errorf("process message: %w", err)
+				replyText = message.GeneralError
+				quickReplyURL = ""
+				quickReplyLabel = ""
 			}
 
-		case webhook.MessageEvent:
-			// テキストメッセージの場合
-			switch e.Message.(type) {
-			case webhook.TextMessageContent:
-				// userIDを取得
-				var userID string
-				switch source := e.Source.(type) {
-				case webhook.UserSource:
-					userID = source.UserId
-				default:
-					log.Println("Unsupported source type")
-					continue
-				}
-
-				// UserServiceで処理
-				replyText, quickReplyURL, quickReplyLabel, err := h.userService.ProcessTextMessage(r.Context(), userID)
-				if err != nil {
-					log.Printf("Failed to process message: %v", err)
-					replyText = message.GeneralError
-					quickReplyURL = ""
-					quickReplyLabel = ""
-				}
-
-				// LINE APIで返信
-				textMessage := messaging_api.TextMessage{
-					Text: replyText,
-				}
+			// LINE APIで返信
+			textMessage := messaging_api.TextMessage{
+				Text: replyText,
+			}
 
-				// QuickReplyがある場合は追加
-				if quickReplyURL != "" && quickReplyLabel != "" {
-					textMessage.QuickReply = &messaging_api.QuickReply{
-						Items: []messaging_api.QuickReplyItem{
-							{
-								Type: "action",
-								Action: &messaging_api.UriAction{
-									Label: quickReplyLabel,
-									Uri:   quickReplyURL,
-								},
+			// QuickReplyがある場合は追加
+			if quickReplyURL != "" && quickReplyLabel != "" {
+				textMessage.QuickReply = &messaging_api.QuickReply{
+					Items: []messaging_api.QuickReplyItem{
+						{
+							Type: "action",
+							Action: &messaging_api.UriAction{
+								Label: quickReplyLabel,
+								Uri:   quickReplyURL,
 							},
 						},
-					}
+					},
 				}
+			}
 
-				_, err = h.bot.ReplyMessage(
-					&messaging_api.ReplyMessageRequest{
-						ReplyToken: e.ReplyToken,
-						Messages: []messaging_api.MessageInterface{
-							textMessage,
-						},
+			_, err = h.bot.ReplyMessage(
+				&messaging_api.ReplyMessageRequest{
+					ReplyToken: e.ReplyToken,
+					Messages: []messaging_api.MessageInterface{
+						textMessage,
 					},
-				)
-				if err != nil {
-					log.Println("Failed to reply message:", err)
-				} else {
-					log.Printf("Replied: %s", replyText)
-				}
+				},
+			)
+			if err != nil {
+				log.Println("Failed to reply message:", err)
+				return fmt.Errorf("reply message: %w", err)
 			}
+			log.Printf("Replied: %s", replyText)
+			return processErr
 		}
 	}
+	return nil
+}
 
-	w.WriteHeader(http.StatusOK)
+// replyTextMessage は返信メッセージを LINE のテキストメッセージに変換する
+// URL のあるクイックリプライはリンク、それ以外はポストバックのボタンにする
+func replyTextMessage(reply *model.Reply) messaging_api.TextMessage {
+	textMessage := messaging_api.TextMessage{
+		Text: reply.Text,
+	}
+	if len(reply.QuickReplies) == 0 {
+		return textMessage
+	}
+
+	items := make([]messaging_api.QuickReplyItem, 0, len(reply.QuickReplies))
+	for _, q := range reply.QuickReplies {
+		var action messaging_api.ActionInterface
+		if q.URL != "" {
+			action = &messaging_api.UriAction{Label: q.Label, Uri: q.URL}
+		} else {
+			action = &messaging_api.PostbackAction{Label: q.Label, Data: q.PostbackData, DisplayText: q.Label}
+		}
+		items = append(items, messaging_api.QuickReplyItem{Type: "action", Action: action})
+	}
+	textMessage.QuickReply = &messaging_api.QuickReply{Items: items}
+	return textMessage
 }
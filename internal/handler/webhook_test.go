@@ -2,17 +2,26 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
+	"github.com/line/line-bot-sdk-go/v8/linebot/webhook"
+	"github.com/morinonusi421/cupid/internal/linebot"
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/internal/service"
 	servicemocks "github.com/morinonusi421/cupid/internal/service/mocks"
+	"github.com/morinonusi421/cupid/pkg/workerpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockLineBotClient は linebot.Client の mock
@@ -36,6 +45,27 @@ func (m *MockLineBotClient) PushMessage(request *messaging_api.PushMessageReques
 	return args.Get(0).(*messaging_api.PushMessageResponse), args.Error(1)
 }
 
+func (m *MockLineBotClient) PushMessageWithRetryKey(request *messaging_api.PushMessageRequest, retryKey string) (*messaging_api.PushMessageResponse, error) {
+	args := m.Called(request, retryKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*messaging_api.PushMessageResponse), args.Error(1)
+}
+
+func (m *MockLineBotClient) MonthlyUsage(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockLineBotClient) Quota(ctx context.Context) (*linebot.Quota, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*linebot.Quota), args.Error(1)
+}
+
 // generateSignature はLINE Webhookの署名を生成する
 func generateSignature(channelSecret, body string) string {
 	mac := hmac.New(sha256.New, []byte(channelSecret))
@@ -67,7 +97,7 @@ func TestWebhookHandler_Handle(t *testing.T) {
 				}]
 			}`,
 			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService) {
-				mockUserService.EXPECT().ProcessTextMessage(mock.Anything, "U-test-user").
+				mockUserService.EXPECT().ProcessTextMessage(mock.Anything, "U-test-user", "こんにちは").
 					Return("こんにちは", "", "", nil)
 				mockBot.On("ReplyMessage", mock.MatchedBy(func(r *messaging_api.ReplyMessageRequest) bool {
 					return r.ReplyToken == "reply-token-123" && len(r.Messages) == 1
@@ -88,7 +118,39 @@ func TestWebhookHandler_Handle(t *testing.T) {
 				}]
 			}`,
 			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService) {
-				mockUserService.EXPECT().ProcessFollowEvent(mock.Anything, "reply-token-456").Return(nil)
+				mockUserService.EXPECT().ProcessFollowEvent(mock.Anything, "U-new-user", "reply-token-456").Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "正常系 - ブロック（フォロー解除）イベントで退会させる",
+			webhookBodyJSON: `{
+				"destination": "U1234567890",
+				"events": [{
+					"type": "unfollow",
+					"source": {"type": "user", "userId": "U-leaving-user"},
+					"timestamp": 1234567890123,
+					"mode": "active"
+				}]
+			}`,
+			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService) {
+				mockUserService.EXPECT().DeleteAccount(mock.Anything, "U-leaving-user").Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "正常系 - 未登録のユーザーのブロック",
+			webhookBodyJSON: `{
+				"destination": "U1234567890",
+				"events": [{
+					"type": "unfollow",
+					"source": {"type": "user", "userId": "U-unknown-user"},
+					"timestamp": 1234567890123,
+					"mode": "active"
+				}]
+			}`,
+			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService) {
+				mockUserService.EXPECT().DeleteAccount(mock.Anything, "U-unknown-user").Return(service.ErrUserNotFound)
 			},
 			expectedStatusCode: http.StatusOK,
 		},
@@ -109,6 +171,57 @@ func TestWebhookHandler_Handle(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusOK,
 		},
+		{
+			name: "正常系 - ポストバックイベント（クイックリプライのボタン付きで返信）",
+			webhookBodyJSON: `{
+				"destination": "U1234567890",
+				"events": [{
+					"type": "postback",
+					"replyToken": "reply-token-postback",
+					"source": {"type": "user", "userId": "U-test-user"},
+					"timestamp": 1234567890123,
+					"mode": "active",
+					"postback": {"data": "action=unmatch"}
+				}]
+			}`,
+			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService) {
+				mockUserService.EXPECT().ProcessPostback(mock.Anything, "U-test-user", "action=unmatch").
+					Return(&model.Reply{
+						Text:         "解除しますか？",
+						QuickReplies: []model.QuickReply{{Label: "解除する", PostbackData: "action=unmatch&confirm=1"}},
+					}, nil)
+				mockBot.On("ReplyMessage", mock.MatchedBy(func(r *messaging_api.ReplyMessageRequest) bool {
+					if r.ReplyToken != "reply-token-postback" || len(r.Messages) != 1 {
+						return false
+					}
+					msg, ok := r.Messages[0].(messaging_api.TextMessage)
+					if !ok || msg.QuickReply == nil || len(msg.QuickReply.Items) != 1 {
+						return false
+					}
+					action, ok := msg.QuickReply.Items[0].Action.(*messaging_api.PostbackAction)
+					return ok && action.Data == "action=unmatch&confirm=1"
+				})).Return(&messaging_api.ReplyMessageResponse{}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "正常系 - 未対応のポストバックには返信しない",
+			webhookBodyJSON: `{
+				"destination": "U1234567890",
+				"events": [{
+					"type": "postback",
+					"replyToken": "reply-token-postback",
+					"source": {"type": "user", "userId": "U-test-user"},
+					"timestamp": 1234567890123,
+					"mode": "active",
+					"postback": {"data": "action=unknown"}
+				}]
+			}`,
+			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService) {
+				mockUserService.EXPECT().ProcessPostback(mock.Anything, "U-test-user", "action=unknown").Return(nil, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
 		{
 			name:            "異常系 - 不正な署名",
 			webhookBodyJSON: `{"events":[]}`,
@@ -133,7 +246,9 @@ func TestWebhookHandler_Handle(t *testing.T) {
 			mockBot := new(MockLineBotClient)
 			mockUserService := servicemocks.NewMockUserService(t)
 			tt.mockSetup(mockBot, mockUserService)
-			handler := NewWebhookHandler(channelSecret, mockBot, mockUserService)
+			pool := workerpool.New(2, 10)
+			defer pool.Drain(context.Background())
+			handler := NewWebhookHandler(channelSecret, mockBot, mockUserService, servicemocks.NewMockWebhookEventService(t), pool, time.Second)
 
 			bodyBytes := []byte(tt.webhookBodyJSON)
 			signature := tt.signature
@@ -149,8 +264,195 @@ func TestWebhookHandler_Handle(t *testing.T) {
 			handler.Handle(rr, req)
 
 			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			// イベントは非同期に処理されるため完了を待つ
+			pool.Wait()
 			mockBot.AssertExpectations(t)
 			mockUserService.AssertExpectations(t)
 		})
 	}
 }
+
+func TestWebhookHandler_Handle_Async(t *testing.T) {
+	channelSecret := "test-channel-secret"
+	body := `{
+		"destination": "U1234567890",
+		"events": [
+			{"type": "message", "replyToken": "reply-1", "source": {"type": "user", "userId": "U-async-user"}, "timestamp": 1234567890123, "mode": "active", "message": {"type": "text", "id": "msg-1", "text": "1"}},
+			{"type": "message", "replyToken": "reply-2", "source": {"type": "user", "userId": "U-async-user"}, "timestamp": 1234567890124, "mode": "active", "message": {"type": "text", "id": "msg-2", "text": "2"}}
+		]
+	}`
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader([]byte(body)))
+		req.Header.Set("X-Line-Signature", generateSignature(channelSecret, body))
+		return req
+	}
+
+	t.Run("処理の完了を待たずに200を返し、同じユーザーのイベントは順番に処理する", func(t *testing.T) {
+		mockBot := new(MockLineBotClient)
+		mockUserService := servicemocks.NewMockUserService(t)
+		pool := workerpool.New(4, 10)
+		defer pool.Drain(context.Background())
+		handler := NewWebhookHandler(channelSecret, mockBot, mockUserService, servicemocks.NewMockWebhookEventService(t), pool, time.Second)
+
+		release := make(chan struct{})
+		mockUserService.EXPECT().ProcessTextMessage(mock.Anything, "U-async-user", mock.Anything).
+			RunAndReturn(func(ctx context.Context, userID, text string) (string, string, string, error) {
+				<-release
+				return "ok", "", "", nil
+			}).Times(2)
+		var replied []string
+		mockBot.On("ReplyMessage", mock.Anything).Run(func(args mock.Arguments) {
+			replied = append(replied, args.Get(0).(*messaging_api.ReplyMessageRequest).ReplyToken)
+		}).Return(&messaging_api.ReplyMessageResponse{}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.Handle(rr, newRequest())
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		close(release)
+		pool.Wait()
+		assert.Equal(t, []string{"reply-1", "reply-2"}, replied)
+	})
+
+	t.Run("キューが満杯の場合は503を返す", func(t *testing.T) {
+		mockBot := new(MockLineBotClient)
+		mockUserService := servicemocks.NewMockUserService(t)
+		pool := workerpool.New(1, 1)
+		defer pool.Drain(context.Background())
+		handler := NewWebhookHandler(channelSecret, mockBot, mockUserService, servicemocks.NewMockWebhookEventService(t), pool, 10*time.Millisecond)
+
+		// ワーカーを塞ぎ、キューを満杯にする
+		release := make(chan struct{})
+		started := make(chan struct{})
+		require.NoError(t, pool.Submit(context.Background(), "busy", func(ctx context.Context) {
+			close(started)
+			<-release
+		}))
+		<-started
+		require.NoError(t, pool.Submit(context.Background(), "busy", func(ctx context.Context) {}))
+
+		rr := httptest.NewRecorder()
+		handler.Handle(rr, newRequest())
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		assert.Equal(t, int64(1), pool.Stats().Rejected)
+
+		close(release)
+		pool.Wait()
+	})
+}
+
+func TestWebhookHandler_Handle_Deduplication(t *testing.T) {
+	channelSecret := "test-channel-secret"
+	body := `{
+		"destination": "U1234567890",
+		"events": [{
+			"type": "message",
+			"replyToken": "reply-token-123",
+			"source": {"type": "user", "userId": "U-test-user"},
+			"timestamp": 1234567890123,
+			"mode": "active",
+			"webhookEventId": "01HEVENT",
+			"deliveryContext": {"isRedelivery": true},
+			"message": {"type": "text", "id": "msg-id-123", "text": "こんにちは"}
+		}]
+	}`
+	isEvent := mock.MatchedBy(func(e *model.WebhookEvent) bool {
+		return e.ID == "01HEVENT" && e.EventType == "message" && e.SourceID == "U-test-user" && e.IsRedelivery
+	})
+
+	tests := []struct {
+		name      string
+		mockSetup func(*MockLineBotClient, *servicemocks.MockUserService, *servicemocks.MockWebhookEventService)
+	}{
+		{
+			name: "処理済みのイベントは処理しない",
+			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService, mockEventService *servicemocks.MockWebhookEventService) {
+				mockEventService.EXPECT().Begin(mock.Anything, isEvent).Return(false, nil)
+			},
+		},
+		{
+			name: "処理に成功した結果を記録する",
+			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService, mockEventService *servicemocks.MockWebhookEventService) {
+				mockEventService.EXPECT().Begin(mock.Anything, isEvent).Return(true, nil)
+				mockUserService.EXPECT().ProcessTextMessage(mock.Anything, "U-test-user", mock.Anything).Return("こんにちは", "", "", nil)
+				mockBot.On("ReplyMessage", mock.Anything).Return(&messaging_api.ReplyMessageResponse{}, nil)
+				mockEventService.EXPECT().Complete(mock.Anything, "01HEVENT", nil).Return(nil)
+			},
+		},
+		{
+			name: "処理に失敗した結果を記録する",
+			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService, mockEventService *servicemocks.MockWebhookEventService) {
+				mockEventService.EXPECT().Begin(mock.Anything, isEvent).Return(true, nil)
+				mockUserService.EXPECT().ProcessTextMessage(mock.Anything, "U-test-user", mock.Anything).Return("", "", "", errors.New("db error"))
+				mockBot.On("ReplyMessage", mock.Anything).Return(&messaging_api.ReplyMessageResponse{}, nil)
+				mockEventService.EXPECT().Complete(mock.Anything, "01HEVENT", mock.MatchedBy(func(err error) bool {
+					return err != nil
+				})).Return(nil)
+			},
+		},
+		{
+			name: "重複確認に失敗した場合も処理する",
+			mockSetup: func(mockBot *MockLineBotClient, mockUserService *servicemocks.MockUserService, mockEventService *servicemocks.MockWebhookEventService) {
+				mockEventService.EXPECT().Begin(mock.Anything, isEvent).Return(false, errors.New("db locked"))
+				mockUserService.EXPECT().ProcessTextMessage(mock.Anything, "U-test-user", mock.Anything).Return("こんにちは", "", "", nil)
+				mockBot.On("ReplyMessage", mock.Anything).Return(&messaging_api.ReplyMessageResponse{}, nil)
+				mockEventService.EXPECT().Complete(mock.Anything, "01HEVENT", nil).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBot := new(MockLineBotClient)
+			mockUserService := servicemocks.NewMockUserService(t)
+			mockEventService := servicemocks.NewMockWebhookEventService(t)
+			tt.mockSetup(mockBot, mockUserService, mockEventService)
+			pool := workerpool.New(2, 10)
+			defer pool.Drain(context.Background())
+			handler := NewWebhookHandler(channelSecret, mockBot, mockUserService, mockEventService, pool, time.Second)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader([]byte(body)))
+			req.Header.Set("X-Line-Signature", generateSignature(channelSecret, body))
+			rr := httptest.NewRecorder()
+			handler.Handle(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			pool.Wait()
+			mockBot.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookHandler_ProcessEvent_CancelledContext(t *testing.T) {
+	// 停止時にワーカーの ctx がキャンセルされても、処理結果は記録する
+	mockBot := new(MockLineBotClient)
+	mockUserService := servicemocks.NewMockUserService(t)
+	mockEventService := servicemocks.NewMockWebhookEventService(t)
+	pool := workerpool.New(1, 1)
+	defer pool.Drain(context.Background())
+	handler := NewWebhookHandler("test-channel-secret", mockBot, mockUserService, mockEventService, pool, time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockEventService.EXPECT().Begin(mock.Anything, mock.Anything).Return(true, nil)
+	mockUserService.EXPECT().ProcessTextMessage(mock.Anything, "U-test-user", "こんにちは").
+		RunAndReturn(func(ctx context.Context, userID, text string) (string, string, string, error) {
+			cancel()
+			return "", "", "", ctx.Err()
+		})
+	mockBot.On("ReplyMessage", mock.Anything).Return(&messaging_api.ReplyMessageResponse{}, nil)
+	mockEventService.EXPECT().Complete(mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Err() == nil
+	}), "01HEVENT", mock.MatchedBy(func(err error) bool {
+		return errors.Is(err, context.Canceled)
+	})).Return(nil)
+
+	handler.processEvent(ctx, webhook.MessageEvent{
+		ReplyToken:     "reply-token-123",
+		Source:         webhook.UserSource{UserId: "U-test-user"},
+		WebhookEventId: "01HEVENT",
+		Message:        webhook.TextMessageContent{Text: "こんにちは"},
+	})
+	mockBot.AssertExpectations(t)
+}
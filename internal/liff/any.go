@@ -0,0 +1,38 @@
+package liff
+
+import "errors"
+
+// anyVerifier accepts a token that any of its verifiers accepts
+type anyVerifier []Verifier
+
+// AnyOf returns a Verifier that accepts tokens issued for any of the given LIFF channels
+//
+// Verifiers are tried in order and the first successful result is returned.
+// It is meant for endpoints shared by several LIFF apps (e.g. the user and crush pages).
+func AnyOf(verifiers ...Verifier) Verifier {
+	return anyVerifier(verifiers)
+}
+
+func (vs anyVerifier) VerifyAccessToken(accessToken string) (*Principal, error) {
+	return vs.first(func(v Verifier) (*Principal, error) { return v.VerifyAccessToken(accessToken) })
+}
+
+func (vs anyVerifier) VerifyIDToken(idToken string) (*Principal, error) {
+	return vs.first(func(v Verifier) (*Principal, error) { return v.VerifyIDToken(idToken) })
+}
+
+// first returns the first principal verify returns, or all the errors if every verifier rejects the token
+func (vs anyVerifier) first(verify func(Verifier) (*Principal, error)) (*Principal, error) {
+	if len(vs) == 0 {
+		return nil, errors.New("no verifier configured")
+	}
+	var errs []error
+	for _, v := range vs {
+		principal, err := verify(v)
+		if err == nil {
+			return principal, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errors.Join(errs...)
+}
@@ -0,0 +1,54 @@
+package liff
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubVerifier accepts only the token it was given
+type stubVerifier struct {
+	token     string
+	principal *Principal
+}
+
+func (s stubVerifier) verify(token string) (*Principal, error) {
+	if token != s.token {
+		return nil, errors.New("rejected by " + s.token)
+	}
+	return s.principal, nil
+}
+
+func (s stubVerifier) VerifyAccessToken(accessToken string) (*Principal, error) {
+	return s.verify(accessToken)
+}
+
+func (s stubVerifier) VerifyIDToken(idToken string) (*Principal, error) {
+	return s.verify(idToken)
+}
+
+func TestAnyOf(t *testing.T) {
+	userPage := stubVerifier{token: "user-token", principal: &Principal{UserID: "U-alice"}}
+	crushPage := stubVerifier{token: "crush-token", principal: &Principal{UserID: "U-bob"}}
+	v := AnyOf(userPage, crushPage)
+
+	principal, err := v.VerifyIDToken("user-token")
+	require.NoError(t, err)
+	assert.Equal(t, "U-alice", principal.UserID)
+
+	// Falls through to the next verifier
+	principal, err = v.VerifyIDToken("crush-token")
+	require.NoError(t, err)
+	assert.Equal(t, "U-bob", principal.UserID)
+
+	// Rejected by every verifier
+	_, err = v.VerifyAccessToken("other-token")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rejected by user-token")
+	assert.Contains(t, err.Error(), "rejected by crush-token")
+
+	_, err = AnyOf().VerifyIDToken("user-token")
+	assert.Error(t, err)
+}
@@ -0,0 +1,178 @@
+package liff
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultJWKSTTL is how long fetched keys are cached when LINE does not send Cache-Control max-age
+	defaultJWKSTTL = time.Hour
+	// minJWKSRefreshInterval limits refetches, whether triggered by expiry or an unknown key ID (key rotation),
+	// and also applies after a failed fetch so an outage is not hammered
+	minJWKSRefreshInterval = time.Minute
+	// jwksStaleGrace is how long an expired key set keeps being used while it cannot be refetched
+	jwksStaleGrace = 24 * time.Hour
+)
+
+// jwk is a single key of LINE's JSON Web Key Set
+type jwk struct {
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv"`
+	Kid string `json:"kid"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jwksCache fetches and caches the ES256 public keys used to sign LIFF ID tokens
+//
+// Keys are refetched when the cache expires, or when a token refers to an unknown key ID
+// (LINE has rotated its keys), at most once per minJWKSRefreshInterval. The fetch runs without
+// holding mu and concurrent callers wait for the same fetch, so a slow LINE does not block
+// requests that can be served from the cache. If the fetch fails, the failure is remembered
+// until the next attempt is allowed and the expired key set is used for up to jwksStaleGrace.
+type jwksCache struct {
+	url    string
+	client *retryingClient
+	now    func() time.Time
+
+	mu          sync.Mutex
+	keys        map[string]*ecdsa.PublicKey
+	expiresAt   time.Time
+	attemptedAt time.Time     // start of the last fetch, successful or not
+	lastErr     error         // error of the last fetch (nil if it succeeded)
+	refreshing  chan struct{} // closed when the fetch in progress finishes (nil if none)
+}
+
+// key returns the public key with the given key ID, fetching the key set if needed
+func (c *jwksCache) key(kid string) (*ecdsa.PublicKey, error) {
+	c.mu.Lock()
+	now := c.now()
+	if key, ok := c.keys[kid]; ok && now.Before(c.expiresAt) {
+		c.mu.Unlock()
+		return key, nil
+	}
+	if !c.attemptedAt.IsZero() && now.Sub(c.attemptedAt) < minJWKSRefreshInterval && c.refreshing == nil {
+		defer c.mu.Unlock()
+		return c.cachedKey(kid, now)
+	}
+	done := c.refreshing
+	if done == nil {
+		done = c.startRefresh(now)
+	}
+	c.mu.Unlock()
+
+	<-done
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cachedKey(kid, c.now())
+}
+
+// cachedKey returns the key from the current key set, which may be expired by up to jwksStaleGrace (c.mu must be held)
+func (c *jwksCache) cachedKey(kid string, now time.Time) (*ecdsa.PublicKey, error) {
+	if key, ok := c.keys[kid]; ok && now.Before(c.expiresAt.Add(jwksStaleGrace)) {
+		return key, nil
+	}
+	if c.lastErr != nil {
+		return nil, c.lastErr
+	}
+	return nil, fmt.Errorf("unknown key ID %q", kid)
+}
+
+// startRefresh fetches the key set in the background and returns a channel closed when it finishes (c.mu must be held)
+func (c *jwksCache) startRefresh(now time.Time) chan struct{} {
+	done := make(chan struct{})
+	c.refreshing = done
+	c.attemptedAt = now
+
+	go func() {
+		keys, ttl, err := c.fetch()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if err == nil {
+			c.keys = keys
+			c.expiresAt = c.now().Add(ttl)
+		}
+		c.lastErr = err
+		c.refreshing = nil
+		close(done)
+	}()
+	return done
+}
+
+// fetch fetches the key set from LINE and returns it with how long it may be cached
+func (c *jwksCache) fetch() (map[string]*ecdsa.PublicKey, time.Duration, error) {
+	resp, err := c.client.get(c.url)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("failed to fetch JWKS: status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]*ecdsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "EC" || k.Crv != "P-256" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid key %q in JWKS: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	return keys, maxAge(resp.Header.Get("Cache-Control"), defaultJWKSTTL), nil
+}
+
+// publicKey converts a P-256 JWK into an ECDSA public key
+func (k jwk) publicKey() (*ecdsa.PublicKey, error) {
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x: %w", err)
+	}
+	y, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y: %w", err)
+	}
+	if len(x) != 32 || len(y) != 32 {
+		return nil, fmt.Errorf("invalid coordinate length")
+	}
+
+	// Uncompressed point: 0x04 || X || Y
+	point := append(append([]byte{4}, x...), y...)
+	return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+}
+
+// maxAge returns the max-age of a Cache-Control header, or def if it is missing
+func maxAge(cacheControl string, def time.Duration) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(directive), "=")
+		if !ok || !strings.EqualFold(name, "max-age") {
+			continue
+		}
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return def
+}
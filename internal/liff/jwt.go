@@ -0,0 +1,99 @@
+package liff
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// lineIssuer is the iss claim of ID tokens issued by LINE
+	lineIssuer = "https://access.line.me"
+	// clockSkew is the tolerance applied to exp and iat
+	clockSkew = 30 * time.Second
+)
+
+// errLocalVerificationUnavailable means the token could not be checked locally
+// (unsupported algorithm, JWKS unreachable or unknown key) and should be verified remotely
+var errLocalVerificationUnavailable = errors.New("ID token cannot be verified locally")
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// verifyIDTokenLocal checks the ES256 signature of an ID token against LINE's JWKS and validates its claims
+func (v *verifier) verifyIDTokenLocal(idToken, nonce string) (*IDTokenVerifyResponse, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed ID token")
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid ID token header: %w", err)
+	}
+	if header.Alg != "ES256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", errLocalVerificationUnavailable, header.Alg)
+	}
+
+	key, err := v.jwks.key(header.Kid)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errLocalVerificationUnavailable, err)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 64 {
+		return nil, fmt.Errorf("invalid ID token signature encoding")
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return nil, fmt.Errorf("invalid ID token signature")
+	}
+
+	var claims IDTokenVerifyResponse
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid ID token payload: %w", err)
+	}
+	if err := v.validateClaims(&claims, nonce); err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+// validateClaims checks iss, aud, exp, iat, sub and (when given) nonce
+func (v *verifier) validateClaims(claims *IDTokenVerifyResponse, nonce string) error {
+	now := v.now()
+	switch {
+	case claims.ISS != lineIssuer:
+		return fmt.Errorf("issuer mismatch: %q", claims.ISS)
+	case claims.Aud != v.channelID:
+		return fmt.Errorf("channel ID mismatch")
+	case claims.Sub == "":
+		return fmt.Errorf("missing subject")
+	case !now.Before(time.Unix(claims.Exp, 0).Add(clockSkew)):
+		return fmt.Errorf("ID token expired")
+	case time.Unix(claims.Iat, 0).After(now.Add(clockSkew)):
+		return fmt.Errorf("ID token issued in the future")
+	case nonce != "" && claims.Nonce != nonce:
+		return fmt.Errorf("nonce mismatch")
+	}
+	return nil
+}
+
+// decodeSegment decodes a base64url-encoded JWT segment as JSON
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
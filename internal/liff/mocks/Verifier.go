@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	liff "github.com/morinonusi421/cupid/internal/liff"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockVerifier is an autogenerated mock type for the Verifier type
 type MockVerifier struct {
@@ -18,22 +21,24 @@ func (_m *MockVerifier) EXPECT() *MockVerifier_Expecter {
 }
 
 // VerifyAccessToken provides a mock function with given fields: accessToken
-func (_m *MockVerifier) VerifyAccessToken(accessToken string) (string, error) {
+func (_m *MockVerifier) VerifyAccessToken(accessToken string) (*liff.Principal, error) {
 	ret := _m.Called(accessToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyAccessToken")
 	}
 
-	var r0 string
+	var r0 *liff.Principal
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(string) (*liff.Principal, error)); ok {
 		return rf(accessToken)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
+	if rf, ok := ret.Get(0).(func(string) *liff.Principal); ok {
 		r0 = rf(accessToken)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*liff.Principal)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(string) error); ok {
@@ -63,33 +68,35 @@ func (_c *MockVerifier_VerifyAccessToken_Call) Run(run func(accessToken string))
 	return _c
 }
 
-func (_c *MockVerifier_VerifyAccessToken_Call) Return(_a0 string, _a1 error) *MockVerifier_VerifyAccessToken_Call {
+func (_c *MockVerifier_VerifyAccessToken_Call) Return(_a0 *liff.Principal, _a1 error) *MockVerifier_VerifyAccessToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockVerifier_VerifyAccessToken_Call) RunAndReturn(run func(string) (string, error)) *MockVerifier_VerifyAccessToken_Call {
+func (_c *MockVerifier_VerifyAccessToken_Call) RunAndReturn(run func(string) (*liff.Principal, error)) *MockVerifier_VerifyAccessToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // VerifyIDToken provides a mock function with given fields: idToken
-func (_m *MockVerifier) VerifyIDToken(idToken string) (string, error) {
+func (_m *MockVerifier) VerifyIDToken(idToken string) (*liff.Principal, error) {
 	ret := _m.Called(idToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyIDToken")
 	}
 
-	var r0 string
+	var r0 *liff.Principal
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(string) (*liff.Principal, error)); ok {
 		return rf(idToken)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
+	if rf, ok := ret.Get(0).(func(string) *liff.Principal); ok {
 		r0 = rf(idToken)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*liff.Principal)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(string) error); ok {
@@ -119,12 +126,12 @@ func (_c *MockVerifier_VerifyIDToken_Call) Run(run func(idToken string)) *MockVe
 	return _c
 }
 
-func (_c *MockVerifier_VerifyIDToken_Call) Return(_a0 string, _a1 error) *MockVerifier_VerifyIDToken_Call {
+func (_c *MockVerifier_VerifyIDToken_Call) Return(_a0 *liff.Principal, _a1 error) *MockVerifier_VerifyIDToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockVerifier_VerifyIDToken_Call) RunAndReturn(run func(string) (string, error)) *MockVerifier_VerifyIDToken_Call {
+func (_c *MockVerifier_VerifyIDToken_Call) RunAndReturn(run func(string) (*liff.Principal, error)) *MockVerifier_VerifyIDToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,73 @@
+package liff
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how requests to LINE's API are retried
+//
+// Transport errors, 429 and 5xx responses are retried; other responses are returned as is.
+type RetryPolicy struct {
+	MaxAttempts int           // total attempts including the first one (1 disables retries)
+	BaseDelay   time.Duration // delay before the first retry, doubled on each retry
+	MaxDelay    time.Duration // upper bound of the delay
+}
+
+// DefaultRetryPolicy is used when no RetryPolicy is given
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: 200 * time.Millisecond, MaxDelay: 2 * time.Second}
+
+// backoff returns the delay before the given retry (1 for the first retry)
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// retryingClient sends requests with an http.Client, retrying transient failures
+type retryingClient struct {
+	httpClient *http.Client
+	policy     RetryPolicy
+	sleep      func(time.Duration)
+}
+
+// do sends the request built by newRequest, rebuilding it for each attempt so the body can be resent
+func (c *retryingClient) do(newRequest func() (*http.Request, error)) (*http.Response, error) {
+	attempts := max(c.policy.MaxAttempts, 1)
+	for attempt := 1; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if attempt >= attempts || !retryable(resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			// Drain the body so the connection can be reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		c.sleep(c.policy.backoff(attempt))
+	}
+}
+
+// get sends a GET request to url
+func (c *retryingClient) get(url string) (*http.Response, error) {
+	return c.do(func() (*http.Request, error) {
+		return http.NewRequest(http.MethodGet, url, nil)
+	})
+}
+
+// retryable reports whether a request that ended with resp or err may succeed if sent again
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
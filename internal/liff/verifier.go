@@ -2,31 +2,100 @@ package liff
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
+)
+
+const (
+	// defaultBaseURL is the origin of LINE's OAuth and profile APIs
+	defaultBaseURL = "https://api.line.me"
+	// defaultTimeout bounds each request to LINE's API when no HTTP client is given
+	defaultTimeout = 10 * time.Second
 )
 
 // Verifier is an interface for LIFF token verification
 type Verifier interface {
-	VerifyAccessToken(accessToken string) (string, error)
-	VerifyIDToken(idToken string) (string, error)
+	VerifyAccessToken(accessToken string) (*Principal, error)
+	// VerifyIDToken verifies LIFF ID token and returns the LINE user it was issued to
+	VerifyIDToken(idToken string) (*Principal, error)
+}
+
+// Principal is the LINE user authenticated by a token
+type Principal struct {
+	UserID      string
+	DisplayName string    // LINE display name (empty if the profile scope was not granted)
+	PictureURL  string    // LINE profile image URL (may be empty)
+	AMR         []string  // authentication methods, e.g. "linesso", "lineautologin", "pwd"
+	ExpiresAt   time.Time // expiry of the token
 }
 
 // verifier is the concrete implementation of Verifier
+//
+// ID tokens are verified locally (ES256 signature against LINE's cached JWKS plus claim checks).
+// LINE's verify endpoint is used only when the token cannot be verified locally.
 type verifier struct {
-	channelID string
+	channelID  string
+	httpClient *http.Client
+	retry      RetryPolicy
+	baseURL    string
+	client     *retryingClient
+	jwks       *jwksCache
+	now        func() time.Time
+}
+
+// Option configures a Verifier
+type Option func(*verifier)
+
+// WithHTTPClient sets the HTTP client used to call LINE's API (e.g. to set a timeout)
+func WithHTTPClient(c *http.Client) Option {
+	return func(v *verifier) {
+		v.httpClient = c
+	}
 }
 
-func NewVerifier(channelID string) Verifier {
-	return &verifier{channelID: channelID}
+// WithBaseURL sets the origin of LINE's API (e.g. a fake server in tests)
+func WithBaseURL(baseURL string) Option {
+	return func(v *verifier) {
+		v.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithRetryPolicy sets how failed requests to LINE's API are retried
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(v *verifier) {
+		v.retry = p
+	}
+}
+
+// NewVerifier creates a Verifier for the given LIFF channel
+//
+// By default it calls https://api.line.me with a 10s timeout and DefaultRetryPolicy.
+func NewVerifier(channelID string, opts ...Option) Verifier {
+	v := &verifier{
+		channelID:  channelID,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		retry:      DefaultRetryPolicy,
+		baseURL:    defaultBaseURL,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	v.client = &retryingClient{httpClient: v.httpClient, policy: v.retry, sleep: time.Sleep}
+	v.jwks = &jwksCache{url: v.baseURL + "/oauth2/v2.1/certs", client: v.client, now: v.now}
+	return v
 }
 
 type VerifyResponse struct {
-	ClientID string `json:"client_id"`
-	Exp      int64  `json:"exp"`
+	ClientID  string `json:"client_id"`
+	Exp       int64  `json:"exp"`
+	ExpiresIn int64  `json:"expires_in"`
 }
 
 type ProfileResponse struct {
@@ -48,94 +117,136 @@ type IDTokenVerifyResponse struct {
 	Picture string   `json:"picture"`
 }
 
-func (v *verifier) VerifyAccessToken(accessToken string) (string, error) {
+func (v *verifier) VerifyAccessToken(accessToken string) (*Principal, error) {
 	// Call LINE's token verification endpoint
-	url := "https://api.line.me/oauth2/v2.1/verify?access_token=" + accessToken
+	url := v.baseURL + "/oauth2/v2.1/verify?access_token=" + accessToken
 
-	resp, err := http.Get(url)
+	resp, err := v.client.get(url)
 	if err != nil {
-		return "", fmt.Errorf("failed to verify token: %w", err)
+		return nil, fmt.Errorf("failed to verify token: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("token verification failed: %s", string(body))
+		return nil, fmt.Errorf("token verification failed: %s", string(body))
 	}
 
 	var verifyResp VerifyResponse
 	if err := json.NewDecoder(resp.Body).Decode(&verifyResp); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
+		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
 	// Verify channel ID matches
 	if verifyResp.ClientID != v.channelID {
-		return "", fmt.Errorf("channel ID mismatch")
+		return nil, fmt.Errorf("channel ID mismatch")
 	}
 
 	// Get user profile using the access token
-	profileURL := "https://api.line.me/v2/profile"
-	profileReq, err := http.NewRequest("GET", profileURL, nil)
+	profileURL := v.baseURL + "/v2/profile"
+	profileResp, err := v.client.do(func() (*http.Request, error) {
+		req, err := http.NewRequest("GET", profileURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		return req, nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create profile request: %w", err)
-	}
-	profileReq.Header.Set("Authorization", "Bearer "+accessToken)
-
-	profileResp, err := http.DefaultClient.Do(profileReq)
-	if err != nil {
-		return "", fmt.Errorf("failed to get profile: %w", err)
+		return nil, fmt.Errorf("failed to get profile: %w", err)
 	}
 	defer profileResp.Body.Close()
 
 	if profileResp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(profileResp.Body)
-		return "", fmt.Errorf("profile request failed: %s", string(body))
+		return nil, fmt.Errorf("profile request failed: %s", string(body))
 	}
 
 	var profile ProfileResponse
 	if err := json.NewDecoder(profileResp.Body).Decode(&profile); err != nil {
-		return "", fmt.Errorf("failed to decode profile response: %w", err)
+		return nil, fmt.Errorf("failed to decode profile response: %w", err)
+	}
+
+	return &Principal{
+		UserID:      profile.UserID,
+		DisplayName: profile.DisplayName,
+		PictureURL:  profile.PictureURL,
+		ExpiresAt:   v.now().Add(time.Duration(verifyResp.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// VerifyIDToken verifies LIFF ID token and returns the LINE user it was issued to
+func (v *verifier) VerifyIDToken(idToken string) (*Principal, error) {
+	return v.verifyIDTokenWithNonce(idToken, "")
+}
+
+// verifyIDTokenWithNonce verifies LIFF ID token (and its nonce if not empty) and returns the LINE user it was issued to
+//
+// VerifyIDToken passes an empty nonce: LIFF ID tokens cannot carry a server-issued nonce,
+// so replays are rejected by the auth middleware instead.
+func (v *verifier) verifyIDTokenWithNonce(idToken, nonce string) (*Principal, error) {
+	claims, err := v.verifyIDTokenLocal(idToken, nonce)
+	if errors.Is(err, errLocalVerificationUnavailable) {
+		log.Printf("Falling back to remote ID token verification: %v", err)
+		claims, err = v.verifyIDTokenRemote(idToken, nonce)
+	}
+	if err != nil {
+		return nil, err
 	}
+	return claims.principal(), nil
+}
 
-	return profile.UserID, nil
+// principal converts verified ID token claims into a Principal
+func (c *IDTokenVerifyResponse) principal() *Principal {
+	return &Principal{
+		UserID:      c.Sub,
+		DisplayName: c.Name,
+		PictureURL:  c.Picture,
+		AMR:         c.AmR,
+		ExpiresAt:   time.Unix(c.Exp, 0),
+	}
 }
 
-// VerifyIDToken verifies LIFF ID token and returns LINE user ID
-func (v *verifier) VerifyIDToken(idToken string) (string, error) {
+// verifyIDTokenRemote verifies ID token with LINE's verify endpoint
+func (v *verifier) verifyIDTokenRemote(idToken, nonce string) (*IDTokenVerifyResponse, error) {
 	// Call LINE's ID token verification endpoint
-	apiURL := "https://api.line.me/oauth2/v2.1/verify"
+	apiURL := v.baseURL + "/oauth2/v2.1/verify"
 
 	// Prepare form data
 	data := url.Values{}
 	data.Set("id_token", idToken)
 	data.Set("client_id", v.channelID)
-
-	req, err := http.NewRequest("POST", apiURL, strings.NewReader(data.Encode()))
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+	if nonce != "" {
+		data.Set("nonce", nonce)
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := v.client.do(func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", apiURL, strings.NewReader(data.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req, nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to verify ID token: %w", err)
+		return nil, fmt.Errorf("failed to verify ID token: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("ID token verification failed: %s", string(body))
+		return nil, fmt.Errorf("ID token verification failed: %s", string(body))
 	}
 
 	var verifyResp IDTokenVerifyResponse
 	if err := json.NewDecoder(resp.Body).Decode(&verifyResp); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
+		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Verify channel ID matches
-	if verifyResp.Aud != v.channelID {
-		return "", fmt.Errorf("channel ID mismatch")
+	// LINE has checked the signature and expiry; check the claims that depend on this channel
+	if err := v.validateClaims(&verifyResp, nonce); err != nil {
+		return nil, err
 	}
 
-	return verifyResp.Sub, nil
+	return &verifyResp, nil
 }
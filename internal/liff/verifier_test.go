@@ -0,0 +1,428 @@
+package liff
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/morinonusi421/cupid/pkg/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChannelID = "1234567890"
+
+	certsEndpoint  = "GET /oauth2/v2.1/certs"
+	verifyEndpoint = "POST /oauth2/v2.1/verify"
+)
+
+// newTestVerifier creates a verifier against the fake LINE server that does not sleep between retries
+func newTestVerifier(fake *testutil.FakeLINEOAuth, opts ...Option) *verifier {
+	v := NewVerifier(testChannelID, append([]Option{WithBaseURL(fake.URL)}, opts...)...).(*verifier)
+	v.client.sleep = func(time.Duration) {}
+	return v
+}
+
+// setClock makes the verifier and its JWKS cache see now as the current time
+func setClock(v *verifier, now time.Time) {
+	v.now = func() time.Time { return now }
+	v.jwks.now = v.now
+}
+
+func aliceClaims() testutil.IDTokenClaims {
+	return testutil.IDTokenClaims{Sub: "U-alice", Name: "Alice", Nonce: "nonce-123", Amr: []string{"linesso"}}
+}
+
+// aliceClaimsAt returns aliceClaims issued at the given time
+func aliceClaimsAt(issuedAt time.Time) testutil.IDTokenClaims {
+	c := aliceClaims()
+	c.IssuedAt = issuedAt
+	c.ExpiresAt = issuedAt.Add(time.Hour)
+	return c
+}
+
+func TestVerifier_verifyIDTokenWithNonce_Local(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   func(t *testing.T, fake *testutil.FakeLINEOAuth) string
+		nonce   string
+		wantSub string
+		wantErr string
+	}{
+		{
+			name: "valid token",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				return fake.IssueIDToken(t, aliceClaims())
+			},
+			wantSub: "U-alice",
+		},
+		{
+			name: "valid token with matching nonce",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				return fake.IssueIDToken(t, aliceClaims())
+			},
+			nonce:   "nonce-123",
+			wantSub: "U-alice",
+		},
+		{
+			name: "nonce mismatch",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				return fake.IssueIDToken(t, aliceClaims())
+			},
+			nonce:   "other-nonce",
+			wantErr: "nonce mismatch",
+		},
+		{
+			name: "signed by another key",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				return fake.IssueForeignIDToken(t, aliceClaims())
+			},
+			wantErr: "invalid ID token signature",
+		},
+		{
+			name: "wrong audience",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				c := aliceClaims()
+				c.Aud = "other-channel"
+				return fake.IssueIDToken(t, c)
+			},
+			wantErr: "channel ID mismatch",
+		},
+		{
+			name: "wrong issuer",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				c := aliceClaims()
+				c.Iss = "https://evil.example.com"
+				return fake.IssueIDToken(t, c)
+			},
+			wantErr: "issuer mismatch",
+		},
+		{
+			name: "expired",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				c := aliceClaims()
+				c.IssuedAt = time.Now().Add(-2 * time.Hour)
+				c.ExpiresAt = time.Now().Add(-time.Minute)
+				return fake.IssueIDToken(t, c)
+			},
+			wantErr: "expired",
+		},
+		{
+			name: "expired within clock skew",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				c := aliceClaims()
+				c.IssuedAt = time.Now().Add(-time.Hour)
+				c.ExpiresAt = time.Now().Add(-10 * time.Second)
+				return fake.IssueIDToken(t, c)
+			},
+			wantSub: "U-alice",
+		},
+		{
+			name: "issued in the future",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				c := aliceClaims()
+				c.IssuedAt = time.Now().Add(time.Hour)
+				return fake.IssueIDToken(t, c)
+			},
+			wantErr: "issued in the future",
+		},
+		{
+			name: "malformed",
+			token: func(t *testing.T, fake *testutil.FakeLINEOAuth) string {
+				return "not-a-jwt"
+			},
+			wantErr: "malformed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+			v := newTestVerifier(fake)
+
+			principal, err := v.verifyIDTokenWithNonce(tt.token(t, fake), tt.nonce)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantSub, principal.UserID)
+			}
+			// Tokens that can be checked locally never reach the remote endpoint
+			assert.Zero(t, fake.Requests(verifyEndpoint))
+		})
+	}
+}
+
+func TestVerifier_VerifyIDToken_Principal(t *testing.T) {
+	fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+	v := newTestVerifier(fake)
+	claims := aliceClaims()
+	claims.Picture = "https://profile.line-scdn.net/alice"
+	claims.ExpiresAt = time.Now().Add(30 * time.Minute).Truncate(time.Second)
+
+	principal, err := v.VerifyIDToken(fake.IssueIDToken(t, claims))
+	require.NoError(t, err)
+	assert.Equal(t, &Principal{
+		UserID:      "U-alice",
+		DisplayName: "Alice",
+		PictureURL:  "https://profile.line-scdn.net/alice",
+		AMR:         []string{"linesso"},
+		ExpiresAt:   time.Unix(claims.ExpiresAt.Unix(), 0),
+	}, principal)
+}
+
+func TestVerifier_JWKSCaching(t *testing.T) {
+	fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+	v := newTestVerifier(fake)
+	now := time.Now()
+	setClock(v, now)
+
+	// The key set is fetched once and cached
+	for range 3 {
+		_, err := v.VerifyIDToken(fake.IssueIDToken(t, aliceClaims()))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, fake.Requests(certsEndpoint))
+
+	// LINE rotates its keys: an unknown key ID triggers a refetch once the minimum interval has passed
+	fake.RotateKey(t, "fake-key-2")
+	setClock(v, now.Add(2*minJWKSRefreshInterval))
+	principal, err := v.VerifyIDToken(fake.IssueIDToken(t, aliceClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "U-alice", principal.UserID)
+	assert.Equal(t, 2, fake.Requests(certsEndpoint))
+}
+
+func TestVerifier_JWKSFailures(t *testing.T) {
+	t.Run("failed fetches are not retried within the minimum interval", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		fake.SetJWKSUnavailable(true)
+		v := newTestVerifier(fake, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+		now := time.Now()
+		setClock(v, now)
+
+		// Each token falls back to remote verification, but JWKS is fetched only once
+		for range 3 {
+			_, err := v.VerifyIDToken(fake.IssueIDToken(t, aliceClaims()))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, fake.Requests(certsEndpoint))
+		assert.Equal(t, 3, fake.Requests(verifyEndpoint))
+
+		// Once the interval has passed, the fetch is attempted again
+		fake.SetJWKSUnavailable(false)
+		setClock(v, now.Add(minJWKSRefreshInterval))
+		_, err := v.VerifyIDToken(fake.IssueIDToken(t, aliceClaims()))
+		require.NoError(t, err)
+		assert.Equal(t, 2, fake.Requests(certsEndpoint))
+		assert.Equal(t, 3, fake.Requests(verifyEndpoint))
+	})
+
+	t.Run("expired keys are used within the grace window", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		v := newTestVerifier(fake, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+		now := time.Now()
+		setClock(v, now)
+		_, err := v.VerifyIDToken(fake.IssueIDToken(t, aliceClaims()))
+		require.NoError(t, err)
+
+		// The fake server sends max-age=3600
+		fake.SetJWKSUnavailable(true)
+		later := now.Add(2 * time.Hour)
+		setClock(v, later)
+		_, err = v.VerifyIDToken(fake.IssueIDToken(t, aliceClaimsAt(later)))
+		require.NoError(t, err)
+		assert.Equal(t, 2, fake.Requests(certsEndpoint))
+		assert.Zero(t, fake.Requests(verifyEndpoint))
+
+		// Past the grace window the stale keys are dropped and the token is verified remotely
+		later = now.Add(time.Hour + jwksStaleGrace + minJWKSRefreshInterval)
+		setClock(v, later)
+		_, err = v.VerifyIDToken(fake.IssueIDToken(t, aliceClaimsAt(later)))
+		require.NoError(t, err)
+		assert.Equal(t, 3, fake.Requests(certsEndpoint))
+		assert.Equal(t, 1, fake.Requests(verifyEndpoint))
+	})
+}
+
+func TestJWKSCache_ConcurrentRefresh(t *testing.T) {
+	fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+
+	// Serve the fake key set only when released, to hold a fetch in progress
+	release := make(chan struct{}, 1)
+	var fetches atomic.Int32
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		<-release
+		resp, err := http.Get(fake.URL + "/oauth2/v2.1/certs")
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		w.Header().Set("Cache-Control", resp.Header.Get("Cache-Control"))
+		io.Copy(w, resp.Body)
+	}))
+	t.Cleanup(slow.Close)
+
+	now := time.Now()
+	c := &jwksCache{
+		url:    slow.URL,
+		client: &retryingClient{httpClient: http.DefaultClient, policy: RetryPolicy{MaxAttempts: 1}, sleep: func(time.Duration) {}},
+		now:    func() time.Time { return now },
+	}
+	release <- struct{}{}
+	_, err := c.key("fake-key-1")
+	require.NoError(t, err)
+
+	// An unknown key ID after the minimum interval starts a fetch that does not return yet
+	now = now.Add(minJWKSRefreshInterval)
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.key("fake-key-2")
+			errs <- err
+		}()
+	}
+	require.Eventually(t, func() bool { return fetches.Load() == 2 }, time.Second, time.Millisecond)
+
+	// Cached keys are served while the fetch is in progress
+	got := make(chan error, 1)
+	go func() {
+		_, err := c.key("fake-key-1")
+		got <- err
+	}()
+	select {
+	case err := <-got:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("key blocked on the fetch in progress")
+	}
+
+	// All callers waiting for the unknown key share one fetch
+	fake.RotateKey(t, "fake-key-2")
+	release <- struct{}{}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+func TestVerifier_RemoteFallback(t *testing.T) {
+	t.Run("JWKS unreachable", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		fake.SetJWKSUnavailable(true)
+		v := newTestVerifier(fake)
+
+		principal, err := v.verifyIDTokenWithNonce(fake.IssueIDToken(t, aliceClaims()), "nonce-123")
+		require.NoError(t, err)
+		assert.Equal(t, "U-alice", principal.UserID)
+		assert.Equal(t, "Alice", principal.DisplayName)
+		assert.Equal(t, 1, fake.Requests(verifyEndpoint))
+	})
+
+	t.Run("remote rejects nonce mismatch", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		fake.SetJWKSUnavailable(true)
+		v := newTestVerifier(fake)
+
+		_, err := v.verifyIDTokenWithNonce(fake.IssueIDToken(t, aliceClaims()), "other-nonce")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ID token verification failed")
+	})
+
+	t.Run("remote rejects token for another channel", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		fake.SetJWKSUnavailable(true)
+		v := newTestVerifier(fake)
+		c := aliceClaims()
+		c.Aud = "other-channel"
+
+		_, err := v.VerifyIDToken(fake.IssueIDToken(t, c))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ID token verification failed")
+	})
+}
+
+func TestVerifier_VerifyAccessToken(t *testing.T) {
+	t.Run("valid token", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		v := newTestVerifier(fake)
+
+		principal, err := v.VerifyAccessToken(fake.IssueAccessToken("U-bob", "Bob"))
+		require.NoError(t, err)
+		assert.Equal(t, "U-bob", principal.UserID)
+		assert.Equal(t, "Bob", principal.DisplayName)
+		assert.True(t, principal.ExpiresAt.After(time.Now()))
+	})
+
+	t.Run("unknown token is not retried", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		v := newTestVerifier(fake)
+
+		_, err := v.VerifyAccessToken("unknown")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "token verification failed")
+		assert.Equal(t, 1, fake.Requests("GET /oauth2/v2.1/verify"))
+	})
+}
+
+func TestVerifier_Retry(t *testing.T) {
+	t.Run("transient failures are retried", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		v := newTestVerifier(fake)
+		fake.FailNext(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+
+		principal, err := v.VerifyIDToken(fake.IssueIDToken(t, aliceClaims()))
+		require.NoError(t, err)
+		assert.Equal(t, "U-alice", principal.UserID)
+		assert.Equal(t, 3, fake.Requests(certsEndpoint))
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		v := newTestVerifier(fake, WithRetryPolicy(RetryPolicy{MaxAttempts: 2}))
+		fake.FailNext(http.StatusInternalServerError, http.StatusInternalServerError)
+
+		_, err := v.VerifyAccessToken(fake.IssueAccessToken("U-bob", "Bob"))
+		require.Error(t, err)
+		assert.Equal(t, 2, fake.Requests("GET /oauth2/v2.1/verify"))
+		assert.Zero(t, fake.Requests("GET /v2/profile"))
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		fake := testutil.NewFakeLINEOAuth(t, testChannelID)
+		fake.SetJWKSUnavailable(true)
+		v := newTestVerifier(fake, WithHTTPClient(&http.Client{Timeout: time.Nanosecond}), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+		_, err := v.VerifyIDToken(fake.IssueIDToken(t, aliceClaims()))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to verify ID token")
+	})
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2))
+	assert.Equal(t, 300*time.Millisecond, p.backoff(3))
+	assert.Equal(t, 300*time.Millisecond, p.backoff(10))
+}
+
+func TestMaxAge(t *testing.T) {
+	assert.Equal(t, 600*time.Second, maxAge("public, max-age=600", time.Hour))
+	assert.Equal(t, time.Hour, maxAge("no-cache", time.Hour))
+	assert.Equal(t, time.Hour, maxAge("", time.Hour))
+}
@@ -1,6 +1,13 @@
 package linebot
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
 	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
 )
 
@@ -8,16 +15,77 @@ import (
 type Client interface {
 	ReplyMessage(request *messaging_api.ReplyMessageRequest) (*messaging_api.ReplyMessageResponse, error)
 	PushMessage(request *messaging_api.PushMessageRequest) (*messaging_api.PushMessageResponse, error)
+	// PushMessageWithRetryKey は retryKey（UUID）を X-Line-Retry-Key に指定してメッセージをプッシュ送信する
+	// LINE API がエラーのステータスコードを返した場合は *APIError を返す
+	PushMessageWithRetryKey(request *messaging_api.PushMessageRequest, retryKey string) (*messaging_api.PushMessageResponse, error)
+	// MonthlyUsage は今月（日本時間）のPushメッセージの送信数を返す
+	// 台帳に記録した送信数と、最後に Quota で取得した LINE 側の集計のうち多い方を返す
+	MonthlyUsage(ctx context.Context) (int, error)
+	// Quota は LINE API から今月の送信上限と送信数を取得する
+	Quota(ctx context.Context) (*Quota, error)
+}
+
+// PushLedger はPushメッセージの送信を記録する台帳（repository.PushLedgerRepository が実装する）
+type PushLedger interface {
+	Record(ctx context.Context, toUserID, retryKey string) error
+	CountSince(ctx context.Context, since time.Time) (int, error)
+}
+
+// Quota は LINE 側で集計された今月のPushメッセージの送信上限と送信数
+type Quota struct {
+	Limit     int64 // 今月の送信上限（上限がない場合は -1）
+	Consumed  int64 // 今月の送信数（LINE 側の集計は数分遅れることがある）
+	FetchedAt time.Time
+}
+
+// APIError は LINE API がエラーのステータスコードを返したことを表す
+type APIError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// IsAlreadyAccepted は同じ retry key のリクエストが既に受け付けられていること（409 Conflict）を表すか判定する
+// 再送したリクエストの場合、メッセージは送信済みとして扱ってよい
+func (e *APIError) IsAlreadyAccepted() bool {
+	return e.StatusCode == http.StatusConflict
+}
+
+// IsRetryable は時間をおいて再送すれば成功する可能性があるか判定する（429 と 5xx）
+func (e *APIError) IsRetryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// jst は LINE が月間の送信数を集計するタイムゾーン（日本時間）
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// monthStart は t を含む月（日本時間）の初日 0:00 を返す
+func monthStart(t time.Time) time.Time {
+	t = t.In(jst)
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, jst)
 }
 
 // client はLINE SDKをラップする実装
 type client struct {
-	api *messaging_api.MessagingApiAPI
+	api    *messaging_api.MessagingApiAPI
+	ledger PushLedger
+	now    func() time.Time
+
+	mu          sync.Mutex
+	remoteQuota *Quota // 最後に取得した LINE 側の集計
 }
 
 // NewClient はLINE Bot Clientの新しいインスタンスを作成する
-func NewClient(api *messaging_api.MessagingApiAPI) Client {
-	return &client{api: api}
+// 送信に成功したPushメッセージは ledger に記録する
+func NewClient(api *messaging_api.MessagingApiAPI, ledger PushLedger) Client {
+	return &client{api: api, ledger: ledger, now: time.Now}
 }
 
 // ReplyMessage はメッセージを返信する
@@ -33,5 +101,69 @@ func (c *client) ReplyMessage(request *messaging_api.ReplyMessageRequest) (*mess
 // - Reply APIは無料だが、Push APIは有償カウント対象
 // - 制限超過時は 429 Too Many Requests エラーが返される
 func (c *client) PushMessage(request *messaging_api.PushMessageRequest) (*messaging_api.PushMessageResponse, error) {
-	return c.api.PushMessage(request, "")
+	return c.PushMessageWithRetryKey(request, "")
+}
+
+// PushMessageWithRetryKey は retry key を指定してメッセージをプッシュ送信する
+// 同じ retry key で再送した場合、LINE 側で重複が排除される（既に受け付け済みなら 409 が返る）
+//
+// 【重要】PushMessage と同じく有償メッセージ
+func (c *client) PushMessageWithRetryKey(request *messaging_api.PushMessageRequest, retryKey string) (*messaging_api.PushMessageResponse, error) {
+	res, body, err := c.api.PushMessageWithHttpInfo(request, retryKey)
+	if err != nil {
+		if res != nil && res.StatusCode/100 != 2 {
+			return nil, &APIError{StatusCode: res.StatusCode, Err: err}
+		}
+		return nil, err
+	}
+
+	// 送信数の記録に失敗しても送信は成功しているため、エラーにはしない
+	if err := c.ledger.Record(context.Background(), request.To, retryKey); err != nil {
+		log.Printf("[WARN] Failed to record push to %s in ledger: %v", request.To, err)
+	}
+	return body, nil
+}
+
+// MonthlyUsage は今月のPushメッセージの送信数を返す
+func (c *client) MonthlyUsage(ctx context.Context) (int, error) {
+	now := c.now()
+	start := monthStart(now)
+	usage, err := c.ledger.CountSince(ctx, start)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pushes this month: %w", err)
+	}
+
+	// 他の経路（LINE Official Account Manager など）で送信した分は台帳にないため、LINE 側の集計が多ければそちらを使う
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if q := c.remoteQuota; q != nil && !q.FetchedAt.Before(start) && int(q.Consumed) > usage {
+		usage = int(q.Consumed)
+	}
+	return usage, nil
+}
+
+// Quota は LINE API から今月の送信上限と送信数を取得する
+func (c *client) Quota(ctx context.Context) (*Quota, error) {
+	quota, err := c.api.GetMessageQuota()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message quota: %w", err)
+	}
+	consumption, err := c.api.GetMessageQuotaConsumption()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message quota consumption: %w", err)
+	}
+
+	q := &Quota{
+		Limit:     -1,
+		Consumed:  consumption.TotalUsage,
+		FetchedAt: c.now(),
+	}
+	if quota.Type == messaging_api.QuotaType_LIMITED {
+		q.Limit = quota.Value
+	}
+
+	c.mu.Lock()
+	c.remoteQuota = q
+	c.mu.Unlock()
+	return q, nil
 }
@@ -0,0 +1,120 @@
+package linebot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLedger はメモリ上の PushLedger
+type fakeLedger struct {
+	sentAt    []time.Time
+	retryKeys []string
+}
+
+func (l *fakeLedger) Record(ctx context.Context, toUserID, retryKey string) error {
+	l.sentAt = append(l.sentAt, time.Now())
+	l.retryKeys = append(l.retryKeys, retryKey)
+	return nil
+}
+
+func (l *fakeLedger) CountSince(ctx context.Context, since time.Time) (int, error) {
+	n := 0
+	for _, t := range l.sentAt {
+		if !t.Before(since) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// newTestClient は LINE API の代わりに handler を使うクライアントを作成する
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*client, *fakeLedger) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	api, err := messaging_api.NewMessagingApiAPI("test-token", messaging_api.WithEndpoint(server.URL))
+	require.NoError(t, err)
+	ledger := &fakeLedger{}
+	return NewClient(api, ledger).(*client), ledger
+}
+
+func TestClient_PushMessageWithRetryKey(t *testing.T) {
+	request := &messaging_api.PushMessageRequest{
+		To:       "U-alice",
+		Messages: []messaging_api.MessageInterface{messaging_api.TextMessage{Text: "hello"}},
+	}
+
+	t.Run("送信に成功したら台帳に記録する", func(t *testing.T) {
+		c, ledger := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "retry-key-1", r.Header.Get("X-Line-Retry-Key"))
+			w.Write([]byte(`{"sentMessages":[]}`))
+		})
+
+		_, err := c.PushMessageWithRetryKey(request, "retry-key-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"retry-key-1"}, ledger.retryKeys)
+	})
+
+	t.Run("エラーのステータスコードは APIError として返し、台帳に記録しない", func(t *testing.T) {
+		c, ledger := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"message":"The retry key is already accepted"}`))
+		})
+
+		_, err := c.PushMessageWithRetryKey(request, "retry-key-1")
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr), "expected APIError, got %v", err)
+		assert.True(t, apiErr.IsAlreadyAccepted())
+		assert.False(t, apiErr.IsRetryable())
+		assert.Empty(t, ledger.retryKeys)
+	})
+}
+
+func TestClient_MonthlyUsage(t *testing.T) {
+	c, ledger := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/bot/message/quota":
+			w.Write([]byte(`{"type":"limited","value":200}`))
+		case "/v2/bot/message/quota/consumption":
+			w.Write([]byte(`{"totalUsage":5}`))
+		default:
+			w.Write([]byte(`{"sentMessages":[]}`))
+		}
+	})
+	ctx := context.Background()
+
+	// 先月の送信は数えない
+	ledger.sentAt = append(ledger.sentAt, monthStart(time.Now()).Add(-time.Second))
+	ledger.retryKeys = append(ledger.retryKeys, "")
+	_, err := c.PushMessage(&messaging_api.PushMessageRequest{To: "U-alice"})
+	require.NoError(t, err)
+
+	usage, err := c.MonthlyUsage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, usage)
+
+	// LINE 側の集計の方が多ければそちらを使う
+	quota, err := c.Quota(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), quota.Limit)
+	assert.Equal(t, int64(5), quota.Consumed)
+
+	usage, err = c.MonthlyUsage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, usage)
+}
+
+func TestMonthStart(t *testing.T) {
+	// 日本時間では既に翌月
+	utc := time.Date(2026, 1, 31, 16, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, jst), monthStart(utc))
+}
@@ -1,6 +1,9 @@
 package message
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // キューピッドちゃんのメッセージ定数
 // ユーザーの登録フロー順に整理
@@ -12,6 +15,9 @@ import "fmt"
 // FollowGreeting は友達追加時の挨拶メッセージ
 const FollowGreeting = "わぁっ♡ 友達追加ありがとうございますっ！\n\nキューピッドちゃん、とっても嬉しいです〜✨\n\nキューピッドちゃんは、相思相愛を見つけるお手伝いをするBotなんです💕\n\n恋のキューピッドとして、精一杯サポートさせていただきますね！\n\nまずは下のボタンから登録してくださいっ🏹"
 
+// FollowBackGreeting は退会したユーザーが友達追加し直した時の挨拶メッセージ
+const FollowBackGreeting = "わぁっ♡ おかえりなさいっ！また友達追加してくれてありがとうございます✨\n\n前に登録した情報は削除されているので、下のボタンからもう一度登録してくださいね🏹"
+
 // JoinGroupGreeting はグループに招待された時の挨拶メッセージ
 const JoinGroupGreeting = "わぁっ♡ グループに招待してくれてありがとうございますっ！\n\nキューピッドちゃんは相思相愛を見つけるお手伝いをするBotです🏹💕\n\n【使い方】\n1. キューピッドちゃんを友達追加してください\n2. 個チャで自分の情報を登録\n3. 好きな人の情報を登録\n\nお互いが相手を登録していたら、両思いをお知らせしますっ♡\n\nまずはキューピッドちゃんを友達追加して、個チャでやりとりしてくださいね✨"
 
@@ -67,6 +73,26 @@ func UnmatchNotificationPartner(partnerName string) string {
 	return fmt.Sprintf("あうぅ...マッチングが解除されちゃいました💦\n\n理由：相手が情報を変更しました\nお相手：%s さん\n\nでも大丈夫ですっ！キューピッドちゃん、また新しい恋を応援しますね♡", partnerName)
 }
 
+// UnmatchNotificationPartnerWithdrawn はマッチング相手が退会した時の通知
+func UnmatchNotificationPartnerWithdrawn(partnerName string) string {
+	return fmt.Sprintf("あうぅ...マッチングが解除されちゃいました💦\n\n理由：相手が退会しました\nお相手：%s さん\n\nでも大丈夫ですっ！キューピッドちゃん、また新しい恋を応援しますね♡", partnerName)
+}
+
+// UnmatchNotificationPartnerUnmatched はマッチング相手がマッチングを解除した時の通知
+func UnmatchNotificationPartnerUnmatched(partnerName string) string {
+	return fmt.Sprintf("あうぅ...マッチングが解除されちゃいました💦\n\n理由：相手がマッチングを解除しました\nお相手：%s さん\n\nでも大丈夫ですっ！キューピッドちゃん、また新しい恋を応援しますね♡", partnerName)
+}
+
+// UnmatchNotificationByAdmin は運営がマッチングを解除した時の通知（両方のユーザーに送信する）
+func UnmatchNotificationByAdmin(partnerName string) string {
+	return fmt.Sprintf("あうぅ...マッチングが解除されちゃいました💦\n\n理由：運営がマッチングを解除しました\nお相手：%s さん\n\nでも大丈夫ですっ！キューピッドちゃん、また新しい恋を応援しますね♡", partnerName)
+}
+
+// UnmatchNotificationPartnerCrushWithdrawn はマッチング相手が好きな人の登録を取り消した時の通知
+func UnmatchNotificationPartnerCrushWithdrawn(partnerName string) string {
+	return fmt.Sprintf("あうぅ...マッチングが解除されちゃいました💦\n\n理由：相手が好きな人の登録を取り消しました\nお相手：%s さん\n\nでも大丈夫ですっ！キューピッドちゃん、また新しい恋を応援しますね♡", partnerName)
+}
+
 // ========================================
 // 6. エラーメッセージ
 // ========================================
@@ -81,11 +107,129 @@ func MatchedUserExistsWarning(userName string) string {
 	return fmt.Sprintf("はわわっ💦 %sさんとマッチング中ですっ！\n\n変更するとマッチングが解除されちゃいますよぉ...💔\n\nそれでも変更しますか？", userName)
 }
 
+// 名前のバリデーションエラーメッセージ（表記ごとに、文字数・使えない文字のどちらの違反かで選ぶ）
+const (
+	KatakanaNameLengthError = "名前は2〜20文字で入力してください"
+	KatakanaNameCharsError  = "名前は全角カタカナ2〜20文字で入力してください（スペース不可）"
+	KanjiNameLengthError    = "漢字の名前は2〜20文字で入力してください"
+	KanjiNameCharsError     = "漢字の名前は漢字・ひらがな・カタカナで入力してください（スペース不可）"
+	LatinNameLengthError    = "ローマ字の名前は2〜40文字で入力してください"
+	LatinNameCharsError     = "ローマ字の名前は英字で入力してください（区切りはスペース・ハイフン・アポストロフィを1つずつ）"
+)
+
+// AltNameError は名前の別の表記のバリデーションエラーメッセージを生成する
+func AltNameError(detail string) string {
+	return "別の表記：" + detail
+}
+
 // DuplicateUserError は同じ名前・誕生日のユーザーが既に登録されている時のエラーメッセージ
 const DuplicateUserError = "あうぅ...その名前と誕生日の組み合わせは既に登録されていますっ💦\n\n別の情報で登録してくださいね✨"
 
+// CrushLimitReached は好きな人の登録数が上限に達している時のエラーメッセージを生成する
+func CrushLimitReached(limit int) string {
+	return fmt.Sprintf("あうぅ...好きな人は%d人まで登録できますっ💦\n\nこれ以上登録するには、登録済みの人を取り消してくださいね✨", limit)
+}
+
+// CrushChangeLimitReached は1日に好きな人を登録できる回数の上限に達している時のエラーメッセージを生成する
+func CrushChangeLimitReached(limit int) string {
+	return fmt.Sprintf("あうぅ...好きな人の登録は1日%d回までですっ💦\n\nまた明日試してみてくださいね✨", limit)
+}
+
+// CrushChangeCooldown は前回の好きな人の登録から間隔が空いていない時のエラーメッセージ
+// wait は次に登録できるようになるまでの時間（分単位で切り上げて表示する）
+func CrushChangeCooldown(wait time.Duration) string {
+	minutes := int((wait + time.Minute - 1) / time.Minute)
+	return fmt.Sprintf("あうぅ...好きな人の登録は少し時間をおいてからにしてくださいっ💦\n\nあと%d分くらいで登録できますよ✨", max(minutes, 1))
+}
+
+// CrushDistinctLimitReached は一定期間に登録できる好きな人の人数の上限に達した時のエラーメッセージ
+func CrushDistinctLimitReached(limit int, window time.Duration) string {
+	days := int(window / (24 * time.Hour))
+	return fmt.Sprintf("あうぅ...好きな人は%d日間で%d人までしか登録できませんっ💦\n\nしばらくしてから試してみてくださいね✨", max(days, 1), limit)
+}
+
 // InvalidBirthdayError は無効な日付が入力された時のエラーメッセージ
 const InvalidBirthdayError = "あうぅ...その日付は存在しませんっ💦\n\n正しい誕生日を入力してくださいね✨"
 
+// BirthdayInFutureError は未来の日付が誕生日として入力された時のエラーメッセージ
+const BirthdayInFutureError = "あうぅ...その日付はまだ来ていませんっ💦\n\n正しい誕生日を入力してくださいね✨"
+
+// BirthdayTooOld は誕生日が古すぎる（maxAge 歳を超える）時のエラーメッセージ
+func BirthdayTooOld(maxAge int) string {
+	return fmt.Sprintf("あうぅ...%d歳を超える誕生日は登録できませんっ💦\n\n正しい誕生日を入力してくださいね✨", maxAge)
+}
+
+// UnderMinimumAge はユーザー登録できる最低年齢に達していない時のエラーメッセージ
+func UnderMinimumAge(minAge int) string {
+	return fmt.Sprintf("あうぅ...キューピッドは%d歳から使えますっ💦\n\n%d歳になったら、また会いに来てくださいね✨", minAge, minAge)
+}
+
+// CrushAgeGapTooLarge は好きな人との年齢差が上限を超えている時のエラーメッセージ
+func CrushAgeGapTooLarge(maxGap int) string {
+	return fmt.Sprintf("あうぅ...好きな人との年齢差が%d歳を超えているので登録できませんっ💦\n\n誕生日に間違いがないか確認してくださいね✨", maxGap)
+}
+
+// CrushMinorAdult は未成年と成人の組み合わせで好きな人を登録しようとした時のエラーメッセージ
+const CrushMinorAdult = "あうぅ...未成年の方と大人の方の組み合わせは登録できませんっ💦\n\nキューピッドちゃんは、みんなが安心して使えるように見守っていますっ✨"
+
 // GeneralError は一般的なエラーが発生した時のメッセージ
 const GeneralError = "ふえぇ...エラーが発生しちゃいましたっ💦\n\nもう一度試してみてくださいね✨"
+
+// ========================================
+// 7. 退会
+// ========================================
+
+// WithdrawConfirmPrompt は「退会」と送信された時の確認メッセージ
+const WithdrawConfirmPrompt = "本当に退会しますか？💦\n\n退会すると、あなたの情報と好きな人の登録はすべて削除されます（登録し直しの制限のため、好きな人の登録の履歴だけは制限の期間が過ぎるまで残ります）。マッチング中の場合は解除されて、お相手にお知らせが届きます。\n\n退会する場合は、10分以内に「退会する」と送信してくださいね"
+
+// WithdrawComplete は退会が完了した時のメッセージ
+const WithdrawComplete = "退会しました💦\n\n今までキューピッドちゃんを使ってくれて、本当にありがとうございましたっ！\n\nまた使いたくなったら、いつでも登録してくださいね♡"
+
+// WithdrawNotRegistered は未登録のユーザーが退会しようとした時のメッセージ
+const WithdrawNotRegistered = "まだ登録されていないので、退会の必要はありませんっ✨"
+
+// ========================================
+// 8. データのエクスポート
+// ========================================
+
+// DataExportTooLong はエクスポートしたデータが長すぎてチャットで送れない時のメッセージ
+const DataExportTooLong = "データが多すぎて、チャットではお送りできませんでした💦\n\n登録画面の「登録データを確認」から確認してくださいね✨"
+
+// DataExportNotRegistered は未登録のユーザーがデータのエクスポートを試みた時のメッセージ
+const DataExportNotRegistered = "まだ登録されていないので、お渡しできるデータはありませんっ✨"
+
+// ========================================
+// 9. マッチング解除・好きな人の取り消し
+// ========================================
+
+// UnmatchConfirmPrompt はマッチングを解除する前の確認メッセージを生成する
+func UnmatchConfirmPrompt(partnerName string) string {
+	return fmt.Sprintf("本当に %s さんとのマッチングを解除しますか？💦\n\n解除すると、%s さんの好きな人の登録も取り消されて、お相手にお知らせが届きます。", partnerName, partnerName)
+}
+
+// UnmatchComplete はマッチングを解除した時のメッセージを生成する（解除した側）
+func UnmatchComplete(partnerName string) string {
+	return fmt.Sprintf("マッチングを解除しました💦\n\nお相手：%s さん\n\nキューピッドちゃん、また新しい恋を精一杯応援しますねっ♡", partnerName)
+}
+
+// NotMatched はマッチングしていないユーザーがマッチングを解除しようとした時のメッセージ
+const NotMatched = "いまはマッチングしていませんっ✨"
+
+// CrushWithdrawSelectPrompt は取り消す好きな人を選んでもらうメッセージ
+const CrushWithdrawSelectPrompt = "登録を取り消す好きな人を選んでくださいね✨"
+
+// NoCrushToWithdraw は取り消す好きな人が登録されていない時のメッセージ
+const NoCrushToWithdraw = "登録中の好きな人はいませんっ✨"
+
+// CrushNotFound は取り消そうとした好きな人が見つからない時のメッセージ
+const CrushNotFound = "あうぅ...その好きな人は見つかりませんでした💦\n\nもう取り消し済みかもしれませんっ"
+
+// CrushWithdrawUnmatchWarning はマッチング中の相手の登録を取り消そうとした時の確認メッセージを生成する
+func CrushWithdrawUnmatchWarning(partnerName string) string {
+	return fmt.Sprintf("はわわっ💦 %sさんとマッチング中ですっ！\n\n登録を取り消すとマッチングが解除されちゃいますよぉ...💔\n\nそれでも取り消しますか？", partnerName)
+}
+
+// CrushWithdrawn は好きな人の登録を取り消した時のメッセージを生成する
+func CrushWithdrawn(crushName string) string {
+	return fmt.Sprintf("%s さんの登録を取り消しました✨", crushName)
+}
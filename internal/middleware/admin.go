@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"log"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/pkg/httputil"
+)
+
+// AdminActorKey は認証した管理者（model.AdminActor）を context に保存するキー
+const AdminActorKey contextKey = "admin_actor"
+
+// AdminAuth は管理API（/admin/）の認証を行うミドルウェア
+//
+// 接続元のIPアドレスを許可リストで確認してから、Bearer トークンまたは Basic 認証で認証する。
+// LIFF の認証（AuthMiddleware）とは独立しており、LINE のアカウントでは管理APIを使えない。
+type AdminAuth struct {
+	token     string
+	username  string
+	password  string
+	allowlist []netip.Prefix
+}
+
+// NewAdminAuth は AdminAuth の新しいインスタンスを作成する
+//
+// token: Bearer 認証のトークン（空なら Bearer 認証を使わない）
+// username, password: Basic 認証のユーザー名とパスワード（空なら Basic 認証を使わない）
+// allowlist: 接続を許可するIPアドレスの範囲
+func NewAdminAuth(token, username, password string, allowlist []netip.Prefix) *AdminAuth {
+	return &AdminAuth{
+		token:     token,
+		username:  username,
+		password:  password,
+		allowlist: allowlist,
+	}
+}
+
+// Authenticate は認証を行うミドルウェア関数
+// 許可リストにないIPアドレスからは 403、認証に失敗した場合は 401 を返す
+func (a *AdminAuth) Authenticate(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ip := clientIP(r)
+		if !a.allowed(ip) {
+			log.Printf("Admin API access denied from %s: %s %s", ip, r.Method, r.URL.Path)
+			httputil.WriteJSONError(w, http.StatusForbidden, map[string]string{"error": "forbidden"})
+			return
+		}
+
+		name, ok := a.authenticate(r)
+		if !ok {
+			log.Printf("Admin API authentication failed from %s: %s %s", ip, r.Method, r.URL.Path)
+			if a.username != "" {
+				w.Header().Set("WWW-Authenticate", `Basic realm="cupid-admin", charset="UTF-8"`)
+			}
+			httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), AdminActorKey, model.AdminActor{Name: name, RemoteIP: ip})
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// allowed は接続元のIPアドレスが許可リストに含まれるかを返す
+func (a *AdminAuth) allowed(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap().WithZone("")
+	for _, prefix := range a.allowlist {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticate は Authorization ヘッダーを検証し、管理者の名前（token / basic:<ユーザー名>）を返す
+func (a *AdminAuth) authenticate(r *http.Request) (string, bool) {
+	if a.token != "" {
+		if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok && secureEqual(token, a.token) {
+			return "token", true
+		}
+	}
+	if a.username != "" {
+		if username, password, ok := r.BasicAuth(); ok {
+			// ユーザー名とパスワードの両方を比較する（どちらが違うかを応答時間で推測させない）
+			userOK := secureEqual(username, a.username)
+			passwordOK := secureEqual(password, a.password)
+			if userOK && passwordOK {
+				return "basic:" + username, true
+			}
+		}
+	}
+	return "", false
+}
+
+// secureEqual は2つの文字列を一定時間で比較する（長さの違いも応答時間から分からないよう、ハッシュ値を比較する）
+func secureEqual(got, want string) bool {
+	g := sha256.Sum256([]byte(got))
+	w := sha256.Sum256([]byte(want))
+	return subtle.ConstantTimeCompare(g[:], w[:]) == 1
+}
+
+// GetAdminActorFromContext は context から認証した管理者を取得する
+func GetAdminActorFromContext(ctx context.Context) (model.AdminActor, bool) {
+	actor, ok := ctx.Value(AdminActorKey).(model.AdminActor)
+	return actor, ok
+}
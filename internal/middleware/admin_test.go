@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAdminToken    = "admin-token-0123456789abcdef"
+	testAdminUser     = "ops"
+	testAdminPassword = "admin-password-0123456789"
+)
+
+// actorHandler は context の管理者を返すハンドラー
+func actorHandler(w http.ResponseWriter, r *http.Request) {
+	actor, _ := GetAdminActorFromContext(r.Context())
+	w.Write([]byte(actor.Name + "@" + actor.RemoteIP))
+}
+
+func TestAdminAuth_Authenticate(t *testing.T) {
+	allowlist := []netip.Prefix{netip.MustParsePrefix("127.0.0.1/32"), netip.MustParsePrefix("10.0.0.0/8")}
+
+	tests := []struct {
+		name           string
+		auth           *AdminAuth
+		remoteAddr     string
+		realIP         string
+		setAuth        func(*http.Request)
+		expectedStatus int
+		expectedBody   string
+		expectBasic    bool
+	}{
+		{
+			name:           "正常系 - Bearer トークン",
+			auth:           NewAdminAuth(testAdminToken, testAdminUser, testAdminPassword, allowlist),
+			remoteAddr:     "10.1.2.3:5000",
+			setAuth:        func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+testAdminToken) },
+			expectedStatus: http.StatusOK,
+			expectedBody:   "token@10.1.2.3",
+		},
+		{
+			name:           "正常系 - Basic 認証",
+			auth:           NewAdminAuth(testAdminToken, testAdminUser, testAdminPassword, allowlist),
+			remoteAddr:     "127.0.0.1:5000",
+			setAuth:        func(r *http.Request) { r.SetBasicAuth(testAdminUser, testAdminPassword) },
+			expectedStatus: http.StatusOK,
+			expectedBody:   "basic:ops@127.0.0.1",
+		},
+		{
+			name:           "正常系 - リバースプロキシ経由（X-Real-IP で判定）",
+			auth:           NewAdminAuth(testAdminToken, "", "", allowlist),
+			remoteAddr:     "127.0.0.1:5000",
+			realIP:         "10.9.9.9",
+			setAuth:        func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+testAdminToken) },
+			expectedStatus: http.StatusOK,
+			expectedBody:   "token@10.9.9.9",
+		},
+		{
+			name:           "異常系 - 許可リストにないIPアドレスは認証情報が正しくても 403",
+			auth:           NewAdminAuth(testAdminToken, "", "", allowlist),
+			remoteAddr:     "203.0.113.1:5000",
+			setAuth:        func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+testAdminToken) },
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "異常系 - リバースプロキシ経由で許可リストにないIPアドレス",
+			auth:           NewAdminAuth(testAdminToken, "", "", allowlist),
+			remoteAddr:     "127.0.0.1:5000",
+			realIP:         "203.0.113.1",
+			setAuth:        func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+testAdminToken) },
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "異常系 - トークンが違う",
+			auth:           NewAdminAuth(testAdminToken, testAdminUser, testAdminPassword, allowlist),
+			remoteAddr:     "127.0.0.1:5000",
+			setAuth:        func(r *http.Request) { r.Header.Set("Authorization", "Bearer wrong-token") },
+			expectedStatus: http.StatusUnauthorized,
+			expectBasic:    true,
+		},
+		{
+			name:           "異常系 - パスワードが違う",
+			auth:           NewAdminAuth("", testAdminUser, testAdminPassword, allowlist),
+			remoteAddr:     "127.0.0.1:5000",
+			setAuth:        func(r *http.Request) { r.SetBasicAuth(testAdminUser, "wrong-password") },
+			expectedStatus: http.StatusUnauthorized,
+			expectBasic:    true,
+		},
+		{
+			name:           "異常系 - Basic 認証を設定していない場合は Basic 認証を受け付けない",
+			auth:           NewAdminAuth(testAdminToken, "", "", allowlist),
+			remoteAddr:     "127.0.0.1:5000",
+			setAuth:        func(r *http.Request) { r.SetBasicAuth("", "") },
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "異常系 - Authorization ヘッダーがない",
+			auth:           NewAdminAuth(testAdminToken, "", "", allowlist),
+			remoteAddr:     "127.0.0.1:5000",
+			setAuth:        func(r *http.Request) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/admin/stats", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-IP", tt.realIP)
+			}
+			tt.setAuth(req)
+
+			rec := httptest.NewRecorder()
+			tt.auth.Authenticate(actorHandler)(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, rec.Body.String())
+			}
+			assert.Equal(t, tt.expectBasic, rec.Header().Get("WWW-Authenticate") != "")
+		})
+	}
+}
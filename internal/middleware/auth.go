@@ -2,9 +2,13 @@ package middleware
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/morinonusi421/cupid/internal/liff"
 	"github.com/morinonusi421/cupid/pkg/httputil"
@@ -12,48 +16,209 @@ import (
 
 type contextKey string
 
-const UserIDKey contextKey = "user_id"
+const (
+	UserIDKey    contextKey = "user_id"
+	PrincipalKey contextKey = "principal"
+)
+
+// NonceHeader は状態を変更するリクエストで nonce を送るヘッダー
+const NonceHeader = "X-Cupid-Nonce"
+
+// invalidNonceMessage は nonce がない・不正な場合のエラーメッセージ
+const invalidNonceMessage = "リクエストの検証に失敗しました。ページを再読み込みしてください"
+
+// usedTokenError は状態の変更に使用済みの IDトークンを拒否するエラーコード
+const usedTokenError = "id_token_used"
 
-// AuthMiddleware は LIFF ID Token を検証し、user_id を context に保存するミドルウェア
+// usedTokenRetention は IDトークンの有効期限を過ぎてから使用済みの記録を残しておく期間
+// （トークン検証で許容する時刻のずれより長くする）
+const usedTokenRetention = time.Minute
+
+// AuthMiddleware は LIFF ID Token を検証し、user_id と認証されたユーザー（liff.Principal）を context に保存するミドルウェア
 type AuthMiddleware struct {
 	verifier liff.Verifier
+	nonces   *NonceIssuer
+	replays  *ReplayCache
 }
 
-func NewAuthMiddleware(verifier liff.Verifier) *AuthMiddleware {
+func NewAuthMiddleware(verifier liff.Verifier, nonces *NonceIssuer, replays *ReplayCache) *AuthMiddleware {
 	return &AuthMiddleware{
 		verifier: verifier,
+		nonces:   nonces,
+		replays:  replays,
 	}
 }
 
 // Authenticate は認証を行うミドルウェア関数
 func (m *AuthMiddleware) Authenticate(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Authorization ヘッダーからトークン取得
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証が必要です"})
+		token, ok := bearerToken(w, r)
+		if !ok {
 			return
 		}
 
-		// "Bearer {token}" 形式からトークン抽出
-		token := strings.TrimPrefix(authHeader, "Bearer ")
-		if token == authHeader { // Bearer プレフィックスがない
-			httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "無効な認証形式です"})
+		// トークン検証して認証されたユーザーを取得
+		principal, err := m.verifier.VerifyIDToken(token)
+		if err != nil {
+			log.Printf("Token verification failed: %v", err)
+			httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証に失敗しました"})
 			return
 		}
 
-		// トークン検証して user_id 取得
-		userID, err := m.verifier.VerifyIDToken(token)
+		next(w, r.WithContext(withPrincipal(r.Context(), principal)))
+	}
+}
+
+// AuthenticateOnce は状態を変更する API 用の認証を行うミドルウェア関数
+//
+// Authenticate に加えて、同じ IDトークン向けに発行された未使用の nonce（NonceHeader）を要求する。
+// LIFF の IDトークンにはサーバーが発行した nonce を含められないため、IDトークン自体も使い捨てにする。
+// 状態の変更に成功した IDトークンは有効期限まで使えないため、盗まれたトークンで nonce を取り直しても再送できない
+func (m *AuthMiddleware) AuthenticateOnce(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(w, r)
+		if !ok {
+			return
+		}
+
+		// nonce がこのトークン向けに発行されたものか確認（トークン検証より安価なため先に行う）
+		nonce := r.Header.Get(NonceHeader)
+		if nonce == "" {
+			httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": invalidNonceMessage})
+			return
+		}
+		expiresAt, err := m.nonces.Verify(nonce, token)
+		if err != nil {
+			log.Printf("Nonce verification failed: %v", err)
+			msg := invalidNonceMessage
+			if errors.Is(err, ErrNonceExpired) {
+				msg = "時間が経ちすぎたため送信できませんでした。もう一度お試しください"
+			}
+			httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": msg})
+			return
+		}
+
+		principal, err := m.verifier.VerifyIDToken(token)
 		if err != nil {
 			log.Printf("Token verification failed: %v", err)
 			httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証に失敗しました"})
 			return
 		}
 
-		// user_id を context に保存
-		ctx := context.WithValue(r.Context(), UserIDKey, userID)
-		next(w, r.WithContext(ctx))
+		// 同じ nonce は一度しか使えない
+		if !m.replays.Use(nonce, expiresAt) {
+			log.Printf("Replayed request rejected: user_id=%s", principal.UserID)
+			httputil.WriteJSONError(w, http.StatusConflict, map[string]string{"error": "このリクエストは既に送信されています"})
+			return
+		}
+
+		// 同じ IDトークンは、状態の変更に一度成功したら使えない
+		// 処理中の同じトークンのリクエストも受け付けず、失敗した場合（確認が必要な場合など）は使い直せるよう戻す
+		key := tokenKey(token)
+		if !m.replays.Use(key, tokenUsableUntil(principal, expiresAt)) {
+			log.Printf("Reused ID token rejected: user_id=%s", principal.UserID)
+			writeTokenUsed(w)
+			return
+		}
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next(rec, r.WithContext(withPrincipal(r.Context(), principal)))
+		if rec.status >= http.StatusBadRequest {
+			m.replays.Release(key)
+		}
+	}
+}
+
+// IssueNonce は Authorization ヘッダーの IDトークンに紐づく nonce を発行するハンドラー
+// 検証に成功した、状態の変更にまだ使われていない IDトークンにだけ発行する
+func (m *AuthMiddleware) IssueNonce(w http.ResponseWriter, r *http.Request) {
+	token, ok := bearerToken(w, r)
+	if !ok {
+		return
+	}
+
+	principal, err := m.verifier.VerifyIDToken(token)
+	if err != nil {
+		log.Printf("Token verification failed: %v", err)
+		httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証に失敗しました"})
+		return
+	}
+	if m.replays.Used(tokenKey(token)) {
+		log.Printf("Nonce requested with a used ID token: user_id=%s", principal.UserID)
+		writeTokenUsed(w)
+		return
+	}
+
+	nonce, expiresAt := m.nonces.Issue(token)
+	httputil.WriteJSONResponse(w, http.StatusOK, map[string]any{
+		"nonce":      nonce,
+		"expires_at": expiresAt.Unix(),
+	})
+}
+
+// tokenKey は使用済みの IDトークンを ReplayCache に記録するキー（トークンそのものは保持しない）
+func tokenKey(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return "id_token:" + hex.EncodeToString(hash[:])
+}
+
+// tokenUsableUntil は IDトークンを使用済みとして記録しておく期限
+// 検証の時刻のずれを見込んで有効期限より長く記録する（有効期限が分からない場合は nonce の有効期限まで）
+func tokenUsableUntil(principal *liff.Principal, nonceExpiresAt time.Time) time.Time {
+	until := principal.ExpiresAt.Add(usedTokenRetention)
+	if until.Before(nonceExpiresAt) {
+		return nonceExpiresAt
 	}
+	return until
+}
+
+// writeTokenUsed は使用済みの IDトークンを拒否する（クライアントはログインし直して新しいトークンを取得する）
+func writeTokenUsed(w http.ResponseWriter) {
+	httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{
+		"error":   usedTokenError,
+		"message": "ログインし直してからもう一度お試しください",
+	})
+}
+
+// statusRecorder は後続のハンドラーが返したステータスコードを記録する
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// bearerToken は Authorization ヘッダーからトークンを取り出す
+// 取り出せない場合は 401 を書き込んで false を返す
+func bearerToken(w http.ResponseWriter, r *http.Request) (string, bool) {
+	// Authorization ヘッダーからトークン取得
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "認証が必要です"})
+		return "", false
+	}
+
+	// "Bearer {token}" 形式からトークン抽出
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+	if token == authHeader { // Bearer プレフィックスがない
+		httputil.WriteJSONError(w, http.StatusUnauthorized, map[string]string{"error": "無効な認証形式です"})
+		return "", false
+	}
+	return token, true
+}
+
+// withPrincipal は認証されたユーザーとその user_id を context に保存する
+func withPrincipal(ctx context.Context, principal *liff.Principal) context.Context {
+	ctx = context.WithValue(ctx, UserIDKey, principal.UserID)
+	return context.WithValue(ctx, PrincipalKey, principal)
+}
+
+// GetPrincipalFromContext は context から認証されたユーザーを取得する
+func GetPrincipalFromContext(ctx context.Context) (*liff.Principal, bool) {
+	principal, ok := ctx.Value(PrincipalKey).(*liff.Principal)
+	return principal, ok
 }
 
 // GetUserIDFromContext は context から user_id を取得する
@@ -0,0 +1,241 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/liff"
+	liffmocks "github.com/morinonusi421/cupid/internal/liff/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+const testIDToken = "id-token-alice"
+
+// okHandler は context の user_id と表示名を返すハンドラー
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	userID, _ := GetUserIDFromContext(r.Context())
+	principal, _ := GetPrincipalFromContext(r.Context())
+	w.Write([]byte(userID + ":" + principal.DisplayName))
+}
+
+func newRequest(token, nonce string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/register-crush", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if nonce != "" {
+		req.Header.Set(NonceHeader, nonce)
+	}
+	return req
+}
+
+func TestAuthMiddleware_AuthenticateOnce(t *testing.T) {
+	nonces := NewNonceIssuer(5 * time.Minute)
+	validNonce := func() string {
+		nonce, _ := nonces.Issue(testIDToken)
+		return nonce
+	}
+
+	tests := []struct {
+		name           string
+		token          string
+		nonce          func() string
+		mockSetup      func(*liffmocks.MockVerifier)
+		expectedStatus int
+	}{
+		{
+			name:  "正常系",
+			token: testIDToken,
+			nonce: validNonce,
+			mockSetup: func(m *liffmocks.MockVerifier) {
+				m.EXPECT().VerifyIDToken(testIDToken).Return(&liff.Principal{UserID: "U-alice", DisplayName: "Alice"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "nonce がない",
+			token:          testIDToken,
+			nonce:          func() string { return "" },
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:  "別のトークン向けの nonce",
+			token: testIDToken,
+			nonce: func() string {
+				nonce, _ := nonces.Issue("id-token-mallory")
+				return nonce
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "改ざんされた nonce",
+			token:          testIDToken,
+			nonce:          func() string { return validNonce() + "x" },
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:  "トークンが無効（nonce は消費されない）",
+			token: testIDToken,
+			nonce: validNonce,
+			mockSetup: func(m *liffmocks.MockVerifier) {
+				m.EXPECT().VerifyIDToken(testIDToken).Return(nil, errors.New("expired"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Authorization ヘッダーがない",
+			nonce:          validNonce,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := liffmocks.NewMockVerifier(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(verifier)
+			}
+			m := NewAuthMiddleware(verifier, nonces, NewReplayCache())
+
+			rec := httptest.NewRecorder()
+			m.AuthenticateOnce(okHandler)(rec, newRequest(tt.token, tt.nonce()))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "U-alice:Alice", rec.Body.String())
+			}
+		})
+	}
+}
+
+// issueNonce は /api/nonce を呼び出し、ステータスコードと発行された nonce を返す
+func issueNonce(t *testing.T, m *AuthMiddleware, token string) (int, string) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/api/nonce", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	m.IssueNonce(rec, req)
+
+	var resp struct {
+		Nonce string `json:"nonce"`
+	}
+	if rec.Code == http.StatusOK {
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	}
+	return rec.Code, resp.Nonce
+}
+
+// statusHandler は status を返すハンドラー
+func statusHandler(status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}
+}
+
+func TestAuthMiddleware_AuthenticateOnce_Replay(t *testing.T) {
+	verifier := liffmocks.NewMockVerifier(t)
+	verifier.EXPECT().VerifyIDToken(testIDToken).Return(&liff.Principal{UserID: "U-alice", DisplayName: "Alice", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	m := NewAuthMiddleware(verifier, NewNonceIssuer(5*time.Minute), NewReplayCache())
+
+	// nonce を取得
+	code, _ := issueNonce(t, m, "")
+	assert.Equal(t, http.StatusUnauthorized, code, "トークンなしでは発行しない")
+	code, nonce := issueNonce(t, m, testIDToken)
+	assert.Equal(t, http.StatusOK, code)
+
+	// 確認が必要で失敗した場合は、トークンは使用済みにならない
+	rec := httptest.NewRecorder()
+	m.AuthenticateOnce(statusHandler(http.StatusConflict))(rec, newRequest(testIDToken, nonce))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// 同じトークンと nonce の再送は拒否
+	rec = httptest.NewRecorder()
+	m.AuthenticateOnce(okHandler)(rec, newRequest(testIDToken, nonce))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// 新しい nonce なら同じトークンで送り直せる（確認ダイアログ後の再送信など）
+	_, nonce = issueNonce(t, m, testIDToken)
+	rec = httptest.NewRecorder()
+	m.AuthenticateOnce(okHandler)(rec, newRequest(testIDToken, nonce))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestAuthMiddleware_AuthenticateOnce_CapturedToken(t *testing.T) {
+	verifier := liffmocks.NewMockVerifier(t)
+	verifier.EXPECT().VerifyIDToken(testIDToken).Return(&liff.Principal{UserID: "U-alice", DisplayName: "Alice", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	m := NewAuthMiddleware(verifier, NewNonceIssuer(5*time.Minute), NewReplayCache())
+
+	// 本人が nonce を2つ取得し、1つ目で登録に成功する
+	_, nonce := issueNonce(t, m, testIDToken)
+	_, spareNonce := issueNonce(t, m, testIDToken)
+	rec := httptest.NewRecorder()
+	m.AuthenticateOnce(okHandler)(rec, newRequest(testIDToken, nonce))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// 盗んだトークンで新しい nonce を取得しようとしても発行されない
+	code, _ := issueNonce(t, m, testIDToken)
+	assert.Equal(t, http.StatusUnauthorized, code)
+
+	// 使用前に取得した nonce や、新しく発行された nonce と組み合わせても再送できない
+	freshNonce, _ := m.nonces.Issue(testIDToken)
+	for _, n := range []string{spareNonce, freshNonce} {
+		rec = httptest.NewRecorder()
+		m.AuthenticateOnce(okHandler)(rec, newRequest(testIDToken, n))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), usedTokenError)
+	}
+}
+
+func TestAuthMiddleware_IssueNonce_InvalidToken(t *testing.T) {
+	verifier := liffmocks.NewMockVerifier(t)
+	verifier.EXPECT().VerifyIDToken("forged-token").Return(nil, errors.New("invalid signature"))
+	m := NewAuthMiddleware(verifier, NewNonceIssuer(5*time.Minute), NewReplayCache())
+
+	code, nonce := issueNonce(t, m, "forged-token")
+
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.Empty(t, nonce)
+}
+
+func TestNonceIssuer_Expired(t *testing.T) {
+	nonces := NewNonceIssuer(time.Minute)
+	now := time.Now()
+	nonces.now = func() time.Time { return now }
+	nonce, expiresAt := nonces.Issue(testIDToken)
+
+	got, err := nonces.Verify(nonce, testIDToken)
+	assert.NoError(t, err)
+	assert.Equal(t, expiresAt, got)
+
+	nonces.now = func() time.Time { return now.Add(2 * time.Minute) }
+	_, err = nonces.Verify(nonce, testIDToken)
+	assert.ErrorIs(t, err, ErrNonceExpired)
+}
+
+func TestReplayCache_Use(t *testing.T) {
+	cache := NewReplayCache()
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	assert.True(t, cache.Use("a", now.Add(time.Minute)))
+	assert.False(t, cache.Use("a", now.Add(time.Minute)))
+	assert.True(t, cache.Use("b", now.Add(time.Minute)))
+
+	// 未使用に戻すと再び使える
+	assert.True(t, cache.Used("b"))
+	cache.Release("b")
+	assert.False(t, cache.Used("b"))
+	assert.True(t, cache.Use("b", now.Add(time.Minute)))
+
+	// 期限切れのエントリは削除され、再び使える
+	now = now.Add(2 * time.Minute)
+	assert.False(t, cache.Used("a"))
+	assert.True(t, cache.Use("a", now.Add(time.Minute)))
+	assert.Len(t, cache.used, 1)
+}
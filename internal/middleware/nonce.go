@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidNonce は nonce が改ざんされている、または別の IDトークン向けに発行されたことを表す
+	ErrInvalidNonce = errors.New("invalid nonce")
+	// ErrNonceExpired は nonce の有効期限が切れていることを表す
+	ErrNonceExpired = errors.New("nonce expired")
+)
+
+// nonceRandomSize は nonce に含める乱数のバイト数
+const nonceRandomSize = 16
+
+// NonceIssuer は状態を変更する API 用の使い捨て nonce を発行・検証する
+//
+// nonce は IDトークンに紐づけて HMAC で署名するため、発行時にサーバー側で何も保存しない
+// 使用済みかどうかは ReplayCache で判定する
+type NonceIssuer struct {
+	key []byte
+	ttl time.Duration
+	now func() time.Time
+}
+
+// NewNonceIssuer は有効期間 ttl の nonce を発行する NonceIssuer を作成する
+// 署名鍵は起動ごとに生成する（再起動すると発行済みの nonce は無効になる）
+func NewNonceIssuer(ttl time.Duration) *NonceIssuer {
+	key := make([]byte, 32)
+	rand.Read(key)
+	return &NonceIssuer{key: key, ttl: ttl, now: time.Now}
+}
+
+// Issue は IDトークン idToken に紐づく nonce と有効期限を返す
+func (n *NonceIssuer) Issue(idToken string) (string, time.Time) {
+	expiresAt := n.now().Add(n.ttl).Truncate(time.Second)
+	payload := make([]byte, nonceRandomSize+8)
+	rand.Read(payload[:nonceRandomSize])
+	binary.BigEndian.PutUint64(payload[nonceRandomSize:], uint64(expiresAt.Unix()))
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(n.sign(payload, idToken)), expiresAt
+}
+
+// Verify は nonce が idToken 向けに発行され、有効期限内であることを確認し、有効期限を返す
+func (n *NonceIssuer) Verify(nonce, idToken string) (time.Time, error) {
+	encodedPayload, encodedSig, ok := strings.Cut(nonce, ".")
+	if !ok {
+		return time.Time{}, ErrInvalidNonce
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != nonceRandomSize+8 {
+		return time.Time{}, ErrInvalidNonce
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, n.sign(payload, idToken)) {
+		return time.Time{}, ErrInvalidNonce
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[nonceRandomSize:])), 0)
+	if !n.now().Before(expiresAt) {
+		return time.Time{}, ErrNonceExpired
+	}
+	return expiresAt, nil
+}
+
+// sign は nonce の内容と IDトークンの HMAC を計算する
+func (n *NonceIssuer) sign(payload []byte, idToken string) []byte {
+	mac := hmac.New(sha256.New, n.key)
+	mac.Write(payload)
+	tokenHash := sha256.Sum256([]byte(idToken))
+	mac.Write(tokenHash[:])
+	return mac.Sum(nil)
+}
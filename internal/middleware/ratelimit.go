@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/morinonusi421/cupid/pkg/httputil"
+)
+
+// rateLimitedMessage は制限を超えたリクエストに返すメッセージ
+const rateLimitedMessage = "短時間に多くのリクエストが送信されました。しばらく待ってからもう一度お試しください"
+
+// Limit はトークンバケットの設定
+// Burst 回まで連続で受け付け、その後は Every ごとに1回分回復する
+type Limit struct {
+	Burst int
+	Every time.Duration
+}
+
+// RateLimiter はエンドポイントごとのリクエスト数を LINE ユーザーIDとクライアントIPごとに制限するミドルウェア
+//
+// 名前と誕生日を総当たりして、誰が誰を登録しているかを探られるのを防ぐ
+// バケットはプロセス内のメモリに保持する（サーバーは1台で動かす前提）
+type RateLimiter struct {
+	byUser *tokenBuckets
+	byIP   *tokenBuckets
+}
+
+// NewRateLimiter は1つのエンドポイント用の RateLimiter を作成する
+func NewRateLimiter(perUser, perIP Limit) *RateLimiter {
+	return &RateLimiter{
+		byUser: newTokenBuckets(perUser),
+		byIP:   newTokenBuckets(perIP),
+	}
+}
+
+// LimitByIP はクライアントIPごとにリクエスト数を制限する（認証より前に適用する）
+func (l *RateLimiter) LimitByIP(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ip := clientIP(r)
+		if ok, retryAfter := l.byIP.take(ip); !ok {
+			log.Printf("Rate limited by IP: ip=%s path=%s", ip, r.URL.Path)
+			WriteTooManyRequests(w, retryAfter, "rate_limited", rateLimitedMessage)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// LimitByUser は認証された LINE ユーザーごとにリクエスト数を制限する（認証より後に適用する）
+func (l *RateLimiter) LimitByUser(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := GetUserIDFromContext(r.Context())
+		if !ok {
+			next(w, r)
+			return
+		}
+		if ok, retryAfter := l.byUser.take(userID); !ok {
+			log.Printf("Rate limited by user: user_id=%s path=%s", userID, r.URL.Path)
+			WriteTooManyRequests(w, retryAfter, "rate_limited", rateLimitedMessage)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// WriteTooManyRequests は Retry-After（秒、切り上げ）付きの 429 レスポンスを書き込む
+func WriteTooManyRequests(w http.ResponseWriter, retryAfter time.Duration, code, message string) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	httputil.WriteJSONError(w, http.StatusTooManyRequests, map[string]string{
+		"error":   code,
+		"message": message,
+	})
+}
+
+// clientIP はリクエスト元のIPアドレスを返す
+// ローカルの Nginx 経由のリクエストは Nginx が設定した X-Real-IP を使う（外部から送られた値は信用しない）
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+			return realIP
+		}
+	}
+	return host
+}
+
+// bucketPruneInterval は満タンに戻ったバケットを削除する間隔
+const bucketPruneInterval = time.Minute
+
+// tokenBuckets はキーごとのトークンバケット
+type tokenBuckets struct {
+	limit Limit
+	now   func() time.Time
+
+	mu         sync.Mutex
+	buckets    map[string]*tokenBucket
+	lastPruned time.Time
+}
+
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+func newTokenBuckets(limit Limit) *tokenBuckets {
+	if limit.Burst < 1 || limit.Every <= 0 {
+		panic(fmt.Sprintf("invalid rate limit: %+v", limit))
+	}
+	return &tokenBuckets{limit: limit, now: time.Now, buckets: make(map[string]*tokenBucket)}
+}
+
+// take は key のバケットからトークンを1つ取り出す
+// 取り出せない場合は false と、次のトークンが貯まるまでの時間を返す
+func (b *tokenBuckets) take(key string) (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if now.Sub(b.lastPruned) >= bucketPruneInterval {
+		for k, bucket := range b.buckets {
+			if b.refill(bucket, now) >= float64(b.limit.Burst) {
+				delete(b.buckets, k)
+			}
+		}
+		b.lastPruned = now
+	}
+
+	bucket, ok := b.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(b.limit.Burst), updatedAt: now}
+		b.buckets[key] = bucket
+	}
+	tokens := b.refill(bucket, now)
+	if tokens < 1 {
+		return false, time.Duration((1 - tokens) * float64(b.limit.Every))
+	}
+	bucket.tokens = tokens - 1
+	return true, 0
+}
+
+// refill は経過時間分のトークンを補充した残量を返す
+func (b *tokenBuckets) refill(bucket *tokenBucket, now time.Time) float64 {
+	elapsed := now.Sub(bucket.updatedAt)
+	bucket.tokens = min(float64(b.limit.Burst), bucket.tokens+float64(elapsed)/float64(b.limit.Every))
+	bucket.updatedAt = now
+	return bucket.tokens
+}
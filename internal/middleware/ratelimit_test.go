@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// noContentHandler は何もせず 204 を返すハンドラー
+func noContentHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestRateLimiter_LimitByIP(t *testing.T) {
+	limiter := NewRateLimiter(Limit{Burst: 1, Every: time.Minute}, Limit{Burst: 2, Every: 10 * time.Second})
+	now := time.Now()
+	limiter.byIP.now = func() time.Time { return now }
+	handler := limiter.LimitByIP(noContentHandler)
+
+	request := func(remoteAddr, realIP string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/register-crush", nil)
+		req.RemoteAddr = remoteAddr
+		if realIP != "" {
+			req.Header.Set("X-Real-IP", realIP)
+		}
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec
+	}
+
+	// Burst 回までは受け付ける
+	assert.Equal(t, http.StatusNoContent, request("203.0.113.1:1234", "").Code)
+	assert.Equal(t, http.StatusNoContent, request("203.0.113.1:1235", "").Code)
+
+	// 超えると 429 と Retry-After を返す
+	rec := request("203.0.113.1:1236", "")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "10", rec.Header().Get("Retry-After"))
+	assert.Contains(t, rec.Body.String(), "rate_limited")
+
+	// 別のIPは影響を受けない
+	assert.Equal(t, http.StatusNoContent, request("203.0.113.2:1234", "").Code)
+
+	// 外部から送られた X-Real-IP は信用しない
+	assert.Equal(t, http.StatusTooManyRequests, request("203.0.113.1:1237", "198.51.100.1").Code)
+
+	// ローカルの Nginx 経由なら X-Real-IP で数える
+	assert.Equal(t, http.StatusNoContent, request("127.0.0.1:5000", "198.51.100.1").Code)
+
+	// 時間が経つと回復する
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, http.StatusNoContent, request("203.0.113.1:1238", "").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request("203.0.113.1:1239", "").Code)
+}
+
+func TestRateLimiter_LimitByUser(t *testing.T) {
+	limiter := NewRateLimiter(Limit{Burst: 1, Every: time.Minute}, Limit{Burst: 10, Every: time.Second})
+	handler := limiter.LimitByUser(noContentHandler)
+
+	request := func(userID string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/register-crush", nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserIDKey, userID))
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, request("U-alice"))
+	assert.Equal(t, http.StatusTooManyRequests, request("U-alice"))
+	assert.Equal(t, http.StatusNoContent, request("U-bob"))
+}
+
+func TestTokenBuckets_Prune(t *testing.T) {
+	buckets := newTokenBuckets(Limit{Burst: 2, Every: time.Second})
+	now := time.Now()
+	buckets.now = func() time.Time { return now }
+
+	buckets.take("a")
+	buckets.take("b")
+	assert.Len(t, buckets.buckets, 2)
+
+	// 満タンに戻ったバケットは削除される
+	now = now.Add(bucketPruneInterval)
+	buckets.take("c")
+	assert.Len(t, buckets.buckets, 1)
+}
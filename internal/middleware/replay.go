@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+)
+
+// replayPruneInterval は期限切れのエントリを削除する間隔
+const replayPruneInterval = time.Minute
+
+// ReplayCache は使用済みの nonce・IDトークンを有効期限まで記録し、同じリクエストの再送を検出する
+//
+// プロセス内のメモリに保持する（サーバーは1台で動かす前提）
+type ReplayCache struct {
+	mu         sync.Mutex
+	used       map[string]time.Time
+	lastPruned time.Time
+	now        func() time.Time
+}
+
+// NewReplayCache は空の ReplayCache を作成する
+func NewReplayCache() *ReplayCache {
+	return &ReplayCache{used: make(map[string]time.Time), now: time.Now}
+}
+
+// Use は key を until まで使用済みとして記録する
+// 既に使用済みの場合は false を返す
+func (c *ReplayCache) Use(key string, until time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if now.Sub(c.lastPruned) >= replayPruneInterval {
+		for k, expiresAt := range c.used {
+			if !now.Before(expiresAt) {
+				delete(c.used, k)
+			}
+		}
+		c.lastPruned = now
+	}
+
+	if expiresAt, ok := c.used[key]; ok && now.Before(expiresAt) {
+		return false
+	}
+	c.used[key] = until
+	return true
+}
+
+// Used は key が使用済みかどうかを返す
+func (c *ReplayCache) Used(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt, ok := c.used[key]
+	return ok && c.now().Before(expiresAt)
+}
+
+// Release は Use で記録した key を未使用に戻す
+func (c *ReplayCache) Release(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.used, key)
+}
@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+// AdminAction は管理API（/admin/）の操作の種類
+type AdminAction string
+
+const (
+	AdminUserLookup   AdminAction = "user_lookup"   // ユーザーの検索
+	AdminMatchList    AdminAction = "match_list"    // マッチング中のペアの一覧
+	AdminForceUnmatch AdminAction = "force_unmatch" // マッチングの強制解除
+	AdminUserDelete   AdminAction = "user_delete"   // ユーザーの削除（退会済みにする）
+	AdminStatsView    AdminAction = "stats"         // 集計
+)
+
+// AdminResultOK は成功した操作の記録の result
+const AdminResultOK = "ok"
+
+// AdminActor は管理APIを呼び出した管理者
+type AdminActor struct {
+	Name     string // 認証方法と管理者（token / basic:<ユーザー名>）
+	RemoteIP string // 接続元のIPアドレス
+}
+
+// AdminAuditEntry は管理APIの操作の記録（admin_audit_log の1行）
+type AdminAuditEntry struct {
+	ID        int64
+	Actor     string
+	RemoteIP  string
+	Action    AdminAction
+	Target    string // 操作の対象（ユーザーのLINE IDなど、なければ空）
+	Detail    string // 検索条件など
+	Result    string // 成功なら AdminResultOK、失敗ならエラーの内容
+	CreatedAt time.Time
+}
+
+// AdminUserDetail は管理APIで検索したユーザーの情報
+type AdminUserDetail struct {
+	User        *User
+	Crushes     []*Crush // 登録中の好きな人
+	MatchedUser *User    // マッチング相手（マッチングしていなければnil）
+}
+
+// MatchedPair はマッチング中の2人
+type MatchedPair struct {
+	User    *User
+	Partner *User
+}
+
+// AdminStats は管理APIで返す集計
+type AdminStats struct {
+	Users                int // 登録中のユーザー数（退会済みを除く）
+	WithdrawnUsers       int // 退会済みのユーザー数
+	MatchedPairs         int // マッチング中のペアの数
+	Crushes              int // 登録中の好きな人の数
+	PendingNotifications int // 送信待ちのPush通知の数
+	DeadNotifications    int // 送信を諦めたPush通知の数
+
+	SkippedLowPriorityPushes int64 // 起動してから、予算に達していたため捨てた低優先度のPushメッセージの数
+}
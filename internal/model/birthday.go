@@ -0,0 +1,140 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// Birthday は誕生日（YYYY-MM-DD 形式の日付）
+// 入力された誕生日は ParseBirthday で作る（形式と範囲を検証する）
+// ゼロ値（空）は誕生日が未設定であることを表す（退会したユーザーなど）
+type Birthday string
+
+// BirthdayLayout は誕生日の形式（API・DB とも同じ）
+const BirthdayLayout = "2006-01-02"
+
+// MaxAge は誕生日として受け付ける最高年齢
+const MaxAge = 120
+
+// jst は誕生日の日付を扱うタイムゾーン（日本時間）
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// BirthdayErrorReason は誕生日のバリデーションで違反した規則の種類
+type BirthdayErrorReason string
+
+const (
+	BirthdayInvalidFormat BirthdayErrorReason = "invalid_format" // 形式が違う・存在しない日付
+	BirthdayInFuture      BirthdayErrorReason = "in_future"      // 今日より後の日付
+	BirthdayTooOld        BirthdayErrorReason = "too_old"        // MaxAge 歳を超える日付
+)
+
+// BirthdayError は誕生日のバリデーションエラー
+// ユーザーに表示するメッセージは Reason から呼び出し側で選ぶ
+type BirthdayError struct {
+	Value  string
+	Reason BirthdayErrorReason
+}
+
+func (e *BirthdayError) Error() string {
+	return fmt.Sprintf("invalid birthday %q: %s", e.Value, e.Reason)
+}
+
+// ParseBirthday は入力された誕生日を検証して Birthday に変換する
+//
+//   - YYYY-MM-DD 形式の存在する日付であること
+//   - today（日本時間の日付で比較する）より後でないこと
+//   - today 時点で MaxAge 歳以下であること
+//
+// 無効な場合は *BirthdayError を返す
+func ParseBirthday(s string, today time.Time) (Birthday, error) {
+	b, err := parseBirthday(s)
+	if err != nil {
+		return "", err
+	}
+	if b.Time().After(date(today)) {
+		return "", &BirthdayError{Value: s, Reason: BirthdayInFuture}
+	}
+	if b.AgeOn(today) > MaxAge {
+		return "", &BirthdayError{Value: s, Reason: BirthdayTooOld}
+	}
+	return b, nil
+}
+
+// parseBirthday は誕生日の形式だけを検証する（保存済みの値の読み込みに使う）
+func parseBirthday(s string) (Birthday, error) {
+	t, err := time.Parse(BirthdayLayout, s)
+	if err != nil {
+		return "", &BirthdayError{Value: s, Reason: BirthdayInvalidFormat}
+	}
+	return Birthday(t.Format(BirthdayLayout)), nil
+}
+
+// String は YYYY-MM-DD 形式の誕生日を返す（未設定なら空）
+func (b Birthday) String() string {
+	return string(b)
+}
+
+// IsZero は誕生日が未設定かを返す
+func (b Birthday) IsZero() bool {
+	return b == ""
+}
+
+// Time は誕生日の0時（UTC）を返す（未設定・形式が違う場合はゼロ値）
+func (b Birthday) Time() time.Time {
+	t, err := time.Parse(BirthdayLayout, string(b))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// AgeOn は today（日本時間の日付）時点の満年齢を返す
+func (b Birthday) AgeOn(today time.Time) int {
+	born := b.Time()
+	now := date(today)
+	age := now.Year() - born.Year()
+	if now.Month() < born.Month() || now.Month() == born.Month() && now.Day() < born.Day() {
+		age--
+	}
+	return age
+}
+
+// Scan は DB の値（YYYY-MM-DD 形式の TEXT）を読み込む（database/sql.Scanner）
+// 空文字・NULL は未設定として読み込む
+func (b *Birthday) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*b = ""
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Birthday", src)
+	}
+	if s == "" {
+		*b = ""
+		return nil
+	}
+	parsed, err := parseBirthday(s)
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
+
+// Value は DB に保存する値を返す（database/sql/driver.Valuer）
+// 未設定の場合は空文字を保存する（birthday カラムは NOT NULL のため）
+func (b Birthday) Value() (driver.Value, error) {
+	return string(b), nil
+}
+
+// date は t の日本時間の日付の0時（UTC）を返す（Birthday.Time と比較するため）
+func date(t time.Time) time.Time {
+	y, m, d := t.In(jst).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
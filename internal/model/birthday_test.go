@@ -0,0 +1,90 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBirthday(t *testing.T) {
+	// 日本時間 2026-04-01 08:00（UTC では前日）
+	today := time.Date(2026, 3, 31, 23, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected Birthday
+		reason   BirthdayErrorReason
+	}{
+		{name: "有効な日付", input: "1990-01-15", expected: "1990-01-15"},
+		{name: "うるう日", input: "2000-02-29", expected: "2000-02-29"},
+		{name: "今日（日本時間）", input: "2026-04-01", expected: "2026-04-01"},
+		{name: "MaxAge 歳（明日で MaxAge+1 歳）", input: "1905-04-02", expected: "1905-04-02"},
+		{name: "空", input: "", reason: BirthdayInvalidFormat},
+		{name: "形式が違う", input: "1990/01/15", reason: BirthdayInvalidFormat},
+		{name: "ゼロ埋めなし", input: "1990-1-15", reason: BirthdayInvalidFormat},
+		{name: "存在しない日付", input: "1990-02-30", reason: BirthdayInvalidFormat},
+		{name: "うるう年でない2月29日", input: "2001-02-29", reason: BirthdayInvalidFormat},
+		{name: "明日（日本時間）", input: "2026-04-02", reason: BirthdayInFuture},
+		{name: "MaxAge 歳を超える", input: "1905-04-01", reason: BirthdayTooOld},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := ParseBirthday(tt.input, today)
+			if tt.reason == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, b)
+				return
+			}
+			var birthdayErr *BirthdayError
+			if assert.True(t, errors.As(err, &birthdayErr)) {
+				assert.Equal(t, tt.reason, birthdayErr.Reason)
+			}
+			assert.True(t, b.IsZero())
+		})
+	}
+}
+
+func TestBirthday_AgeOn(t *testing.T) {
+	b := Birthday("2000-04-01")
+
+	assert.Equal(t, 25, b.AgeOn(time.Date(2026, 3, 31, 14, 59, 0, 0, time.UTC))) // 日本時間 3/31 23:59
+	assert.Equal(t, 26, b.AgeOn(time.Date(2026, 3, 31, 15, 0, 0, 0, time.UTC)))  // 日本時間 4/1 0:00
+	assert.Equal(t, 0, b.AgeOn(time.Date(2000, 4, 1, 0, 0, 0, 0, jst)))
+}
+
+func TestBirthday_Scan(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      any
+		expected Birthday
+		wantErr  bool
+	}{
+		{name: "文字列", src: "1990-01-15", expected: "1990-01-15"},
+		{name: "バイト列", src: []byte("1990-01-15"), expected: "1990-01-15"},
+		{name: "空文字は未設定", src: "", expected: ""},
+		{name: "NULL は未設定", src: nil, expected: ""},
+		{name: "形式が違う", src: "1990/01/15", wantErr: true},
+		{name: "対応しない型", src: int64(19900115), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Birthday
+			err := b.Scan(tt.src)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, b)
+
+			v, err := b.Value()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected.String(), v)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package model
+
+import "time"
+
+// Crush は好きな人のドメインモデル
+// 1人のユーザーが複数の好きな人を登録できる（上限は UserService で設定）
+type Crush struct {
+	ID           int64
+	UserLineID   string   // 登録したユーザーのLINE ID
+	Name         string   // 好きな人の名前
+	Birthday     Birthday // 好きな人の誕生日
+	RegisteredAt string
+	AltName      string // 好きな人の名前の別の表記（なければ空）
+}
+
+// IdentityKey は名前と別の表記から同じ人かどうかの判定に使うキーを返す
+func (c *Crush) IdentityKey() IdentityKey {
+	return NewIdentityKey(c.Name, c.AltName)
+}
+
+// IsSamePerson は、指定された名前のキーと誕生日がこの好きな人と一致するかをチェックする
+// 名前はどちらかの表記の組み合わせが1つでも一致すれば同じとみなす
+func (c *Crush) IsSamePerson(key IdentityKey, birthday Birthday) bool {
+	return c.IdentityKey().Matches(key) && c.Birthday == birthday
+}
+
+// FindCrush は、指定された名前のキーと誕生日に一致する好きな人を返す（見つからなければnil）
+func FindCrush(crushes []*Crush, key IdentityKey, birthday Birthday) *Crush {
+	for _, c := range crushes {
+		if c.IsSamePerson(key, birthday) {
+			return c
+		}
+	}
+	return nil
+}
+
+// CrushHistoryAction は好きな人の登録の履歴の種類
+type CrushHistoryAction string
+
+const (
+	CrushAdded   CrushHistoryAction = "add"    // 登録
+	CrushRemoved CrushHistoryAction = "remove" // 取り消し
+)
+
+// CrushHistory は好きな人の登録の履歴（crush_history の1行）
+// 一定期間に登録できる人数と登録の間隔の制限に使う（UserService で設定）
+type CrushHistory struct {
+	ID         int64
+	UserLineID string   // 登録したユーザーのLINE ID
+	Name       string   // 好きな人の名前
+	AltName    string   // 好きな人の名前の別の表記（なければ空）
+	Birthday   Birthday // 好きな人の誕生日
+	Action     CrushHistoryAction
+	ChangedAt  time.Time
+}
+
+// IdentityKey は名前と別の表記から同じ人かどうかの判定に使うキーを返す
+func (h *CrushHistory) IdentityKey() IdentityKey {
+	return NewIdentityKey(h.Name, h.AltName)
+}
+
+// IsSamePerson は、指定された名前のキーと誕生日がこの履歴の好きな人と一致するかをチェックする
+// 好きな人の登録（Crush.IsSamePerson）と違い、名前（Primary）のキーと誕生日が一致する場合だけ同じとみなす
+// （別の表記に前に登録した名前を入れて、別の人を同じ人として登録し直せないようにする）
+func (h *CrushHistory) IsSamePerson(key IdentityKey, birthday Birthday) bool {
+	return h.IdentityKey().Primary == key.Primary && h.Birthday == birthday
+}
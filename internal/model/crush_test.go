@@ -0,0 +1,52 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindCrush(t *testing.T) {
+	crushes := []*Crush{
+		{ID: 1, Name: "タナカハナコ", Birthday: "1990-05-05"},
+		{ID: 2, Name: "サトウケンタ", Birthday: "1992-03-15"},
+	}
+
+	t.Run("一致する好きな人がいる場合はそれを返す", func(t *testing.T) {
+		found := FindCrush(crushes, NewIdentityKey("サトウケンタ", ""), "1992-03-15")
+		assert.NotNil(t, found)
+		assert.Equal(t, int64(2), found.ID)
+	})
+
+	t.Run("誕生日が異なる場合はnilを返す", func(t *testing.T) {
+		assert.Nil(t, FindCrush(crushes, NewIdentityKey("サトウケンタ", ""), "1992-03-16"))
+	})
+
+	t.Run("別の表記で登録した好きな人も返す", func(t *testing.T) {
+		multi := []*Crush{{ID: 3, Name: "山田花子", AltName: "ヤマダハナコ", Birthday: "1995-01-01"}}
+		found := FindCrush(multi, NewIdentityKey("やまだはなこ", ""), "1995-01-01")
+		assert.NotNil(t, found)
+		assert.Equal(t, int64(3), found.ID)
+	})
+
+	t.Run("好きな人が未登録の場合はnilを返す", func(t *testing.T) {
+		assert.Nil(t, FindCrush(nil, NewIdentityKey("タナカハナコ", ""), "1990-05-05"))
+	})
+}
+
+func TestCrushHistory_IsSamePerson(t *testing.T) {
+	h := &CrushHistory{Name: "タナカハナコ", AltName: "Hanako Tanaka", Birthday: "1990-05-05"}
+
+	t.Run("名前のキーと誕生日が一致すれば同じ人", func(t *testing.T) {
+		assert.True(t, h.IsSamePerson(NewIdentityKey("たなかはなこ", ""), "1990-05-05"))
+	})
+
+	t.Run("誕生日が異なる場合は別の人", func(t *testing.T) {
+		assert.False(t, h.IsSamePerson(NewIdentityKey("タナカハナコ", ""), "1990-05-06"))
+	})
+
+	t.Run("別の表記だけが一致する場合は別の人", func(t *testing.T) {
+		assert.False(t, h.IsSamePerson(NewIdentityKey("サトウケンタ", "タナカハナコ"), "1990-05-05"))
+		assert.False(t, h.IsSamePerson(NewIdentityKey("Hanako Tanaka", ""), "1990-05-05"))
+	})
+}
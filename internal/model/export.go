@@ -0,0 +1,115 @@
+package model
+
+import "time"
+
+// DataExport はユーザー本人に開示するデータ一式（JSON で出力する）
+// 他のユーザーのLINE IDなど、本人以外を特定する情報は含めない
+type DataExport struct {
+	ExportedAt    time.Time              `json:"exported_at"`
+	User          ExportedUser           `json:"user"`
+	Crushes       []ExportedCrush        `json:"crushes"`
+	CrushHistory  []ExportedCrushHistory `json:"crush_history"`
+	MatchHistory  []ExportedMatchHistory `json:"match_history"`
+	Notifications []ExportedNotification `json:"notifications"`
+}
+
+// ExportedUser はユーザー情報（users の1行）
+type ExportedUser struct {
+	LineID          string `json:"line_id"`
+	Name            string `json:"name"`
+	AltName         string `json:"alt_name"`
+	Birthday        string `json:"birthday"`
+	LineDisplayName string `json:"line_display_name"`
+	Matched         bool   `json:"matched"`
+	RegisteredAt    string `json:"registered_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+// ExportedCrush は登録中の好きな人
+type ExportedCrush struct {
+	Name         string `json:"name"`
+	AltName      string `json:"alt_name"`
+	Birthday     string `json:"birthday"`
+	RegisteredAt string `json:"registered_at"`
+}
+
+// ExportedCrushHistory は好きな人の登録の履歴
+type ExportedCrushHistory struct {
+	Name      string             `json:"name"`
+	AltName   string             `json:"alt_name"`
+	Birthday  string             `json:"birthday"`
+	Action    CrushHistoryAction `json:"action"`
+	ChangedAt time.Time          `json:"changed_at"`
+}
+
+// ExportedMatchHistory はマッチングの成立・解除の履歴
+type ExportedMatchHistory struct {
+	PartnerName string             `json:"partner_name"`
+	Action      MatchHistoryAction `json:"action"`
+	ChangedAt   time.Time          `json:"changed_at"`
+}
+
+// ExportedNotification はユーザーに送信した（送信待ちを含む）Push通知
+type ExportedNotification struct {
+	Kind        NotificationKind   `json:"kind"`
+	Text        string             `json:"text"`
+	Status      NotificationStatus `json:"status"`
+	CreatedAt   string             `json:"created_at"`
+	DeliveredAt string             `json:"delivered_at,omitempty"`
+}
+
+// NewDataExport はユーザーのデータから DataExport を作成する
+// 一覧が空の場合も JSON では null ではなく [] になるようにする
+func NewDataExport(user *User, crushes []*Crush, crushHistory []*CrushHistory, matchHistory []*MatchHistory, notifications []*Notification, exportedAt time.Time) *DataExport {
+	export := &DataExport{
+		ExportedAt: exportedAt.UTC(),
+		User: ExportedUser{
+			LineID:          user.LineID,
+			Name:            user.Name,
+			AltName:         user.AltName,
+			Birthday:        user.Birthday.String(),
+			LineDisplayName: user.LineDisplayName,
+			Matched:         user.IsMatched(),
+			RegisteredAt:    user.RegisteredAt,
+			UpdatedAt:       user.UpdatedAt,
+		},
+		Crushes:       make([]ExportedCrush, 0, len(crushes)),
+		CrushHistory:  make([]ExportedCrushHistory, 0, len(crushHistory)),
+		MatchHistory:  make([]ExportedMatchHistory, 0, len(matchHistory)),
+		Notifications: make([]ExportedNotification, 0, len(notifications)),
+	}
+	for _, c := range crushes {
+		export.Crushes = append(export.Crushes, ExportedCrush{
+			Name:         c.Name,
+			AltName:      c.AltName,
+			Birthday:     c.Birthday.String(),
+			RegisteredAt: c.RegisteredAt,
+		})
+	}
+	for _, h := range crushHistory {
+		export.CrushHistory = append(export.CrushHistory, ExportedCrushHistory{
+			Name:      h.Name,
+			AltName:   h.AltName,
+			Birthday:  h.Birthday.String(),
+			Action:    h.Action,
+			ChangedAt: h.ChangedAt.UTC(),
+		})
+	}
+	for _, h := range matchHistory {
+		export.MatchHistory = append(export.MatchHistory, ExportedMatchHistory{
+			PartnerName: h.PartnerName,
+			Action:      h.Action,
+			ChangedAt:   h.ChangedAt.UTC(),
+		})
+	}
+	for _, n := range notifications {
+		export.Notifications = append(export.Notifications, ExportedNotification{
+			Kind:        n.Kind,
+			Text:        n.Text,
+			Status:      n.Status,
+			CreatedAt:   n.CreatedAt,
+			DeliveredAt: n.DeliveredAt,
+		})
+	}
+	return export
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// MatchHistoryAction はマッチングの履歴の種類
+type MatchHistoryAction string
+
+const (
+	MatchStarted MatchHistoryAction = "match"   // マッチング成立
+	MatchEnded   MatchHistoryAction = "unmatch" // マッチング解除
+)
+
+// MatchHistory はマッチングの成立・解除の履歴（match_history の1行）
+// マッチングした2人それぞれに1行ずつ記録する
+type MatchHistory struct {
+	ID            int64
+	UserLineID    string // 履歴の持ち主のLINE ID
+	PartnerLineID string // 相手のLINE ID
+	PartnerName   string // その時点の相手の名前
+	Action        MatchHistoryAction
+	ChangedAt     time.Time
+}
@@ -0,0 +1,234 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 名前の正規化とマッチング用のキー
+//
+// NormalizeName は保存する名前の表記を揃える（入力のゆれを吸収する）
+// NameKey は同じ人かどうかの判定に使う（表記を揃えたうえで、間違えやすい違いを無視する）
+// どちらも名前の表記に合うネームポリシー（NamePolicyFor）に従う
+
+// NormalizeName は入力された名前を、表記に合うネームポリシーで保存する形に揃える
+func NormalizeName(name string) string {
+	return NamePolicyFor(name).Normalize(name)
+}
+
+// ValidateName は NormalizeName で揃えた名前を、表記に合うネームポリシーで検証する
+// 無効な場合は *NameError を返す
+func ValidateName(name string) error {
+	return NamePolicyFor(name).Validate(name)
+}
+
+// NameKey は名前を、表記に合うネームポリシーでマッチング用のキーに変換する
+// 同じキーの名前は同じ人の名前として扱う
+func NameKey(name string) string {
+	return NamePolicyFor(name).Key(name)
+}
+
+// SameName は2つの名前が同じ人の名前かどうかを NameKey で判定する
+func SameName(a, b string) bool {
+	return NameKey(a) == NameKey(b)
+}
+
+// IdentityKey は同じ人かどうかの判定に使う名前のキー
+// 名前（Primary）に加えて、別の表記（Secondary、例: カタカナの名前に対するローマ字）を1つ持てる
+type IdentityKey struct {
+	Primary   string
+	Secondary string // 別の表記が登録されていなければ空
+}
+
+// NewIdentityKey は名前と別の表記（なければ空）から IdentityKey を作る
+func NewIdentityKey(name, altName string) IdentityKey {
+	key := IdentityKey{Primary: NameKey(name)}
+	if altName != "" {
+		key.Secondary = NameKey(altName)
+	}
+	return key
+}
+
+// Matches は、どちらかの表記の組み合わせが1つでも一致すれば true を返す
+func (k IdentityKey) Matches(other IdentityKey) bool {
+	for _, a := range k.keys() {
+		for _, b := range other.keys() {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// keys は空でないキーを返す（退会したユーザーなど、名前が空の場合は一致させない）
+func (k IdentityKey) keys() []string {
+	keys := make([]string, 0, 2)
+	for _, key := range []string{k.Primary, k.Secondary} {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// ========================================
+// カタカナ
+// ========================================
+
+// katakanaNamePolicy は全角カタカナの名前の規則（漢字・英字を含まない名前はすべてこの規則に従う）
+//
+//   - 2〜20文字の全角カタカナ（スペース不可、長音符可、中黒は途中のみ可）
+//   - ひらがな・半角カナで入力されてもカタカナに揃える
+type katakanaNamePolicy struct{}
+
+const (
+	katakanaNameMinLength = 2
+	katakanaNameMaxLength = 20
+)
+
+// longVowelVariants は長音符（ー）として扱う文字（ハイフン・ダッシュ類）
+var longVowelVariants = map[rune]bool{
+	'-': true, '‐': true, '‑': true, '‒': true, '–': true, '—': true, '―': true, '−': true, '─': true, '━': true,
+}
+
+// middleDotVariants は中黒（・）として扱う文字
+var middleDotVariants = map[rune]bool{
+	'·': true, '•': true, '‧': true, '∙': true, '⋅': true,
+}
+
+func (katakanaNamePolicy) Script() NameScript { return NameScriptKatakana }
+
+func (katakanaNamePolicy) Detect(string) bool { return true }
+
+// Normalize は入力された名前を保存する形に揃える
+//
+//   - NFKC 正規化（半角カナ→全角カナ、全角英数→半角英数など）
+//   - ひらがな→カタカナ
+//   - ハイフン・ダッシュ類→長音符（ー）、中点の類→中黒（・）
+//   - 空白（全角スペースを含む）の除去
+func (katakanaNamePolicy) Normalize(name string) string {
+	return normalizeKana(name, true)
+}
+
+// Validate は名前が 2〜20文字の全角カタカナ（スペース不可、中黒は途中のみ可）かを検証する
+func (katakanaNamePolicy) Validate(name string) error {
+	if err := validateLength(NameScriptKatakana, name, katakanaNameMinLength, katakanaNameMaxLength); err != nil {
+		return err
+	}
+
+	runes := []rune(name)
+	for i, r := range runes {
+		isInnerDot := r == '・' && i > 0 && i < len(runes)-1
+		if !unicode.In(r, unicode.Katakana) && r != 'ー' && !isInnerDot {
+			return &NameError{Script: NameScriptKatakana, Violation: NameInvalidChars, MinLength: katakanaNameMinLength, MaxLength: katakanaNameMaxLength}
+		}
+	}
+	return nil
+}
+
+// Key は Normalize で表記を揃えたうえで、ヴ/ブ・ヂ/ジ・ヅ/ズの違い、長音の書き方（ー/ウ/オ）と中黒を無視する
+func (p katakanaNamePolicy) Key(name string) string {
+	return kanaKey(p.Normalize(name))
+}
+
+// normalizeKana は NFKC 正規化・空白の除去・長音符と中黒の統一を行う
+// toKatakana が true の場合はひらがなをカタカナに揃える
+func normalizeKana(name string, toKatakana bool) string {
+	name = norm.NFKC.String(name)
+
+	var b strings.Builder
+	b.Grow(len(name))
+	for _, r := range name {
+		switch {
+		case unicode.IsSpace(r):
+			continue
+		case toKatakana && isHiragana(r):
+			r = hiraganaToKatakana(r)
+		case longVowelVariants[r]:
+			r = 'ー'
+		case middleDotVariants[r]:
+			r = '・'
+		}
+		b.WriteRune(r)
+	}
+	// ひらがなの濁点・半濁点が結合文字で入力された場合に、カタカナに変換した後で合成する
+	return norm.NFC.String(b.String())
+}
+
+// isHiragana はカタカナに揃えるひらがなかを返す
+func isHiragana(r rune) bool {
+	return r >= 'ぁ' && r <= 'ゖ' || r == 'ゝ' || r == 'ゞ'
+}
+
+// hiraganaToKatakana はひらがなをカタカナに変換する（ひらがなとカタカナは同じ並びで 0x60 離れている）
+func hiraganaToKatakana(r rune) rune {
+	return r + 'ァ' - 'ぁ'
+}
+
+// kanaKey はカタカナに揃えた名前の仮名の部分を、マッチング用のキーに変換する（カタカナ・漢字の名前で共通）
+// 規則を変えた場合は、既存の行のキー（users.name_key・crushes.crush_name_key など）を作り直す Go のマイグレーションを追加する（repository.Migrations）
+// 既存の行のキー（users.name_key・crushes.crush_name_key など）は起動時に作り直される（repository.RebuildNameKeys）
+func kanaKey(name string) string {
+	return foldLongVowels(nameKeyReplacer.Replace(name))
+}
+
+// nameKeyReplacer は同じ音の別の書き方を1つに揃える（ヴ→バ行、ヂ→ジなど）
+// ヴァ・ヴィ…はヴより先に置き換える。ヵ・ヶは「ヶ丘」のように大きい仮名と同じ読みで使われるため揃える
+//
+// 小書きの仮名（ャ・ュ・ョ・ッ・ァ など）は読みが変わる（キョウコとキヨウコは別の名前）ため揃えない
+// 入力ミスの可能性がある場合は、マッチングしなかった時に候補として示す（SuggestNames）
+var nameKeyReplacer = strings.NewReplacer(
+	"ヴァ", "バ", "ヴィ", "ビ", "ヴゥ", "ブ", "ヴェ", "ベ", "ヴォ", "ボ", "ヴ", "ブ",
+	"ヵ", "カ", "ヶ", "ケ",
+	"ヂ", "ジ", "ヅ", "ズ", "ヰ", "イ", "ヱ", "エ", "ヲ", "オ",
+	"・", "",
+)
+
+// foldLongVowels は長音の書き方を揃える（タロー・タロウ・タロオ→タロウ、ケーコ・ケイコ→ケイコ）
+//
+//   - 長音符（ー）は直前の仮名の母音に置き換える（エ段はイ、オ段はウ）
+//   - オ段の後のウ・オはウ、エ段の後のイ・エはイにする
+//
+// 直前が母音のない文字（ン・ッ・漢字など）の長音符はそのまま残す
+func foldLongVowels(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	var prev rune // 直前の文字の母音（ア・イ・ウ・エ・オ、なければ 0）
+	for _, r := range name {
+		switch {
+		case r == 'ー' && prev != 0:
+			r = longVowels[prev]
+		case (r == 'ウ' || r == 'オ') && prev == 'オ':
+			r = 'ウ'
+		case (r == 'イ' || r == 'エ') && prev == 'エ':
+			r = 'イ'
+		}
+		b.WriteRune(r)
+		prev = kanaVowels[r]
+	}
+	return b.String()
+}
+
+// longVowels は母音ごとの長音の書き方（エ段はエイ、オ段はオウと書くことが多いため、イ・ウに揃える）
+var longVowels = map[rune]rune{'ア': 'ア', 'イ': 'イ', 'ウ': 'ウ', 'エ': 'イ', 'オ': 'ウ'}
+
+// kanaVowels はカタカナの母音（拗音の小書きの仮名は、その仮名の母音）
+var kanaVowels = func() map[rune]rune {
+	rows := map[rune]string{
+		'ア': "アカガサザタダナハバパマヤラワァャヮ",
+		'イ': "イキギシジチニヒビピミリィ",
+		'ウ': "ウクグスズツヌフブプムユルゥュ",
+		'エ': "エケゲセゼテデネヘベペメレェ",
+		'オ': "オコゴソゾトドノホボポモヨロォョ",
+	}
+	vowels := make(map[rune]rune)
+	for vowel, row := range rows {
+		for _, r := range row {
+			vowels[r] = vowel
+		}
+	}
+	return vowels
+}()
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// kanjiNamePolicy は漢字の名前の規則（漢字を1文字でも含む名前はこの規則に従う）
+//
+//   - 2〜20文字の漢字・ひらがな・カタカナ（スペース不可、長音符・々可、中黒は途中のみ可）
+//   - 漢字を1文字以上含む
+//   - ひらがなは入力されたまま保存し、マッチング用のキーではカタカナと同じに扱う
+type kanjiNamePolicy struct{}
+
+const (
+	kanjiNameMinLength = 2
+	kanjiNameMaxLength = 20
+)
+
+func (kanjiNamePolicy) Script() NameScript { return NameScriptKanji }
+
+// Detect は名前に漢字が含まれているかを返す（互換漢字は NFKC で揃えてから判定する）
+func (kanjiNamePolicy) Detect(name string) bool {
+	return strings.ContainsFunc(norm.NFKC.String(name), isKanji)
+}
+
+// Normalize は NFKC 正規化・空白の除去・長音符と中黒の統一を行う（ひらがなはそのまま）
+func (kanjiNamePolicy) Normalize(name string) string {
+	return normalizeKana(name, false)
+}
+
+// Validate は名前が 2〜20文字の漢字・ひらがな・カタカナで、漢字を含むかを検証する
+func (kanjiNamePolicy) Validate(name string) error {
+	if err := validateLength(NameScriptKanji, name, kanjiNameMinLength, kanjiNameMaxLength); err != nil {
+		return err
+	}
+
+	invalid := &NameError{Script: NameScriptKanji, Violation: NameInvalidChars, MinLength: kanjiNameMinLength, MaxLength: kanjiNameMaxLength}
+	if !strings.ContainsFunc(name, isKanji) {
+		return invalid
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		isInnerDot := r == '・' && i > 0 && i < len(runes)-1
+		if !isKanji(r) && !unicode.In(r, unicode.Hiragana, unicode.Katakana) && r != 'ー' && !isInnerDot {
+			return invalid
+		}
+	}
+	return nil
+}
+
+// Key は表記を揃えたうえで、仮名の部分をカタカナの名前と同じように扱う（ひらがな→カタカナ、ヴ/ブ・長音の書き方などの違いを無視）
+// 漢字の部分はそのまま比較する（異体字は区別する）
+func (kanjiNamePolicy) Key(name string) string {
+	return kanaKey(normalizeKana(name, true))
+}
+
+// isKanji は漢字（々・〆を含む）かを返す
+func isKanji(r rune) bool {
+	return unicode.Is(unicode.Han, r)
+}
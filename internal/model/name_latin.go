@@ -0,0 +1,103 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// latinNamePolicy はローマ字（英字）の名前の規則（英字を1文字でも含み、漢字を含まない名前はこの規則に従う）
+//
+//   - 2〜40文字の英字（アクセント記号付きを含む）
+//   - 単語の区切りとしてスペース・ハイフン・アポストロフィを1つずつ使える（先頭・末尾は不可）
+//   - 大文字・小文字、アクセント記号、区切りの違いはマッチング用のキーでは無視する
+type latinNamePolicy struct{}
+
+const (
+	latinNameMinLength = 2
+	latinNameMaxLength = 40
+)
+
+// apostropheVariants はアポストロフィ（'）として扱う文字
+var apostropheVariants = map[rune]bool{
+	'’': true, '‘': true, 'ʼ': true, '`': true, '´': true,
+}
+
+func (latinNamePolicy) Script() NameScript { return NameScriptLatin }
+
+// Detect は名前に英字が含まれているかを返す（全角英字は NFKC で揃えてから判定する）
+func (latinNamePolicy) Detect(name string) bool {
+	return strings.ContainsFunc(norm.NFKC.String(name), isLatinLetter)
+}
+
+// Normalize は入力された名前を保存する形に揃える
+//
+//   - NFKC 正規化（全角英字→半角英字など）
+//   - ハイフン・ダッシュ類→ハイフン（-）、アポストロフィの類→アポストロフィ（'）
+//   - 連続する空白を1つのスペースにまとめ、先頭・末尾の空白を除去
+//
+// 大文字・小文字は入力されたまま保存する
+func (latinNamePolicy) Normalize(name string) string {
+	name = norm.NFKC.String(name)
+
+	var b strings.Builder
+	b.Grow(len(name))
+	for _, r := range name {
+		switch {
+		case longVowelVariants[r]:
+			r = '-'
+		case apostropheVariants[r]:
+			r = '\''
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFC.String(strings.Join(strings.Fields(b.String()), " "))
+}
+
+// Validate は名前が 2〜40文字の英字で、区切りが単語の間に1つずつかを検証する
+func (latinNamePolicy) Validate(name string) error {
+	if err := validateLength(NameScriptLatin, name, latinNameMinLength, latinNameMaxLength); err != nil {
+		return err
+	}
+
+	invalid := &NameError{Script: NameScriptLatin, Violation: NameInvalidChars, MinLength: latinNameMinLength, MaxLength: latinNameMaxLength}
+	prevLetter := false
+	for _, r := range name {
+		switch {
+		case isLatinLetter(r):
+			prevLetter = true
+		case isLatinSeparator(r) && prevLetter:
+			prevLetter = false
+		default:
+			return invalid
+		}
+	}
+	if !prevLetter {
+		return invalid
+	}
+	return nil
+}
+
+// Key は名前を小文字にし、アクセント記号と区切り（スペース・ハイフン・アポストロフィ）を取り除く
+// 例: "José O'Brien" → "joseobrien"
+func (p latinNamePolicy) Key(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(p.Normalize(name)) {
+		if unicode.Is(unicode.Mn, r) || isLatinSeparator(r) {
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// isLatinLetter は英字（アクセント記号付きを含む）かを返す
+func isLatinLetter(r rune) bool {
+	return unicode.IsLetter(r) && unicode.Is(unicode.Latin, r)
+}
+
+// isLatinSeparator はローマ字の名前で単語の区切りに使える文字かを返す
+func isLatinSeparator(r rune) bool {
+	return r == ' ' || r == '-' || r == '\''
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// 名前の表記ごとの規則（ネームポリシー）
+//
+// 名前はカタカナ・漢字・ローマ字のいずれかの表記で登録できる。
+// 表記ごとに正規化・バリデーション・マッチング用のキーの作り方が異なるため、
+// NamePolicy として実装し、入力された名前から NamePolicyFor で選ぶ。
+
+// NameScript は名前の表記の種類
+type NameScript string
+
+const (
+	NameScriptKatakana NameScript = "katakana" // 全角カタカナ
+	NameScriptKanji    NameScript = "kanji"    // 漢字（ひらがな・カタカナ混じりを含む）
+	NameScriptLatin    NameScript = "latin"    // ローマ字（英字）
+)
+
+// NameViolation は名前のバリデーションで違反した規則の種類
+type NameViolation string
+
+const (
+	NameTooShort     NameViolation = "too_short"     // 文字数が足りない
+	NameTooLong      NameViolation = "too_long"      // 文字数が多すぎる
+	NameInvalidChars NameViolation = "invalid_chars" // 使えない文字・区切り方を含む
+)
+
+// NameError は名前のバリデーションエラー
+// ユーザーに表示するメッセージは表記（Script）と違反した規則（Violation）から呼び出し側で選ぶ
+type NameError struct {
+	Script    NameScript
+	Violation NameViolation
+	MinLength int
+	MaxLength int
+}
+
+func (e *NameError) Error() string {
+	return fmt.Sprintf("invalid %s name: %s (%d-%d characters)", e.Script, e.Violation, e.MinLength, e.MaxLength)
+}
+
+// NamePolicy は1つの表記の名前の規則
+type NamePolicy interface {
+	// Script は表記の種類を返す
+	Script() NameScript
+	// Detect は入力された名前がこの表記で書かれているかを返す
+	Detect(name string) bool
+	// Normalize は入力された名前を保存する形に揃える
+	Normalize(name string) string
+	// Validate は Normalize で揃えた名前が有効かを検証する（無効なら *NameError を返す）
+	Validate(name string) error
+	// Key は名前をマッチング用のキーに変換する（同じキーの名前は同じ人の名前として扱う）
+	Key(name string) string
+}
+
+// namePolicies は Detect で表記を判定する順番に並べたネームポリシー
+// 漢字を含む名前は漢字、英字を含む名前はローマ字、それ以外はカタカナとして扱う
+var namePolicies = []NamePolicy{
+	kanjiNamePolicy{},
+	latinNamePolicy{},
+	katakanaNamePolicy{},
+}
+
+// NamePolicyFor は名前の表記に合うネームポリシーを返す（どれにも当てはまらなければカタカナ）
+func NamePolicyFor(name string) NamePolicy {
+	for _, p := range namePolicies {
+		if p.Detect(name) {
+			return p
+		}
+	}
+	return katakanaNamePolicy{}
+}
+
+// validateLength は名前の文字数を検証する
+func validateLength(script NameScript, name string, minLength, maxLength int) error {
+	switch n := utf8.RuneCountInString(name); {
+	case n < minLength:
+		return &NameError{Script: script, Violation: NameTooShort, MinLength: minLength, MaxLength: maxLength}
+	case n > maxLength:
+		return &NameError{Script: script, Violation: NameTooLong, MinLength: minLength, MaxLength: maxLength}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package model
+
+import "strings"
+
+// maxNameSuggestions は SuggestNames が返す候補の最大数
+const maxNameSuggestions = 3
+
+// SuggestNames はカタカナの名前について、入力ミスの多い違いを直した候補を返す
+//
+//   - 拗音・促音の大きさ（キヨウコ↔キョウコ、ハツトリ↔ハットリ）
+//   - 末尾の長音の抜け（タナカタロ→タナカタロウ、シンペ→シンペイ）
+//
+// どちらも読みが変わるため NameKey では同じ名前として扱わず、マッチングしなかった時に
+// 別の表記として登録するかを本人に選んでもらう。候補は登録済みの名前を調べずに作る
+// （誰が登録されているかを候補から推測できないようにするため）。
+// 名前と同じキーになる候補・無効な候補は含めない（カタカナ以外の名前は nil）
+func SuggestNames(name string) []string {
+	name = NormalizeName(name)
+	if NamePolicyFor(name).Script() != NameScriptKatakana || ValidateName(name) != nil {
+		return nil
+	}
+
+	runes := []rune(name)
+	var candidates []string
+	for i := range runes {
+		if swapped, ok := swapKanaSize(runes, i); ok {
+			candidate := append([]rune{}, runes...)
+			candidate[i] = swapped
+			candidates = append(candidates, string(candidate))
+		}
+	}
+	if vowel, ok := missingLongVowel(runes[len(runes)-1]); ok {
+		candidates = append(candidates, name+string(vowel))
+	}
+
+	key := NameKey(name)
+	seen := map[string]bool{key: true}
+	var suggestions []string
+	for _, c := range candidates {
+		k := NameKey(c)
+		if seen[k] || ValidateName(c) != nil {
+			continue
+		}
+		seen[k] = true
+		suggestions = append(suggestions, c)
+		if len(suggestions) == maxNameSuggestions {
+			break
+		}
+	}
+	return suggestions
+}
+
+// smallKana は大きさを間違えやすい仮名（大きい仮名→小書きの仮名）
+var smallKana = map[rune]rune{'ヤ': 'ャ', 'ユ': 'ュ', 'ヨ': 'ョ', 'ツ': 'ッ'}
+
+// largeKana は smallKana の逆（小書きの仮名→大きい仮名）
+var largeKana = func() map[rune]rune {
+	large := make(map[rune]rune, len(smallKana))
+	for l, s := range smallKana {
+		large[s] = l
+	}
+	return large
+}()
+
+// swapKanaSize は runes[i] の大きさを入れ替えた仮名を返す（入れ替えると読める位置の場合だけ ok）
+//
+//   - ヤ・ユ・ヨ は、イ段の仮名（イを除く）の後だけ小さくする
+//   - ツ は、名前の途中で、母音以外の仮名の前だけ小さくする
+func swapKanaSize(runes []rune, i int) (rune, bool) {
+	r := runes[i]
+	if large, ok := largeKana[r]; ok {
+		return large, i > 0
+	}
+	small, ok := smallKana[r]
+	if !ok || i == 0 {
+		return 0, false
+	}
+	if r == 'ツ' {
+		if i == len(runes)-1 {
+			return 0, false
+		}
+		next := runes[i+1]
+		_, isKana := kanaVowels[next]
+		return small, isKana && !isVowelKana(next) && !isSmallKana(next)
+	}
+	prev := runes[i-1]
+	return small, kanaVowels[prev] == 'イ' && !isVowelKana(prev) && !isSmallKana(prev)
+}
+
+// missingLongVowel は末尾の仮名の後に抜けていそうな長音（オ段・ュ→ウ、エ段→イ）を返す
+// 「〜コ」は「子」の読みで長音が付かないことがほとんどのため除く
+func missingLongVowel(last rune) (rune, bool) {
+	if isVowelKana(last) || last == 'コ' {
+		return 0, false
+	}
+	switch {
+	case kanaVowels[last] == 'オ', last == 'ュ':
+		return 'ウ', true
+	case kanaVowels[last] == 'エ':
+		return 'イ', true
+	}
+	return 0, false
+}
+
+// isSmallKana は小書きの仮名かを返す
+func isSmallKana(r rune) bool {
+	return strings.ContainsRune("ァィゥェォャュョッヮ", r)
+}
+
+// isVowelKana は母音の仮名（ア・イ・ウ・エ・オ）かを返す
+func isVowelKana(r rune) bool {
+	return r == 'ア' || r == 'イ' || r == 'ウ' || r == 'エ' || r == 'オ'
+}
@@ -0,0 +1,263 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "全角カタカナはそのまま", input: "ヤマダタロウ", expected: "ヤマダタロウ"},
+		{name: "ひらがな→カタカナ", input: "やまだたろう", expected: "ヤマダタロウ"},
+		{name: "ゔ→ヴ", input: "ゔぃくとる", expected: "ヴィクトル"},
+		{name: "半角カナ→全角カナ（濁点を合成する）", input: "ﾔﾏﾀﾞﾀﾛｳ", expected: "ヤマダタロウ"},
+		{name: "半角の長音符", input: "ｻﾄｰ", expected: "サトー"},
+		{name: "ハイフン・ダッシュ→長音符", input: "サト-ユ―キ", expected: "サトーユーキ"},
+		{name: "中点の類→中黒", input: "ジョン·スミス", expected: "ジョン・スミス"},
+		{name: "半角の中黒", input: "ジョン･スミス", expected: "ジョン・スミス"},
+		{name: "空白（全角を含む）を除去", input: " ヤマダ　タロウ ", expected: "ヤマダタロウ"},
+		{name: "結合文字の濁点", input: "がき", expected: "ガキ"},
+		{name: "漢字はそのまま", input: "山田太郎", expected: "山田太郎"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeName(tt.input))
+		})
+	}
+}
+
+func TestNameKey(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "ヤマダタロウ", expected: "ヤマダタロウ"},
+		{input: "ヴァイオリン", expected: "バイオリン"},
+		{input: "ヴィヴィアン", expected: "ビビアン"},
+		{input: "ヴ", expected: "ブ"},
+		{input: "キョウコ", expected: "キョウコ"},
+		{input: "ハットリ", expected: "ハットリ"},
+		{input: "ミカヅキ", expected: "ミカズキ"},
+		{input: "チヂミ", expected: "チジミ"},
+		{input: "ジョン・スミス", expected: "ジョンスミス"},
+		{input: "マーサー", expected: "マアサア"},
+		{input: "タロー", expected: "タロウ"},
+		{input: "トオル", expected: "トウル"},
+		{input: "ケーコ", expected: "ケイコ"},
+		{input: "キョーコ", expected: "キョウコ"},
+		{input: "ユーキ", expected: "ユウキ"},
+		{input: "ジュンー", expected: "ジュンー"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NameKey(tt.input))
+		})
+	}
+}
+
+func TestSameName(t *testing.T) {
+	t.Run("ヴとブの違いは同じ名前として扱う", func(t *testing.T) {
+		assert.True(t, SameName("ヴィクトル", "ビクトル"))
+	})
+
+	t.Run("長音の書き方の違いは同じ名前として扱う", func(t *testing.T) {
+		assert.True(t, SameName("タロー", "タロウ"))
+		assert.True(t, SameName("サトー", "サトオ"))
+		assert.True(t, SameName("ケーコ", "ケイコ"))
+	})
+
+	t.Run("小書きの仮名の違いは区別する（読みが変わるため、候補として示す）", func(t *testing.T) {
+		assert.False(t, SameName("キョウコ", "キヨウコ"))
+		assert.False(t, SameName("ハットリ", "ハツトリ"))
+	})
+
+	t.Run("ひらがな・半角カナでも同じ名前として扱う", func(t *testing.T) {
+		assert.True(t, SameName("やまだたろう", "ﾔﾏﾀﾞﾀﾛｳ"))
+	})
+
+	// 末尾の長音の抜けは別の名前の可能性があるため、同じ名前として扱わずに候補として示す（TestSuggestNames）
+	t.Run("末尾の長音が抜けた名前は区別する", func(t *testing.T) {
+		assert.False(t, SameName("タナカタロ", "タナカタロウ"))
+	})
+
+	// 候補を別の表記として追加すると、正しい名前で登録した人と同じ人として扱う
+	t.Run("末尾の長音が抜けた名前も、候補を別の表記に加えれば同じ人", func(t *testing.T) {
+		typo := NewIdentityKey("タナカタロ", "")
+		correct := NewIdentityKey("タナカタロウ", "")
+		assert.False(t, typo.Matches(correct))
+
+		suggestions := SuggestNames("タナカタロ")
+		assert.Contains(t, suggestions, "タナカタロウ")
+		assert.True(t, NewIdentityKey("タナカタロ", suggestions[0]).Matches(correct))
+	})
+}
+
+func TestSuggestNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{name: "末尾の長音の抜け（オ段）", input: "タナカタロ", expected: []string{"タナカタロウ"}},
+		{name: "末尾の長音の抜け（エ段）", input: "タナカシンペ", expected: []string{"タナカシンペイ"}},
+		{name: "大きい拗音", input: "キヨウコ", expected: []string{"キョウコ"}},
+		{name: "小さい拗音", input: "キョウコ", expected: []string{"キヨウコ"}},
+		{name: "大きい促音", input: "ハツトリ", expected: []string{"ハットリ"}},
+		{name: "ひらがなは揃えてから候補を作る", input: "はつとりじよう", expected: []string{"ハットリジヨウ", "ハツトリジョウ"}},
+		{name: "名前と同じキーの候補は含めない", input: "タロー", expected: nil},
+		{name: "候補は3つまで", input: "キヨシツキヨシツ", expected: []string{"キョシツキヨシツ", "キヨシッキヨシツ", "キヨシツキョシツ"}},
+		{name: "候補がない名前", input: "ヤマダハナ", expected: nil},
+		{name: "カタカナ以外の名前", input: "山田太郎", expected: nil},
+		{name: "無効な名前", input: "ア", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SuggestNames(tt.input))
+		})
+	}
+}
+
+func TestNormalizeName_Scripts(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "漢字のひらがなはそのまま", input: "山田 さくら", expected: "山田さくら"},
+		{name: "漢字と半角カナ", input: "山田ｻｸﾗ", expected: "山田サクラ"},
+		{name: "ローマ字の全角英字・連続する空白", input: "Ｔａｒｏ　　Yamada ", expected: "Taro Yamada"},
+		{name: "ローマ字のアポストロフィの類", input: "Conan O’Brien", expected: "Conan O'Brien"},
+		{name: "ローマ字のダッシュ類", input: "Anne–Marie", expected: "Anne-Marie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeName(tt.input))
+		})
+	}
+}
+
+func TestNamePolicyFor(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected NameScript
+	}{
+		{input: "ヤマダタロウ", expected: NameScriptKatakana},
+		{input: "やまだたろう", expected: NameScriptKatakana},
+		{input: "", expected: NameScriptKatakana},
+		{input: "山田太郎", expected: NameScriptKanji},
+		{input: "山田さくら", expected: NameScriptKanji},
+		{input: "Taro Yamada", expected: NameScriptLatin},
+		{input: "Ｙａｍａｄａ", expected: NameScriptLatin},
+		{input: "Yamadaタロウ", expected: NameScriptLatin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NamePolicyFor(tt.input).Script())
+		})
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		violation NameViolation // 空なら有効
+		script    NameScript
+	}{
+		// カタカナ
+		{name: "カタカナ", input: "ヤマダタロウ"},
+		{name: "カタカナの中黒", input: "ジョン・スミス"},
+		{name: "カタカナの先頭の中黒", input: "・ジョン", violation: NameInvalidChars, script: NameScriptKatakana},
+		{name: "カタカナの1文字", input: "ア", violation: NameTooShort, script: NameScriptKatakana},
+		{name: "カタカナの21文字", input: "アイウエオカキクケコサシスセソタチツテトナ", violation: NameTooLong, script: NameScriptKatakana},
+		{name: "カタカナのスペース", input: "ヤマダ タロウ", violation: NameInvalidChars, script: NameScriptKatakana},
+		{name: "記号のみ", input: "！？", violation: NameInvalidChars, script: NameScriptKatakana},
+		{name: "空文字", input: "", violation: NameTooShort, script: NameScriptKatakana},
+		// 漢字
+		{name: "漢字", input: "山田太郎"},
+		{name: "漢字とひらがな", input: "山田さくら"},
+		{name: "漢字の々", input: "佐々木"},
+		{name: "漢字の1文字", input: "林", violation: NameTooShort, script: NameScriptKanji},
+		{name: "漢字の21文字", input: "山田山田山田山田山田山田山田山田山田山田山", violation: NameTooLong, script: NameScriptKanji},
+		{name: "漢字のスペース", input: "山田 太郎", violation: NameInvalidChars, script: NameScriptKanji},
+		{name: "漢字と記号", input: "山田★", violation: NameInvalidChars, script: NameScriptKanji},
+		// ローマ字
+		{name: "ローマ字", input: "Taro Yamada"},
+		{name: "ローマ字のハイフン・アポストロフィ", input: "Anne-Marie O'Brien"},
+		{name: "ローマ字のアクセント記号", input: "José García"},
+		{name: "ローマ字の1文字", input: "A", violation: NameTooShort, script: NameScriptLatin},
+		{name: "ローマ字の41文字", input: "Abcdefghijklmnopqrstuvwxyzabcdefghijklmno", violation: NameTooLong, script: NameScriptLatin},
+		{name: "ローマ字の連続する区切り", input: "Anne--Marie", violation: NameInvalidChars, script: NameScriptLatin},
+		{name: "ローマ字の末尾の区切り", input: "Taro-", violation: NameInvalidChars, script: NameScriptLatin},
+		{name: "ローマ字と数字", input: "Taro2", violation: NameInvalidChars, script: NameScriptLatin},
+		{name: "ローマ字とカタカナ", input: "Yamadaタロウ", violation: NameInvalidChars, script: NameScriptLatin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateName(tt.input)
+			if tt.violation == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var nameErr *NameError
+			if assert.ErrorAs(t, err, &nameErr) {
+				assert.Equal(t, tt.script, nameErr.Script)
+				assert.Equal(t, tt.violation, nameErr.Violation)
+			}
+		})
+	}
+}
+
+func TestNameKey_Scripts(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "山田さくら", expected: "山田サクラ"},
+		{input: "山田きょうこ", expected: "山田キョウコ"},
+		{input: "佐藤ゆーこ", expected: "佐藤ユウコ"},
+		{input: "Taro Yamada", expected: "taroyamada"},
+		{input: "José O'Brien", expected: "joseobrien"},
+		{input: "ANNE-MARIE", expected: "annemarie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NameKey(tt.input))
+		})
+	}
+}
+
+func TestIdentityKey_Matches(t *testing.T) {
+	t.Run("名前同士が一致すればtrue", func(t *testing.T) {
+		assert.True(t, NewIdentityKey("ヤマダタロウ", "").Matches(NewIdentityKey("やまだたろう", "")))
+	})
+
+	t.Run("別の表記と名前が一致すればtrue", func(t *testing.T) {
+		assert.True(t, NewIdentityKey("山田太郎", "Taro Yamada").Matches(NewIdentityKey("taro yamada", "")))
+		assert.True(t, NewIdentityKey("ヤマダタロウ", "").Matches(NewIdentityKey("山田太郎", "ヤマダタロウ")))
+	})
+
+	t.Run("別の表記同士が一致すればtrue", func(t *testing.T) {
+		assert.True(t, NewIdentityKey("山田太郎", "Taro Yamada").Matches(NewIdentityKey("ヤマダタロウ", "TARO YAMADA")))
+	})
+
+	t.Run("どの組み合わせも一致しなければfalse", func(t *testing.T) {
+		assert.False(t, NewIdentityKey("山田太郎", "Taro Yamada").Matches(NewIdentityKey("ヤマダタロウ", "Yamada Taro")))
+	})
+
+	t.Run("空の名前同士は一致しない", func(t *testing.T) {
+		assert.False(t, NewIdentityKey("", "").Matches(NewIdentityKey("", "")))
+	})
+}
@@ -0,0 +1,34 @@
+package model
+
+// NotificationKind はPush通知の種類
+type NotificationKind string
+
+const (
+	NotificationMatch   NotificationKind = "match"   // マッチング成立
+	NotificationUnmatch NotificationKind = "unmatch" // マッチング解除
+)
+
+// NotificationStatus はPush通知の送信状況
+type NotificationStatus string
+
+const (
+	NotificationPending   NotificationStatus = "pending"   // 送信待ち・再送待ち
+	NotificationDelivered NotificationStatus = "delivered" // 送信済み
+	NotificationDead      NotificationStatus = "dead"      // 再送を諦めた
+)
+
+// Notification は送信待ちのPush通知（notification_outbox の1行）
+// マッチング成立・解除と同じトランザクションで記録し、バックグラウンドで送信する
+type Notification struct {
+	ID            int64
+	ToUserID      string // 送信先のLINE ID
+	Kind          NotificationKind
+	Text          string
+	RetryKey      string // X-Line-Retry-Key（再送しても二重に届かないよう、すべての送信で同じ値を使う）
+	Status        NotificationStatus
+	Attempts      int
+	NextAttemptAt string
+	LastError     string
+	CreatedAt     string
+	DeliveredAt   string
+}
@@ -0,0 +1,15 @@
+package model
+
+// Reply はLINEのトークで返信するメッセージ
+type Reply struct {
+	Text         string
+	QuickReplies []QuickReply
+}
+
+// QuickReply はメッセージに付けるクイックリプライのボタン
+// URL を指定するとページを開き、PostbackData を指定するとポストバックイベントを送る
+type QuickReply struct {
+	Label        string // ボタンの表示名（20文字まで）
+	URL          string
+	PostbackData string
+}
@@ -1,8 +1,6 @@
 package model
 
 import (
-	"unicode"
-
 	"github.com/aarondl/null/v8"
 )
 
@@ -10,47 +8,33 @@ import (
 type User struct {
 	LineID             string
 	Name               string
-	Birthday           string
-	CrushName          null.String // 好きな人の名前（NULL=未設定）
-	CrushBirthday      null.String // 好きな人の誕生日（NULL=未設定）
+	Birthday           Birthday
 	MatchedWithUserID  null.String // マッチング相手のLINE ID（NULL=未マッチ）
 	RegisteredAt       string
 	UpdatedAt          string
+	LineDisplayName    string // LINE の表示名（管理者向けの参考情報、マッチングには使わない）
+	AltName            string // 名前の別の表記（例: カタカナの名前に対するローマ字、なければ空）
 }
 
-// IsSamePerson は、指定された名前と誕生日が自分と一致するかをチェックする
-func (u *User) IsSamePerson(name, birthday string) bool {
-	return u.Name == name && u.Birthday == birthday
+// UserStatus はユーザーの登録・好きな人・マッチングの状況（LIFFの画面で表示する）
+type UserStatus struct {
+	User            *User    // 未登録ならnil
+	Crushes         []*Crush // 登録中の好きな人
+	MatchedUserName string   // マッチング相手の名前（マッチングしていなければ空）
 }
 
-// IsMatched は、マッチング中かどうかを返す
-func (u *User) IsMatched() bool {
-	return u.MatchedWithUserID.Valid
+// IdentityKey は名前と別の表記から同じ人かどうかの判定に使うキーを返す
+func (u *User) IdentityKey() IdentityKey {
+	return NewIdentityKey(u.Name, u.AltName)
 }
 
-// HasCrush は、好きな人が登録されているかを返す
-func (u *User) HasCrush() bool {
-	return u.CrushName.Valid && u.CrushBirthday.Valid
+// IsSamePerson は、指定された名前のキーと誕生日が自分と一致するかをチェックする
+// 名前はどちらかの表記の組み合わせが1つでも一致すれば同じとみなす
+func (u *User) IsSamePerson(key IdentityKey, birthday Birthday) bool {
+	return u.IdentityKey().Matches(key) && u.Birthday == birthday
 }
 
-// IsValidName は名前が有効なカタカナ文字列かをチェックする
-// 2〜20文字の全角カタカナ（スペース不可）であること
-// 返り値: (有効かどうか, エラーメッセージ)
-func IsValidName(name string) (bool, string) {
-	runes := []rune(name)
-	length := len(runes)
-
-	// 長さチェック: 2〜20文字
-	if length < 2 || length > 20 {
-		return false, "名前は2〜20文字で入力してください"
-	}
-
-	// カタカナチェック: 全角カタカナのみ（長音符を含む）
-	for _, r := range name {
-		if !unicode.In(r, unicode.Katakana) && r != 'ー' {
-			return false, "名前は全角カタカナ2〜20文字で入力してください（スペース不可）"
-		}
-	}
-
-	return true, ""
+// IsMatched は、マッチング中かどうかを返す
+func (u *User) IsMatched() bool {
+	return u.MatchedWithUserID.Valid
 }
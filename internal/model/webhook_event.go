@@ -0,0 +1,24 @@
+package model
+
+// WebhookEventStatus はWebhookイベントの処理状況
+type WebhookEventStatus string
+
+const (
+	WebhookEventProcessing WebhookEventStatus = "processing" // 処理中（再送されても処理しない。処理が中断されたまま一定時間が経てば再処理する）
+	WebhookEventSucceeded  WebhookEventStatus = "succeeded"  // 処理済み（再送されても処理しない）
+	WebhookEventFailed     WebhookEventStatus = "failed"     // 処理に失敗（再送された場合は再処理する）
+)
+
+// WebhookEvent は受信したWebhookイベントの処理記録
+// LINE の再送（deliveryContext.isRedelivery）で同じイベントを二重に処理しないために使う
+type WebhookEvent struct {
+	ID           string // webhookEventId
+	EventType    string
+	SourceID     string // 送信元のユーザー・グループ・トークルームID
+	IsRedelivery bool
+	Status       WebhookEventStatus
+	Error        string // 失敗した場合のエラー内容
+	ReceivedAt   string
+	ProcessedAt  string
+	ClaimedAt    string // 処理を始めた日時
+}
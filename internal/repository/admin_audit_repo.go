@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/morinonusi421/cupid/entities"
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+// AdminAuditRepository は管理APIの操作の記録（admin_audit_log）のデータアクセス層のインターフェース
+//
+// Record を UserRepository.WithTx の fn 内で呼ぶと、操作と同じトランザクションで記録される
+type AdminAuditRepository interface {
+	// Record は操作を1件記録する（entry.ID には保存後の値が設定される）
+	Record(ctx context.Context, entry *model.AdminAuditEntry) error
+	// ListSince は since 以降の記録を古い順に返す
+	ListSince(ctx context.Context, since time.Time) ([]*model.AdminAuditEntry, error)
+}
+
+type adminAuditRepository struct {
+	db *sql.DB
+}
+
+// NewAdminAuditRepository は AdminAuditRepository の新しいインスタンスを作成する
+func NewAdminAuditRepository(db *sql.DB) AdminAuditRepository {
+	return &adminAuditRepository{db: db}
+}
+
+// Record は操作を1件記録する（CreatedAt が空の場合は現在時刻）
+func (r *adminAuditRepository) Record(ctx context.Context, entry *model.AdminAuditEntry) error {
+	createdAt := entry.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	e := &entities.AdminAuditLog{
+		Actor:     entry.Actor,
+		RemoteIP:  entry.RemoteIP,
+		Action:    string(entry.Action),
+		Target:    entry.Target,
+		Detail:    entry.Detail,
+		Result:    entry.Result,
+		CreatedAt: createdAt.UTC().Format(sqliteTimeFormat),
+	}
+	if err := e.Insert(ctx, executorFromContext(ctx, r.db), boil.Infer()); err != nil {
+		return err
+	}
+
+	recorded, err := adminAuditEntityToModel(e)
+	if err != nil {
+		return err
+	}
+	*entry = *recorded
+	return nil
+}
+
+// ListSince は since 以降の記録を古い順に返す
+func (r *adminAuditRepository) ListSince(ctx context.Context, since time.Time) ([]*model.AdminAuditEntry, error) {
+	entityEntries, err := entities.AdminAuditLogs(
+		qm.Where(entities.AdminAuditLogColumns.CreatedAt+" >= ?", since.UTC().Format(sqliteTimeFormat)),
+		qm.OrderBy(entities.AdminAuditLogColumns.CreatedAt+", "+entities.AdminAuditLogColumns.ID),
+	).All(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.AdminAuditEntry, 0, len(entityEntries))
+	for _, e := range entityEntries {
+		entry, err := adminAuditEntityToModel(e)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// adminAuditEntityToModel は entities.AdminAuditLog を model.AdminAuditEntry に変換する
+func adminAuditEntityToModel(e *entities.AdminAuditLog) (*model.AdminAuditEntry, error) {
+	createdAt, err := time.Parse(sqliteTimeFormat, e.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid created_at %q: %w", e.CreatedAt, err)
+	}
+	return &model.AdminAuditEntry{
+		ID:        e.ID.Int64,
+		Actor:     e.Actor,
+		RemoteIP:  e.RemoteIP,
+		Action:    model.AdminAction(e.Action),
+		Target:    e.Target,
+		Detail:    e.Detail,
+		Result:    e.Result,
+		CreatedAt: createdAt,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+func TestAdminAuditRepository_RecordList(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewAdminAuditRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := []*model.AdminAuditEntry{
+		{Actor: "token", RemoteIP: "127.0.0.1", Action: model.AdminStatsView, Result: model.AdminResultOK, CreatedAt: base.Add(-time.Hour)},
+		{Actor: "basic:ops", RemoteIP: "10.0.0.5", Action: model.AdminUserDelete, Target: "U-alice", Result: model.AdminResultOK, CreatedAt: base.Add(time.Hour)},
+		{Actor: "token", RemoteIP: "127.0.0.1", Action: model.AdminUserLookup, Target: "U-bob", Detail: "line_id=U-bob", Result: "user not found", CreatedAt: base},
+	}
+	for _, e := range entries {
+		if err := repo.Record(ctx, e); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+		if e.ID == 0 {
+			t.Error("Expected ID to be set")
+		}
+	}
+
+	// since 以降の記録を古い順に返す
+	got, err := repo.ListSince(ctx, base)
+	if err != nil {
+		t.Fatalf("ListSince failed: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(got))
+	}
+	if got[0].Action != model.AdminUserLookup || got[1].Action != model.AdminUserDelete {
+		t.Errorf("Unexpected order: %s, %s", got[0].Action, got[1].Action)
+	}
+	if got[0].Target != "U-bob" || got[0].Detail != "line_id=U-bob" || got[0].Result != "user not found" {
+		t.Errorf("Unexpected entry: %+v", got[0])
+	}
+	if got[1].Actor != "basic:ops" || got[1].RemoteIP != "10.0.0.5" {
+		t.Errorf("Unexpected actor: %s (%s)", got[1].Actor, got[1].RemoteIP)
+	}
+	if !got[0].CreatedAt.Equal(base) {
+		t.Errorf("Expected created_at %v, got %v", base, got[0].CreatedAt)
+	}
+
+	// ユーザーを削除しても記録は残る（users を参照しない）
+	userRepo := NewUserRepository(db)
+	if err := userRepo.Create(ctx, &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := userRepo.Delete(ctx, "U-alice"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	got, err = repo.ListSince(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("ListSince failed: %v", err)
+	}
+	if len(got) != 3 {
+		t.Errorf("Expected 3 entries, got %d", len(got))
+	}
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/morinonusi421/cupid/entities"
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+// CrushChangeRepository は好きな人の登録回数・登録の履歴のデータアクセス層のインターフェース
+//
+// 1日あたりの登録回数は crush_change_counts、登録の履歴は crush_history に記録する
+// day は日本時間の日付（YYYY-MM-DD）
+type CrushChangeRepository interface {
+	// CountOn は day にユーザーが好きな人を登録した回数を返す
+	CountOn(ctx context.Context, userLineID, day string) (int, error)
+	// Increment は day の登録回数を1増やす
+	Increment(ctx context.Context, userLineID, day string) error
+	// RecordHistory は登録の履歴を1件記録する（history.ID には保存後の値が設定される）
+	RecordHistory(ctx context.Context, history *model.CrushHistory) error
+	// ListHistorySince は since 以降のユーザーの登録の履歴を古い順に返す
+	ListHistorySince(ctx context.Context, userLineID string, since time.Time) ([]*model.CrushHistory, error)
+	// DeleteHistoryBefore は before より前のユーザーの登録の履歴を削除する（1日あたりの登録回数は残す）
+	DeleteHistoryBefore(ctx context.Context, userLineID string, before time.Time) error
+	// PruneWithdrawnHistory は退会したユーザーの before より前の登録の履歴を削除し、削除した件数を返す
+	PruneWithdrawnHistory(ctx context.Context, before time.Time) (int64, error)
+}
+
+type crushChangeRepository struct {
+	db *sql.DB
+}
+
+// NewCrushChangeRepository は CrushChangeRepository の新しいインスタンスを作成する
+func NewCrushChangeRepository(db *sql.DB) CrushChangeRepository {
+	return &crushChangeRepository{db: db}
+}
+
+// CountOn は day にユーザーが好きな人を登録した回数を返す
+func (r *crushChangeRepository) CountOn(ctx context.Context, userLineID, day string) (int, error) {
+	e, err := entities.CrushChangeCounts(
+		qm.Where(entities.CrushChangeCountColumns.UserLineID+" = ? AND "+entities.CrushChangeCountColumns.Day+" = ?", userLineID, day),
+	).One(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return int(e.Changes), nil
+}
+
+// Increment は day の登録回数を1増やす
+func (r *crushChangeRepository) Increment(ctx context.Context, userLineID, day string) error {
+	_, err := executorFromContext(ctx, r.db).ExecContext(ctx, `
+INSERT INTO crush_change_counts (user_line_id, day, changes)
+VALUES (?, ?, 1)
+ON CONFLICT (user_line_id, day) DO UPDATE SET changes = changes + 1`,
+		userLineID, day,
+	)
+	return err
+}
+
+// RecordHistory は登録の履歴を1件記録する（ChangedAt が空の場合は現在時刻）
+func (r *crushChangeRepository) RecordHistory(ctx context.Context, history *model.CrushHistory) error {
+	changedAt := history.ChangedAt
+	if changedAt.IsZero() {
+		changedAt = time.Now()
+	}
+	e := &entities.CrushHistory{
+		UserLineID:    history.UserLineID,
+		CrushName:     history.Name,
+		CrushAltName:  history.AltName,
+		CrushBirthday: history.Birthday.String(),
+		Action:        string(history.Action),
+		ChangedAt:     changedAt.UTC().Format(sqliteTimeFormat),
+	}
+	if err := e.Insert(ctx, executorFromContext(ctx, r.db), boil.Infer()); err != nil {
+		return err
+	}
+
+	recorded, err := crushHistoryEntityToModel(e)
+	if err != nil {
+		return err
+	}
+	*history = *recorded
+	return nil
+}
+
+// ListHistorySince は since 以降のユーザーの登録の履歴を古い順に返す
+func (r *crushChangeRepository) ListHistorySince(ctx context.Context, userLineID string, since time.Time) ([]*model.CrushHistory, error) {
+	entityHistories, err := entities.CrushHistories(
+		qm.Where(entities.CrushHistoryColumns.UserLineID+" = ?", userLineID),
+		qm.And(entities.CrushHistoryColumns.ChangedAt+" >= ?", since.UTC().Format(sqliteTimeFormat)),
+		qm.OrderBy(entities.CrushHistoryColumns.ChangedAt+", "+entities.CrushHistoryColumns.ID),
+	).All(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make([]*model.CrushHistory, 0, len(entityHistories))
+	for _, e := range entityHistories {
+		h, err := crushHistoryEntityToModel(e)
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, h)
+	}
+	return histories, nil
+}
+
+// DeleteHistoryBefore は before より前のユーザーの登録の履歴を削除する
+func (r *crushChangeRepository) DeleteHistoryBefore(ctx context.Context, userLineID string, before time.Time) error {
+	_, err := entities.CrushHistories(
+		qm.Where(entities.CrushHistoryColumns.UserLineID+" = ?", userLineID),
+		qm.And(entities.CrushHistoryColumns.ChangedAt+" < ?", before.UTC().Format(sqliteTimeFormat)),
+	).DeleteAll(ctx, executorFromContext(ctx, r.db))
+	return err
+}
+
+// PruneWithdrawnHistory は退会したユーザーの before より前の登録の履歴を削除し、削除した件数を返す
+// 利用中のユーザーの履歴はデータのエクスポートで返すため削除しない
+func (r *crushChangeRepository) PruneWithdrawnHistory(ctx context.Context, before time.Time) (int64, error) {
+	return entities.CrushHistories(
+		qm.Where(entities.CrushHistoryColumns.ChangedAt+" < ?", before.UTC().Format(sqliteTimeFormat)),
+		qm.And(entities.CrushHistoryColumns.UserLineID+" IN (SELECT "+entities.UserColumns.LineUserID+" FROM "+entities.TableNames.Users+" WHERE "+entities.UserColumns.DeletedAt+" IS NOT NULL)"),
+	).DeleteAll(ctx, executorFromContext(ctx, r.db))
+}
+
+// crushHistoryEntityToModel は entities.CrushHistory を model.CrushHistory に変換する
+func crushHistoryEntityToModel(e *entities.CrushHistory) (*model.CrushHistory, error) {
+	changedAt, err := time.Parse(sqliteTimeFormat, e.ChangedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid changed_at %q: %w", e.ChangedAt, err)
+	}
+	return &model.CrushHistory{
+		ID:         e.ID.Int64,
+		UserLineID: e.UserLineID,
+		Name:       e.CrushName,
+		AltName:    e.CrushAltName,
+		Birthday:   model.Birthday(e.CrushBirthday),
+		Action:     model.CrushHistoryAction(e.Action),
+		ChangedAt:  changedAt,
+	}, nil
+}
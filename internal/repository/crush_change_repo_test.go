@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+func TestCrushChangeRepository_CountOnAndIncrement(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	repo := NewCrushChangeRepository(db)
+	ctx := context.Background()
+
+	if err := userRepo.Create(ctx, &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	// 記録がなければ0回
+	count, err := repo.CountOn(ctx, "U-alice", "2026-03-01")
+	if err != nil {
+		t.Fatalf("CountOn failed: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected 0 changes, got %d", count)
+	}
+
+	for range 3 {
+		if err := repo.Increment(ctx, "U-alice", "2026-03-01"); err != nil {
+			t.Fatalf("Increment failed: %v", err)
+		}
+	}
+	if err := repo.Increment(ctx, "U-alice", "2026-03-02"); err != nil {
+		t.Fatalf("Increment failed: %v", err)
+	}
+
+	// 日ごとに数える
+	count, err = repo.CountOn(ctx, "U-alice", "2026-03-01")
+	if err != nil {
+		t.Fatalf("CountOn failed: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 changes on 2026-03-01, got %d", count)
+	}
+	count, err = repo.CountOn(ctx, "U-alice", "2026-03-02")
+	if err != nil {
+		t.Fatalf("CountOn failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 change on 2026-03-02, got %d", count)
+	}
+}
+
+func TestCrushChangeRepository_RecordAndListHistory(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	repo := NewCrushChangeRepository(db)
+	ctx := context.Background()
+
+	if err := userRepo.Create(ctx, &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	histories := []*model.CrushHistory{
+		{UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05", Action: model.CrushAdded, ChangedAt: base},
+		{UserLineID: "U-alice", Name: "キャロル", AltName: "Carol", Birthday: "1996-06-06", Action: model.CrushAdded, ChangedAt: base.Add(2 * time.Hour)},
+		{UserLineID: "U-alice", Name: "デイブ", Birthday: "1997-07-07", Action: model.CrushAdded, ChangedAt: base.Add(time.Hour)},
+	}
+	for _, h := range histories {
+		if err := repo.RecordHistory(ctx, h); err != nil {
+			t.Fatalf("RecordHistory failed: %v", err)
+		}
+		if h.ID == 0 {
+			t.Error("Expected ID to be set")
+		}
+	}
+
+	// since 以降の履歴を古い順に返す
+	got, err := repo.ListHistorySince(ctx, "U-alice", base.Add(30*time.Minute))
+	if err != nil {
+		t.Fatalf("ListHistorySince failed: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 histories, got %d", len(got))
+	}
+	if got[0].Name != "デイブ" || got[1].Name != "キャロル" {
+		t.Errorf("Unexpected order: %s, %s", got[0].Name, got[1].Name)
+	}
+	if !got[0].ChangedAt.Equal(base.Add(time.Hour)) {
+		t.Errorf("Expected changed_at %v, got %v", base.Add(time.Hour), got[0].ChangedAt)
+	}
+	if got[0].Action != model.CrushAdded {
+		t.Errorf("Expected action %q, got %q", model.CrushAdded, got[0].Action)
+	}
+	if got[0].AltName != "" || got[1].AltName != "Carol" {
+		t.Errorf("Unexpected alt names: %q, %q", got[0].AltName, got[1].AltName)
+	}
+
+	// 他のユーザーの履歴は含まない
+	got, err = repo.ListHistorySince(ctx, "U-bob", base)
+	if err != nil {
+		t.Fatalf("ListHistorySince failed: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Expected no histories, got %d", len(got))
+	}
+	// DeleteHistoryBefore は before より前の履歴だけを削除する
+	if err := repo.DeleteHistoryBefore(ctx, "U-alice", base.Add(90*time.Minute)); err != nil {
+		t.Fatalf("DeleteHistoryBefore failed: %v", err)
+	}
+	got, err = repo.ListHistorySince(ctx, "U-alice", base)
+	if err != nil {
+		t.Fatalf("ListHistorySince failed: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "キャロル" {
+		t.Errorf("Expected only キャロル to remain, got %+v", got)
+	}
+}
+
+func TestCrushChangeRepository_PruneWithdrawnHistory(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	repo := NewCrushChangeRepository(db)
+	ctx := context.Background()
+
+	for _, u := range []*model.User{
+		{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"},
+		{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, h := range []*model.CrushHistory{
+		{UserLineID: "U-alice", Name: "キャロル", Birthday: "1996-06-06", Action: model.CrushAdded, ChangedAt: base},
+		{UserLineID: "U-alice", Name: "デイブ", Birthday: "1997-07-07", Action: model.CrushAdded, ChangedAt: base.Add(48 * time.Hour)},
+		{UserLineID: "U-bob", Name: "キャロル", Birthday: "1996-06-06", Action: model.CrushAdded, ChangedAt: base},
+	} {
+		if err := repo.RecordHistory(ctx, h); err != nil {
+			t.Fatalf("RecordHistory failed: %v", err)
+		}
+	}
+	if err := userRepo.Delete(ctx, "U-alice"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	n, err := repo.PruneWithdrawnHistory(ctx, base.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("PruneWithdrawnHistory failed: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("Expected 1 history to be pruned, got %d", n)
+	}
+
+	// 退会したユーザーの期間内の履歴と、利用中のユーザーの履歴は残る
+	alice, err := repo.ListHistorySince(ctx, "U-alice", base)
+	if err != nil {
+		t.Fatalf("ListHistorySince failed: %v", err)
+	}
+	if len(alice) != 1 || alice[0].Name != "デイブ" {
+		t.Errorf("Expected only デイブ to remain for U-alice, got %+v", alice)
+	}
+	bob, err := repo.ListHistorySince(ctx, "U-bob", base)
+	if err != nil {
+		t.Fatalf("ListHistorySince failed: %v", err)
+	}
+	if len(bob) != 1 {
+		t.Errorf("Expected U-bob's history to remain, got %d", len(bob))
+	}
+}
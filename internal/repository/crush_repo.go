@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/morinonusi421/cupid/entities"
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+// CrushRepository は好きな人のデータアクセス層のインターフェース
+//
+// トランザクションは UserRepository.WithTx で開始する（fn に渡された ctx を使えば同じトランザクションに参加する）
+type CrushRepository interface {
+	Add(ctx context.Context, crush *model.Crush) error
+	ListByUserID(ctx context.Context, userLineID string) ([]*model.Crush, error)
+	Remove(ctx context.Context, userLineID string, crushID int64) error
+	SetAltName(ctx context.Context, userLineID string, crushID int64, altName string) error
+	RemoveAll(ctx context.Context, userLineID string) error
+}
+
+type crushRepository struct {
+	db *sql.DB
+}
+
+// NewCrushRepository は CrushRepository の新しいインスタンスを作成する
+func NewCrushRepository(db *sql.DB) CrushRepository {
+	return &crushRepository{db: db}
+}
+
+// Add は好きな人を追加する（crush.ID と crush.RegisteredAt には保存後の値が設定される）
+func (r *crushRepository) Add(ctx context.Context, crush *model.Crush) error {
+	entityCrush := &entities.Crush{
+		UserLineID:      crush.UserLineID,
+		CrushName:       crush.Name,
+		CrushNameKey:    model.NameKey(crush.Name),
+		CrushAltName:    crush.AltName,
+		CrushAltNameKey: altNameKey(crush.AltName),
+		CrushBirthday:   crush.Birthday.String(),
+		RegisteredAt:    crush.RegisteredAt,
+	}
+	if err := entityCrush.Insert(ctx, executorFromContext(ctx, r.db), boil.Infer()); err != nil {
+		return err
+	}
+
+	*crush = *crushEntityToModel(entityCrush)
+	return nil
+}
+
+// ListByUserID はユーザーが登録した好きな人を登録順に返す
+func (r *crushRepository) ListByUserID(ctx context.Context, userLineID string) ([]*model.Crush, error) {
+	entityCrushes, err := entities.Crushes(
+		qm.Where(entities.CrushColumns.UserLineID+" = ?", userLineID),
+		qm.OrderBy(entities.CrushColumns.ID),
+	).All(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		return nil, err
+	}
+
+	crushes := make([]*model.Crush, 0, len(entityCrushes))
+	for _, e := range entityCrushes {
+		crushes = append(crushes, crushEntityToModel(e))
+	}
+	return crushes, nil
+}
+
+// Remove はユーザーが登録した好きな人を削除する（他のユーザーの登録は削除しない）
+func (r *crushRepository) Remove(ctx context.Context, userLineID string, crushID int64) error {
+	_, err := entities.Crushes(
+		qm.Where(entities.CrushColumns.ID+" = ? AND "+entities.CrushColumns.UserLineID+" = ?", crushID, userLineID),
+	).DeleteAll(ctx, executorFromContext(ctx, r.db))
+	return err
+}
+
+// SetAltName は登録済みの好きな人の別の表記を設定する（他のユーザーの登録は変更しない）
+func (r *crushRepository) SetAltName(ctx context.Context, userLineID string, crushID int64, altName string) error {
+	_, err := entities.Crushes(
+		qm.Where(entities.CrushColumns.ID+" = ? AND "+entities.CrushColumns.UserLineID+" = ?", crushID, userLineID),
+	).UpdateAll(ctx, executorFromContext(ctx, r.db), entities.M{
+		entities.CrushColumns.CrushAltName:    altName,
+		entities.CrushColumns.CrushAltNameKey: altNameKey(altName),
+	})
+	return err
+}
+
+// RemoveAll はユーザーが登録した好きな人をすべて削除する
+func (r *crushRepository) RemoveAll(ctx context.Context, userLineID string) error {
+	_, err := entities.Crushes(
+		qm.Where(entities.CrushColumns.UserLineID+" = ?", userLineID),
+	).DeleteAll(ctx, executorFromContext(ctx, r.db))
+	return err
+}
+
+// crushEntityToModel は entities.Crush を model.Crush に変換する
+func crushEntityToModel(e *entities.Crush) *model.Crush {
+	return &model.Crush{
+		ID:           e.ID.Int64,
+		UserLineID:   e.UserLineID,
+		Name:         e.CrushName,
+		Birthday:     model.Birthday(e.CrushBirthday),
+		RegisteredAt: e.RegisteredAt,
+		AltName:      e.CrushAltName,
+	}
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+func TestCrushRepository_AddListRemove(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	crushRepo := NewCrushRepository(db)
+	ctx := context.Background()
+
+	for _, id := range []string{"U-alice", "U-bob"} {
+		if err := userRepo.Create(ctx, &model.User{LineID: id, Name: id, Birthday: "1990-01-01"}); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	first := &model.Crush{UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"}
+	second := &model.Crush{UserLineID: "U-alice", Name: "キャロル", Birthday: "1992-02-02"}
+	other := &model.Crush{UserLineID: "U-bob", Name: "アリス", Birthday: "1990-01-01"}
+	for _, c := range []*model.Crush{first, second, other} {
+		if err := crushRepo.Add(ctx, c); err != nil {
+			t.Fatalf("Add failed: %v", err)
+		}
+	}
+	if first.ID == 0 || first.RegisteredAt == "" {
+		t.Errorf("Expected ID and RegisteredAt to be set, got %+v", first)
+	}
+
+	// 同じ相手の重複登録はUNIQUE制約でエラー
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"}); err == nil {
+		t.Error("Expected duplicate crush to fail")
+	}
+
+	// 登録順に返される
+	crushes, err := crushRepo.ListByUserID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(crushes) != 2 || crushes[0].Name != "ボブ" || crushes[1].Name != "キャロル" {
+		t.Fatalf("Unexpected crushes: %+v", crushes)
+	}
+
+	// 他人の好きな人は削除できない
+	if err := crushRepo.Remove(ctx, "U-alice", other.ID); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	bobCrushes, err := crushRepo.ListByUserID(ctx, "U-bob")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(bobCrushes) != 1 {
+		t.Errorf("Expected other user's crush to remain, got %d", len(bobCrushes))
+	}
+
+	// 自分の好きな人は削除できる
+	if err := crushRepo.Remove(ctx, "U-alice", first.ID); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	crushes, err = crushRepo.ListByUserID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(crushes) != 1 || crushes[0].Name != "キャロル" {
+		t.Errorf("Unexpected crushes after remove: %+v", crushes)
+	}
+	// RemoveAll は自分の好きな人だけをすべて削除する
+	if err := crushRepo.RemoveAll(ctx, "U-alice"); err != nil {
+		t.Fatalf("RemoveAll failed: %v", err)
+	}
+	crushes, err = crushRepo.ListByUserID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(crushes) != 0 {
+		t.Errorf("Expected no crushes after RemoveAll, got %+v", crushes)
+	}
+	bobCrushes, err = crushRepo.ListByUserID(ctx, "U-bob")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(bobCrushes) != 1 {
+		t.Errorf("Expected other user's crush to remain after RemoveAll, got %d", len(bobCrushes))
+	}
+}
+
+func TestCrushRepository_SetAltName(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	crushRepo := NewCrushRepository(db)
+	ctx := context.Background()
+
+	for _, id := range []string{"U-alice", "U-bob"} {
+		if err := userRepo.Create(ctx, &model.User{LineID: id, Name: id, Birthday: "1990-01-01"}); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	crush := &model.Crush{UserLineID: "U-alice", Name: "タナカタロ", Birthday: "1995-05-05"}
+	if err := crushRepo.Add(ctx, crush); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	// 他人の好きな人には設定できない
+	if err := crushRepo.SetAltName(ctx, "U-bob", crush.ID, "タナカジロウ"); err != nil {
+		t.Fatalf("SetAltName failed: %v", err)
+	}
+	if err := crushRepo.SetAltName(ctx, "U-alice", crush.ID, "タナカタロウ"); err != nil {
+		t.Fatalf("SetAltName failed: %v", err)
+	}
+
+	crushes, err := crushRepo.ListByUserID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(crushes) != 1 || crushes[0].AltName != "タナカタロウ" {
+		t.Fatalf("Expected alt name タナカタロウ, got %+v", crushes)
+	}
+
+	// 別の表記のキーでも検索できる
+	var altKey string
+	if err := db.QueryRow("SELECT crush_alt_name_key FROM crushes WHERE id = ?", crush.ID).Scan(&altKey); err != nil {
+		t.Fatalf("Failed to select crush: %v", err)
+	}
+	if altKey != "タナカタロウ" {
+		t.Errorf("Expected alt name key タナカタロウ, got %s", altKey)
+	}
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/morinonusi421/cupid/entities"
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+// MatchHistoryRepository はマッチングの成立・解除の履歴（match_history）のデータアクセス層のインターフェース
+//
+// Record を UserRepository.WithTx の fn 内で呼ぶと、マッチング成立・解除と同じトランザクションで記録される
+type MatchHistoryRepository interface {
+	// Record は履歴を1件記録する（history.ID には保存後の値が設定される）
+	Record(ctx context.Context, history *model.MatchHistory) error
+	// ListByUserID はユーザーの履歴を古い順に返す
+	ListByUserID(ctx context.Context, userLineID string) ([]*model.MatchHistory, error)
+	// DeleteByUserID はユーザーの履歴をすべて削除する（相手側の履歴は残す）
+	DeleteByUserID(ctx context.Context, userLineID string) error
+}
+
+type matchHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewMatchHistoryRepository は MatchHistoryRepository の新しいインスタンスを作成する
+func NewMatchHistoryRepository(db *sql.DB) MatchHistoryRepository {
+	return &matchHistoryRepository{db: db}
+}
+
+// Record は履歴を1件記録する（ChangedAt が空の場合は現在時刻）
+func (r *matchHistoryRepository) Record(ctx context.Context, history *model.MatchHistory) error {
+	changedAt := history.ChangedAt
+	if changedAt.IsZero() {
+		changedAt = time.Now()
+	}
+	e := &entities.MatchHistory{
+		UserLineID:    history.UserLineID,
+		PartnerLineID: history.PartnerLineID,
+		PartnerName:   history.PartnerName,
+		Action:        string(history.Action),
+		ChangedAt:     changedAt.UTC().Format(sqliteTimeFormat),
+	}
+	if err := e.Insert(ctx, executorFromContext(ctx, r.db), boil.Infer()); err != nil {
+		return err
+	}
+
+	recorded, err := matchHistoryEntityToModel(e)
+	if err != nil {
+		return err
+	}
+	*history = *recorded
+	return nil
+}
+
+// ListByUserID はユーザーの履歴を古い順に返す
+func (r *matchHistoryRepository) ListByUserID(ctx context.Context, userLineID string) ([]*model.MatchHistory, error) {
+	entityHistories, err := entities.MatchHistories(
+		qm.Where(entities.MatchHistoryColumns.UserLineID+" = ?", userLineID),
+		qm.OrderBy(entities.MatchHistoryColumns.ChangedAt+", "+entities.MatchHistoryColumns.ID),
+	).All(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make([]*model.MatchHistory, 0, len(entityHistories))
+	for _, e := range entityHistories {
+		h, err := matchHistoryEntityToModel(e)
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, h)
+	}
+	return histories, nil
+}
+
+// DeleteByUserID はユーザーの履歴をすべて削除する
+func (r *matchHistoryRepository) DeleteByUserID(ctx context.Context, userLineID string) error {
+	_, err := entities.MatchHistories(
+		qm.Where(entities.MatchHistoryColumns.UserLineID+" = ?", userLineID),
+	).DeleteAll(ctx, executorFromContext(ctx, r.db))
+	return err
+}
+
+// matchHistoryEntityToModel は entities.MatchHistory を model.MatchHistory に変換する
+func matchHistoryEntityToModel(e *entities.MatchHistory) (*model.MatchHistory, error) {
+	changedAt, err := time.Parse(sqliteTimeFormat, e.ChangedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid changed_at %q: %w", e.ChangedAt, err)
+	}
+	return &model.MatchHistory{
+		ID:            e.ID.Int64,
+		UserLineID:    e.UserLineID,
+		PartnerLineID: e.PartnerLineID,
+		PartnerName:   e.PartnerName,
+		Action:        model.MatchHistoryAction(e.Action),
+		ChangedAt:     changedAt,
+	}, nil
+}
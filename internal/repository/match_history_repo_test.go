@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+func TestMatchHistoryRepository_RecordListDelete(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	repo := NewMatchHistoryRepository(db)
+	ctx := context.Background()
+
+	for _, u := range []*model.User{
+		{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"},
+		{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	histories := []*model.MatchHistory{
+		{UserLineID: "U-alice", PartnerLineID: "U-bob", PartnerName: "ボブ", Action: model.MatchEnded, ChangedAt: base.Add(time.Hour)},
+		{UserLineID: "U-alice", PartnerLineID: "U-bob", PartnerName: "ボブ", Action: model.MatchStarted, ChangedAt: base},
+		{UserLineID: "U-bob", PartnerLineID: "U-alice", PartnerName: "アリス", Action: model.MatchStarted, ChangedAt: base},
+	}
+	for _, h := range histories {
+		if err := repo.Record(ctx, h); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+		if h.ID == 0 {
+			t.Error("Expected ID to be set")
+		}
+	}
+
+	// ユーザーの履歴を古い順に返す
+	got, err := repo.ListByUserID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 histories, got %d", len(got))
+	}
+	if got[0].Action != model.MatchStarted || got[1].Action != model.MatchEnded {
+		t.Errorf("Unexpected order: %s, %s", got[0].Action, got[1].Action)
+	}
+	if got[0].PartnerName != "ボブ" || got[0].PartnerLineID != "U-bob" {
+		t.Errorf("Unexpected partner: %s (%s)", got[0].PartnerName, got[0].PartnerLineID)
+	}
+	if !got[0].ChangedAt.Equal(base) {
+		t.Errorf("Expected changed_at %v, got %v", base, got[0].ChangedAt)
+	}
+
+	// DeleteByUserID は相手側の履歴を残す
+	if err := repo.DeleteByUserID(ctx, "U-alice"); err != nil {
+		t.Fatalf("DeleteByUserID failed: %v", err)
+	}
+	got, err = repo.ListByUserID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Expected no histories after delete, got %d", len(got))
+	}
+	got, err = repo.ListByUserID(ctx, "U-bob")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(got) != 1 {
+		t.Errorf("Expected partner's history to remain, got %d", len(got))
+	}
+}
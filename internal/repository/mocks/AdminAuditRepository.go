@@ -0,0 +1,145 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/morinonusi421/cupid/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAdminAuditRepository is an autogenerated mock type for the AdminAuditRepository type
+type MockAdminAuditRepository struct {
+	mock.Mock
+}
+
+type MockAdminAuditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAdminAuditRepository) EXPECT() *MockAdminAuditRepository_Expecter {
+	return &MockAdminAuditRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListSince provides a mock function with given fields: ctx, since
+func (_m *MockAdminAuditRepository) ListSince(ctx context.Context, since time.Time) ([]*model.AdminAuditEntry, error) {
+	ret := _m.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSince")
+	}
+
+	var r0 []*model.AdminAuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*model.AdminAuditEntry, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*model.AdminAuditEntry); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AdminAuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminAuditRepository_ListSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSince'
+type MockAdminAuditRepository_ListSince_Call struct {
+	*mock.Call
+}
+
+// ListSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+func (_e *MockAdminAuditRepository_Expecter) ListSince(ctx interface{}, since interface{}) *MockAdminAuditRepository_ListSince_Call {
+	return &MockAdminAuditRepository_ListSince_Call{Call: _e.mock.On("ListSince", ctx, since)}
+}
+
+func (_c *MockAdminAuditRepository_ListSince_Call) Run(run func(ctx context.Context, since time.Time)) *MockAdminAuditRepository_ListSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAdminAuditRepository_ListSince_Call) Return(_a0 []*model.AdminAuditEntry, _a1 error) *MockAdminAuditRepository_ListSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminAuditRepository_ListSince_Call) RunAndReturn(run func(context.Context, time.Time) ([]*model.AdminAuditEntry, error)) *MockAdminAuditRepository_ListSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, entry
+func (_m *MockAdminAuditRepository) Record(ctx context.Context, entry *model.AdminAuditEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AdminAuditEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAdminAuditRepository_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockAdminAuditRepository_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *model.AdminAuditEntry
+func (_e *MockAdminAuditRepository_Expecter) Record(ctx interface{}, entry interface{}) *MockAdminAuditRepository_Record_Call {
+	return &MockAdminAuditRepository_Record_Call{Call: _e.mock.On("Record", ctx, entry)}
+}
+
+func (_c *MockAdminAuditRepository_Record_Call) Run(run func(ctx context.Context, entry *model.AdminAuditEntry)) *MockAdminAuditRepository_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.AdminAuditEntry))
+	})
+	return _c
+}
+
+func (_c *MockAdminAuditRepository_Record_Call) Return(_a0 error) *MockAdminAuditRepository_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAdminAuditRepository_Record_Call) RunAndReturn(run func(context.Context, *model.AdminAuditEntry) error) *MockAdminAuditRepository_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAdminAuditRepository creates a new instance of MockAdminAuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAdminAuditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAdminAuditRepository {
+	mock := &MockAdminAuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,357 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/morinonusi421/cupid/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockCrushChangeRepository is an autogenerated mock type for the CrushChangeRepository type
+type MockCrushChangeRepository struct {
+	mock.Mock
+}
+
+type MockCrushChangeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCrushChangeRepository) EXPECT() *MockCrushChangeRepository_Expecter {
+	return &MockCrushChangeRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountOn provides a mock function with given fields: ctx, userLineID, day
+func (_m *MockCrushChangeRepository) CountOn(ctx context.Context, userLineID string, day string) (int, error) {
+	ret := _m.Called(ctx, userLineID, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOn")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int, error)); ok {
+		return rf(ctx, userLineID, day)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = rf(ctx, userLineID, day)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userLineID, day)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCrushChangeRepository_CountOn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOn'
+type MockCrushChangeRepository_CountOn_Call struct {
+	*mock.Call
+}
+
+// CountOn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+//   - day string
+func (_e *MockCrushChangeRepository_Expecter) CountOn(ctx interface{}, userLineID interface{}, day interface{}) *MockCrushChangeRepository_CountOn_Call {
+	return &MockCrushChangeRepository_CountOn_Call{Call: _e.mock.On("CountOn", ctx, userLineID, day)}
+}
+
+func (_c *MockCrushChangeRepository_CountOn_Call) Run(run func(ctx context.Context, userLineID string, day string)) *MockCrushChangeRepository_CountOn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_CountOn_Call) Return(_a0 int, _a1 error) *MockCrushChangeRepository_CountOn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_CountOn_Call) RunAndReturn(run func(context.Context, string, string) (int, error)) *MockCrushChangeRepository_CountOn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteHistoryBefore provides a mock function with given fields: ctx, userLineID, before
+func (_m *MockCrushChangeRepository) DeleteHistoryBefore(ctx context.Context, userLineID string, before time.Time) error {
+	ret := _m.Called(ctx, userLineID, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHistoryBefore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, userLineID, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCrushChangeRepository_DeleteHistoryBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteHistoryBefore'
+type MockCrushChangeRepository_DeleteHistoryBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteHistoryBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+//   - before time.Time
+func (_e *MockCrushChangeRepository_Expecter) DeleteHistoryBefore(ctx interface{}, userLineID interface{}, before interface{}) *MockCrushChangeRepository_DeleteHistoryBefore_Call {
+	return &MockCrushChangeRepository_DeleteHistoryBefore_Call{Call: _e.mock.On("DeleteHistoryBefore", ctx, userLineID, before)}
+}
+
+func (_c *MockCrushChangeRepository_DeleteHistoryBefore_Call) Run(run func(ctx context.Context, userLineID string, before time.Time)) *MockCrushChangeRepository_DeleteHistoryBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_DeleteHistoryBefore_Call) Return(_a0 error) *MockCrushChangeRepository_DeleteHistoryBefore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_DeleteHistoryBefore_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockCrushChangeRepository_DeleteHistoryBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Increment provides a mock function with given fields: ctx, userLineID, day
+func (_m *MockCrushChangeRepository) Increment(ctx context.Context, userLineID string, day string) error {
+	ret := _m.Called(ctx, userLineID, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Increment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userLineID, day)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCrushChangeRepository_Increment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Increment'
+type MockCrushChangeRepository_Increment_Call struct {
+	*mock.Call
+}
+
+// Increment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+//   - day string
+func (_e *MockCrushChangeRepository_Expecter) Increment(ctx interface{}, userLineID interface{}, day interface{}) *MockCrushChangeRepository_Increment_Call {
+	return &MockCrushChangeRepository_Increment_Call{Call: _e.mock.On("Increment", ctx, userLineID, day)}
+}
+
+func (_c *MockCrushChangeRepository_Increment_Call) Run(run func(ctx context.Context, userLineID string, day string)) *MockCrushChangeRepository_Increment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_Increment_Call) Return(_a0 error) *MockCrushChangeRepository_Increment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_Increment_Call) RunAndReturn(run func(context.Context, string, string) error) *MockCrushChangeRepository_Increment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHistorySince provides a mock function with given fields: ctx, userLineID, since
+func (_m *MockCrushChangeRepository) ListHistorySince(ctx context.Context, userLineID string, since time.Time) ([]*model.CrushHistory, error) {
+	ret := _m.Called(ctx, userLineID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHistorySince")
+	}
+
+	var r0 []*model.CrushHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]*model.CrushHistory, error)); ok {
+		return rf(ctx, userLineID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []*model.CrushHistory); ok {
+		r0 = rf(ctx, userLineID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CrushHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, userLineID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCrushChangeRepository_ListHistorySince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHistorySince'
+type MockCrushChangeRepository_ListHistorySince_Call struct {
+	*mock.Call
+}
+
+// ListHistorySince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+//   - since time.Time
+func (_e *MockCrushChangeRepository_Expecter) ListHistorySince(ctx interface{}, userLineID interface{}, since interface{}) *MockCrushChangeRepository_ListHistorySince_Call {
+	return &MockCrushChangeRepository_ListHistorySince_Call{Call: _e.mock.On("ListHistorySince", ctx, userLineID, since)}
+}
+
+func (_c *MockCrushChangeRepository_ListHistorySince_Call) Run(run func(ctx context.Context, userLineID string, since time.Time)) *MockCrushChangeRepository_ListHistorySince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_ListHistorySince_Call) Return(_a0 []*model.CrushHistory, _a1 error) *MockCrushChangeRepository_ListHistorySince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_ListHistorySince_Call) RunAndReturn(run func(context.Context, string, time.Time) ([]*model.CrushHistory, error)) *MockCrushChangeRepository_ListHistorySince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PruneWithdrawnHistory provides a mock function with given fields: ctx, before
+func (_m *MockCrushChangeRepository) PruneWithdrawnHistory(ctx context.Context, before time.Time) (int64, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneWithdrawnHistory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCrushChangeRepository_PruneWithdrawnHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneWithdrawnHistory'
+type MockCrushChangeRepository_PruneWithdrawnHistory_Call struct {
+	*mock.Call
+}
+
+// PruneWithdrawnHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockCrushChangeRepository_Expecter) PruneWithdrawnHistory(ctx interface{}, before interface{}) *MockCrushChangeRepository_PruneWithdrawnHistory_Call {
+	return &MockCrushChangeRepository_PruneWithdrawnHistory_Call{Call: _e.mock.On("PruneWithdrawnHistory", ctx, before)}
+}
+
+func (_c *MockCrushChangeRepository_PruneWithdrawnHistory_Call) Run(run func(ctx context.Context, before time.Time)) *MockCrushChangeRepository_PruneWithdrawnHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_PruneWithdrawnHistory_Call) Return(_a0 int64, _a1 error) *MockCrushChangeRepository_PruneWithdrawnHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_PruneWithdrawnHistory_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *MockCrushChangeRepository_PruneWithdrawnHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordHistory provides a mock function with given fields: ctx, history
+func (_m *MockCrushChangeRepository) RecordHistory(ctx context.Context, history *model.CrushHistory) error {
+	ret := _m.Called(ctx, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CrushHistory) error); ok {
+		r0 = rf(ctx, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCrushChangeRepository_RecordHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordHistory'
+type MockCrushChangeRepository_RecordHistory_Call struct {
+	*mock.Call
+}
+
+// RecordHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - history *model.CrushHistory
+func (_e *MockCrushChangeRepository_Expecter) RecordHistory(ctx interface{}, history interface{}) *MockCrushChangeRepository_RecordHistory_Call {
+	return &MockCrushChangeRepository_RecordHistory_Call{Call: _e.mock.On("RecordHistory", ctx, history)}
+}
+
+func (_c *MockCrushChangeRepository_RecordHistory_Call) Run(run func(ctx context.Context, history *model.CrushHistory)) *MockCrushChangeRepository_RecordHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CrushHistory))
+	})
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_RecordHistory_Call) Return(_a0 error) *MockCrushChangeRepository_RecordHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCrushChangeRepository_RecordHistory_Call) RunAndReturn(run func(context.Context, *model.CrushHistory) error) *MockCrushChangeRepository_RecordHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCrushChangeRepository creates a new instance of MockCrushChangeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCrushChangeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCrushChangeRepository {
+	mock := &MockCrushChangeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
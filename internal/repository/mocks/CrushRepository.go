@@ -0,0 +1,287 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/morinonusi421/cupid/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCrushRepository is an autogenerated mock type for the CrushRepository type
+type MockCrushRepository struct {
+	mock.Mock
+}
+
+type MockCrushRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCrushRepository) EXPECT() *MockCrushRepository_Expecter {
+	return &MockCrushRepository_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, crush
+func (_m *MockCrushRepository) Add(ctx context.Context, crush *model.Crush) error {
+	ret := _m.Called(ctx, crush)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Crush) error); ok {
+		r0 = rf(ctx, crush)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCrushRepository_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type MockCrushRepository_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - crush *model.Crush
+func (_e *MockCrushRepository_Expecter) Add(ctx interface{}, crush interface{}) *MockCrushRepository_Add_Call {
+	return &MockCrushRepository_Add_Call{Call: _e.mock.On("Add", ctx, crush)}
+}
+
+func (_c *MockCrushRepository_Add_Call) Run(run func(ctx context.Context, crush *model.Crush)) *MockCrushRepository_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Crush))
+	})
+	return _c
+}
+
+func (_c *MockCrushRepository_Add_Call) Return(_a0 error) *MockCrushRepository_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCrushRepository_Add_Call) RunAndReturn(run func(context.Context, *model.Crush) error) *MockCrushRepository_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userLineID
+func (_m *MockCrushRepository) ListByUserID(ctx context.Context, userLineID string) ([]*model.Crush, error) {
+	ret := _m.Called(ctx, userLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []*model.Crush
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.Crush, error)); ok {
+		return rf(ctx, userLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Crush); ok {
+		r0 = rf(ctx, userLineID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Crush)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCrushRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockCrushRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+func (_e *MockCrushRepository_Expecter) ListByUserID(ctx interface{}, userLineID interface{}) *MockCrushRepository_ListByUserID_Call {
+	return &MockCrushRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userLineID)}
+}
+
+func (_c *MockCrushRepository_ListByUserID_Call) Run(run func(ctx context.Context, userLineID string)) *MockCrushRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCrushRepository_ListByUserID_Call) Return(_a0 []*model.Crush, _a1 error) *MockCrushRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCrushRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, string) ([]*model.Crush, error)) *MockCrushRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: ctx, userLineID, crushID
+func (_m *MockCrushRepository) Remove(ctx context.Context, userLineID string, crushID int64) error {
+	ret := _m.Called(ctx, userLineID, crushID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, userLineID, crushID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCrushRepository_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockCrushRepository_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+//   - crushID int64
+func (_e *MockCrushRepository_Expecter) Remove(ctx interface{}, userLineID interface{}, crushID interface{}) *MockCrushRepository_Remove_Call {
+	return &MockCrushRepository_Remove_Call{Call: _e.mock.On("Remove", ctx, userLineID, crushID)}
+}
+
+func (_c *MockCrushRepository_Remove_Call) Run(run func(ctx context.Context, userLineID string, crushID int64)) *MockCrushRepository_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCrushRepository_Remove_Call) Return(_a0 error) *MockCrushRepository_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCrushRepository_Remove_Call) RunAndReturn(run func(context.Context, string, int64) error) *MockCrushRepository_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAll provides a mock function with given fields: ctx, userLineID
+func (_m *MockCrushRepository) RemoveAll(ctx context.Context, userLineID string) error {
+	ret := _m.Called(ctx, userLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userLineID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCrushRepository_RemoveAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAll'
+type MockCrushRepository_RemoveAll_Call struct {
+	*mock.Call
+}
+
+// RemoveAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+func (_e *MockCrushRepository_Expecter) RemoveAll(ctx interface{}, userLineID interface{}) *MockCrushRepository_RemoveAll_Call {
+	return &MockCrushRepository_RemoveAll_Call{Call: _e.mock.On("RemoveAll", ctx, userLineID)}
+}
+
+func (_c *MockCrushRepository_RemoveAll_Call) Run(run func(ctx context.Context, userLineID string)) *MockCrushRepository_RemoveAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCrushRepository_RemoveAll_Call) Return(_a0 error) *MockCrushRepository_RemoveAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCrushRepository_RemoveAll_Call) RunAndReturn(run func(context.Context, string) error) *MockCrushRepository_RemoveAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAltName provides a mock function with given fields: ctx, userLineID, crushID, altName
+func (_m *MockCrushRepository) SetAltName(ctx context.Context, userLineID string, crushID int64, altName string) error {
+	ret := _m.Called(ctx, userLineID, crushID, altName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAltName")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) error); ok {
+		r0 = rf(ctx, userLineID, crushID, altName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCrushRepository_SetAltName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAltName'
+type MockCrushRepository_SetAltName_Call struct {
+	*mock.Call
+}
+
+// SetAltName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+//   - crushID int64
+//   - altName string
+func (_e *MockCrushRepository_Expecter) SetAltName(ctx interface{}, userLineID interface{}, crushID interface{}, altName interface{}) *MockCrushRepository_SetAltName_Call {
+	return &MockCrushRepository_SetAltName_Call{Call: _e.mock.On("SetAltName", ctx, userLineID, crushID, altName)}
+}
+
+func (_c *MockCrushRepository_SetAltName_Call) Run(run func(ctx context.Context, userLineID string, crushID int64, altName string)) *MockCrushRepository_SetAltName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCrushRepository_SetAltName_Call) Return(_a0 error) *MockCrushRepository_SetAltName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCrushRepository_SetAltName_Call) RunAndReturn(run func(context.Context, string, int64, string) error) *MockCrushRepository_SetAltName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCrushRepository creates a new instance of MockCrushRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCrushRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCrushRepository {
+	mock := &MockCrushRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
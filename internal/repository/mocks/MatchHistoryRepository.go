@@ -0,0 +1,190 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/morinonusi421/cupid/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMatchHistoryRepository is an autogenerated mock type for the MatchHistoryRepository type
+type MockMatchHistoryRepository struct {
+	mock.Mock
+}
+
+type MockMatchHistoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMatchHistoryRepository) EXPECT() *MockMatchHistoryRepository_Expecter {
+	return &MockMatchHistoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userLineID
+func (_m *MockMatchHistoryRepository) DeleteByUserID(ctx context.Context, userLineID string) error {
+	ret := _m.Called(ctx, userLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userLineID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchHistoryRepository_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type MockMatchHistoryRepository_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+func (_e *MockMatchHistoryRepository_Expecter) DeleteByUserID(ctx interface{}, userLineID interface{}) *MockMatchHistoryRepository_DeleteByUserID_Call {
+	return &MockMatchHistoryRepository_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userLineID)}
+}
+
+func (_c *MockMatchHistoryRepository_DeleteByUserID_Call) Run(run func(ctx context.Context, userLineID string)) *MockMatchHistoryRepository_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchHistoryRepository_DeleteByUserID_Call) Return(_a0 error) *MockMatchHistoryRepository_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchHistoryRepository_DeleteByUserID_Call) RunAndReturn(run func(context.Context, string) error) *MockMatchHistoryRepository_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, userLineID
+func (_m *MockMatchHistoryRepository) ListByUserID(ctx context.Context, userLineID string) ([]*model.MatchHistory, error) {
+	ret := _m.Called(ctx, userLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []*model.MatchHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.MatchHistory, error)); ok {
+		return rf(ctx, userLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.MatchHistory); ok {
+		r0 = rf(ctx, userLineID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.MatchHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchHistoryRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockMatchHistoryRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLineID string
+func (_e *MockMatchHistoryRepository_Expecter) ListByUserID(ctx interface{}, userLineID interface{}) *MockMatchHistoryRepository_ListByUserID_Call {
+	return &MockMatchHistoryRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, userLineID)}
+}
+
+func (_c *MockMatchHistoryRepository_ListByUserID_Call) Run(run func(ctx context.Context, userLineID string)) *MockMatchHistoryRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchHistoryRepository_ListByUserID_Call) Return(_a0 []*model.MatchHistory, _a1 error) *MockMatchHistoryRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchHistoryRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, string) ([]*model.MatchHistory, error)) *MockMatchHistoryRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, history
+func (_m *MockMatchHistoryRepository) Record(ctx context.Context, history *model.MatchHistory) error {
+	ret := _m.Called(ctx, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MatchHistory) error); ok {
+		r0 = rf(ctx, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchHistoryRepository_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockMatchHistoryRepository_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - history *model.MatchHistory
+func (_e *MockMatchHistoryRepository_Expecter) Record(ctx interface{}, history interface{}) *MockMatchHistoryRepository_Record_Call {
+	return &MockMatchHistoryRepository_Record_Call{Call: _e.mock.On("Record", ctx, history)}
+}
+
+func (_c *MockMatchHistoryRepository_Record_Call) Run(run func(ctx context.Context, history *model.MatchHistory)) *MockMatchHistoryRepository_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.MatchHistory))
+	})
+	return _c
+}
+
+func (_c *MockMatchHistoryRepository_Record_Call) Return(_a0 error) *MockMatchHistoryRepository_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchHistoryRepository_Record_Call) RunAndReturn(run func(context.Context, *model.MatchHistory) error) *MockMatchHistoryRepository_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMatchHistoryRepository creates a new instance of MockMatchHistoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMatchHistoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMatchHistoryRepository {
+	mock := &MockMatchHistoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
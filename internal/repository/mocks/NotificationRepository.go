@@ -0,0 +1,459 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/morinonusi421/cupid/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockNotificationRepository is an autogenerated mock type for the NotificationRepository type
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+type MockNotificationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationRepository) EXPECT() *MockNotificationRepository_Expecter {
+	return &MockNotificationRepository_Expecter{mock: &_m.Mock}
+}
+
+// DiscardPending provides a mock function with given fields: ctx, toUserID, errMsg
+func (_m *MockNotificationRepository) DiscardPending(ctx context.Context, toUserID string, errMsg string) error {
+	ret := _m.Called(ctx, toUserID, errMsg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscardPending")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, toUserID, errMsg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationRepository_DiscardPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscardPending'
+type MockNotificationRepository_DiscardPending_Call struct {
+	*mock.Call
+}
+
+// DiscardPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - toUserID string
+//   - errMsg string
+func (_e *MockNotificationRepository_Expecter) DiscardPending(ctx interface{}, toUserID interface{}, errMsg interface{}) *MockNotificationRepository_DiscardPending_Call {
+	return &MockNotificationRepository_DiscardPending_Call{Call: _e.mock.On("DiscardPending", ctx, toUserID, errMsg)}
+}
+
+func (_c *MockNotificationRepository_DiscardPending_Call) Run(run func(ctx context.Context, toUserID string, errMsg string)) *MockNotificationRepository_DiscardPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_DiscardPending_Call) Return(_a0 error) *MockNotificationRepository_DiscardPending_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationRepository_DiscardPending_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationRepository_DiscardPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enqueue provides a mock function with given fields: ctx, notification
+func (_m *MockNotificationRepository) Enqueue(ctx context.Context, notification *model.Notification) error {
+	ret := _m.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Notification) error); ok {
+		r0 = rf(ctx, notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationRepository_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockNotificationRepository_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification *model.Notification
+func (_e *MockNotificationRepository_Expecter) Enqueue(ctx interface{}, notification interface{}) *MockNotificationRepository_Enqueue_Call {
+	return &MockNotificationRepository_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, notification)}
+}
+
+func (_c *MockNotificationRepository_Enqueue_Call) Run(run func(ctx context.Context, notification *model.Notification)) *MockNotificationRepository_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Notification))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_Enqueue_Call) Return(_a0 error) *MockNotificationRepository_Enqueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationRepository_Enqueue_Call) RunAndReturn(run func(context.Context, *model.Notification) error) *MockNotificationRepository_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *MockNotificationRepository) FindByID(ctx context.Context, id int64) (*model.Notification, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*model.Notification, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.Notification); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotificationRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockNotificationRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockNotificationRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockNotificationRepository_FindByID_Call {
+	return &MockNotificationRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockNotificationRepository_FindByID_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_FindByID_Call) Return(_a0 *model.Notification, _a1 error) *MockNotificationRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotificationRepository_FindByID_Call) RunAndReturn(run func(context.Context, int64) (*model.Notification, error)) *MockNotificationRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function with given fields: ctx, toUserID
+func (_m *MockNotificationRepository) ListByUserID(ctx context.Context, toUserID string) ([]*model.Notification, error) {
+	ret := _m.Called(ctx, toUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []*model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.Notification, error)); ok {
+		return rf(ctx, toUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Notification); ok {
+		r0 = rf(ctx, toUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, toUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotificationRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockNotificationRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - toUserID string
+func (_e *MockNotificationRepository_Expecter) ListByUserID(ctx interface{}, toUserID interface{}) *MockNotificationRepository_ListByUserID_Call {
+	return &MockNotificationRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", ctx, toUserID)}
+}
+
+func (_c *MockNotificationRepository_ListByUserID_Call) Run(run func(ctx context.Context, toUserID string)) *MockNotificationRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_ListByUserID_Call) Return(_a0 []*model.Notification, _a1 error) *MockNotificationRepository_ListByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotificationRepository_ListByUserID_Call) RunAndReturn(run func(context.Context, string) ([]*model.Notification, error)) *MockNotificationRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDue provides a mock function with given fields: ctx, now, limit
+func (_m *MockNotificationRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.Notification, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDue")
+	}
+
+	var r0 []*model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*model.Notification, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*model.Notification); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotificationRepository_ListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDue'
+type MockNotificationRepository_ListDue_Call struct {
+	*mock.Call
+}
+
+// ListDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *MockNotificationRepository_Expecter) ListDue(ctx interface{}, now interface{}, limit interface{}) *MockNotificationRepository_ListDue_Call {
+	return &MockNotificationRepository_ListDue_Call{Call: _e.mock.On("ListDue", ctx, now, limit)}
+}
+
+func (_c *MockNotificationRepository_ListDue_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockNotificationRepository_ListDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_ListDue_Call) Return(_a0 []*model.Notification, _a1 error) *MockNotificationRepository_ListDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotificationRepository_ListDue_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*model.Notification, error)) *MockNotificationRepository_ListDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDead provides a mock function with given fields: ctx, id, attempts, errMsg
+func (_m *MockNotificationRepository) MarkDead(ctx context.Context, id int64, attempts int, errMsg string) error {
+	ret := _m.Called(ctx, id, attempts, errMsg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, string) error); ok {
+		r0 = rf(ctx, id, attempts, errMsg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationRepository_MarkDead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDead'
+type MockNotificationRepository_MarkDead_Call struct {
+	*mock.Call
+}
+
+// MarkDead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - attempts int
+//   - errMsg string
+func (_e *MockNotificationRepository_Expecter) MarkDead(ctx interface{}, id interface{}, attempts interface{}, errMsg interface{}) *MockNotificationRepository_MarkDead_Call {
+	return &MockNotificationRepository_MarkDead_Call{Call: _e.mock.On("MarkDead", ctx, id, attempts, errMsg)}
+}
+
+func (_c *MockNotificationRepository_MarkDead_Call) Run(run func(ctx context.Context, id int64, attempts int, errMsg string)) *MockNotificationRepository_MarkDead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_MarkDead_Call) Return(_a0 error) *MockNotificationRepository_MarkDead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationRepository_MarkDead_Call) RunAndReturn(run func(context.Context, int64, int, string) error) *MockNotificationRepository_MarkDead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDelivered provides a mock function with given fields: ctx, id, attempts
+func (_m *MockNotificationRepository) MarkDelivered(ctx context.Context, id int64, attempts int) error {
+	ret := _m.Called(ctx, id, attempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDelivered")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) error); ok {
+		r0 = rf(ctx, id, attempts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationRepository_MarkDelivered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDelivered'
+type MockNotificationRepository_MarkDelivered_Call struct {
+	*mock.Call
+}
+
+// MarkDelivered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - attempts int
+func (_e *MockNotificationRepository_Expecter) MarkDelivered(ctx interface{}, id interface{}, attempts interface{}) *MockNotificationRepository_MarkDelivered_Call {
+	return &MockNotificationRepository_MarkDelivered_Call{Call: _e.mock.On("MarkDelivered", ctx, id, attempts)}
+}
+
+func (_c *MockNotificationRepository_MarkDelivered_Call) Run(run func(ctx context.Context, id int64, attempts int)) *MockNotificationRepository_MarkDelivered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_MarkDelivered_Call) Return(_a0 error) *MockNotificationRepository_MarkDelivered_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationRepository_MarkDelivered_Call) RunAndReturn(run func(context.Context, int64, int) error) *MockNotificationRepository_MarkDelivered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduleRetry provides a mock function with given fields: ctx, id, attempts, nextAttemptAt, errMsg
+func (_m *MockNotificationRepository) ScheduleRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, errMsg string) error {
+	ret := _m.Called(ctx, id, attempts, nextAttemptAt, errMsg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleRetry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, time.Time, string) error); ok {
+		r0 = rf(ctx, id, attempts, nextAttemptAt, errMsg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationRepository_ScheduleRetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleRetry'
+type MockNotificationRepository_ScheduleRetry_Call struct {
+	*mock.Call
+}
+
+// ScheduleRetry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - attempts int
+//   - nextAttemptAt time.Time
+//   - errMsg string
+func (_e *MockNotificationRepository_Expecter) ScheduleRetry(ctx interface{}, id interface{}, attempts interface{}, nextAttemptAt interface{}, errMsg interface{}) *MockNotificationRepository_ScheduleRetry_Call {
+	return &MockNotificationRepository_ScheduleRetry_Call{Call: _e.mock.On("ScheduleRetry", ctx, id, attempts, nextAttemptAt, errMsg)}
+}
+
+func (_c *MockNotificationRepository_ScheduleRetry_Call) Run(run func(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, errMsg string)) *MockNotificationRepository_ScheduleRetry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(time.Time), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_ScheduleRetry_Call) Return(_a0 error) *MockNotificationRepository_ScheduleRetry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationRepository_ScheduleRetry_Call) RunAndReturn(run func(context.Context, int64, int, time.Time, string) error) *MockNotificationRepository_ScheduleRetry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationRepository creates a new instance of MockNotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationRepository {
+	mock := &MockNotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
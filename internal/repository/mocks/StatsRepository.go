@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/morinonusi421/cupid/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockStatsRepository is an autogenerated mock type for the StatsRepository type
+type MockStatsRepository struct {
+	mock.Mock
+}
+
+type MockStatsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStatsRepository) EXPECT() *MockStatsRepository_Expecter {
+	return &MockStatsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Collect provides a mock function with given fields: ctx
+func (_m *MockStatsRepository) Collect(ctx context.Context) (*model.AdminStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Collect")
+	}
+
+	var r0 *model.AdminStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.AdminStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.AdminStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AdminStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStatsRepository_Collect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Collect'
+type MockStatsRepository_Collect_Call struct {
+	*mock.Call
+}
+
+// Collect is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStatsRepository_Expecter) Collect(ctx interface{}) *MockStatsRepository_Collect_Call {
+	return &MockStatsRepository_Collect_Call{Call: _e.mock.On("Collect", ctx)}
+}
+
+func (_c *MockStatsRepository_Collect_Call) Run(run func(ctx context.Context)) *MockStatsRepository_Collect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStatsRepository_Collect_Call) Return(_a0 *model.AdminStats, _a1 error) *MockStatsRepository_Collect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStatsRepository_Collect_Call) RunAndReturn(run func(context.Context) (*model.AdminStats, error)) *MockStatsRepository_Collect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStatsRepository creates a new instance of MockStatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStatsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStatsRepository {
+	mock := &MockStatsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
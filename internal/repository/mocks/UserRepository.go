@@ -7,6 +7,8 @@ import (
 
 	model "github.com/morinonusi421/cupid/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockUserRepository is an autogenerated mock type for the UserRepository type
@@ -69,6 +71,53 @@ func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// Delete provides a mock function with given fields: ctx, lineID
+func (_m *MockUserRepository) Delete(ctx context.Context, lineID string) error {
+	ret := _m.Called(ctx, lineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, lineID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lineID string
+func (_e *MockUserRepository_Expecter) Delete(ctx interface{}, lineID interface{}) *MockUserRepository_Delete_Call {
+	return &MockUserRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, lineID)}
+}
+
+func (_c *MockUserRepository_Delete_Call) Run(run func(ctx context.Context, lineID string)) *MockUserRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) Return(_a0 error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByLineID provides a mock function with given fields: ctx, lineID
 func (_m *MockUserRepository) FindByLineID(ctx context.Context, lineID string) (*model.User, error) {
 	ret := _m.Called(ctx, lineID)
@@ -128,9 +177,9 @@ func (_c *MockUserRepository_FindByLineID_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// FindByNameAndBirthday provides a mock function with given fields: ctx, name, birthday
-func (_m *MockUserRepository) FindByNameAndBirthday(ctx context.Context, name string, birthday string) (*model.User, error) {
-	ret := _m.Called(ctx, name, birthday)
+// FindByNameAndBirthday provides a mock function with given fields: ctx, key, birthday
+func (_m *MockUserRepository) FindByNameAndBirthday(ctx context.Context, key model.IdentityKey, birthday model.Birthday) (*model.User, error) {
+	ret := _m.Called(ctx, key, birthday)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindByNameAndBirthday")
@@ -138,19 +187,19 @@ func (_m *MockUserRepository) FindByNameAndBirthday(ctx context.Context, name st
 
 	var r0 *model.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.User, error)); ok {
-		return rf(ctx, name, birthday)
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdentityKey, model.Birthday) (*model.User, error)); ok {
+		return rf(ctx, key, birthday)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.User); ok {
-		r0 = rf(ctx, name, birthday)
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdentityKey, model.Birthday) *model.User); ok {
+		r0 = rf(ctx, key, birthday)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, name, birthday)
+	if rf, ok := ret.Get(1).(func(context.Context, model.IdentityKey, model.Birthday) error); ok {
+		r1 = rf(ctx, key, birthday)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -165,15 +214,15 @@ type MockUserRepository_FindByNameAndBirthday_Call struct {
 
 // FindByNameAndBirthday is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-//   - birthday string
-func (_e *MockUserRepository_Expecter) FindByNameAndBirthday(ctx interface{}, name interface{}, birthday interface{}) *MockUserRepository_FindByNameAndBirthday_Call {
-	return &MockUserRepository_FindByNameAndBirthday_Call{Call: _e.mock.On("FindByNameAndBirthday", ctx, name, birthday)}
+//   - key model.IdentityKey
+//   - birthday model.Birthday
+func (_e *MockUserRepository_Expecter) FindByNameAndBirthday(ctx interface{}, key interface{}, birthday interface{}) *MockUserRepository_FindByNameAndBirthday_Call {
+	return &MockUserRepository_FindByNameAndBirthday_Call{Call: _e.mock.On("FindByNameAndBirthday", ctx, key, birthday)}
 }
 
-func (_c *MockUserRepository_FindByNameAndBirthday_Call) Run(run func(ctx context.Context, name string, birthday string)) *MockUserRepository_FindByNameAndBirthday_Call {
+func (_c *MockUserRepository_FindByNameAndBirthday_Call) Run(run func(ctx context.Context, key model.IdentityKey, birthday model.Birthday)) *MockUserRepository_FindByNameAndBirthday_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(model.IdentityKey), args[2].(model.Birthday))
 	})
 	return _c
 }
@@ -183,29 +232,29 @@ func (_c *MockUserRepository_FindByNameAndBirthday_Call) Return(_a0 *model.User,
 	return _c
 }
 
-func (_c *MockUserRepository_FindByNameAndBirthday_Call) RunAndReturn(run func(context.Context, string, string) (*model.User, error)) *MockUserRepository_FindByNameAndBirthday_Call {
+func (_c *MockUserRepository_FindByNameAndBirthday_Call) RunAndReturn(run func(context.Context, model.IdentityKey, model.Birthday) (*model.User, error)) *MockUserRepository_FindByNameAndBirthday_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindMatchingUser provides a mock function with given fields: ctx, currentUser
-func (_m *MockUserRepository) FindMatchingUser(ctx context.Context, currentUser *model.User) (*model.User, error) {
+// FindMatchingUsers provides a mock function with given fields: ctx, currentUser
+func (_m *MockUserRepository) FindMatchingUsers(ctx context.Context, currentUser *model.User) ([]*model.User, error) {
 	ret := _m.Called(ctx, currentUser)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindMatchingUser")
+		panic("no return value specified for FindMatchingUsers")
 	}
 
-	var r0 *model.User
+	var r0 []*model.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.User) (*model.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.User) ([]*model.User, error)); ok {
 		return rf(ctx, currentUser)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.User) *model.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.User) []*model.User); ok {
 		r0 = rf(ctx, currentUser)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
+			r0 = ret.Get(0).([]*model.User)
 		}
 	}
 
@@ -218,31 +267,194 @@ func (_m *MockUserRepository) FindMatchingUser(ctx context.Context, currentUser
 	return r0, r1
 }
 
-// MockUserRepository_FindMatchingUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMatchingUser'
-type MockUserRepository_FindMatchingUser_Call struct {
+// MockUserRepository_FindMatchingUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMatchingUsers'
+type MockUserRepository_FindMatchingUsers_Call struct {
 	*mock.Call
 }
 
-// FindMatchingUser is a helper method to define mock.On call
+// FindMatchingUsers is a helper method to define mock.On call
 //   - ctx context.Context
 //   - currentUser *model.User
-func (_e *MockUserRepository_Expecter) FindMatchingUser(ctx interface{}, currentUser interface{}) *MockUserRepository_FindMatchingUser_Call {
-	return &MockUserRepository_FindMatchingUser_Call{Call: _e.mock.On("FindMatchingUser", ctx, currentUser)}
+func (_e *MockUserRepository_Expecter) FindMatchingUsers(ctx interface{}, currentUser interface{}) *MockUserRepository_FindMatchingUsers_Call {
+	return &MockUserRepository_FindMatchingUsers_Call{Call: _e.mock.On("FindMatchingUsers", ctx, currentUser)}
 }
 
-func (_c *MockUserRepository_FindMatchingUser_Call) Run(run func(ctx context.Context, currentUser *model.User)) *MockUserRepository_FindMatchingUser_Call {
+func (_c *MockUserRepository_FindMatchingUsers_Call) Run(run func(ctx context.Context, currentUser *model.User)) *MockUserRepository_FindMatchingUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*model.User))
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_FindMatchingUser_Call) Return(_a0 *model.User, _a1 error) *MockUserRepository_FindMatchingUser_Call {
+func (_c *MockUserRepository_FindMatchingUsers_Call) Return(_a0 []*model.User, _a1 error) *MockUserRepository_FindMatchingUsers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUserRepository_FindMatchingUser_Call) RunAndReturn(run func(context.Context, *model.User) (*model.User, error)) *MockUserRepository_FindMatchingUser_Call {
+func (_c *MockUserRepository_FindMatchingUsers_Call) RunAndReturn(run func(context.Context, *model.User) ([]*model.User, error)) *MockUserRepository_FindMatchingUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsDeleted provides a mock function with given fields: ctx, lineID
+func (_m *MockUserRepository) IsDeleted(ctx context.Context, lineID string) (bool, error) {
+	ret := _m.Called(ctx, lineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsDeleted")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, lineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, lineID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, lineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_IsDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsDeleted'
+type MockUserRepository_IsDeleted_Call struct {
+	*mock.Call
+}
+
+// IsDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lineID string
+func (_e *MockUserRepository_Expecter) IsDeleted(ctx interface{}, lineID interface{}) *MockUserRepository_IsDeleted_Call {
+	return &MockUserRepository_IsDeleted_Call{Call: _e.mock.On("IsDeleted", ctx, lineID)}
+}
+
+func (_c *MockUserRepository_IsDeleted_Call) Run(run func(ctx context.Context, lineID string)) *MockUserRepository_IsDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_IsDeleted_Call) Return(_a0 bool, _a1 error) *MockUserRepository_IsDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_IsDeleted_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockUserRepository_IsDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMatched provides a mock function with given fields: ctx
+func (_m *MockUserRepository) ListMatched(ctx context.Context) ([]*model.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMatched")
+	}
+
+	var r0 []*model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_ListMatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMatched'
+type MockUserRepository_ListMatched_Call struct {
+	*mock.Call
+}
+
+// ListMatched is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserRepository_Expecter) ListMatched(ctx interface{}) *MockUserRepository_ListMatched_Call {
+	return &MockUserRepository_ListMatched_Call{Call: _e.mock.On("ListMatched", ctx)}
+}
+
+func (_c *MockUserRepository_ListMatched_Call) Run(run func(ctx context.Context)) *MockUserRepository_ListMatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_ListMatched_Call) Return(_a0 []*model.User, _a1 error) *MockUserRepository_ListMatched_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_ListMatched_Call) RunAndReturn(run func(context.Context) ([]*model.User, error)) *MockUserRepository_ListMatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestWithdrawal provides a mock function with given fields: ctx, lineID, at
+func (_m *MockUserRepository) RequestWithdrawal(ctx context.Context, lineID string, at time.Time) error {
+	ret := _m.Called(ctx, lineID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestWithdrawal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, lineID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_RequestWithdrawal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestWithdrawal'
+type MockUserRepository_RequestWithdrawal_Call struct {
+	*mock.Call
+}
+
+// RequestWithdrawal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lineID string
+//   - at time.Time
+func (_e *MockUserRepository_Expecter) RequestWithdrawal(ctx interface{}, lineID interface{}, at interface{}) *MockUserRepository_RequestWithdrawal_Call {
+	return &MockUserRepository_RequestWithdrawal_Call{Call: _e.mock.On("RequestWithdrawal", ctx, lineID, at)}
+}
+
+func (_c *MockUserRepository_RequestWithdrawal_Call) Run(run func(ctx context.Context, lineID string, at time.Time)) *MockUserRepository_RequestWithdrawal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_RequestWithdrawal_Call) Return(_a0 error) *MockUserRepository_RequestWithdrawal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_RequestWithdrawal_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockUserRepository_RequestWithdrawal_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -294,6 +506,159 @@ func (_c *MockUserRepository_Update_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UpdateLineDisplayName provides a mock function with given fields: ctx, lineID, displayName
+func (_m *MockUserRepository) UpdateLineDisplayName(ctx context.Context, lineID string, displayName string) error {
+	ret := _m.Called(ctx, lineID, displayName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLineDisplayName")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, lineID, displayName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdateLineDisplayName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLineDisplayName'
+type MockUserRepository_UpdateLineDisplayName_Call struct {
+	*mock.Call
+}
+
+// UpdateLineDisplayName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lineID string
+//   - displayName string
+func (_e *MockUserRepository_Expecter) UpdateLineDisplayName(ctx interface{}, lineID interface{}, displayName interface{}) *MockUserRepository_UpdateLineDisplayName_Call {
+	return &MockUserRepository_UpdateLineDisplayName_Call{Call: _e.mock.On("UpdateLineDisplayName", ctx, lineID, displayName)}
+}
+
+func (_c *MockUserRepository_UpdateLineDisplayName_Call) Run(run func(ctx context.Context, lineID string, displayName string)) *MockUserRepository_UpdateLineDisplayName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateLineDisplayName_Call) Return(_a0 error) *MockUserRepository_UpdateLineDisplayName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateLineDisplayName_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserRepository_UpdateLineDisplayName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function with given fields: ctx, fn
+func (_m *MockUserRepository) WithTx(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockUserRepository_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockUserRepository_Expecter) WithTx(ctx interface{}, fn interface{}) *MockUserRepository_WithTx_Call {
+	return &MockUserRepository_WithTx_Call{Call: _e.mock.On("WithTx", ctx, fn)}
+}
+
+func (_c *MockUserRepository_WithTx_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockUserRepository_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_WithTx_Call) Return(_a0 error) *MockUserRepository_WithTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_WithTx_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockUserRepository_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithdrawalRequestedSince provides a mock function with given fields: ctx, lineID, since
+func (_m *MockUserRepository) WithdrawalRequestedSince(ctx context.Context, lineID string, since time.Time) (bool, error) {
+	ret := _m.Called(ctx, lineID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithdrawalRequestedSince")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (bool, error)); ok {
+		return rf(ctx, lineID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) bool); ok {
+		r0 = rf(ctx, lineID, since)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, lineID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_WithdrawalRequestedSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithdrawalRequestedSince'
+type MockUserRepository_WithdrawalRequestedSince_Call struct {
+	*mock.Call
+}
+
+// WithdrawalRequestedSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lineID string
+//   - since time.Time
+func (_e *MockUserRepository_Expecter) WithdrawalRequestedSince(ctx interface{}, lineID interface{}, since interface{}) *MockUserRepository_WithdrawalRequestedSince_Call {
+	return &MockUserRepository_WithdrawalRequestedSince_Call{Call: _e.mock.On("WithdrawalRequestedSince", ctx, lineID, since)}
+}
+
+func (_c *MockUserRepository_WithdrawalRequestedSince_Call) Run(run func(ctx context.Context, lineID string, since time.Time)) *MockUserRepository_WithdrawalRequestedSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_WithdrawalRequestedSince_Call) Return(_a0 bool, _a1 error) *MockUserRepository_WithdrawalRequestedSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_WithdrawalRequestedSince_Call) RunAndReturn(run func(context.Context, string, time.Time) (bool, error)) *MockUserRepository_WithdrawalRequestedSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUserRepository(t interface {
@@ -0,0 +1,262 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/morinonusi421/cupid/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockWebhookEventRepository is an autogenerated mock type for the WebhookEventRepository type
+type MockWebhookEventRepository struct {
+	mock.Mock
+}
+
+type MockWebhookEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookEventRepository) EXPECT() *MockWebhookEventRepository_Expecter {
+	return &MockWebhookEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, event, staleBefore
+func (_m *MockWebhookEventRepository) Claim(ctx context.Context, event *model.WebhookEvent, staleBefore time.Time) (bool, error) {
+	ret := _m.Called(ctx, event, staleBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookEvent, time.Time) (bool, error)); ok {
+		return rf(ctx, event, staleBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookEvent, time.Time) bool); ok {
+		r0 = rf(ctx, event, staleBefore)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.WebhookEvent, time.Time) error); ok {
+		r1 = rf(ctx, event, staleBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookEventRepository_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockWebhookEventRepository_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *model.WebhookEvent
+//   - staleBefore time.Time
+func (_e *MockWebhookEventRepository_Expecter) Claim(ctx interface{}, event interface{}, staleBefore interface{}) *MockWebhookEventRepository_Claim_Call {
+	return &MockWebhookEventRepository_Claim_Call{Call: _e.mock.On("Claim", ctx, event, staleBefore)}
+}
+
+func (_c *MockWebhookEventRepository_Claim_Call) Run(run func(ctx context.Context, event *model.WebhookEvent, staleBefore time.Time)) *MockWebhookEventRepository_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.WebhookEvent), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockWebhookEventRepository_Claim_Call) Return(_a0 bool, _a1 error) *MockWebhookEventRepository_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookEventRepository_Claim_Call) RunAndReturn(run func(context.Context, *model.WebhookEvent, time.Time) (bool, error)) *MockWebhookEventRepository_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReceivedBefore provides a mock function with given fields: ctx, before
+func (_m *MockWebhookEventRepository) DeleteReceivedBefore(ctx context.Context, before time.Time) (int64, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReceivedBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookEventRepository_DeleteReceivedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReceivedBefore'
+type MockWebhookEventRepository_DeleteReceivedBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteReceivedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockWebhookEventRepository_Expecter) DeleteReceivedBefore(ctx interface{}, before interface{}) *MockWebhookEventRepository_DeleteReceivedBefore_Call {
+	return &MockWebhookEventRepository_DeleteReceivedBefore_Call{Call: _e.mock.On("DeleteReceivedBefore", ctx, before)}
+}
+
+func (_c *MockWebhookEventRepository_DeleteReceivedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockWebhookEventRepository_DeleteReceivedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockWebhookEventRepository_DeleteReceivedBefore_Call) Return(_a0 int64, _a1 error) *MockWebhookEventRepository_DeleteReceivedBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookEventRepository_DeleteReceivedBefore_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *MockWebhookEventRepository_DeleteReceivedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, eventID
+func (_m *MockWebhookEventRepository) FindByID(ctx context.Context, eventID string) (*model.WebhookEvent, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *model.WebhookEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.WebhookEvent, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.WebhookEvent); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookEventRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockWebhookEventRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockWebhookEventRepository_Expecter) FindByID(ctx interface{}, eventID interface{}) *MockWebhookEventRepository_FindByID_Call {
+	return &MockWebhookEventRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, eventID)}
+}
+
+func (_c *MockWebhookEventRepository_FindByID_Call) Run(run func(ctx context.Context, eventID string)) *MockWebhookEventRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookEventRepository_FindByID_Call) Return(_a0 *model.WebhookEvent, _a1 error) *MockWebhookEventRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookEventRepository_FindByID_Call) RunAndReturn(run func(context.Context, string) (*model.WebhookEvent, error)) *MockWebhookEventRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Finish provides a mock function with given fields: ctx, eventID, status, errMsg
+func (_m *MockWebhookEventRepository) Finish(ctx context.Context, eventID string, status model.WebhookEventStatus, errMsg string) error {
+	ret := _m.Called(ctx, eventID, status, errMsg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Finish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.WebhookEventStatus, string) error); ok {
+		r0 = rf(ctx, eventID, status, errMsg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWebhookEventRepository_Finish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Finish'
+type MockWebhookEventRepository_Finish_Call struct {
+	*mock.Call
+}
+
+// Finish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - status model.WebhookEventStatus
+//   - errMsg string
+func (_e *MockWebhookEventRepository_Expecter) Finish(ctx interface{}, eventID interface{}, status interface{}, errMsg interface{}) *MockWebhookEventRepository_Finish_Call {
+	return &MockWebhookEventRepository_Finish_Call{Call: _e.mock.On("Finish", ctx, eventID, status, errMsg)}
+}
+
+func (_c *MockWebhookEventRepository_Finish_Call) Run(run func(ctx context.Context, eventID string, status model.WebhookEventStatus, errMsg string)) *MockWebhookEventRepository_Finish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.WebhookEventStatus), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockWebhookEventRepository_Finish_Call) Return(_a0 error) *MockWebhookEventRepository_Finish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWebhookEventRepository_Finish_Call) RunAndReturn(run func(context.Context, string, model.WebhookEventStatus, string) error) *MockWebhookEventRepository_Finish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookEventRepository creates a new instance of MockWebhookEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookEventRepository {
+	mock := &MockWebhookEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/pkg/database"
+)
+
+// Migrations は SQL で書けないため Go で適用するマイグレーション（database.InitDB / LoadMigrations に渡す）
+func Migrations() []database.Migration {
+	return []database.Migration{
+		{
+			// 0011 の SQL（小書きの仮名を大きい仮名に揃える規則）で埋めたキーを、現在の model.NameKey の規則
+			// （小書きの仮名を区別し、長音の表記ゆれを揃える）で作り直す。長音・漢字・ローマ字の規則は SQL で書けない
+			ID:      "0016_rebuild_name_keys.go",
+			Version: 16,
+			UpFunc: func(tx *sql.Tx) error {
+				_, err := rebuildNameKeys(tx)
+				return err
+			},
+		},
+	}
+}
+
+// nameKeyTables は名前のキーを持つテーブル（キー以外のカラムはマイグレーションの時点のスキーマに依存しないよう名前で指定する）
+var nameKeyTables = []struct {
+	table, id, name, altName, nameKey, altNameKey string
+}{
+	{"users", "line_user_id", "name", "alt_name", "name_key", "alt_name_key"},
+	{"crushes", "id", "crush_name", "crush_alt_name", "crush_name_key", "crush_alt_name_key"},
+}
+
+// rebuildNameKeys は users・crushes の名前のキーを model.NameKey で作り直し、更新した行数を返す
+// キーが変わらない行は更新しない
+func rebuildNameKeys(tx *sql.Tx) (int64, error) {
+	var updated int64
+	for _, t := range nameKeyTables {
+		rows, err := tx.Query(fmt.Sprintf("SELECT %s, %s, %s, %s, %s FROM %s", t.id, t.name, t.altName, t.nameKey, t.altNameKey, t.table))
+		if err != nil {
+			return 0, fmt.Errorf("failed to list %s: %w", t.table, err)
+		}
+		type change struct {
+			id              any
+			nameKey, altKey string
+		}
+		var changes []change
+		for rows.Next() {
+			var (
+				id                             any
+				name, altName, nameKey, altKey string
+			)
+			if err := rows.Scan(&id, &name, &altName, &nameKey, &altKey); err != nil {
+				rows.Close()
+				return 0, fmt.Errorf("failed to scan %s: %w", t.table, err)
+			}
+			if wantName, wantAlt := model.NameKey(name), altNameKey(altName); nameKey != wantName || altKey != wantAlt {
+				changes = append(changes, change{id: id, nameKey: wantName, altKey: wantAlt})
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return 0, fmt.Errorf("failed to list %s: %w", t.table, err)
+		}
+
+		update := fmt.Sprintf("UPDATE %s SET %s = ?, %s = ? WHERE %s = ?", t.table, t.nameKey, t.altNameKey, t.id)
+		for _, c := range changes {
+			res, err := tx.Exec(update, c.nameKey, c.altKey, c.id)
+			if err != nil {
+				return 0, fmt.Errorf("failed to update name keys of %s %v: %w", t.table, c.id, err)
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				return 0, err
+			}
+			updated += n
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"os"
+	"testing"
+
+	"github.com/morinonusi421/cupid/pkg/database"
+)
+
+// TestMigrations_RebuildNameKeys は 0011 の規則で埋めたキーが 0016 で現在の規則に作り直されることを確認する
+func TestMigrations_RebuildNameKeys(t *testing.T) {
+	testDBPath := "test_repo_name_keys.db"
+	defer os.Remove(testDBPath)
+
+	db, err := database.Open(testDBPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	migrations, err := database.LoadMigrations(Migrations()...)
+	if err != nil {
+		t.Fatalf("LoadMigrations failed: %v", err)
+	}
+	var before []database.Migration
+	for _, m := range migrations {
+		if m.ID == "0016_rebuild_name_keys.go" {
+			break
+		}
+		before = append(before, m)
+	}
+	if _, err := database.Migrate(db, before, false); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+
+	// 古い規則のキー（小書きの仮名を大きい仮名に揃えていた）と、キーが空の行
+	if _, err := db.Exec(`INSERT INTO users (line_user_id, name, name_key, alt_name, alt_name_key, birthday) VALUES
+		('U1', 'キョウコ', 'キヨウコ', '', '', '1990-01-01'),
+		('U2', 'サトータロー', '', 'Taro Sato', '', '1990-01-01'),
+		('U3', 'ヤマダハナ', 'ヤマダハナ', '', '', '1990-01-01')`); err != nil {
+		t.Fatalf("Failed to insert users: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO crushes (user_line_id, crush_name, crush_name_key, crush_alt_name, crush_alt_name_key, crush_birthday) VALUES
+		('U1', 'ハットリ', 'ハツトリ', 'キョーコ', 'キヨーコ', '1990-01-01'),
+		('U3', 'ヤマダタロウ', 'ヤマダタロウ', '', '', '1990-01-01')`); err != nil {
+		t.Fatalf("Failed to insert crushes: %v", err)
+	}
+
+	applied, err := database.Migrate(db, migrations, false)
+	if err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	if len(applied) == 0 || applied[0] != "0016_rebuild_name_keys.go" {
+		t.Fatalf("Expected 0016_rebuild_name_keys.go to be applied, got %v", applied)
+	}
+
+	users := map[string][2]string{
+		"U1": {"キョウコ", ""},
+		"U2": {"サトウタロウ", "tarosato"},
+		"U3": {"ヤマダハナ", ""},
+	}
+	for lineID, want := range users {
+		var nameKey, altKey string
+		if err := db.QueryRow("SELECT name_key, alt_name_key FROM users WHERE line_user_id = ?", lineID).Scan(&nameKey, &altKey); err != nil {
+			t.Fatalf("Failed to select user: %v", err)
+		}
+		if nameKey != want[0] || altKey != want[1] {
+			t.Errorf("Expected keys of %s to be %v, got [%s %s]", lineID, want, nameKey, altKey)
+		}
+	}
+
+	var crushKey, crushAltKey string
+	if err := db.QueryRow("SELECT crush_name_key, crush_alt_name_key FROM crushes WHERE user_line_id = 'U1'").Scan(&crushKey, &crushAltKey); err != nil {
+		t.Fatalf("Failed to select crush: %v", err)
+	}
+	if crushKey != "ハットリ" || crushAltKey != "キョウコ" {
+		t.Errorf("Expected crush keys [ハットリ キョウコ], got [%s %s]", crushKey, crushAltKey)
+	}
+
+	// 適用済みのため、次の起動では作り直さない
+	applied, err = database.Migrate(db, migrations, false)
+	if err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	if len(applied) != 0 {
+		t.Errorf("Expected no migrations to apply, got %v", applied)
+	}
+}
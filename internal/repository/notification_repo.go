@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/morinonusi421/cupid/entities"
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+// NotificationRepository は送信待ちPush通知（notification_outbox）のデータアクセス層のインターフェース
+//
+// Enqueue を UserRepository.WithTx の fn 内で呼ぶと、マッチング成立・解除と同じトランザクションで記録される
+type NotificationRepository interface {
+	// Enqueue は通知を送信待ちとして記録する（notification.ID などには保存後の値が設定される）
+	Enqueue(ctx context.Context, notification *model.Notification) error
+	// ListDue は now の時点で送信すべき通知を古い順に最大 limit 件返す
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*model.Notification, error)
+	MarkDelivered(ctx context.Context, id int64, attempts int) error
+	// ScheduleRetry は送信に失敗した通知を nextAttemptAt に再送するよう記録する
+	ScheduleRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, errMsg string) error
+	// MarkDead は再送を諦めた通知を記録する
+	MarkDead(ctx context.Context, id int64, attempts int, errMsg string) error
+	// DiscardPending は toUserID 宛ての送信待ちの通知をすべて送信しないものとして記録する
+	DiscardPending(ctx context.Context, toUserID, errMsg string) error
+	// ListByUserID は toUserID 宛ての通知を送信状況に関係なく古い順に返す
+	ListByUserID(ctx context.Context, toUserID string) ([]*model.Notification, error)
+	FindByID(ctx context.Context, id int64) (*model.Notification, error)
+}
+
+type notificationRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationRepository は NotificationRepository の新しいインスタンスを作成する
+func NewNotificationRepository(db *sql.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// Enqueue は通知を送信待ちとして記録する（すぐに送信対象になる）
+func (r *notificationRepository) Enqueue(ctx context.Context, notification *model.Notification) error {
+	e := &entities.NotificationOutbox{
+		ToUserID: notification.ToUserID,
+		Kind:     string(notification.Kind),
+		Text:     notification.Text,
+		RetryKey: notification.RetryKey,
+		Status:   string(model.NotificationPending),
+	}
+	if err := e.Insert(ctx, executorFromContext(ctx, r.db), boil.Infer()); err != nil {
+		return err
+	}
+
+	*notification = *notificationEntityToModel(e)
+	return nil
+}
+
+// ListDue は送信待ちで再送時刻を過ぎた通知を返す
+func (r *notificationRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.Notification, error) {
+	es, err := entities.NotificationOutboxes(
+		qm.Where(entities.NotificationOutboxColumns.Status+" = ?", string(model.NotificationPending)),
+		qm.And(entities.NotificationOutboxColumns.NextAttemptAt+" <= ?", now.UTC().Format(sqliteTimeFormat)),
+		qm.OrderBy(entities.NotificationOutboxColumns.ID),
+		qm.Limit(limit),
+	).All(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*model.Notification, 0, len(es))
+	for _, e := range es {
+		notifications = append(notifications, notificationEntityToModel(e))
+	}
+	return notifications, nil
+}
+
+// MarkDelivered は通知を送信済みとして記録する
+func (r *notificationRepository) MarkDelivered(ctx context.Context, id int64, attempts int) error {
+	return r.update(ctx, id, entities.M{
+		entities.NotificationOutboxColumns.Status:      string(model.NotificationDelivered),
+		entities.NotificationOutboxColumns.Attempts:    attempts,
+		entities.NotificationOutboxColumns.DeliveredAt: time.Now().UTC().Format(sqliteTimeFormat),
+	})
+}
+
+// ScheduleRetry は通知を再送待ちとして記録する
+func (r *notificationRepository) ScheduleRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, errMsg string) error {
+	return r.update(ctx, id, entities.M{
+		entities.NotificationOutboxColumns.Attempts:      attempts,
+		entities.NotificationOutboxColumns.NextAttemptAt: nextAttemptAt.UTC().Format(sqliteTimeFormat),
+		entities.NotificationOutboxColumns.LastError:     null.StringFrom(errMsg),
+	})
+}
+
+// MarkDead は通知を再送しないものとして記録する
+func (r *notificationRepository) MarkDead(ctx context.Context, id int64, attempts int, errMsg string) error {
+	return r.update(ctx, id, entities.M{
+		entities.NotificationOutboxColumns.Status:    string(model.NotificationDead),
+		entities.NotificationOutboxColumns.Attempts:  attempts,
+		entities.NotificationOutboxColumns.LastError: null.StringFrom(errMsg),
+	})
+}
+
+// DiscardPending は toUserID 宛ての送信待ちの通知を dead にする（退会したユーザーには送信できないため）
+func (r *notificationRepository) DiscardPending(ctx context.Context, toUserID, errMsg string) error {
+	_, err := entities.NotificationOutboxes(
+		qm.Where(entities.NotificationOutboxColumns.ToUserID+" = ?", toUserID),
+		qm.And(entities.NotificationOutboxColumns.Status+" = ?", string(model.NotificationPending)),
+	).UpdateAll(ctx, executorFromContext(ctx, r.db), entities.M{
+		entities.NotificationOutboxColumns.Status:    string(model.NotificationDead),
+		entities.NotificationOutboxColumns.LastError: null.StringFrom(errMsg),
+	})
+	return err
+}
+
+// ListByUserID は toUserID 宛ての通知を古い順に返す（データのエクスポート用）
+func (r *notificationRepository) ListByUserID(ctx context.Context, toUserID string) ([]*model.Notification, error) {
+	entityNotifications, err := entities.NotificationOutboxes(
+		qm.Where(entities.NotificationOutboxColumns.ToUserID+" = ?", toUserID),
+		qm.OrderBy(entities.NotificationOutboxColumns.ID),
+	).All(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*model.Notification, 0, len(entityNotifications))
+	for _, e := range entityNotifications {
+		notifications = append(notifications, notificationEntityToModel(e))
+	}
+	return notifications, nil
+}
+
+// FindByID は通知を返す（見つからなければnil）
+func (r *notificationRepository) FindByID(ctx context.Context, id int64) (*model.Notification, error) {
+	e, err := entities.NotificationOutboxes(
+		qm.Where(entities.NotificationOutboxColumns.ID+" = ?", id),
+	).One(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return notificationEntityToModel(e), nil
+}
+
+func (r *notificationRepository) update(ctx context.Context, id int64, cols entities.M) error {
+	_, err := entities.NotificationOutboxes(
+		qm.Where(entities.NotificationOutboxColumns.ID+" = ?", id),
+	).UpdateAll(ctx, executorFromContext(ctx, r.db), cols)
+	return err
+}
+
+// notificationEntityToModel は entities.NotificationOutbox を model.Notification に変換する
+func notificationEntityToModel(e *entities.NotificationOutbox) *model.Notification {
+	return &model.Notification{
+		ID:            e.ID.Int64,
+		ToUserID:      e.ToUserID,
+		Kind:          model.NotificationKind(e.Kind),
+		Text:          e.Text,
+		RetryKey:      e.RetryKey,
+		Status:        model.NotificationStatus(e.Status),
+		Attempts:      int(e.Attempts),
+		NextAttemptAt: e.NextAttemptAt,
+		LastError:     e.LastError.String,
+		CreatedAt:     e.CreatedAt,
+		DeliveredAt:   e.DeliveredAt.String,
+	}
+}
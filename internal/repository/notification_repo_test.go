@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+func TestNotificationRepository_Lifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	ctx := context.Background()
+
+	n := &model.Notification{ToUserID: "U-alice", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "key-1"}
+	if err := repo.Enqueue(ctx, n); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+	if n.ID == 0 || n.Status != model.NotificationPending || n.NextAttemptAt == "" {
+		t.Fatalf("Unexpected notification after enqueue: %+v", n)
+	}
+
+	// 記録した直後から送信対象になる
+	due, err := repo.ListDue(ctx, time.Now().Add(time.Second), 10)
+	if err != nil {
+		t.Fatalf("ListDue failed: %v", err)
+	}
+	if len(due) != 1 || due[0].ID != n.ID {
+		t.Fatalf("Expected notification %d to be due, got %+v", n.ID, due)
+	}
+
+	// 再送時刻までは送信対象にならない
+	if err := repo.ScheduleRetry(ctx, n.ID, 1, time.Now().Add(time.Hour), "429"); err != nil {
+		t.Fatalf("ScheduleRetry failed: %v", err)
+	}
+	due, err = repo.ListDue(ctx, time.Now().Add(time.Second), 10)
+	if err != nil {
+		t.Fatalf("ListDue failed: %v", err)
+	}
+	if len(due) != 0 {
+		t.Errorf("Expected no due notifications before retry time, got %+v", due)
+	}
+	due, err = repo.ListDue(ctx, time.Now().Add(2*time.Hour), 10)
+	if err != nil {
+		t.Fatalf("ListDue failed: %v", err)
+	}
+	if len(due) != 1 || due[0].Attempts != 1 || due[0].LastError != "429" {
+		t.Fatalf("Expected retried notification to be due, got %+v", due)
+	}
+
+	// 送信済みになれば送信対象にならない
+	if err := repo.MarkDelivered(ctx, n.ID, 2); err != nil {
+		t.Fatalf("MarkDelivered failed: %v", err)
+	}
+	found, err := repo.FindByID(ctx, n.ID)
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if found.Status != model.NotificationDelivered || found.Attempts != 2 || found.DeliveredAt == "" {
+		t.Errorf("Unexpected notification after delivery: %+v", found)
+	}
+	due, err = repo.ListDue(ctx, time.Now().Add(2*time.Hour), 10)
+	if err != nil {
+		t.Fatalf("ListDue failed: %v", err)
+	}
+	if len(due) != 0 {
+		t.Errorf("Expected delivered notification not to be due, got %+v", due)
+	}
+}
+
+func TestNotificationRepository_MarkDead(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	ctx := context.Background()
+
+	n := &model.Notification{ToUserID: "U-alice", Kind: model.NotificationUnmatch, Text: "解除されました", RetryKey: "key-1"}
+	if err := repo.Enqueue(ctx, n); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+	if err := repo.MarkDead(ctx, n.ID, 3, "400 invalid user"); err != nil {
+		t.Fatalf("MarkDead failed: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, n.ID)
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if found.Status != model.NotificationDead || found.Attempts != 3 || found.LastError != "400 invalid user" {
+		t.Errorf("Unexpected notification after dead-lettering: %+v", found)
+	}
+}
+
+func TestNotificationRepository_DiscardPending(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	ctx := context.Background()
+
+	pending := &model.Notification{ToUserID: "U-alice", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "key-1"}
+	delivered := &model.Notification{ToUserID: "U-alice", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "key-2"}
+	other := &model.Notification{ToUserID: "U-bob", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "key-3"}
+	for _, n := range []*model.Notification{pending, delivered, other} {
+		if err := repo.Enqueue(ctx, n); err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+	}
+	if err := repo.MarkDelivered(ctx, delivered.ID, 1); err != nil {
+		t.Fatalf("MarkDelivered failed: %v", err)
+	}
+
+	if err := repo.DiscardPending(ctx, "U-alice", "user deleted"); err != nil {
+		t.Fatalf("DiscardPending failed: %v", err)
+	}
+
+	// 送信待ちの通知だけが dead になる
+	want := map[int64]model.NotificationStatus{
+		pending.ID:   model.NotificationDead,
+		delivered.ID: model.NotificationDelivered,
+		other.ID:     model.NotificationPending,
+	}
+	for id, status := range want {
+		found, err := repo.FindByID(ctx, id)
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if found.Status != status {
+			t.Errorf("Expected notification %d to be %s, got %s", id, status, found.Status)
+		}
+	}
+}
+
+func TestNotificationRepository_ListByUserID(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+	ctx := context.Background()
+
+	first := &model.Notification{ToUserID: "U-alice", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "key-1"}
+	other := &model.Notification{ToUserID: "U-bob", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "key-2"}
+	second := &model.Notification{ToUserID: "U-alice", Kind: model.NotificationUnmatch, Text: "解除されました", RetryKey: "key-3"}
+	for _, n := range []*model.Notification{first, other, second} {
+		if err := repo.Enqueue(ctx, n); err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+	}
+	if err := repo.MarkDelivered(ctx, first.ID, 1); err != nil {
+		t.Fatalf("MarkDelivered failed: %v", err)
+	}
+
+	// 送信状況に関係なく、宛先のユーザーの通知だけを古い順に返す
+	got, err := repo.ListByUserID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 notifications, got %d", len(got))
+	}
+	if got[0].ID != first.ID || got[1].ID != second.ID {
+		t.Errorf("Unexpected order: %d, %d", got[0].ID, got[1].ID)
+	}
+	if got[0].Status != model.NotificationDelivered || got[0].DeliveredAt == "" {
+		t.Errorf("Expected first notification to be delivered, got %s", got[0].Status)
+	}
+	if got[1].Status != model.NotificationPending {
+		t.Errorf("Expected second notification to be pending, got %s", got[1].Status)
+	}
+}
+
+func TestNotificationRepository_EnqueueInTx(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	repo := NewNotificationRepository(db)
+	ctx := context.Background()
+
+	// トランザクションがロールバックされた場合は記録されない
+	errRollback := errors.New("rollback")
+	var n *model.Notification
+	err := userRepo.WithTx(ctx, func(ctx context.Context) error {
+		n = &model.Notification{ToUserID: "U-alice", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "key-1"}
+		if err := repo.Enqueue(ctx, n); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("Expected rollback error, got %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, n.ID)
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if found != nil {
+		t.Errorf("Expected notification to be rolled back, got %+v", found)
+	}
+}
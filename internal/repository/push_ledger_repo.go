@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/morinonusi421/cupid/entities"
+)
+
+// PushLedgerRepository は送信したPushメッセージの記録（push_ledger）のデータアクセス層のインターフェース
+// linebot.PushLedger を満たす
+type PushLedgerRepository interface {
+	// Record は1回のPush送信を記録する（retryKey は指定しなかった場合は空文字列）
+	Record(ctx context.Context, toUserID, retryKey string) error
+	// CountSince は since 以降に送信したPushメッセージの数を返す
+	CountSince(ctx context.Context, since time.Time) (int, error)
+}
+
+type pushLedgerRepository struct {
+	db *sql.DB
+}
+
+// NewPushLedgerRepository は PushLedgerRepository の新しいインスタンスを作成する
+func NewPushLedgerRepository(db *sql.DB) PushLedgerRepository {
+	return &pushLedgerRepository{db: db}
+}
+
+// Record は1回のPush送信を記録する
+func (r *pushLedgerRepository) Record(ctx context.Context, toUserID, retryKey string) error {
+	e := &entities.PushLedger{
+		ToUserID: toUserID,
+		RetryKey: null.NewString(retryKey, retryKey != ""),
+	}
+	return e.Insert(ctx, executorFromContext(ctx, r.db), boil.Infer())
+}
+
+// CountSince は since 以降に送信したPushメッセージの数を返す
+func (r *pushLedgerRepository) CountSince(ctx context.Context, since time.Time) (int, error) {
+	n, err := entities.PushLedgers(
+		qm.Where(entities.PushLedgerColumns.SentAt+" >= ?", since.UTC().Format(sqliteTimeFormat)),
+	).Count(ctx, executorFromContext(ctx, r.db))
+	return int(n), err
+}
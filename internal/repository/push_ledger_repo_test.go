@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPushLedgerRepository_CountSince(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewPushLedgerRepository(db)
+	ctx := context.Background()
+
+	// 先月の送信（数えない）
+	if _, err := db.Exec("INSERT INTO push_ledger (to_user_id, sent_at) VALUES ('U-alice', ?)", time.Now().AddDate(0, -1, 0).UTC().Format(sqliteTimeFormat)); err != nil {
+		t.Fatalf("Failed to insert old ledger entry: %v", err)
+	}
+	if err := repo.Record(ctx, "U-alice", ""); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if err := repo.Record(ctx, "U-bob", "retry-key-1"); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+
+	n, err := repo.CountSince(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("CountSince failed: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Expected 2 pushes in the last hour, got %d", n)
+	}
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/morinonusi421/cupid/entities"
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+// StatsRepository は管理APIで返す集計のデータアクセス層のインターフェース
+type StatsRepository interface {
+	// Collect はユーザー・好きな人・マッチング・Push通知の件数を集計する
+	Collect(ctx context.Context) (*model.AdminStats, error)
+}
+
+type statsRepository struct {
+	db *sql.DB
+}
+
+// NewStatsRepository は StatsRepository の新しいインスタンスを作成する
+func NewStatsRepository(db *sql.DB) StatsRepository {
+	return &statsRepository{db: db}
+}
+
+// Collect はユーザー・好きな人・マッチング・Push通知の件数を集計する
+// WithTx の fn 内で呼ぶと、同じ時点の件数を集計できる
+func (r *statsRepository) Collect(ctx context.Context) (*model.AdminStats, error) {
+	exec := executorFromContext(ctx, r.db)
+
+	users, err := entities.Users(qm.Where(entities.UserColumns.DeletedAt+" IS NULL")).Count(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	withdrawn, err := entities.Users(qm.Where(entities.UserColumns.DeletedAt+" IS NOT NULL")).Count(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	matched, err := entities.Users(
+		qm.Where(entities.UserColumns.MatchedWithUserID+" IS NOT NULL"),
+		qm.And(entities.UserColumns.DeletedAt+" IS NULL"),
+	).Count(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	crushes, err := entities.Crushes().Count(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := entities.NotificationOutboxes(
+		qm.Where(entities.NotificationOutboxColumns.Status+" = ?", string(model.NotificationPending)),
+	).Count(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	dead, err := entities.NotificationOutboxes(
+		qm.Where(entities.NotificationOutboxColumns.Status+" = ?", string(model.NotificationDead)),
+	).Count(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AdminStats{
+		Users:                int(users),
+		WithdrawnUsers:       int(withdrawn),
+		MatchedPairs:         int(matched) / 2, // マッチング中の2人はお互いを matched_with_user_id に持つ
+		Crushes:              int(crushes),
+		PendingNotifications: int(pending),
+		DeadNotifications:    int(dead),
+	}, nil
+}
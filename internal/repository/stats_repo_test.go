@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+func TestStatsRepository_Collect(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	crushRepo := NewCrushRepository(db)
+	notificationRepo := NewNotificationRepository(db)
+	repo := NewStatsRepository(db)
+	ctx := context.Background()
+
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+	carol := &model.User{LineID: "U-carol", Name: "キャロル", Birthday: "1992-02-02"}
+	dave := &model.User{LineID: "U-dave", Name: "デイブ", Birthday: "1993-03-03"}
+	for _, u := range []*model.User{alice, bob, carol, dave} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	alice.MatchedWithUserID = null.StringFrom("U-bob")
+	bob.MatchedWithUserID = null.StringFrom("U-alice")
+	for _, u := range []*model.User{alice, bob} {
+		if err := userRepo.Update(ctx, u); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+	}
+	for _, c := range []*model.Crush{
+		{UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+		{UserLineID: "U-bob", Name: "アリス", Birthday: "1990-01-01"},
+		{UserLineID: "U-carol", Name: "ボブ", Birthday: "1995-05-05"},
+	} {
+		if err := crushRepo.Add(ctx, c); err != nil {
+			t.Fatalf("Add failed: %v", err)
+		}
+	}
+	if err := userRepo.Delete(ctx, "U-dave"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	delivered := &model.Notification{ToUserID: "U-alice", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "key-1"}
+	dead := &model.Notification{ToUserID: "U-bob", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "key-2"}
+	pending := &model.Notification{ToUserID: "U-carol", Kind: model.NotificationUnmatch, Text: "解除されました", RetryKey: "key-3"}
+	for _, n := range []*model.Notification{delivered, dead, pending} {
+		if err := notificationRepo.Enqueue(ctx, n); err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+	}
+	if err := notificationRepo.MarkDelivered(ctx, delivered.ID, 1); err != nil {
+		t.Fatalf("MarkDelivered failed: %v", err)
+	}
+	if err := notificationRepo.MarkDead(ctx, dead.ID, 5, "400"); err != nil {
+		t.Fatalf("MarkDead failed: %v", err)
+	}
+
+	stats, err := repo.Collect(ctx)
+	if err != nil {
+		t.Fatalf("Collect failed: %v", err)
+	}
+	want := model.AdminStats{
+		Users:                3,
+		WithdrawnUsers:       1,
+		MatchedPairs:         1,
+		Crushes:              3,
+		PendingNotifications: 1,
+		DeadNotifications:    1,
+	}
+	if *stats != want {
+		t.Errorf("Expected %+v, got %+v", want, *stats)
+	}
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// txKey は context にトランザクションを保持するためのキー
+type txKey struct{}
+
+// executorFromContext は context にトランザクションがあればそれを、なければ db を返す
+// Repository の各メソッドはこれを経由して SQL を実行することで、WithTx 内では自動的に同じトランザクションに参加する
+func executorFromContext(ctx context.Context, db *sql.DB) boil.ContextExecutor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// runInTx は fn をトランザクション内で実行する
+//
+// fn がエラーを返した場合（または panic した場合）はロールバックし、成功した場合はコミットする。
+// ctx が既にトランザクションを保持している場合は新しいトランザクションを開始せず、そのまま fn を実行する。
+func runInTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) (err error) {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -12,12 +13,31 @@ import (
 )
 
 // UserRepository はユーザーのデータアクセス層のインターフェース
+//
+// 退会したユーザー（deleted_at が設定されている行）は検索・マッチングの対象にならない
 type UserRepository interface {
 	FindByLineID(ctx context.Context, lineID string) (*model.User, error)
-	FindByNameAndBirthday(ctx context.Context, name, birthday string) (*model.User, error)
+	FindByNameAndBirthday(ctx context.Context, key model.IdentityKey, birthday model.Birthday) (*model.User, error)
 	Create(ctx context.Context, user *model.User) error
 	Update(ctx context.Context, user *model.User) error
-	FindMatchingUser(ctx context.Context, currentUser *model.User) (*model.User, error)
+	UpdateLineDisplayName(ctx context.Context, lineID, displayName string) error
+	// FindMatchingUsers は相互にcrushしているユーザーを優先する順にすべて返す
+	FindMatchingUsers(ctx context.Context, currentUser *model.User) ([]*model.User, error)
+	// ListMatched はマッチング中のユーザーを LINE ID 順にすべて返す（ペアの両方を含む）
+	ListMatched(ctx context.Context) ([]*model.User, error)
+	// Delete はユーザーを退会済みにし、名前・誕生日・LINE の表示名を消す
+	Delete(ctx context.Context, lineID string) error
+	// IsDeleted は LINE ID のユーザーが退会済みかを返す
+	IsDeleted(ctx context.Context, lineID string) (bool, error)
+	// RequestWithdrawal は「退会」と送信された日時を記録する
+	RequestWithdrawal(ctx context.Context, lineID string, at time.Time) error
+	// WithdrawalRequestedSince は since 以降に「退会」と送信されたかを返す
+	WithdrawalRequestedSince(ctx context.Context, lineID string, since time.Time) (bool, error)
+
+	// WithTx は fn を1つのトランザクション内で実行する
+	// fn に渡される ctx を使って呼び出した Repository の操作は、すべて同じトランザクションに参加する
+	// fn がエラーを返した場合はロールバックされる
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type userRepository struct {
@@ -33,7 +53,8 @@ func NewUserRepository(db *sql.DB) UserRepository {
 func (r *userRepository) FindByLineID(ctx context.Context, lineID string) (*model.User, error) {
 	entityUser, err := entities.Users(
 		qm.Where(entities.UserColumns.LineUserID+" = ?", lineID),
-	).One(ctx, r.db)
+		qm.And(entities.UserColumns.DeletedAt+" IS NULL"),
+	).One(ctx, executorFromContext(ctx, r.db))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil // ユーザーが見つからない場合は nil を返す
@@ -44,11 +65,14 @@ func (r *userRepository) FindByLineID(ctx context.Context, lineID string) (*mode
 	return entityToModel(entityUser), nil
 }
 
-// FindByNameAndBirthday は名前と誕生日でユーザーを検索する
-func (r *userRepository) FindByNameAndBirthday(ctx context.Context, name, birthday string) (*model.User, error) {
+// FindByNameAndBirthday は名前のキーと誕生日でユーザーを検索する
+// 名前・別の表記のどちらかの組み合わせが一致するユーザーを返す（model.IdentityKey.Matches と同じ判定）
+func (r *userRepository) FindByNameAndBirthday(ctx context.Context, key model.IdentityKey, birthday model.Birthday) (*model.User, error) {
 	entityUser, err := entities.Users(
-		qm.Where(entities.UserColumns.Name+" = ? AND "+entities.UserColumns.Birthday+" = ?", name, birthday),
-	).One(ctx, r.db)
+		qm.Where(identityKeyMatchSQL(entities.UserColumns.NameKey, entities.UserColumns.AltNameKey, "?", "?")+" AND "+entities.UserColumns.Birthday+" = ?",
+			key.Primary, key.Secondary, key.Primary, key.Secondary, birthday),
+		qm.And(entities.UserColumns.DeletedAt+" IS NULL"),
+	).One(ctx, executorFromContext(ctx, r.db))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -60,41 +84,191 @@ func (r *userRepository) FindByNameAndBirthday(ctx context.Context, name, birthd
 }
 
 // Create は新しいユーザーを作成する
+// 同じ LINE ID の退会済みの行がある場合は、その行を利用中に戻す（退会後に登録し直した場合）
+// 行を削除し直すと好きな人の登録回数・登録の履歴も削除され、退会と再登録で登録の制限を回避できてしまうため、行は残す
 func (r *userRepository) Create(ctx context.Context, user *model.User) error {
+	exec := executorFromContext(ctx, r.db)
 	entityUser := modelToEntity(user)
-	return entityUser.Insert(ctx, r.db, boil.Infer())
+
+	now := time.Now().UTC().Format(sqliteTimeFormat)
+	reactivated, err := entities.Users(
+		qm.Where(entities.UserColumns.LineUserID+" = ?", user.LineID),
+		qm.And(entities.UserColumns.DeletedAt+" IS NOT NULL"),
+	).UpdateAll(ctx, exec, entities.M{
+		entities.UserColumns.Name:                  entityUser.Name,
+		entities.UserColumns.NameKey:               entityUser.NameKey,
+		entities.UserColumns.AltName:               entityUser.AltName,
+		entities.UserColumns.AltNameKey:            entityUser.AltNameKey,
+		entities.UserColumns.Birthday:              entityUser.Birthday,
+		entities.UserColumns.MatchedWithUserID:     entityUser.MatchedWithUserID,
+		entities.UserColumns.LineDisplayName:       entityUser.LineDisplayName,
+		entities.UserColumns.RegisteredAt:          now,
+		entities.UserColumns.UpdatedAt:             now,
+		entities.UserColumns.DeletedAt:             null.String{},
+		entities.UserColumns.WithdrawalRequestedAt: null.String{},
+	})
+	if err != nil {
+		return err
+	}
+	if reactivated > 0 {
+		return nil
+	}
+
+	return entityUser.Insert(ctx, exec, boil.Infer())
 }
 
-// Update は既存のユーザーを更新する
+// Update は既存のユーザーを更新する（退会済みのユーザーは更新しない）
+// model.User が持つカラムだけを更新する。退会日時・「退会」の送信日時は Delete・RequestWithdrawal だけが変更する
+// （マッチングの更新などで、退会の確認を待っている間の記録や退会済みの状態が消えないようにする）
 func (r *userRepository) Update(ctx context.Context, user *model.User) error {
 	entityUser := modelToEntity(user)
-	_, err := entityUser.Update(ctx, r.db, boil.Infer())
+	_, err := entities.Users(
+		qm.Where(entities.UserColumns.LineUserID+" = ?", user.LineID),
+		qm.And(entities.UserColumns.DeletedAt+" IS NULL"),
+	).UpdateAll(ctx, executorFromContext(ctx, r.db), entities.M{
+		entities.UserColumns.Name:              entityUser.Name,
+		entities.UserColumns.NameKey:           entityUser.NameKey,
+		entities.UserColumns.AltName:           entityUser.AltName,
+		entities.UserColumns.AltNameKey:        entityUser.AltNameKey,
+		entities.UserColumns.Birthday:          entityUser.Birthday,
+		entities.UserColumns.MatchedWithUserID: entityUser.MatchedWithUserID,
+		entities.UserColumns.RegisteredAt:      entityUser.RegisteredAt,
+		entities.UserColumns.UpdatedAt:         entityUser.UpdatedAt,
+		entities.UserColumns.LineDisplayName:   entityUser.LineDisplayName,
+	})
 	return err
 }
 
-// FindMatchingUser は相互にcrushしているユーザーを検索する
-func (r *userRepository) FindMatchingUser(ctx context.Context, currentUser *model.User) (*model.User, error) {
-	entityUser, err := entities.Users(
-		qm.Where(
-			entities.UserColumns.Name+" = ? AND "+
-				entities.UserColumns.Birthday+" = ? AND "+
-				entities.UserColumns.CrushName+" = ? AND "+
-				entities.UserColumns.CrushBirthday+" = ? AND "+
-				entities.UserColumns.MatchedWithUserID+" IS NULL",
-			currentUser.CrushName.String,
-			currentUser.CrushBirthday.String,
-			currentUser.Name,
+// UpdateLineDisplayName は LINE の表示名だけを更新する（他のカラムは変更しない）
+// ユーザーが存在しない場合は何もしない
+func (r *userRepository) UpdateLineDisplayName(ctx context.Context, lineID, displayName string) error {
+	_, err := entities.Users(
+		qm.Where(entities.UserColumns.LineUserID+" = ?", lineID),
+	).UpdateAll(ctx, executorFromContext(ctx, r.db), entities.M{
+		entities.UserColumns.LineDisplayName: displayName,
+	})
+	return err
+}
+
+// FindMatchingUsers は相互にcrushしているユーザーを検索する
+//
+// 名前は model.NameKey で比較し、名前・別の表記のどちらかの組み合わせが一致すればよい（model.IdentityKey.Matches と同じ判定）
+//
+// 相手の条件:
+//   - currentUser の好きな人（crushes）のいずれかに、名前・誕生日が一致する
+//   - 相手の好きな人（crushes）のいずれかに、currentUser の名前・誕生日が含まれている
+//   - 相手がまだ誰ともマッチングしていない
+//
+// 候補が複数いる場合は、currentUser が先に登録した好きな人を優先する順に返す
+// （年齢による制限などで先の候補とマッチングできない場合に、次の候補を選べるようにする）
+func (r *userRepository) FindMatchingUsers(ctx context.Context, currentUser *model.User) ([]*model.User, error) {
+	key := currentUser.IdentityKey()
+	entityUsers, err := entities.Users(
+		qm.Select(entities.TableNames.Users+".*"),
+		// currentUser → 相手
+		qm.InnerJoin(
+			entities.TableNames.Crushes+" AS outgoing ON outgoing."+entities.CrushColumns.UserLineID+" = ?"+
+				" AND "+identityKeyMatchSQL("outgoing."+entities.CrushColumns.CrushNameKey, "outgoing."+entities.CrushColumns.CrushAltNameKey,
+				entities.UserTableColumns.NameKey, entities.UserTableColumns.AltNameKey)+
+				" AND outgoing."+entities.CrushColumns.CrushBirthday+" = "+entities.UserTableColumns.Birthday,
+			currentUser.LineID,
+		),
+		// 相手 → currentUser
+		qm.InnerJoin(
+			entities.TableNames.Crushes+" AS incoming ON incoming."+entities.CrushColumns.UserLineID+" = "+entities.UserTableColumns.LineUserID+
+				" AND "+identityKeyMatchSQL("incoming."+entities.CrushColumns.CrushNameKey, "incoming."+entities.CrushColumns.CrushAltNameKey, "?", "?")+
+				" AND incoming."+entities.CrushColumns.CrushBirthday+" = ?",
+			key.Primary, key.Secondary, key.Primary, key.Secondary,
 			currentUser.Birthday,
 		),
-	).One(ctx, r.db)
+		qm.Where(entities.UserTableColumns.LineUserID+" <> ?", currentUser.LineID),
+		qm.Where(entities.UserTableColumns.MatchedWithUserID+" IS NULL"),
+		qm.Where(entities.UserTableColumns.DeletedAt+" IS NULL"),
+		// 名前・別の表記の両方が一致する等で同じ相手が複数回見つかる場合も1人として返す
+		qm.GroupBy(entities.UserTableColumns.LineUserID),
+		qm.OrderBy("MIN(outgoing."+entities.CrushColumns.ID+")"),
+	).All(ctx, executorFromContext(ctx, r.db))
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
 		return nil, err
 	}
 
-	return entityToModel(entityUser), nil
+	users := make([]*model.User, 0, len(entityUsers))
+	for _, e := range entityUsers {
+		users = append(users, entityToModel(e))
+	}
+	return users, nil
+}
+
+// ListMatched はマッチング中のユーザーを LINE ID 順にすべて返す（ペアの両方を含む）
+func (r *userRepository) ListMatched(ctx context.Context) ([]*model.User, error) {
+	entityUsers, err := entities.Users(
+		qm.Where(entities.UserColumns.MatchedWithUserID+" IS NOT NULL"),
+		qm.And(entities.UserColumns.DeletedAt+" IS NULL"),
+		qm.OrderBy(entities.UserColumns.LineUserID),
+	).All(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*model.User, 0, len(entityUsers))
+	for _, e := range entityUsers {
+		users = append(users, entityToModel(e))
+	}
+	return users, nil
+}
+
+// Delete はユーザーを退会済みにする
+// 他のユーザーの好きな人と一致しないよう名前・誕生日を空にし、マッチング相手・LINE の表示名も消す
+// ユーザーが存在しない場合は何もしない
+func (r *userRepository) Delete(ctx context.Context, lineID string) error {
+	_, err := entities.Users(
+		qm.Where(entities.UserColumns.LineUserID+" = ?", lineID),
+		qm.And(entities.UserColumns.DeletedAt+" IS NULL"),
+	).UpdateAll(ctx, executorFromContext(ctx, r.db), entities.M{
+		entities.UserColumns.Name:                  "",
+		entities.UserColumns.NameKey:               "",
+		entities.UserColumns.AltName:               "",
+		entities.UserColumns.AltNameKey:            "",
+		entities.UserColumns.Birthday:              "",
+		entities.UserColumns.MatchedWithUserID:     null.String{},
+		entities.UserColumns.LineDisplayName:       "",
+		entities.UserColumns.DeletedAt:             null.StringFrom(time.Now().UTC().Format(sqliteTimeFormat)),
+		entities.UserColumns.WithdrawalRequestedAt: null.String{},
+	})
+	return err
+}
+
+// IsDeleted は LINE ID のユーザーが退会済みかを返す（行がない場合は false）
+func (r *userRepository) IsDeleted(ctx context.Context, lineID string) (bool, error) {
+	return entities.Users(
+		qm.Where(entities.UserColumns.LineUserID+" = ?", lineID),
+		qm.And(entities.UserColumns.DeletedAt+" IS NOT NULL"),
+	).Exists(ctx, executorFromContext(ctx, r.db))
+}
+
+// RequestWithdrawal は「退会」と送信された日時を記録する（ユーザーが存在しない場合は何もしない）
+func (r *userRepository) RequestWithdrawal(ctx context.Context, lineID string, at time.Time) error {
+	_, err := entities.Users(
+		qm.Where(entities.UserColumns.LineUserID+" = ?", lineID),
+		qm.And(entities.UserColumns.DeletedAt+" IS NULL"),
+	).UpdateAll(ctx, executorFromContext(ctx, r.db), entities.M{
+		entities.UserColumns.WithdrawalRequestedAt: null.StringFrom(at.UTC().Format(sqliteTimeFormat)),
+	})
+	return err
+}
+
+// WithdrawalRequestedSince は since 以降に「退会」と送信されたかを返す（退会済み・未登録の場合は false）
+func (r *userRepository) WithdrawalRequestedSince(ctx context.Context, lineID string, since time.Time) (bool, error) {
+	return entities.Users(
+		qm.Where(entities.UserColumns.LineUserID+" = ?", lineID),
+		qm.And(entities.UserColumns.DeletedAt+" IS NULL"),
+		qm.And(entities.UserColumns.WithdrawalRequestedAt+" >= ?", since.UTC().Format(sqliteTimeFormat)),
+	).Exists(ctx, executorFromContext(ctx, r.db))
+}
+
+// WithTx は fn を1つのトランザクション内で実行する
+func (r *userRepository) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return runInTx(ctx, r.db, fn)
 }
 
 // entityToModel は entities.User を model.User に変換する
@@ -102,12 +276,12 @@ func entityToModel(e *entities.User) *model.User {
 	return &model.User{
 		LineID:            e.LineUserID.String,
 		Name:              e.Name,
-		Birthday:          e.Birthday,
-		CrushName:         e.CrushName,
-		CrushBirthday:     e.CrushBirthday,
+		Birthday:          model.Birthday(e.Birthday),
 		MatchedWithUserID: e.MatchedWithUserID,
 		RegisteredAt:      e.RegisteredAt,
 		UpdatedAt:         e.UpdatedAt,
+		LineDisplayName:   e.LineDisplayName,
+		AltName:           e.AltName,
 	}
 }
 
@@ -116,11 +290,28 @@ func modelToEntity(m *model.User) *entities.User {
 	return &entities.User{
 		LineUserID:        null.StringFrom(m.LineID),
 		Name:              m.Name,
-		Birthday:          m.Birthday,
-		CrushName:         m.CrushName,
-		CrushBirthday:     m.CrushBirthday,
+		NameKey:           model.NameKey(m.Name),
+		AltName:           m.AltName,
+		AltNameKey:        altNameKey(m.AltName),
+		Birthday:          m.Birthday.String(),
 		MatchedWithUserID: m.MatchedWithUserID,
 		RegisteredAt:      m.RegisteredAt,
 		UpdatedAt:         m.UpdatedAt,
+		LineDisplayName:   m.LineDisplayName,
 	}
 }
+
+// altNameKey は別の表記のマッチング用のキーを返す（別の表記がなければ空）
+func altNameKey(altName string) string {
+	if altName == "" {
+		return ""
+	}
+	return model.NameKey(altName)
+}
+
+// identityKeyMatchSQL は名前のキー（key, altKey）と相手のキー（other, otherAlt）のどちらかの組み合わせが一致する条件を返す
+// 空のキーは一致させない（model.IdentityKey.Matches と同じ判定）
+func identityKeyMatchSQL(key, altKey, other, otherAlt string) string {
+	return "((" + key + " <> '' AND " + key + " IN (" + other + ", " + otherAlt + "))" +
+		" OR (" + altKey + " <> '' AND " + altKey + " IN (" + other + ", " + otherAlt + ")))"
+}
@@ -3,8 +3,12 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/morinonusi421/cupid/internal/model"
 	"github.com/morinonusi421/cupid/pkg/testutil"
 )
@@ -12,7 +16,7 @@ import (
 // setupTestDB はテスト用のデータベースをセットアップする
 func setupTestDB(t *testing.T) *sql.DB {
 	t.Helper()
-	return testutil.SetupTestDB(t, "test_repo_cupid.db", "../../db/schema.sql")
+	return testutil.SetupTestDB(t, "test_repo_cupid.db")
 }
 
 func TestUserRepository_Create(t *testing.T) {
@@ -113,6 +117,276 @@ func TestUserRepository_Update(t *testing.T) {
 	}
 }
 
+func TestUserRepository_UpdateLineDisplayName(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	user := &model.User{
+		LineID:            "U111111111",
+		Name:              "タナカタロウ",
+		Birthday:          "1990-01-01",
+		MatchedWithUserID: null.StringFrom("U222222222"),
+		RegisteredAt:      "2026-01-23 00:00:00",
+		UpdatedAt:         "2026-01-23 00:00:00",
+	}
+	if _, err := db.Exec("INSERT INTO users (line_user_id, name, birthday) VALUES ('U222222222', 'スズキハナコ', '1992-02-02')"); err != nil {
+		t.Fatalf("Failed to insert partner: %v", err)
+	}
+	if err := repo.Create(ctx, user); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if err := repo.UpdateLineDisplayName(ctx, "U111111111", "たろう"); err != nil {
+		t.Fatalf("UpdateLineDisplayName failed: %v", err)
+	}
+	// 存在しないユーザーはエラーにならない
+	if err := repo.UpdateLineDisplayName(ctx, "U-unknown", "だれか"); err != nil {
+		t.Fatalf("UpdateLineDisplayName for unknown user failed: %v", err)
+	}
+
+	found, err := repo.FindByLineID(ctx, "U111111111")
+	if err != nil {
+		t.Fatalf("FindByLineID failed: %v", err)
+	}
+	if found.LineDisplayName != "たろう" {
+		t.Errorf("Expected display name 'たろう', got '%s'", found.LineDisplayName)
+	}
+	// 他のカラムは変更されない
+	if found.Name != "タナカタロウ" || found.MatchedWithUserID.String != "U222222222" || found.UpdatedAt != "2026-01-23 00:00:00" {
+		t.Errorf("Expected other columns to be unchanged, got %+v", found)
+	}
+}
+
+func TestUserRepository_Delete(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	crushRepo := NewCrushRepository(db)
+	ctx := context.Background()
+
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01", LineDisplayName: "ありす"}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+	for _, u := range []*model.User{alice, bob} {
+		if err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	// お互いに好きな人として登録している
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-bob", Name: "アリス", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	if err := repo.Delete(ctx, "U-alice"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	// 退会したユーザーは検索・マッチングの対象にならない
+	found, err := repo.FindByLineID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("FindByLineID failed: %v", err)
+	}
+	if found != nil {
+		t.Errorf("Expected deleted user not to be found, got %+v", found)
+	}
+	found, err = repo.FindByNameAndBirthday(ctx, model.NewIdentityKey("アリス", ""), "1990-01-01")
+	if err != nil {
+		t.Fatalf("FindByNameAndBirthday failed: %v", err)
+	}
+	if found != nil {
+		t.Errorf("Expected deleted user not to be found by name, got %+v", found)
+	}
+	matches, err := repo.FindMatchingUsers(ctx, bob)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(matches); got != "" {
+		t.Errorf("Expected deleted user not to match, got %s", got)
+	}
+
+	// 名前・誕生日・LINE の表示名は消える
+	var name, birthday, displayName string
+	if err := db.QueryRow("SELECT name, birthday, line_display_name FROM users WHERE line_user_id = 'U-alice'").Scan(&name, &birthday, &displayName); err != nil {
+		t.Fatalf("Failed to query deleted user: %v", err)
+	}
+	if name != "" || birthday != "" || displayName != "" {
+		t.Errorf("Expected personal data to be erased, got name=%q birthday=%q display=%q", name, birthday, displayName)
+	}
+
+	deleted, err := repo.IsDeleted(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("IsDeleted failed: %v", err)
+	}
+	if !deleted {
+		t.Error("Expected U-alice to be deleted")
+	}
+	deleted, err = repo.IsDeleted(ctx, "U-bob")
+	if err != nil {
+		t.Fatalf("IsDeleted failed: %v", err)
+	}
+	if deleted {
+		t.Error("Expected U-bob not to be deleted")
+	}
+
+	// 退会前に読み込んだユーザーで更新しても、退会済みのまま（名前なども戻らない）
+	if err := repo.Update(ctx, alice); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	deleted, err = repo.IsDeleted(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("IsDeleted failed: %v", err)
+	}
+	if !deleted {
+		t.Error("Expected Update not to restore a deleted user")
+	}
+	if err := db.QueryRow("SELECT name FROM users WHERE line_user_id = 'U-alice'").Scan(&name); err != nil {
+		t.Fatalf("Failed to query deleted user: %v", err)
+	}
+	if name != "" {
+		t.Errorf("Expected Update not to write to a deleted user, got name=%q", name)
+	}
+
+	// 登録し直すと退会済みの行が利用中に戻る
+	if err := repo.Create(ctx, &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Create after delete failed: %v", err)
+	}
+	found, err = repo.FindByLineID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("FindByLineID failed: %v", err)
+	}
+	if found == nil || found.Name != "アリス" || found.RegisteredAt == "" {
+		t.Errorf("Expected re-registered user, got %+v", found)
+	}
+	deleted, err = repo.IsDeleted(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("IsDeleted failed: %v", err)
+	}
+	if deleted {
+		t.Error("Expected re-registered user not to be deleted")
+	}
+}
+
+func TestUserRepository_Create_KeepsCrushChangesOfWithdrawnUser(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	changeRepo := NewCrushChangeRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Create(ctx, &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := changeRepo.Increment(ctx, "U-alice", "2026-03-01"); err != nil {
+		t.Fatalf("Increment failed: %v", err)
+	}
+	changedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := changeRepo.RecordHistory(ctx, &model.CrushHistory{UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05", Action: model.CrushAdded, ChangedAt: changedAt}); err != nil {
+		t.Fatalf("RecordHistory failed: %v", err)
+	}
+
+	// 退会して登録し直しても、登録回数・登録の履歴は削除されない（行を削除すると連鎖して削除される）
+	if err := repo.Delete(ctx, "U-alice"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Create(ctx, &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Create after delete failed: %v", err)
+	}
+
+	count, err := changeRepo.CountOn(ctx, "U-alice", "2026-03-01")
+	if err != nil {
+		t.Fatalf("CountOn failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected crush change count to be kept, got %d", count)
+	}
+	histories, err := changeRepo.ListHistorySince(ctx, "U-alice", changedAt)
+	if err != nil {
+		t.Fatalf("ListHistorySince failed: %v", err)
+	}
+	if len(histories) != 1 {
+		t.Errorf("Expected crush history to be kept, got %d", len(histories))
+	}
+}
+
+func TestUserRepository_RequestWithdrawal(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Create(ctx, &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	requestedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	requested, err := repo.WithdrawalRequestedSince(ctx, "U-alice", requestedAt.Add(-10*time.Minute))
+	if err != nil {
+		t.Fatalf("WithdrawalRequestedSince failed: %v", err)
+	}
+	if requested {
+		t.Error("Expected no withdrawal request before 退会 is sent")
+	}
+
+	if err := repo.RequestWithdrawal(ctx, "U-alice", requestedAt); err != nil {
+		t.Fatalf("RequestWithdrawal failed: %v", err)
+	}
+	for _, tt := range []struct {
+		since time.Time
+		want  bool
+	}{
+		{since: requestedAt.Add(-10 * time.Minute), want: true},
+		{since: requestedAt.Add(time.Second), want: false},
+	} {
+		requested, err := repo.WithdrawalRequestedSince(ctx, "U-alice", tt.since)
+		if err != nil {
+			t.Fatalf("WithdrawalRequestedSince failed: %v", err)
+		}
+		if requested != tt.want {
+			t.Errorf("WithdrawalRequestedSince(%v) = %v, want %v", tt.since, requested, tt.want)
+		}
+	}
+
+	// 確認を待っている間にユーザーが更新されても（登録情報の変更など）記録は消えない
+	alice, err := repo.FindByLineID(ctx, "U-alice")
+	if err != nil {
+		t.Fatalf("FindByLineID failed: %v", err)
+	}
+	alice.AltName = "Alice"
+	if err := repo.Update(ctx, alice); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	requested, err = repo.WithdrawalRequestedSince(ctx, "U-alice", requestedAt.Add(-10*time.Minute))
+	if err != nil {
+		t.Fatalf("WithdrawalRequestedSince failed: %v", err)
+	}
+	if !requested {
+		t.Error("Expected withdrawal request to survive Update")
+	}
+
+	// 退会すると記録は消える
+	if err := repo.Delete(ctx, "U-alice"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Create(ctx, &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Create after delete failed: %v", err)
+	}
+	requested, err = repo.WithdrawalRequestedSince(ctx, "U-alice", requestedAt.Add(-10*time.Minute))
+	if err != nil {
+		t.Fatalf("WithdrawalRequestedSince failed: %v", err)
+	}
+	if requested {
+		t.Error("Expected withdrawal request to be cleared after re-registration")
+	}
+}
+
 func TestUserRepository_FindByNameAndBirthday(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -130,7 +404,7 @@ func TestUserRepository_FindByNameAndBirthday(t *testing.T) {
 	}
 
 	// 名前と誕生日で検索
-	found, err := repo.FindByNameAndBirthday(context.Background(), "山田太郎", "1990-01-01")
+	found, err := repo.FindByNameAndBirthday(context.Background(), model.NewIdentityKey("山田太郎", ""), "1990-01-01")
 	if err != nil {
 		t.Errorf("FindByNameAndBirthday failed: %v", err)
 	}
@@ -142,11 +416,342 @@ func TestUserRepository_FindByNameAndBirthday(t *testing.T) {
 	}
 
 	// 存在しないユーザー
-	notFound, err := repo.FindByNameAndBirthday(context.Background(), "存在しない", "2000-01-01")
+	notFound, err := repo.FindByNameAndBirthday(context.Background(), model.NewIdentityKey("存在しない", ""), "2000-01-01")
 	if err != nil {
 		t.Errorf("FindByNameAndBirthday failed: %v", err)
 	}
 	if notFound != nil {
 		t.Error("Expected nil for non-existent user")
 	}
+	// 表記ゆれがあっても同じ名前として検索する
+	if err := repo.Create(context.Background(), &model.User{LineID: "U_FIND_KEY", Name: "ヴィクトル", Birthday: "1991-01-01"}); err != nil {
+		t.Fatal(err)
+	}
+	found, err = repo.FindByNameAndBirthday(context.Background(), model.NewIdentityKey("ビクトル", ""), "1991-01-01")
+	if err != nil {
+		t.Errorf("FindByNameAndBirthday failed: %v", err)
+	}
+	if found == nil || found.LineID != "U_FIND_KEY" {
+		t.Errorf("Expected U_FIND_KEY, got %v", found)
+	}
+
+	// 別の表記でも検索する（検索する側・される側のどちらの別の表記でもよい）
+	if err := repo.Create(context.Background(), &model.User{LineID: "U_FIND_ALT", Name: "ヤマダハナコ", AltName: "Hanako Yamada", Birthday: "1992-02-02"}); err != nil {
+		t.Fatal(err)
+	}
+	found, err = repo.FindByNameAndBirthday(context.Background(), model.NewIdentityKey("hanako yamada", ""), "1992-02-02")
+	if err != nil {
+		t.Errorf("FindByNameAndBirthday failed: %v", err)
+	}
+	if found == nil || found.LineID != "U_FIND_ALT" || found.AltName != "Hanako Yamada" {
+		t.Errorf("Expected U_FIND_ALT with alt name, got %v", found)
+	}
+	found, err = repo.FindByNameAndBirthday(context.Background(), model.NewIdentityKey("山田花子", "やまだはなこ"), "1992-02-02")
+	if err != nil {
+		t.Errorf("FindByNameAndBirthday failed: %v", err)
+	}
+	if found == nil || found.LineID != "U_FIND_ALT" {
+		t.Errorf("Expected U_FIND_ALT, got %v", found)
+	}
+	found, err = repo.FindByNameAndBirthday(context.Background(), model.NewIdentityKey("山田花子", "Yamada Hanako"), "1992-02-02")
+	if err != nil {
+		t.Errorf("FindByNameAndBirthday failed: %v", err)
+	}
+	if found != nil {
+		t.Errorf("Expected nil when no spelling matches, got %v", found)
+	}
+}
+
+func TestUserRepository_WithTx(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	// コミットされる場合
+	err := repo.WithTx(ctx, func(ctx context.Context) error {
+		return repo.Create(ctx, &model.User{LineID: "U_TX_COMMIT", Name: "コミット", Birthday: "1990-01-01"})
+	})
+	if err != nil {
+		t.Fatalf("WithTx failed: %v", err)
+	}
+	found, err := repo.FindByLineID(ctx, "U_TX_COMMIT")
+	if err != nil {
+		t.Fatalf("FindByLineID failed: %v", err)
+	}
+	if found == nil {
+		t.Error("Expected committed user to be found")
+	}
+
+	// fn がエラーを返した場合はロールバックされる
+	errRollback := errors.New("rollback")
+	err = repo.WithTx(ctx, func(ctx context.Context) error {
+		if err := repo.Create(ctx, &model.User{LineID: "U_TX_ROLLBACK", Name: "ロールバック", Birthday: "1990-01-01"}); err != nil {
+			return err
+		}
+		// 入れ子の WithTx は外側のトランザクションに参加する
+		return repo.WithTx(ctx, func(ctx context.Context) error {
+			inner, err := repo.FindByLineID(ctx, "U_TX_ROLLBACK")
+			if err != nil {
+				return err
+			}
+			if inner == nil {
+				t.Error("Expected user to be visible inside the same transaction")
+			}
+			return errRollback
+		})
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("Expected rollback error, got %v", err)
+	}
+	notFound, err := repo.FindByLineID(ctx, "U_TX_ROLLBACK")
+	if err != nil {
+		t.Fatalf("FindByLineID failed: %v", err)
+	}
+	if notFound != nil {
+		t.Error("Expected rolled back user to be nil")
+	}
+}
+
+// lineIDs はユーザーの LINE ID をカンマ区切りで返す
+func lineIDs(users []*model.User) string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.LineID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestUserRepository_FindMatchingUsers(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	crushRepo := NewCrushRepository(db)
+	ctx := context.Background()
+
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+	carol := &model.User{LineID: "U-carol", Name: "キャロル", Birthday: "1992-02-02"}
+	for _, u := range []*model.User{alice, bob, carol} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	addCrush := func(userLineID, name string, birthday model.Birthday) {
+		t.Helper()
+		if err := crushRepo.Add(ctx, &model.Crush{UserLineID: userLineID, Name: name, Birthday: birthday}); err != nil {
+			t.Fatalf("Add failed: %v", err)
+		}
+	}
+
+	// アリスはキャロルとボブを登録、ボブはアリスを登録
+	addCrush("U-alice", "キャロル", "1992-02-02")
+	addCrush("U-alice", "ボブ", "1995-05-05")
+
+	// 片思いの間はマッチしない
+	found, err := userRepo.FindMatchingUsers(ctx, alice)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(found); got != "" {
+		t.Fatalf("Expected no match, got %s", got)
+	}
+
+	// 2人目の好きな人（ボブ）と相互になればマッチする
+	addCrush("U-bob", "アリス", "1990-01-01")
+	found, err = userRepo.FindMatchingUsers(ctx, alice)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(found); got != "U-bob" {
+		t.Fatalf("Expected U-bob, got %s", got)
+	}
+
+	// 候補が複数いる場合は、アリスが先に登録した好きな人（キャロル）から順に返す
+	addCrush("U-carol", "アリス", "1990-01-01")
+	found, err = userRepo.FindMatchingUsers(ctx, alice)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(found); got != "U-carol,U-bob" {
+		t.Fatalf("Expected U-carol,U-bob, got %s", got)
+	}
+
+	// 相手がマッチング中ならマッチしない
+	bob.MatchedWithUserID = null.StringFrom("U-carol")
+	carol.MatchedWithUserID = null.StringFrom("U-bob")
+	if err := userRepo.Update(ctx, bob); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if err := userRepo.Update(ctx, carol); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	found, err = userRepo.FindMatchingUsers(ctx, alice)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(found); got != "" {
+		t.Errorf("Expected no match while partner is matched, got %s", got)
+	}
+}
+
+func TestUserRepository_FindMatchingUsers_NameKey(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	crushRepo := NewCrushRepository(db)
+	ctx := context.Background()
+
+	victor := &model.User{LineID: "U-victor", Name: "ヴィクトル", Birthday: "1990-01-01"}
+	kyoko := &model.User{LineID: "U-kyoko", Name: "キョウコ", Birthday: "1992-02-02"}
+	for _, u := range []*model.User{victor, kyoko} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	// 登録した名前の表記が違っても（ヴ/ブ・長音の書き方）マッチする
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-victor", Name: "キョーコ", Birthday: "1992-02-02"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-kyoko", Name: "ビクトル", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	found, err := userRepo.FindMatchingUsers(ctx, victor)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(found); got != "U-kyoko" {
+		t.Fatalf("Expected U-kyoko, got %s", got)
+	}
+}
+
+func TestUserRepository_FindMatchingUsers_AltName(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userRepo := NewUserRepository(db)
+	crushRepo := NewCrushRepository(db)
+	ctx := context.Background()
+
+	// taro はカタカナの名前にローマ字の別の表記を登録、hanako は漢字の名前にカタカナの別の表記を登録
+	taro := &model.User{LineID: "U-taro", Name: "ヤマダタロウ", AltName: "Taro Yamada", Birthday: "1990-01-01"}
+	hanako := &model.User{LineID: "U-hanako", Name: "佐藤花子", AltName: "サトウハナコ", Birthday: "1992-02-02"}
+	for _, u := range []*model.User{taro, hanako} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	// taro は hanako をカタカナで、hanako は taro をローマ字で登録する（どちらも相手の別の表記と一致する）
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-taro", Name: "サトウハナコ", Birthday: "1992-02-02"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-hanako", Name: "taro yamada", Birthday: "1990-01-01"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	found, err := userRepo.FindMatchingUsers(ctx, taro)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(found); got != "U-hanako" {
+		t.Fatalf("Expected U-hanako, got %s", got)
+	}
+	found, err = userRepo.FindMatchingUsers(ctx, hanako)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(found); got != "U-taro" {
+		t.Fatalf("Expected U-taro, got %s", got)
+	}
+
+	// 好きな人の別の表記だけが一致する場合もマッチする
+	ken := &model.User{LineID: "U-ken", Name: "Ken Suzuki", Birthday: "1993-03-03"}
+	yui := &model.User{LineID: "U-yui", Name: "タナカユイ", Birthday: "1994-04-04"}
+	for _, u := range []*model.User{ken, yui} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-ken", Name: "田中結衣", AltName: "タナカユイ", Birthday: "1994-04-04"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-yui", Name: "スズキケン", AltName: "KEN SUZUKI", Birthday: "1993-03-03"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	found, err = userRepo.FindMatchingUsers(ctx, ken)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(found); got != "U-yui" {
+		t.Fatalf("Expected U-yui, got %s", got)
+	}
+
+	// どの表記も一致しない場合はマッチしない（taro の好きな人を別の人にする）
+	if err := crushRepo.RemoveAll(ctx, "U-taro"); err != nil {
+		t.Fatalf("RemoveAll failed: %v", err)
+	}
+	if err := crushRepo.Add(ctx, &model.Crush{UserLineID: "U-taro", Name: "佐藤花", Birthday: "1992-02-02"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	found, err = userRepo.FindMatchingUsers(ctx, taro)
+	if err != nil {
+		t.Fatalf("FindMatchingUsers failed: %v", err)
+	}
+	if got := lineIDs(found); got != "" {
+		t.Fatalf("Expected no match, got %s", got)
+	}
+}
+
+func TestUserRepository_ListMatched(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+	carol := &model.User{LineID: "U-carol", Name: "キャロル", Birthday: "1992-02-02"}
+	for _, u := range []*model.User{bob, alice, carol} {
+		if err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	alice.MatchedWithUserID = null.StringFrom("U-bob")
+	bob.MatchedWithUserID = null.StringFrom("U-alice")
+	for _, u := range []*model.User{alice, bob} {
+		if err := repo.Update(ctx, u); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+	}
+
+	users, err := repo.ListMatched(ctx)
+	if err != nil {
+		t.Fatalf("ListMatched failed: %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("Expected 2 users, got %d", len(users))
+	}
+	if users[0].LineID != "U-alice" || users[1].LineID != "U-bob" {
+		t.Errorf("Unexpected order: %s, %s", users[0].LineID, users[1].LineID)
+	}
+
+	// 退会したユーザーは含めない（退会時にマッチングも解除される）
+	if err := repo.Delete(ctx, "U-alice"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	users, err = repo.ListMatched(ctx)
+	if err != nil {
+		t.Fatalf("ListMatched failed: %v", err)
+	}
+	if len(users) != 1 || users[0].LineID != "U-bob" {
+		t.Errorf("Expected only U-bob, got %v", users)
+	}
 }
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/morinonusi421/cupid/entities"
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+// sqliteTimeFormat は SQLite の CURRENT_TIMESTAMP と同じ形式（UTC）
+const sqliteTimeFormat = "2006-01-02 15:04:05"
+
+// WebhookEventRepository は処理済みWebhookイベントのデータアクセス層のインターフェース
+type WebhookEventRepository interface {
+	// Claim はイベントを処理中として記録し、処理してよい場合に true を返す
+	// 未記録のイベント、前回の処理が失敗したイベント、staleBefore より前に処理を始めたまま処理中のイベントのみ処理してよい
+	Claim(ctx context.Context, event *model.WebhookEvent, staleBefore time.Time) (bool, error)
+	// Finish はイベントの処理結果を記録する
+	Finish(ctx context.Context, eventID string, status model.WebhookEventStatus, errMsg string) error
+	FindByID(ctx context.Context, eventID string) (*model.WebhookEvent, error)
+	// DeleteReceivedBefore は before より前に受信したイベントの記録を削除し、削除件数を返す
+	DeleteReceivedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type webhookEventRepository struct {
+	db *sql.DB
+}
+
+// NewWebhookEventRepository は WebhookEventRepository の新しいインスタンスを作成する
+func NewWebhookEventRepository(db *sql.DB) WebhookEventRepository {
+	return &webhookEventRepository{db: db}
+}
+
+// Claim はイベントを処理中として記録する
+// 同じIDの記録が succeeded、または staleBefore 以降に処理を始めた processing の場合は何もせず false を返す
+func (r *webhookEventRepository) Claim(ctx context.Context, event *model.WebhookEvent, staleBefore time.Time) (bool, error) {
+	result, err := executorFromContext(ctx, r.db).ExecContext(ctx, `
+INSERT INTO webhook_events (webhook_event_id, event_type, source_id, is_redelivery, status, claimed_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (webhook_event_id) DO UPDATE SET
+  is_redelivery = excluded.is_redelivery,
+  status = excluded.status,
+  error = NULL,
+  processed_at = NULL,
+  claimed_at = excluded.claimed_at
+WHERE webhook_events.status = ?
+  OR (webhook_events.status = ? AND COALESCE(webhook_events.claimed_at, webhook_events.received_at) < ?)`,
+		event.ID, event.EventType, null.NewString(event.SourceID, event.SourceID != ""), event.IsRedelivery, model.WebhookEventProcessing,
+		time.Now().UTC().Format(sqliteTimeFormat),
+		model.WebhookEventFailed,
+		model.WebhookEventProcessing, staleBefore.UTC().Format(sqliteTimeFormat),
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Finish はイベントの処理結果を記録する
+func (r *webhookEventRepository) Finish(ctx context.Context, eventID string, status model.WebhookEventStatus, errMsg string) error {
+	_, err := entities.WebhookEvents(
+		qm.Where(entities.WebhookEventColumns.WebhookEventID+" = ?", eventID),
+	).UpdateAll(ctx, executorFromContext(ctx, r.db), entities.M{
+		entities.WebhookEventColumns.Status:      string(status),
+		entities.WebhookEventColumns.Error:       null.NewString(errMsg, errMsg != ""),
+		entities.WebhookEventColumns.ProcessedAt: time.Now().UTC().Format(sqliteTimeFormat),
+	})
+	return err
+}
+
+// FindByID はイベントの記録を返す（見つからなければnil）
+func (r *webhookEventRepository) FindByID(ctx context.Context, eventID string) (*model.WebhookEvent, error) {
+	e, err := entities.WebhookEvents(
+		qm.Where(entities.WebhookEventColumns.WebhookEventID+" = ?", eventID),
+	).One(ctx, executorFromContext(ctx, r.db))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &model.WebhookEvent{
+		ID:           e.WebhookEventID.String,
+		EventType:    e.EventType,
+		SourceID:     e.SourceID.String,
+		IsRedelivery: e.IsRedelivery != 0,
+		Status:       model.WebhookEventStatus(e.Status),
+		Error:        e.Error.String,
+		ReceivedAt:   e.ReceivedAt,
+		ProcessedAt:  e.ProcessedAt.String,
+		ClaimedAt:    e.ClaimedAt.String,
+	}, nil
+}
+
+// DeleteReceivedBefore は before より前に受信したイベントの記録を削除する
+func (r *webhookEventRepository) DeleteReceivedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return entities.WebhookEvents(
+		qm.Where(entities.WebhookEventColumns.ReceivedAt+" < ?", before.UTC().Format(sqliteTimeFormat)),
+	).DeleteAll(ctx, executorFromContext(ctx, r.db))
+}
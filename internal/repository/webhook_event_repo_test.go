@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+func TestWebhookEventRepository_Claim(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewWebhookEventRepository(db)
+	ctx := context.Background()
+
+	event := &model.WebhookEvent{ID: "01HEVENT", EventType: "message", SourceID: "U-alice"}
+
+	// 初回は処理してよい
+	claimed, err := repo.Claim(ctx, event, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Claim failed: %v", err)
+	}
+	if !claimed {
+		t.Fatal("Expected first claim to succeed")
+	}
+
+	// 処理中の再送は処理しない
+	redelivered := &model.WebhookEvent{ID: "01HEVENT", EventType: "message", SourceID: "U-alice", IsRedelivery: true}
+	claimed, err = repo.Claim(ctx, redelivered, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Claim failed: %v", err)
+	}
+	if claimed {
+		t.Error("Expected claim of in-flight event to be rejected")
+	}
+
+	// 処理を始めてから staleBefore が過ぎても処理中のままなら、中断されたとみなして再処理する
+	claimed, err = repo.Claim(ctx, redelivered, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("Claim failed: %v", err)
+	}
+	if !claimed {
+		t.Fatal("Expected stale in-flight event to be claimable again")
+	}
+	found, err := repo.FindByID(ctx, "01HEVENT")
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if found.Status != model.WebhookEventProcessing || found.ClaimedAt == "" {
+		t.Errorf("Unexpected event after stale reclaim: %+v", found)
+	}
+
+	// 失敗した場合は再送時に再処理する
+	if err := repo.Finish(ctx, "01HEVENT", model.WebhookEventFailed, "reply failed"); err != nil {
+		t.Fatalf("Finish failed: %v", err)
+	}
+	claimed, err = repo.Claim(ctx, redelivered, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Claim failed: %v", err)
+	}
+	if !claimed {
+		t.Fatal("Expected failed event to be claimable again")
+	}
+	found, err = repo.FindByID(ctx, "01HEVENT")
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if found.Status != model.WebhookEventProcessing || found.Error != "" || !found.IsRedelivery {
+		t.Errorf("Unexpected event after reclaim: %+v", found)
+	}
+
+	// 成功した場合は再送されても処理しない
+	if err := repo.Finish(ctx, "01HEVENT", model.WebhookEventSucceeded, ""); err != nil {
+		t.Fatalf("Finish failed: %v", err)
+	}
+	claimed, err = repo.Claim(ctx, redelivered, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Claim failed: %v", err)
+	}
+	if claimed {
+		t.Error("Expected claim of succeeded event to be rejected")
+	}
+	found, err = repo.FindByID(ctx, "01HEVENT")
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if found.Status != model.WebhookEventSucceeded || found.ProcessedAt == "" {
+		t.Errorf("Unexpected event after finish: %+v", found)
+	}
+}
+
+func TestWebhookEventRepository_DeleteReceivedBefore(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewWebhookEventRepository(db)
+	ctx := context.Background()
+
+	if _, err := db.Exec(`INSERT INTO webhook_events (webhook_event_id, event_type, status, received_at) VALUES
+		('old', 'message', 'succeeded', '2026-01-01 00:00:00'),
+		('new', 'message', 'succeeded', '2026-01-10 00:00:00')`); err != nil {
+		t.Fatalf("Failed to insert events: %v", err)
+	}
+
+	deleted, err := repo.DeleteReceivedBefore(ctx, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("DeleteReceivedBefore failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("Expected 1 deleted, got %d", deleted)
+	}
+
+	if old, _ := repo.FindByID(ctx, "old"); old != nil {
+		t.Error("Expected old event to be deleted")
+	}
+	if kept, _ := repo.FindByID(ctx, "new"); kept == nil {
+		t.Error("Expected new event to be kept")
+	}
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/internal/repository"
+)
+
+// AdminService は管理API（/admin/）の操作を行うサービスのインターフェース
+//
+// すべての操作は、成功・失敗にかかわらず actor（呼び出した管理者）とともに admin_audit_log に記録する
+type AdminService interface {
+	LookupUserByLineID(ctx context.Context, actor model.AdminActor, lineID string) (*model.AdminUserDetail, error)
+	LookupUserByNameAndBirthday(ctx context.Context, actor model.AdminActor, name string, birthday model.Birthday) (*model.AdminUserDetail, error)
+	ListMatches(ctx context.Context, actor model.AdminActor) ([]*model.MatchedPair, error)
+	ForceUnmatch(ctx context.Context, actor model.AdminActor, lineID string) (partner *model.User, err error)
+	DeleteUser(ctx context.Context, actor model.AdminActor, lineID string) error
+	Stats(ctx context.Context, actor model.AdminActor) (*model.AdminStats, error)
+}
+
+type adminService struct {
+	userRepo    repository.UserRepository
+	crushRepo   repository.CrushRepository
+	auditRepo   repository.AdminAuditRepository
+	statsRepo   repository.StatsRepository
+	userService UserService
+	notifier    NotificationService
+	now         func() time.Time
+}
+
+// NewAdminService は AdminService の新しいインスタンスを作成する
+//
+// マッチングの解除・ユーザーの削除は userService の処理（通知を含む）を使う
+// 集計には notifier が捨てた低優先度のメッセージの数も含める
+func NewAdminService(userRepo repository.UserRepository, crushRepo repository.CrushRepository, auditRepo repository.AdminAuditRepository, statsRepo repository.StatsRepository, userService UserService, notifier NotificationService) AdminService {
+	return &adminService{
+		userRepo:    userRepo,
+		crushRepo:   crushRepo,
+		auditRepo:   auditRepo,
+		statsRepo:   statsRepo,
+		userService: userService,
+		notifier:    notifier,
+		now:         time.Now,
+	}
+}
+
+// LookupUserByLineID は LINE ID でユーザーを検索する（退会済み・未登録の場合は ErrUserNotFound）
+func (s *adminService) LookupUserByLineID(ctx context.Context, actor model.AdminActor, lineID string) (*model.AdminUserDetail, error) {
+	var detail *model.AdminUserDetail
+	err := s.audited(ctx, actor, model.AdminUserLookup, lineID, "line_id="+lineID, func(ctx context.Context) (string, error) {
+		user, err := s.userRepo.FindByLineID(ctx, lineID)
+		if err != nil {
+			return "", fmt.Errorf("failed to find user: %w", err)
+		}
+		detail, err = s.userDetail(ctx, user)
+		return lineID, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// LookupUserByNameAndBirthday は名前と誕生日でユーザーを検索する（登録と同じく、名前は表記を揃えて比較する）
+// 見つからない場合は ErrUserNotFound を返す
+func (s *adminService) LookupUserByNameAndBirthday(ctx context.Context, actor model.AdminActor, name string, birthday model.Birthday) (*model.AdminUserDetail, error) {
+	var detail *model.AdminUserDetail
+	query := fmt.Sprintf("name=%s birthday=%s", name, birthday)
+	err := s.audited(ctx, actor, model.AdminUserLookup, "", query, func(ctx context.Context) (string, error) {
+		key := model.NewIdentityKey(model.NormalizeName(name), "")
+		user, err := s.userRepo.FindByNameAndBirthday(ctx, key, birthday)
+		if err != nil {
+			return "", fmt.Errorf("failed to find user: %w", err)
+		}
+		detail, err = s.userDetail(ctx, user)
+		if err != nil {
+			return "", err
+		}
+		return user.LineID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// ListMatches はマッチング中のペアを返す（ペアごとに1件、LINE ID の小さい方を User とする）
+func (s *adminService) ListMatches(ctx context.Context, actor model.AdminActor) ([]*model.MatchedPair, error) {
+	var pairs []*model.MatchedPair
+	err := s.audited(ctx, actor, model.AdminMatchList, "", "", func(ctx context.Context) (string, error) {
+		users, err := s.userRepo.ListMatched(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list matched users: %w", err)
+		}
+
+		byLineID := make(map[string]*model.User, len(users))
+		for _, u := range users {
+			byLineID[u.LineID] = u
+		}
+		pairs = make([]*model.MatchedPair, 0, len(users)/2)
+		for _, u := range users {
+			partner, ok := byLineID[u.MatchedWithUserID.String]
+			if !ok {
+				// 片側だけマッチング中になっている（本来は起こらない）。運営が気づけるようペアとして返す
+				pairs = append(pairs, &model.MatchedPair{User: u})
+				continue
+			}
+			if u.LineID < partner.LineID {
+				pairs = append(pairs, &model.MatchedPair{User: u, Partner: partner})
+			}
+		}
+		return "", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// ForceUnmatch はマッチングを強制的に解除する（UserService.ForceUnmatch）
+// 解除と操作の記録は1つのトランザクション内で行う
+func (s *adminService) ForceUnmatch(ctx context.Context, actor model.AdminActor, lineID string) (partner *model.User, err error) {
+	err = s.auditedTx(ctx, actor, model.AdminForceUnmatch, lineID, func(ctx context.Context) (string, error) {
+		partner, err = s.userService.ForceUnmatch(ctx, lineID)
+		if err != nil {
+			return "", err
+		}
+		return "partner=" + partner.LineID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return partner, nil
+}
+
+// DeleteUser はユーザーを退会済みにする（UserService.DeleteAccount、マッチング相手には通知する）
+// 削除と操作の記録は1つのトランザクション内で行う
+func (s *adminService) DeleteUser(ctx context.Context, actor model.AdminActor, lineID string) error {
+	return s.auditedTx(ctx, actor, model.AdminUserDelete, lineID, func(ctx context.Context) (string, error) {
+		return "", s.userService.DeleteAccount(ctx, lineID)
+	})
+}
+
+// Stats はユーザー・好きな人・マッチング・Push通知の件数を返す
+func (s *adminService) Stats(ctx context.Context, actor model.AdminActor) (*model.AdminStats, error) {
+	var stats *model.AdminStats
+	err := s.audited(ctx, actor, model.AdminStatsView, "", "", func(ctx context.Context) (string, error) {
+		var err error
+		stats, err = s.statsRepo.Collect(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to collect stats: %w", err)
+		}
+		stats.SkippedLowPriorityPushes = s.notifier.SkippedLowPriorityCount()
+		return "", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// userDetail はユーザーの好きな人とマッチング相手を読み出す（user が nil なら ErrUserNotFound）
+func (s *adminService) userDetail(ctx context.Context, user *model.User) (*model.AdminUserDetail, error) {
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	crushes, err := s.crushRepo.ListByUserID(ctx, user.LineID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list crushes: %w", err)
+	}
+
+	detail := &model.AdminUserDetail{User: user, Crushes: crushes}
+	if user.IsMatched() {
+		detail.MatchedUser, err = s.userRepo.FindByLineID(ctx, user.MatchedWithUserID.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find matched user: %w", err)
+		}
+	}
+	return detail, nil
+}
+
+// audited は読み出しの操作 fn を実行し、結果を記録する
+//
+// fn は記録する操作の対象（空なら target のまま）を返す。
+// 記録に失敗した場合は、操作が成功していてもエラーを返す（記録されないまま結果を返さない）
+func (s *adminService) audited(ctx context.Context, actor model.AdminActor, action model.AdminAction, target, detail string, fn func(ctx context.Context) (string, error)) error {
+	found, err := fn(ctx)
+	if found != "" {
+		target = found
+	}
+	if auditErr := s.audit(ctx, actor, action, target, detail, err); auditErr != nil {
+		return auditErr
+	}
+	return err
+}
+
+// auditedTx は変更の操作 fn をトランザクション内で実行し、成功した場合は同じトランザクションで記録する
+//
+// fn は記録する詳細を返す。記録に失敗した場合は操作ごとロールバックされる。
+// 失敗した操作はロールバックした後で、失敗したことを別に記録する
+func (s *adminService) auditedTx(ctx context.Context, actor model.AdminActor, action model.AdminAction, target string, fn func(ctx context.Context) (string, error)) error {
+	err := s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		detail, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, actor, action, target, detail, nil)
+	})
+	if err != nil {
+		if auditErr := s.audit(ctx, actor, action, target, "", err); auditErr != nil {
+			log.Printf("Failed to record failed admin action %s on %s: %v", action, target, auditErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// audit は操作を1件記録する（opErr が nil なら成功として記録する）
+func (s *adminService) audit(ctx context.Context, actor model.AdminActor, action model.AdminAction, target, detail string, opErr error) error {
+	result := model.AdminResultOK
+	if opErr != nil {
+		result = opErr.Error()
+	}
+	if err := s.auditRepo.Record(ctx, &model.AdminAuditEntry{
+		Actor:     actor.Name,
+		RemoteIP:  actor.RemoteIP,
+		Action:    action,
+		Target:    target,
+		Detail:    detail,
+		Result:    result,
+		CreatedAt: s.now(),
+	}); err != nil {
+		return fmt.Errorf("failed to record admin audit log: %w", err)
+	}
+	return nil
+}
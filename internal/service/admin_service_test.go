@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/morinonusi421/cupid/internal/model"
+	repositorymocks "github.com/morinonusi421/cupid/internal/repository/mocks"
+	servicemocks "github.com/morinonusi421/cupid/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testAdminActor = model.AdminActor{Name: "token", RemoteIP: "127.0.0.1"}
+
+// adminServiceMocks は AdminService のテストで使う mock
+type adminServiceMocks struct {
+	userRepo    *repositorymocks.MockUserRepository
+	crushRepo   *repositorymocks.MockCrushRepository
+	auditRepo   *repositorymocks.MockAdminAuditRepository
+	statsRepo   *repositorymocks.MockStatsRepository
+	userService *servicemocks.MockUserService
+	notifier    *servicemocks.MockNotificationService
+}
+
+func newAdminServiceForTest(t *testing.T) (AdminService, *adminServiceMocks) {
+	m := &adminServiceMocks{
+		userRepo:    repositorymocks.NewMockUserRepository(t),
+		crushRepo:   repositorymocks.NewMockCrushRepository(t),
+		auditRepo:   repositorymocks.NewMockAdminAuditRepository(t),
+		statsRepo:   repositorymocks.NewMockStatsRepository(t),
+		userService: servicemocks.NewMockUserService(t),
+		notifier:    servicemocks.NewMockNotificationService(t),
+	}
+	allowWithTx(m.userRepo)
+	return NewAdminService(m.userRepo, m.crushRepo, m.auditRepo, m.statsRepo, m.userService, m.notifier), m
+}
+
+// isAudit は記録される操作が action・target・result に一致するかを返す
+func isAudit(action model.AdminAction, target, result string) func(*model.AdminAuditEntry) bool {
+	return func(e *model.AdminAuditEntry) bool {
+		return e.Actor == testAdminActor.Name && e.RemoteIP == testAdminActor.RemoteIP &&
+			e.Action == action && e.Target == target && e.Result == result
+	}
+}
+
+func TestAdminService_LookupUserByLineID(t *testing.T) {
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01", MatchedWithUserID: null.StringFrom("U-bob")}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05", MatchedWithUserID: null.StringFrom("U-alice")}
+	crushes := []*model.Crush{{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"}}
+
+	t.Run("正常系 - 好きな人とマッチング相手を返し、記録する", func(t *testing.T) {
+		service, m := newAdminServiceForTest(t)
+		m.userRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+		m.crushRepo.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+		m.userRepo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(bob, nil)
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(isAudit(model.AdminUserLookup, "U-alice", model.AdminResultOK))).Return(nil)
+
+		detail, err := service.LookupUserByLineID(context.Background(), testAdminActor, "U-alice")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &model.AdminUserDetail{User: alice, Crushes: crushes, MatchedUser: bob}, detail)
+	})
+
+	t.Run("異常系 - 見つからない場合も記録する", func(t *testing.T) {
+		service, m := newAdminServiceForTest(t)
+		m.userRepo.EXPECT().FindByLineID(mock.Anything, "U-nobody").Return(nil, nil)
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(isAudit(model.AdminUserLookup, "U-nobody", ErrUserNotFound.Error()))).Return(nil)
+
+		_, err := service.LookupUserByLineID(context.Background(), testAdminActor, "U-nobody")
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("異常系 - 記録に失敗した場合は結果を返さない", func(t *testing.T) {
+		service, m := newAdminServiceForTest(t)
+		m.userRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+		m.crushRepo.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+		m.userRepo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(bob, nil)
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.Anything).Return(errors.New("disk full"))
+
+		detail, err := service.LookupUserByLineID(context.Background(), testAdminActor, "U-alice")
+
+		assert.Error(t, err)
+		assert.Nil(t, detail)
+	})
+}
+
+func TestAdminService_LookupUserByNameAndBirthday(t *testing.T) {
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}
+
+	t.Run("正常系 - 名前の表記を揃えて検索し、見つかったユーザーを対象として記録する", func(t *testing.T) {
+		service, m := newAdminServiceForTest(t)
+		m.userRepo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("アリス", ""), model.Birthday("1990-01-01")).Return(alice, nil)
+		m.crushRepo.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(nil, nil)
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(func(e *model.AdminAuditEntry) bool {
+			return isAudit(model.AdminUserLookup, "U-alice", model.AdminResultOK)(e) && e.Detail == "name=ありす birthday=1990-01-01"
+		})).Return(nil)
+
+		detail, err := service.LookupUserByNameAndBirthday(context.Background(), testAdminActor, "ありす", "1990-01-01")
+
+		assert.NoError(t, err)
+		assert.Equal(t, alice, detail.User)
+		assert.Nil(t, detail.MatchedUser)
+	})
+
+	t.Run("異常系 - 見つからない", func(t *testing.T) {
+		service, m := newAdminServiceForTest(t)
+		m.userRepo.EXPECT().FindByNameAndBirthday(mock.Anything, mock.Anything, model.Birthday("1990-01-01")).Return(nil, nil)
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(isAudit(model.AdminUserLookup, "", ErrUserNotFound.Error()))).Return(nil)
+
+		_, err := service.LookupUserByNameAndBirthday(context.Background(), testAdminActor, "アリス", "1990-01-01")
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestAdminService_ListMatches(t *testing.T) {
+	alice := &model.User{LineID: "U-alice", Name: "アリス", MatchedWithUserID: null.StringFrom("U-bob")}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", MatchedWithUserID: null.StringFrom("U-alice")}
+	carol := &model.User{LineID: "U-carol", Name: "キャロル", MatchedWithUserID: null.StringFrom("U-gone")}
+
+	service, m := newAdminServiceForTest(t)
+	m.userRepo.EXPECT().ListMatched(mock.Anything).Return([]*model.User{alice, bob, carol}, nil)
+	m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(isAudit(model.AdminMatchList, "", model.AdminResultOK))).Return(nil)
+
+	pairs, err := service.ListMatches(context.Background(), testAdminActor)
+
+	assert.NoError(t, err)
+	// ペアごとに1件、相手が見つからない場合は相手なしで返す
+	assert.Equal(t, []*model.MatchedPair{
+		{User: alice, Partner: bob},
+		{User: carol},
+	}, pairs)
+}
+
+func TestAdminService_ForceUnmatch(t *testing.T) {
+	bob := &model.User{LineID: "U-bob", Name: "ボブ"}
+
+	t.Run("正常系 - 解除して同じトランザクションで記録する", func(t *testing.T) {
+		service, m := newAdminServiceForTest(t)
+		m.userService.EXPECT().ForceUnmatch(mock.Anything, "U-alice").Return(bob, nil)
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(func(e *model.AdminAuditEntry) bool {
+			return isAudit(model.AdminForceUnmatch, "U-alice", model.AdminResultOK)(e) && e.Detail == "partner=U-bob"
+		})).Return(nil).Once()
+
+		partner, err := service.ForceUnmatch(context.Background(), testAdminActor, "U-alice")
+
+		assert.NoError(t, err)
+		assert.Equal(t, bob, partner)
+	})
+
+	t.Run("異常系 - 失敗した操作も記録する", func(t *testing.T) {
+		service, m := newAdminServiceForTest(t)
+		m.userService.EXPECT().ForceUnmatch(mock.Anything, "U-alice").Return(nil, ErrNotMatched)
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(isAudit(model.AdminForceUnmatch, "U-alice", ErrNotMatched.Error()))).Return(nil).Once()
+
+		_, err := service.ForceUnmatch(context.Background(), testAdminActor, "U-alice")
+
+		assert.ErrorIs(t, err, ErrNotMatched)
+	})
+}
+
+func TestAdminService_DeleteUser(t *testing.T) {
+	t.Run("正常系", func(t *testing.T) {
+		service, m := newAdminServiceForTest(t)
+		m.userService.EXPECT().DeleteAccount(mock.Anything, "U-alice").Return(nil)
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(isAudit(model.AdminUserDelete, "U-alice", model.AdminResultOK))).Return(nil).Once()
+
+		err := service.DeleteUser(context.Background(), testAdminActor, "U-alice")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系 - 記録に失敗した場合は削除ごとロールバックし、失敗として記録し直す", func(t *testing.T) {
+		service, m := newAdminServiceForTest(t)
+		m.userService.EXPECT().DeleteAccount(mock.Anything, "U-alice").Return(nil)
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(isAudit(model.AdminUserDelete, "U-alice", model.AdminResultOK))).Return(errors.New("disk full")).Once()
+		m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(func(e *model.AdminAuditEntry) bool {
+			return e.Action == model.AdminUserDelete && e.Result != model.AdminResultOK
+		})).Return(nil).Once()
+
+		err := service.DeleteUser(context.Background(), testAdminActor, "U-alice")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAdminService_Stats(t *testing.T) {
+	stats := &model.AdminStats{Users: 3, WithdrawnUsers: 1, MatchedPairs: 1, Crushes: 4}
+
+	service, m := newAdminServiceForTest(t)
+	m.statsRepo.EXPECT().Collect(mock.Anything).Return(stats, nil)
+	m.notifier.EXPECT().SkippedLowPriorityCount().Return(int64(5))
+	m.auditRepo.EXPECT().Record(mock.Anything, mock.MatchedBy(isAudit(model.AdminStatsView, "", model.AdminResultOK))).Return(nil)
+
+	got, err := service.Stats(context.Background(), testAdminActor)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &model.AdminStats{Users: 3, WithdrawnUsers: 1, MatchedPairs: 1, Crushes: 4, SkippedLowPriorityPushes: 5}, got)
+}
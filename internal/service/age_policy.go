@@ -0,0 +1,52 @@
+package service
+
+import (
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/model"
+)
+
+// AgePolicy は年齢による登録の制限
+//
+// 未成年が成人とマッチングしないよう、ユーザー登録できる年齢と、好きな人として登録できる相手の年齢を制限する。
+// 年齢は登録する時点（日本時間の日付）の満年齢で判定する。各項目が 0 の場合はその制限を行わない。
+type AgePolicy struct {
+	MinAge   int // ユーザー登録できる最低年齢
+	MaxGap   int // 自分と好きな人の年齢差の上限（歳）
+	AdultAge int // 成人とみなす年齢（未成年と成人の間では好きな人として登録できない）
+}
+
+// checkUser はユーザー登録できる年齢かを確認する
+func (p AgePolicy) checkUser(birthday model.Birthday, now time.Time) error {
+	if p.MinAge > 0 && birthday.AgeOn(now) < p.MinAge {
+		return &AgeRestrictedError{Reason: AgeBelowMinimum, Limit: p.MinAge}
+	}
+	return nil
+}
+
+// checkCrush は自分と好きな人の年齢の組み合わせが登録できるものかを確認する
+// 未成年と成人の組み合わせは、年齢差の上限の範囲内でも登録できない
+func (p AgePolicy) checkCrush(userBirthday, crushBirthday model.Birthday, now time.Time) error {
+	userAge, crushAge := userBirthday.AgeOn(now), crushBirthday.AgeOn(now)
+	if p.AdultAge > 0 && (userAge < p.AdultAge) != (crushAge < p.AdultAge) {
+		return &AgeRestrictedError{Reason: AgeMinorAdultCrush, Limit: p.AdultAge}
+	}
+	gap := userAge - crushAge
+	if gap < 0 {
+		gap = -gap
+	}
+	if p.MaxGap > 0 && gap > p.MaxGap {
+		return &AgeRestrictedError{Reason: AgeGapTooLarge, Limit: p.MaxGap}
+	}
+	return nil
+}
+
+// checkCrushes は登録済みの好きな人すべてについて checkCrush を行う（自分の誕生日を変更する場合）
+func (p AgePolicy) checkCrushes(userBirthday model.Birthday, crushes []*model.Crush, now time.Time) error {
+	for _, c := range crushes {
+		if err := p.checkCrush(userBirthday, c.Birthday, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
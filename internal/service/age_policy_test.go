@@ -0,0 +1,73 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgePolicy_CheckUser(t *testing.T) {
+	now := time.Date(2026, 4, 1, 12, 0, 0, 0, jst)
+
+	tests := []struct {
+		name     string
+		policy   AgePolicy
+		birthday model.Birthday
+		reason   AgeRestriction
+	}{
+		{name: "最低年齢ちょうど", policy: testAgePolicy, birthday: "2013-04-01"},
+		{name: "最低年齢の前日", policy: testAgePolicy, birthday: "2013-04-02", reason: AgeBelowMinimum},
+		{name: "最低年齢が0なら制限しない", policy: AgePolicy{}, birthday: "2020-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertAgeRestriction(t, tt.reason, tt.policy.checkUser(tt.birthday, now))
+		})
+	}
+}
+
+func TestAgePolicy_CheckCrush(t *testing.T) {
+	now := time.Date(2026, 4, 1, 12, 0, 0, 0, jst)
+
+	tests := []struct {
+		name          string
+		policy        AgePolicy
+		userBirthday  model.Birthday
+		crushBirthday model.Birthday
+		reason        AgeRestriction
+	}{
+		{name: "成人同士", policy: testAgePolicy, userBirthday: "1990-01-01", crushBirthday: "1995-05-05"},
+		{name: "未成年同士", policy: testAgePolicy, userBirthday: "2010-01-01", crushBirthday: "2011-05-05"},
+		{name: "年齢差が上限ちょうど", policy: testAgePolicy, userBirthday: "1980-04-01", crushBirthday: "1990-04-01"},
+		{name: "年齢差が上限を超える", policy: testAgePolicy, userBirthday: "1980-04-01", crushBirthday: "1990-04-02", reason: AgeGapTooLarge},
+		{name: "年齢差は相手が年上でも数える", policy: testAgePolicy, userBirthday: "1995-01-01", crushBirthday: "1970-01-01", reason: AgeGapTooLarge},
+		{name: "成人が未成年を登録", policy: testAgePolicy, userBirthday: "2007-01-01", crushBirthday: "2009-01-01", reason: AgeMinorAdultCrush},
+		{name: "未成年が成人を登録", policy: testAgePolicy, userBirthday: "2009-01-01", crushBirthday: "2008-04-01", reason: AgeMinorAdultCrush},
+		{name: "成人とみなす年齢が0なら未成年と成人の組み合わせも登録できる", policy: AgePolicy{MaxGap: 10}, userBirthday: "2007-01-01", crushBirthday: "2009-01-01"},
+		{name: "年齢差の上限が0なら制限しない", policy: AgePolicy{AdultAge: 18}, userBirthday: "1950-01-01", crushBirthday: "2000-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertAgeRestriction(t, tt.reason, tt.policy.checkCrush(tt.userBirthday, tt.crushBirthday, now))
+		})
+	}
+}
+
+// assertAgeRestriction は err が reason の AgeRestrictedError であることを確認する（reason が空ならエラーなし）
+func assertAgeRestriction(t *testing.T, reason AgeRestriction, err error) {
+	t.Helper()
+	if reason == "" {
+		assert.NoError(t, err)
+		return
+	}
+	var ageErr *AgeRestrictedError
+	if assert.True(t, errors.As(err, &ageErr), "expected AgeRestrictedError, got %v", err) {
+		assert.Equal(t, reason, ageErr.Reason)
+	}
+	assert.ErrorIs(t, err, ErrAgeRestricted)
+}
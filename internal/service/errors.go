@@ -1,6 +1,9 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // Service層で使用するカスタムエラー定義
 var (
@@ -17,6 +20,28 @@ var (
 	// ErrDuplicateUser は重複するユーザーが存在する場合のエラー
 	ErrDuplicateUser = errors.New("duplicate user")
 
+	// ErrCrushLimitReached は好きな人の登録数が上限に達している場合のエラー
+	// 注: 詳細情報が必要な場合は CrushLimitReachedError を使用すること
+	ErrCrushLimitReached = errors.New("crush limit reached")
+
+	// ErrCrushChangeLimitReached は1日に好きな人を登録できる回数の上限に達している場合のエラー
+	// 注: 詳細情報が必要な場合は CrushChangeLimitReachedError を使用すること
+	ErrCrushChangeLimitReached = errors.New("crush change limit reached")
+
+	// ErrCrushChangeRestricted は好きな人を登録し直すことが制限されている場合のエラー（登録の間隔・期間内の人数）
+	// 注: 詳細情報が必要な場合は CrushChangeRestrictedError を使用すること
+	ErrCrushChangeRestricted = errors.New("crush change restricted")
+
+	// ErrNotMatched はマッチングしていないユーザーがマッチングを解除しようとした場合のエラー
+	ErrNotMatched = errors.New("not matched")
+
+	// ErrCrushNotFound は取り消そうとした好きな人が登録されていない場合のエラー
+	ErrCrushNotFound = errors.New("crush not found")
+
+	// ErrAgeRestricted は年齢による登録の制限に当てはまる場合のエラー
+	// 注: 詳細情報が必要な場合は AgeRestrictedError を使用すること
+	ErrAgeRestricted = errors.New("age restricted")
+
 	// ErrInvalidName は名前のバリデーションに失敗した場合のエラー
 	// 注: 詳細情報が必要な場合は ValidationError を使用すること
 	ErrInvalidName = errors.New("invalid name")
@@ -50,3 +75,85 @@ func (e *MatchedUserExistsError) Error() string {
 func (e *MatchedUserExistsError) Is(target error) bool {
 	return target == ErrMatchedUserExists
 }
+
+// CrushLimitReachedError は好きな人の登録数が上限に達している場合の詳細エラー
+// 上限人数を含む
+type CrushLimitReachedError struct {
+	Limit int
+}
+
+func (e *CrushLimitReachedError) Error() string {
+	return "crush limit reached"
+}
+
+// Is implements error comparison for errors.Is()
+func (e *CrushLimitReachedError) Is(target error) bool {
+	return target == ErrCrushLimitReached
+}
+
+// CrushChangeLimitReachedError は1日に好きな人を登録できる回数の上限に達している場合の詳細エラー
+// 上限回数と、次に登録できるようになる時刻（日本時間の翌日0時）を含む
+type CrushChangeLimitReachedError struct {
+	Limit   int
+	RetryAt time.Time
+}
+
+func (e *CrushChangeLimitReachedError) Error() string {
+	return "crush change limit reached"
+}
+
+// Is implements error comparison for errors.Is()
+func (e *CrushChangeLimitReachedError) Is(target error) bool {
+	return target == ErrCrushChangeLimitReached
+}
+
+// CrushChangeRestriction は好きな人を登録し直すことが制限された理由
+type CrushChangeRestriction string
+
+const (
+	CrushChangeCooldown      CrushChangeRestriction = "cooldown"       // 前回の登録から間隔が空いていない
+	CrushChangeDistinctLimit CrushChangeRestriction = "distinct_limit" // 期間内に登録できる人数の上限に達している
+)
+
+// CrushChangeRestrictedError は好きな人を登録し直すことが制限されている場合の詳細エラー
+// 制限の理由と、次に登録できるようになる時刻を含む（人数の上限の場合は上限人数と期間も含む）
+type CrushChangeRestrictedError struct {
+	Reason  CrushChangeRestriction
+	Limit   int
+	Window  time.Duration
+	RetryAt time.Time
+}
+
+func (e *CrushChangeRestrictedError) Error() string {
+	return "crush change restricted: " + string(e.Reason)
+}
+
+// Is implements error comparison for errors.Is()
+func (e *CrushChangeRestrictedError) Is(target error) bool {
+	return target == ErrCrushChangeRestricted
+}
+
+// AgeRestriction は年齢による登録の制限の理由（登録APIのエラーコードとしても使う）
+type AgeRestriction string
+
+const (
+	AgeBelowMinimum    AgeRestriction = "under_minimum_age" // ユーザー登録できる最低年齢に達していない
+	AgeGapTooLarge     AgeRestriction = "age_gap_too_large" // 好きな人との年齢差が上限を超えている
+	AgeMinorAdultCrush AgeRestriction = "minor_adult_crush" // 未成年と成人の組み合わせ
+)
+
+// AgeRestrictedError は年齢による登録の制限に当てはまる場合の詳細エラー
+// 制限の理由と、その制限の年齢（最低年齢・年齢差の上限・成人とみなす年齢のいずれか）を含む
+type AgeRestrictedError struct {
+	Reason AgeRestriction
+	Limit  int
+}
+
+func (e *AgeRestrictedError) Error() string {
+	return "age restricted: " + string(e.Reason)
+}
+
+// Is implements error comparison for errors.Is()
+func (e *AgeRestrictedError) Is(target error) bool {
+	return target == ErrAgeRestricted
+}
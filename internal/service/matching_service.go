@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/morinonusi421/cupid/internal/model"
@@ -13,25 +15,50 @@ import (
 type MatchingService interface {
 	CheckAndUpdateMatch(ctx context.Context, currentUser *model.User) (matched bool, matchedUser *model.User, err error)
 	UnmatchUsers(ctx context.Context, initiatorUserID, partnerUserID string) (initiatorUser *model.User, partnerUser *model.User, err error)
+	ListMatchHistory(ctx context.Context, userID string) ([]*model.MatchHistory, error)
+	DeleteMatchHistory(ctx context.Context, userID string) error
 }
 
 // matchingService は MatchingService の実装
 type matchingService struct {
-	userRepo repository.UserRepository
+	userRepo         repository.UserRepository
+	matchHistoryRepo repository.MatchHistoryRepository
+	agePolicy        AgePolicy
+	now              func() time.Time
 }
 
 // NewMatchingService は MatchingService の新しいインスタンスを作成する
-func NewMatchingService(userRepo repository.UserRepository) MatchingService {
+//
+// マッチングの成立・解除は matchHistoryRepo に両方のユーザーの履歴として記録する
+// agePolicy はマッチングを成立させる時点の年齢でも確認する（登録時と同じ制限）
+func NewMatchingService(userRepo repository.UserRepository, matchHistoryRepo repository.MatchHistoryRepository, agePolicy AgePolicy) MatchingService {
 	return &matchingService{
-		userRepo: userRepo,
+		userRepo:         userRepo,
+		matchHistoryRepo: matchHistoryRepo,
+		agePolicy:        agePolicy,
+		now:              time.Now,
 	}
 }
 
 // CheckAndUpdateMatch は相互マッチングをチェックし、マッチした場合は両方の matched_with_user_id を更新する
 //
 // 処理の流れ:
-// 1. 相互にcrushしているユーザーを検索（FindMatchingUser）
-// 2. 両方が真の場合、両方の matched_with_user_id を更新
+// 1. 相互にcrushしているユーザーを検索（FindMatchingUsers）
+// 2. 年齢による制限（AgePolicy）を今の年齢で確認し、制限されない最初の候補を相手にする
+//    （すべての候補が制限される場合はマッチングしない）
+// 3. 両方の matched_with_user_id を更新し、マッチング成立の履歴を記録
+//
+// 好きな人の登録時にも年齢を確認しているが、登録から時間が経って成人・未成年の境目をまたいだ場合や、
+// 制限の設定を変えた場合に、制限される組み合わせでマッチングが成立しないようにする。
+//
+// 検索と更新は1つのトランザクション内で行うため、同時に登録した2人が
+// 両方とも未マッチの相手を見つけて片側だけマッチする、といった状態にはならない。
+// ctx が既にトランザクションを保持している場合は、そのトランザクションに参加する。
+//
+// currentUser が既にマッチング中の場合は、検索も更新もせずにマッチなしを返す
+// （前の相手との関係を残したまま、別の相手と片側だけマッチした状態になるのを防ぐ）。
+// 検索・更新に失敗した場合はエラーを返す。呼び出し元（登録処理）はエラーを無視せず、
+// 登録ごとロールバックする（マッチングしたはずの登録がマッチなしのまま残らないようにする）。
 //
 // 戻り値:
 //   - matched: マッチングが成立したかどうか
@@ -41,68 +68,143 @@ func (s *matchingService) CheckAndUpdateMatch(
 	ctx context.Context,
 	currentUser *model.User,
 ) (matched bool, matchedUser *model.User, err error) {
-	// 1. 相互にcrushしているユーザーを検索
-	matchedUser, err = s.userRepo.FindMatchingUser(ctx, currentUser)
-	if err != nil {
-		return false, nil, err
-	}
-
-	// マッチング相手が見つからない場合
-	if matchedUser == nil {
+	// 既にマッチング中の場合は何もしない（前の相手が片側だけマッチした状態になるのを防ぐ）
+	if currentUser.IsMatched() {
 		return false, nil, nil
 	}
 
-	// 2. 両方の matched_with_user_id を更新
-	currentUser.MatchedWithUserID = null.StringFrom(matchedUser.LineID)
-	matchedUser.MatchedWithUserID = null.StringFrom(currentUser.LineID)
-
-	if err := s.userRepo.Update(ctx, currentUser); err != nil {
-		return false, nil, err
-	}
-
-	if err := s.userRepo.Update(ctx, matchedUser); err != nil {
+	err = s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		// 1. 相互にcrushしているユーザーを検索
+		candidates, err := s.userRepo.FindMatchingUsers(ctx, currentUser)
+		if err != nil {
+			return err
+		}
+
+		// 2. 今の年齢で制限される組み合わせの候補は飛ばす（登録のエラーにはしない）
+		var found *model.User
+		for _, candidate := range candidates {
+			if err := s.agePolicy.checkCrush(currentUser.Birthday, candidate.Birthday, s.now()); err != nil {
+				log.Printf("Match between %s and %s blocked by age policy: %v", currentUser.LineID, candidate.LineID, err)
+				continue
+			}
+			found = candidate
+			break
+		}
+
+		// マッチング相手が見つからない場合
+		if found == nil {
+			return nil
+		}
+
+		// 3. 両方の matched_with_user_id を更新
+		currentUser.MatchedWithUserID = null.StringFrom(found.LineID)
+		found.MatchedWithUserID = null.StringFrom(currentUser.LineID)
+
+		if err := s.userRepo.Update(ctx, currentUser); err != nil {
+			return err
+		}
+
+		if err := s.userRepo.Update(ctx, found); err != nil {
+			return err
+		}
+
+		if err := s.recordHistory(ctx, currentUser, found, model.MatchStarted); err != nil {
+			return err
+		}
+
+		matchedUser = found
+		return nil
+	})
+	if err != nil {
+		// ロールバックされたため、メモリ上の値も元に戻す
+		currentUser.MatchedWithUserID = null.String{}
 		return false, nil, err
 	}
 
-	return true, matchedUser, nil
+	return matchedUser != nil, matchedUser, nil
 }
 
-// UnmatchUsers はマッチングを解除する（通知送信は行わない）
+// UnmatchUsers はマッチングを解除し、解除の履歴を記録する（通知送信は行わない）
+//
+// ctx が既にトランザクションを保持している場合は、そのトランザクションに参加する。
 //
 // 戻り値:
 //   - initiatorUser: 解除を開始したユーザー（matched_with_user_id が NULL に更新済み）
 //   - partnerUser: 相手ユーザー（matched_with_user_id が NULL に更新済み）
 //   - err: エラー（あれば）
 func (s *matchingService) UnmatchUsers(ctx context.Context, initiatorUserID, partnerUserID string) (*model.User, *model.User, error) {
-	// 開始ユーザーの情報を取得
-	initiatorUser, err := s.userRepo.FindByLineID(ctx, initiatorUserID)
+	var initiatorUser, partnerUser *model.User
+
+	// 両方の更新を1つのトランザクションで行う（片側だけ解除された状態を残さない）
+	err := s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		// 開始ユーザーの情報を取得
+		initiatorUser, err = s.userRepo.FindByLineID(ctx, initiatorUserID)
+		if err != nil {
+			return fmt.Errorf("failed to find initiator user: %w", err)
+		}
+		if initiatorUser == nil {
+			return fmt.Errorf("initiator user not found: %s", initiatorUserID)
+		}
+
+		// 相手のユーザー情報を取得
+		partnerUser, err = s.userRepo.FindByLineID(ctx, partnerUserID)
+		if err != nil {
+			return fmt.Errorf("failed to find partner user: %w", err)
+		}
+		if partnerUser == nil {
+			return fmt.Errorf("partner user not found: %s", partnerUserID)
+		}
+
+		// 両方の matched_with_user_id を NULL に
+		initiatorUser.MatchedWithUserID = null.String{Valid: false}
+		partnerUser.MatchedWithUserID = null.String{Valid: false}
+
+		if err := s.userRepo.Update(ctx, initiatorUser); err != nil {
+			return fmt.Errorf("failed to update initiator user: %w", err)
+		}
+
+		if err := s.userRepo.Update(ctx, partnerUser); err != nil {
+			return fmt.Errorf("failed to update partner user: %w", err)
+		}
+
+		return s.recordHistory(ctx, initiatorUser, partnerUser, model.MatchEnded)
+	})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to find initiator user: %w", err)
-	}
-	if initiatorUser == nil {
-		return nil, nil, fmt.Errorf("initiator user not found: %s", initiatorUserID)
+		return nil, nil, err
 	}
 
-	// 相手のユーザー情報を取得
-	partnerUser, err := s.userRepo.FindByLineID(ctx, partnerUserID)
+	return initiatorUser, partnerUser, nil
+}
+
+// ListMatchHistory はユーザーのマッチングの成立・解除の履歴を古い順に返す
+func (s *matchingService) ListMatchHistory(ctx context.Context, userID string) ([]*model.MatchHistory, error) {
+	histories, err := s.matchHistoryRepo.ListByUserID(ctx, userID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to find partner user: %w", err)
+		return nil, fmt.Errorf("failed to list match history: %w", err)
 	}
-	if partnerUser == nil {
-		return nil, nil, fmt.Errorf("partner user not found: %s", partnerUserID)
-	}
-
-	// 両方の matched_with_user_id を NULL に
-	initiatorUser.MatchedWithUserID = null.String{Valid: false}
-	partnerUser.MatchedWithUserID = null.String{Valid: false}
+	return histories, nil
+}
 
-	if err := s.userRepo.Update(ctx, initiatorUser); err != nil {
-		return nil, nil, fmt.Errorf("failed to update initiator user: %w", err)
+// DeleteMatchHistory はユーザーのマッチングの履歴を削除する（退会時に使う。相手側の履歴は残す）
+func (s *matchingService) DeleteMatchHistory(ctx context.Context, userID string) error {
+	if err := s.matchHistoryRepo.DeleteByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete match history: %w", err)
 	}
+	return nil
+}
 
-	if err := s.userRepo.Update(ctx, partnerUser); err != nil {
-		return nil, nil, fmt.Errorf("failed to update partner user: %w", err)
+// recordHistory はマッチングの成立・解除を両方のユーザーの履歴として記録する
+// 相手の名前はその時点の名前を残す
+func (s *matchingService) recordHistory(ctx context.Context, user, partner *model.User, action model.MatchHistoryAction) error {
+	for _, h := range []*model.MatchHistory{
+		{UserLineID: user.LineID, PartnerLineID: partner.LineID, PartnerName: partner.Name, Action: action},
+		{UserLineID: partner.LineID, PartnerLineID: user.LineID, PartnerName: user.Name, Action: action},
+	} {
+		if err := s.matchHistoryRepo.Record(ctx, h); err != nil {
+			return fmt.Errorf("failed to record match history: %w", err)
+		}
 	}
-
-	return initiatorUser, partnerUser, nil
+	return nil
 }
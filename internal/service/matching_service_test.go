@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/morinonusi421/cupid/internal/model"
@@ -12,11 +13,28 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// allowWithTx は UserRepository の mock で WithTx を受け付け、fn をそのまま実行するように設定する
+func allowWithTx(m *mocks.MockUserRepository) {
+	m.EXPECT().WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+}
+
+// allowMatchHistory は MatchHistoryRepository の mock でマッチングの履歴の記録を受け付けるように設定する
+func allowMatchHistory(m *mocks.MockMatchHistoryRepository) {
+	m.EXPECT().Record(mock.Anything, mock.Anything).Return(nil).Maybe()
+}
+
 // ========================================
 // CheckAndUpdateMatch のテスト
 // ========================================
 
 func TestMatchingService_CheckAndUpdateMatch(t *testing.T) {
+	// 今日の時点で15歳（未成年）の誕生日
+	minorBirthday := model.Birthday(time.Now().AddDate(-15, 0, 0).Format(model.BirthdayLayout))
+
 	tests := []struct {
 		name              string
 		currentUser       *model.User
@@ -32,11 +50,9 @@ func TestMatchingService_CheckAndUpdateMatch(t *testing.T) {
 				LineID:        "U-alice",
 				Name:          "アリス",
 				Birthday:      "1990-01-01",
-				CrushName:     null.StringFrom("ボブ"),
-				CrushBirthday: null.StringFrom("1995-05-05"),
 			},
 			mockSetup: func(m *mocks.MockUserRepository) {
-				m.EXPECT().FindMatchingUser(mock.Anything, mock.Anything).Return(nil, nil)
+				m.EXPECT().FindMatchingUsers(mock.Anything, mock.Anything).Return(nil, nil)
 			},
 			expectedMatched: false,
 			expectedError:   false,
@@ -47,11 +63,9 @@ func TestMatchingService_CheckAndUpdateMatch(t *testing.T) {
 				LineID:        "U-alice",
 				Name:          "アリス",
 				Birthday:      "1990-01-01",
-				CrushName:     null.StringFrom("ボブ"),
-				CrushBirthday: null.StringFrom("1995-05-05"),
 			},
 			mockSetup: func(m *mocks.MockUserRepository) {
-				m.EXPECT().FindMatchingUser(mock.Anything, mock.Anything).Return(nil, nil)
+				m.EXPECT().FindMatchingUsers(mock.Anything, mock.Anything).Return(nil, nil)
 			},
 			expectedMatched: false,
 			expectedError:   false,
@@ -62,18 +76,14 @@ func TestMatchingService_CheckAndUpdateMatch(t *testing.T) {
 				LineID:        "U-alice",
 				Name:          "アリス",
 				Birthday:      "1990-01-01",
-				CrushName:     null.StringFrom("ボブ"),
-				CrushBirthday: null.StringFrom("1995-05-05"),
 			},
 			mockSetup: func(m *mocks.MockUserRepository) {
 				matchedUser := &model.User{
 					LineID:        "U-bob",
 					Name:          "ボブ",
 					Birthday:      "1995-05-05",
-					CrushName:     null.StringFrom("アリス"),
-					CrushBirthday: null.StringFrom("1990-01-01"),
 				}
-				m.EXPECT().FindMatchingUser(mock.Anything, mock.Anything).Return(matchedUser, nil)
+				m.EXPECT().FindMatchingUsers(mock.Anything, mock.Anything).Return([]*model.User{matchedUser}, nil)
 				m.EXPECT().
 					Update(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
 						return (u.LineID == "U-alice" && u.MatchedWithUserID.String == "U-bob") ||
@@ -87,16 +97,88 @@ func TestMatchingService_CheckAndUpdateMatch(t *testing.T) {
 			expectedError:    false,
 		},
 		{
-			name: "異常系 - FindMatchingUserエラー",
+			name: "マッチなし - 既にマッチング中",
+			currentUser: &model.User{
+				LineID:            "U-alice",
+				Name:              "アリス",
+				Birthday:          "1990-01-01",
+				MatchedWithUserID: null.StringFrom("U-charlie"),
+			},
+			mockSetup: func(m *mocks.MockUserRepository) {
+				// 既にマッチング中なので検索しない
+			},
+			expectedMatched: false,
+			expectedError:   false,
+		},
+		{
+			name: "マッチなし - 今の年齢では未成年と成人の組み合わせ",
+			currentUser: &model.User{
+				LineID:   "U-alice",
+				Name:     "アリス",
+				Birthday: "1990-01-01",
+			},
+			mockSetup: func(m *mocks.MockUserRepository) {
+				// 登録後に制限の設定が変わった場合など、相互に登録していても年齢の制限を確認する（Update は呼ばれない）
+				m.EXPECT().FindMatchingUsers(mock.Anything, mock.Anything).Return([]*model.User{{
+					LineID:   "U-bob",
+					Name:     "ボブ",
+					Birthday: minorBirthday,
+				}}, nil)
+			},
+			expectedMatched: false,
+			expectedError:   false,
+		},
+		{
+			name: "マッチング成立 - 年齢で制限される候補を飛ばして次の候補とマッチ",
+			currentUser: &model.User{
+				LineID:   "U-alice",
+				Name:     "アリス",
+				Birthday: "1990-01-01",
+			},
+			mockSetup: func(m *mocks.MockUserRepository) {
+				// 先に登録した相手（ボブ）は未成年のため、次の候補（キャロル）とマッチする
+				m.EXPECT().FindMatchingUsers(mock.Anything, mock.Anything).Return([]*model.User{
+					{LineID: "U-bob", Name: "ボブ", Birthday: minorBirthday},
+					{LineID: "U-carol", Name: "キャロル", Birthday: "1992-02-02"},
+				}, nil)
+				m.EXPECT().
+					Update(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
+						return (u.LineID == "U-alice" && u.MatchedWithUserID.String == "U-carol") ||
+							(u.LineID == "U-carol" && u.MatchedWithUserID.String == "U-alice")
+					})).
+					Return(nil).
+					Times(2)
+			},
+			expectedMatched:  true,
+			expectedUserName: "キャロル",
+			expectedError:    false,
+		},
+		{
+			name: "マッチなし - 年齢差が上限を超える組み合わせ",
+			currentUser: &model.User{
+				LineID:   "U-alice",
+				Name:     "アリス",
+				Birthday: "1980-01-01",
+			},
+			mockSetup: func(m *mocks.MockUserRepository) {
+				m.EXPECT().FindMatchingUsers(mock.Anything, mock.Anything).Return([]*model.User{{
+					LineID:   "U-bob",
+					Name:     "ボブ",
+					Birthday: "1995-05-05",
+				}}, nil)
+			},
+			expectedMatched: false,
+			expectedError:   false,
+		},
+		{
+			name: "異常系 - FindMatchingUsersエラー",
 			currentUser: &model.User{
 				LineID:        "U-alice",
 				Name:          "アリス",
 				Birthday:      "1990-01-01",
-				CrushName:     null.StringFrom("ボブ"),
-				CrushBirthday: null.StringFrom("1995-05-05"),
 			},
 			mockSetup: func(m *mocks.MockUserRepository) {
-				m.EXPECT().FindMatchingUser(mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+				m.EXPECT().FindMatchingUsers(mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
 			},
 			expectedMatched:  false,
 			expectedError:    true,
@@ -108,18 +190,14 @@ func TestMatchingService_CheckAndUpdateMatch(t *testing.T) {
 				LineID:        "U-alice",
 				Name:          "アリス",
 				Birthday:      "1990-01-01",
-				CrushName:     null.StringFrom("ボブ"),
-				CrushBirthday: null.StringFrom("1995-05-05"),
 			},
 			mockSetup: func(m *mocks.MockUserRepository) {
 				matchedUser := &model.User{
 					LineID:        "U-bob",
 					Name:          "ボブ",
 					Birthday:      "1995-05-05",
-					CrushName:     null.StringFrom("アリス"),
-					CrushBirthday: null.StringFrom("1990-01-01"),
 				}
-				m.EXPECT().FindMatchingUser(mock.Anything, mock.Anything).Return(matchedUser, nil)
+				m.EXPECT().FindMatchingUsers(mock.Anything, mock.Anything).Return([]*model.User{matchedUser}, nil)
 				m.EXPECT().
 					Update(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
 						return u.LineID == "U-alice"
@@ -136,18 +214,14 @@ func TestMatchingService_CheckAndUpdateMatch(t *testing.T) {
 				LineID:        "U-alice",
 				Name:          "アリス",
 				Birthday:      "1990-01-01",
-				CrushName:     null.StringFrom("ボブ"),
-				CrushBirthday: null.StringFrom("1995-05-05"),
 			},
 			mockSetup: func(m *mocks.MockUserRepository) {
 				matchedUser := &model.User{
 					LineID:        "U-bob",
 					Name:          "ボブ",
 					Birthday:      "1995-05-05",
-					CrushName:     null.StringFrom("アリス"),
-					CrushBirthday: null.StringFrom("1990-01-01"),
 				}
-				m.EXPECT().FindMatchingUser(mock.Anything, mock.Anything).Return(matchedUser, nil)
+				m.EXPECT().FindMatchingUsers(mock.Anything, mock.Anything).Return([]*model.User{matchedUser}, nil)
 				m.EXPECT().
 					Update(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
 						return u.LineID == "U-alice"
@@ -168,9 +242,13 @@ func TestMatchingService_CheckAndUpdateMatch(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepo := mocks.NewMockUserRepository(t)
+			allowWithTx(mockUserRepo)
 			tt.mockSetup(mockUserRepo)
 
-			service := NewMatchingService(mockUserRepo)
+			mockMatchHistoryRepo := mocks.NewMockMatchHistoryRepository(t)
+			allowMatchHistory(mockMatchHistoryRepo)
+
+			service := NewMatchingService(mockUserRepo, mockMatchHistoryRepo, testAgePolicy)
 			matched, matchedUser, err := service.CheckAndUpdateMatch(context.Background(), tt.currentUser)
 
 			if tt.expectedError {
@@ -192,6 +270,29 @@ func TestMatchingService_CheckAndUpdateMatch(t *testing.T) {
 	}
 }
 
+func TestMatchingService_CheckAndUpdateMatch_AlreadyMatched(t *testing.T) {
+	// 既にマッチング中のユーザーは検索も更新もせずに返す（前の相手との関係はそのまま）
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockMatchHistoryRepo := mocks.NewMockMatchHistoryRepository(t)
+	currentUser := &model.User{
+		LineID:            "U-alice",
+		Name:              "アリス",
+		Birthday:          "1990-01-01",
+		MatchedWithUserID: null.StringFrom("U-charlie"),
+	}
+
+	service := NewMatchingService(mockUserRepo, mockMatchHistoryRepo, testAgePolicy)
+	matched, matchedUser, err := service.CheckAndUpdateMatch(context.Background(), currentUser)
+
+	assert.NoError(t, err)
+	assert.False(t, matched)
+	assert.Nil(t, matchedUser)
+	assert.Equal(t, null.StringFrom("U-charlie"), currentUser.MatchedWithUserID)
+	mockUserRepo.AssertNotCalled(t, "WithTx", mock.Anything, mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "FindMatchingUsers", mock.Anything, mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
 // ========================================
 // UnmatchUsers のテスト
 // ========================================
@@ -357,9 +458,13 @@ func TestMatchingService_UnmatchUsers(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepo := mocks.NewMockUserRepository(t)
+			allowWithTx(mockUserRepo)
 			tt.mockSetup(mockUserRepo)
 
-			service := NewMatchingService(mockUserRepo)
+			mockMatchHistoryRepo := mocks.NewMockMatchHistoryRepository(t)
+			allowMatchHistory(mockMatchHistoryRepo)
+
+			service := NewMatchingService(mockUserRepo, mockMatchHistoryRepo, testAgePolicy)
 			updatedInitiator, updatedPartner, err := service.UnmatchUsers(context.Background(), tt.initiatorUserID, tt.partnerUserID)
 
 			if tt.expectedError {
@@ -377,3 +482,86 @@ func TestMatchingService_UnmatchUsers(t *testing.T) {
 		})
 	}
 }
+
+// ========================================
+// マッチングの履歴のテスト
+// ========================================
+
+func TestMatchingService_RecordsMatchHistory(t *testing.T) {
+	// matchHistoryRecorder は記録された履歴を返す MatchHistoryRepository の mock を作成する
+	matchHistoryRecorder := func(t *testing.T) (*mocks.MockMatchHistoryRepository, *[]*model.MatchHistory) {
+		m := mocks.NewMockMatchHistoryRepository(t)
+		var recorded []*model.MatchHistory
+		m.EXPECT().Record(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, h *model.MatchHistory) error {
+				recorded = append(recorded, h)
+				return nil
+			})
+		return m, &recorded
+	}
+
+	t.Run("マッチング成立 - 両方のユーザーに相手の名前で記録", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepository(t)
+		allowWithTx(mockUserRepo)
+		mockMatchHistoryRepo, recorded := matchHistoryRecorder(t)
+
+		alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}
+		bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+		mockUserRepo.EXPECT().FindMatchingUsers(mock.Anything, alice).Return([]*model.User{bob}, nil)
+		mockUserRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+		service := NewMatchingService(mockUserRepo, mockMatchHistoryRepo, testAgePolicy)
+		matched, _, err := service.CheckAndUpdateMatch(context.Background(), alice)
+
+		assert.NoError(t, err)
+		assert.True(t, matched)
+		assert.Equal(t, []*model.MatchHistory{
+			{UserLineID: "U-alice", PartnerLineID: "U-bob", PartnerName: "ボブ", Action: model.MatchStarted},
+			{UserLineID: "U-bob", PartnerLineID: "U-alice", PartnerName: "アリス", Action: model.MatchStarted},
+		}, *recorded)
+	})
+
+	t.Run("マッチング解除 - 両方のユーザーに記録", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepository(t)
+		allowWithTx(mockUserRepo)
+		mockMatchHistoryRepo, recorded := matchHistoryRecorder(t)
+
+		mockUserRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+			LineID: "U-alice", Name: "アリス", MatchedWithUserID: null.StringFrom("U-bob"),
+		}, nil)
+		mockUserRepo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(&model.User{
+			LineID: "U-bob", Name: "ボブ", MatchedWithUserID: null.StringFrom("U-alice"),
+		}, nil)
+		mockUserRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+		service := NewMatchingService(mockUserRepo, mockMatchHistoryRepo, testAgePolicy)
+		_, _, err := service.UnmatchUsers(context.Background(), "U-alice", "U-bob")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.MatchHistory{
+			{UserLineID: "U-alice", PartnerLineID: "U-bob", PartnerName: "ボブ", Action: model.MatchEnded},
+			{UserLineID: "U-bob", PartnerLineID: "U-alice", PartnerName: "アリス", Action: model.MatchEnded},
+		}, *recorded)
+	})
+
+	t.Run("記録に失敗 - マッチングも成立しない", func(t *testing.T) {
+		mockUserRepo := mocks.NewMockUserRepository(t)
+		allowWithTx(mockUserRepo)
+		mockMatchHistoryRepo := mocks.NewMockMatchHistoryRepository(t)
+
+		alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}
+		bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+		mockUserRepo.EXPECT().FindMatchingUsers(mock.Anything, alice).Return([]*model.User{bob}, nil)
+		mockUserRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+		mockMatchHistoryRepo.EXPECT().Record(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+		service := NewMatchingService(mockUserRepo, mockMatchHistoryRepo, testAgePolicy)
+		matched, matchedUser, err := service.CheckAndUpdateMatch(context.Background(), alice)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to record match history")
+		assert.False(t, matched)
+		assert.Nil(t, matchedUser)
+		assert.False(t, alice.IsMatched())
+	})
+}
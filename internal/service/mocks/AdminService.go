@@ -0,0 +1,384 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/morinonusi421/cupid/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAdminService is an autogenerated mock type for the AdminService type
+type MockAdminService struct {
+	mock.Mock
+}
+
+type MockAdminService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAdminService) EXPECT() *MockAdminService_Expecter {
+	return &MockAdminService_Expecter{mock: &_m.Mock}
+}
+
+// DeleteUser provides a mock function with given fields: ctx, actor, lineID
+func (_m *MockAdminService) DeleteUser(ctx context.Context, actor model.AdminActor, lineID string) error {
+	ret := _m.Called(ctx, actor, lineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor, string) error); ok {
+		r0 = rf(ctx, actor, lineID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAdminService_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockAdminService_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor model.AdminActor
+//   - lineID string
+func (_e *MockAdminService_Expecter) DeleteUser(ctx interface{}, actor interface{}, lineID interface{}) *MockAdminService_DeleteUser_Call {
+	return &MockAdminService_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, actor, lineID)}
+}
+
+func (_c *MockAdminService_DeleteUser_Call) Run(run func(ctx context.Context, actor model.AdminActor, lineID string)) *MockAdminService_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.AdminActor), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAdminService_DeleteUser_Call) Return(_a0 error) *MockAdminService_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAdminService_DeleteUser_Call) RunAndReturn(run func(context.Context, model.AdminActor, string) error) *MockAdminService_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForceUnmatch provides a mock function with given fields: ctx, actor, lineID
+func (_m *MockAdminService) ForceUnmatch(ctx context.Context, actor model.AdminActor, lineID string) (*model.User, error) {
+	ret := _m.Called(ctx, actor, lineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceUnmatch")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor, string) (*model.User, error)); ok {
+		return rf(ctx, actor, lineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor, string) *model.User); ok {
+		r0 = rf(ctx, actor, lineID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AdminActor, string) error); ok {
+		r1 = rf(ctx, actor, lineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminService_ForceUnmatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceUnmatch'
+type MockAdminService_ForceUnmatch_Call struct {
+	*mock.Call
+}
+
+// ForceUnmatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor model.AdminActor
+//   - lineID string
+func (_e *MockAdminService_Expecter) ForceUnmatch(ctx interface{}, actor interface{}, lineID interface{}) *MockAdminService_ForceUnmatch_Call {
+	return &MockAdminService_ForceUnmatch_Call{Call: _e.mock.On("ForceUnmatch", ctx, actor, lineID)}
+}
+
+func (_c *MockAdminService_ForceUnmatch_Call) Run(run func(ctx context.Context, actor model.AdminActor, lineID string)) *MockAdminService_ForceUnmatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.AdminActor), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAdminService_ForceUnmatch_Call) Return(partner *model.User, err error) *MockAdminService_ForceUnmatch_Call {
+	_c.Call.Return(partner, err)
+	return _c
+}
+
+func (_c *MockAdminService_ForceUnmatch_Call) RunAndReturn(run func(context.Context, model.AdminActor, string) (*model.User, error)) *MockAdminService_ForceUnmatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMatches provides a mock function with given fields: ctx, actor
+func (_m *MockAdminService) ListMatches(ctx context.Context, actor model.AdminActor) ([]*model.MatchedPair, error) {
+	ret := _m.Called(ctx, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMatches")
+	}
+
+	var r0 []*model.MatchedPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor) ([]*model.MatchedPair, error)); ok {
+		return rf(ctx, actor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor) []*model.MatchedPair); ok {
+		r0 = rf(ctx, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.MatchedPair)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AdminActor) error); ok {
+		r1 = rf(ctx, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminService_ListMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMatches'
+type MockAdminService_ListMatches_Call struct {
+	*mock.Call
+}
+
+// ListMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor model.AdminActor
+func (_e *MockAdminService_Expecter) ListMatches(ctx interface{}, actor interface{}) *MockAdminService_ListMatches_Call {
+	return &MockAdminService_ListMatches_Call{Call: _e.mock.On("ListMatches", ctx, actor)}
+}
+
+func (_c *MockAdminService_ListMatches_Call) Run(run func(ctx context.Context, actor model.AdminActor)) *MockAdminService_ListMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.AdminActor))
+	})
+	return _c
+}
+
+func (_c *MockAdminService_ListMatches_Call) Return(_a0 []*model.MatchedPair, _a1 error) *MockAdminService_ListMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminService_ListMatches_Call) RunAndReturn(run func(context.Context, model.AdminActor) ([]*model.MatchedPair, error)) *MockAdminService_ListMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LookupUserByLineID provides a mock function with given fields: ctx, actor, lineID
+func (_m *MockAdminService) LookupUserByLineID(ctx context.Context, actor model.AdminActor, lineID string) (*model.AdminUserDetail, error) {
+	ret := _m.Called(ctx, actor, lineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LookupUserByLineID")
+	}
+
+	var r0 *model.AdminUserDetail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor, string) (*model.AdminUserDetail, error)); ok {
+		return rf(ctx, actor, lineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor, string) *model.AdminUserDetail); ok {
+		r0 = rf(ctx, actor, lineID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AdminUserDetail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AdminActor, string) error); ok {
+		r1 = rf(ctx, actor, lineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminService_LookupUserByLineID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupUserByLineID'
+type MockAdminService_LookupUserByLineID_Call struct {
+	*mock.Call
+}
+
+// LookupUserByLineID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor model.AdminActor
+//   - lineID string
+func (_e *MockAdminService_Expecter) LookupUserByLineID(ctx interface{}, actor interface{}, lineID interface{}) *MockAdminService_LookupUserByLineID_Call {
+	return &MockAdminService_LookupUserByLineID_Call{Call: _e.mock.On("LookupUserByLineID", ctx, actor, lineID)}
+}
+
+func (_c *MockAdminService_LookupUserByLineID_Call) Run(run func(ctx context.Context, actor model.AdminActor, lineID string)) *MockAdminService_LookupUserByLineID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.AdminActor), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAdminService_LookupUserByLineID_Call) Return(_a0 *model.AdminUserDetail, _a1 error) *MockAdminService_LookupUserByLineID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminService_LookupUserByLineID_Call) RunAndReturn(run func(context.Context, model.AdminActor, string) (*model.AdminUserDetail, error)) *MockAdminService_LookupUserByLineID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LookupUserByNameAndBirthday provides a mock function with given fields: ctx, actor, name, birthday
+func (_m *MockAdminService) LookupUserByNameAndBirthday(ctx context.Context, actor model.AdminActor, name string, birthday model.Birthday) (*model.AdminUserDetail, error) {
+	ret := _m.Called(ctx, actor, name, birthday)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LookupUserByNameAndBirthday")
+	}
+
+	var r0 *model.AdminUserDetail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor, string, model.Birthday) (*model.AdminUserDetail, error)); ok {
+		return rf(ctx, actor, name, birthday)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor, string, model.Birthday) *model.AdminUserDetail); ok {
+		r0 = rf(ctx, actor, name, birthday)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AdminUserDetail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AdminActor, string, model.Birthday) error); ok {
+		r1 = rf(ctx, actor, name, birthday)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminService_LookupUserByNameAndBirthday_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupUserByNameAndBirthday'
+type MockAdminService_LookupUserByNameAndBirthday_Call struct {
+	*mock.Call
+}
+
+// LookupUserByNameAndBirthday is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor model.AdminActor
+//   - name string
+//   - birthday model.Birthday
+func (_e *MockAdminService_Expecter) LookupUserByNameAndBirthday(ctx interface{}, actor interface{}, name interface{}, birthday interface{}) *MockAdminService_LookupUserByNameAndBirthday_Call {
+	return &MockAdminService_LookupUserByNameAndBirthday_Call{Call: _e.mock.On("LookupUserByNameAndBirthday", ctx, actor, name, birthday)}
+}
+
+func (_c *MockAdminService_LookupUserByNameAndBirthday_Call) Run(run func(ctx context.Context, actor model.AdminActor, name string, birthday model.Birthday)) *MockAdminService_LookupUserByNameAndBirthday_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.AdminActor), args[2].(string), args[3].(model.Birthday))
+	})
+	return _c
+}
+
+func (_c *MockAdminService_LookupUserByNameAndBirthday_Call) Return(_a0 *model.AdminUserDetail, _a1 error) *MockAdminService_LookupUserByNameAndBirthday_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminService_LookupUserByNameAndBirthday_Call) RunAndReturn(run func(context.Context, model.AdminActor, string, model.Birthday) (*model.AdminUserDetail, error)) *MockAdminService_LookupUserByNameAndBirthday_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stats provides a mock function with given fields: ctx, actor
+func (_m *MockAdminService) Stats(ctx context.Context, actor model.AdminActor) (*model.AdminStats, error) {
+	ret := _m.Called(ctx, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 *model.AdminStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor) (*model.AdminStats, error)); ok {
+		return rf(ctx, actor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AdminActor) *model.AdminStats); ok {
+		r0 = rf(ctx, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AdminStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AdminActor) error); ok {
+		r1 = rf(ctx, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminService_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type MockAdminService_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor model.AdminActor
+func (_e *MockAdminService_Expecter) Stats(ctx interface{}, actor interface{}) *MockAdminService_Stats_Call {
+	return &MockAdminService_Stats_Call{Call: _e.mock.On("Stats", ctx, actor)}
+}
+
+func (_c *MockAdminService_Stats_Call) Run(run func(ctx context.Context, actor model.AdminActor)) *MockAdminService_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.AdminActor))
+	})
+	return _c
+}
+
+func (_c *MockAdminService_Stats_Call) Return(_a0 *model.AdminStats, _a1 error) *MockAdminService_Stats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminService_Stats_Call) RunAndReturn(run func(context.Context, model.AdminActor) (*model.AdminStats, error)) *MockAdminService_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAdminService creates a new instance of MockAdminService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAdminService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAdminService {
+	mock := &MockAdminService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -88,6 +88,112 @@ func (_c *MockMatchingService_CheckAndUpdateMatch_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// DeleteMatchHistory provides a mock function with given fields: ctx, userID
+func (_m *MockMatchingService) DeleteMatchHistory(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMatchHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchingService_DeleteMatchHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMatchHistory'
+type MockMatchingService_DeleteMatchHistory_Call struct {
+	*mock.Call
+}
+
+// DeleteMatchHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMatchingService_Expecter) DeleteMatchHistory(ctx interface{}, userID interface{}) *MockMatchingService_DeleteMatchHistory_Call {
+	return &MockMatchingService_DeleteMatchHistory_Call{Call: _e.mock.On("DeleteMatchHistory", ctx, userID)}
+}
+
+func (_c *MockMatchingService_DeleteMatchHistory_Call) Run(run func(ctx context.Context, userID string)) *MockMatchingService_DeleteMatchHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchingService_DeleteMatchHistory_Call) Return(_a0 error) *MockMatchingService_DeleteMatchHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchingService_DeleteMatchHistory_Call) RunAndReturn(run func(context.Context, string) error) *MockMatchingService_DeleteMatchHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMatchHistory provides a mock function with given fields: ctx, userID
+func (_m *MockMatchingService) ListMatchHistory(ctx context.Context, userID string) ([]*model.MatchHistory, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMatchHistory")
+	}
+
+	var r0 []*model.MatchHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.MatchHistory, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.MatchHistory); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.MatchHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchingService_ListMatchHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMatchHistory'
+type MockMatchingService_ListMatchHistory_Call struct {
+	*mock.Call
+}
+
+// ListMatchHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockMatchingService_Expecter) ListMatchHistory(ctx interface{}, userID interface{}) *MockMatchingService_ListMatchHistory_Call {
+	return &MockMatchingService_ListMatchHistory_Call{Call: _e.mock.On("ListMatchHistory", ctx, userID)}
+}
+
+func (_c *MockMatchingService_ListMatchHistory_Call) Run(run func(ctx context.Context, userID string)) *MockMatchingService_ListMatchHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchingService_ListMatchHistory_Call) Return(_a0 []*model.MatchHistory, _a1 error) *MockMatchingService_ListMatchHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchingService_ListMatchHistory_Call) RunAndReturn(run func(context.Context, string) ([]*model.MatchHistory, error)) *MockMatchingService_ListMatchHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnmatchUsers provides a mock function with given fields: ctx, initiatorUserID, partnerUserID
 func (_m *MockMatchingService) UnmatchUsers(ctx context.Context, initiatorUserID string, partnerUserID string) (*model.User, *model.User, error) {
 	ret := _m.Called(ctx, initiatorUserID, partnerUserID)
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockNotificationDispatcher is an autogenerated mock type for the NotificationDispatcher type
+type MockNotificationDispatcher struct {
+	mock.Mock
+}
+
+type MockNotificationDispatcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationDispatcher) EXPECT() *MockNotificationDispatcher_Expecter {
+	return &MockNotificationDispatcher_Expecter{mock: &_m.Mock}
+}
+
+// DispatchDue provides a mock function with given fields: ctx
+func (_m *MockNotificationDispatcher) DispatchDue(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispatchDue")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotificationDispatcher_DispatchDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DispatchDue'
+type MockNotificationDispatcher_DispatchDue_Call struct {
+	*mock.Call
+}
+
+// DispatchDue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationDispatcher_Expecter) DispatchDue(ctx interface{}) *MockNotificationDispatcher_DispatchDue_Call {
+	return &MockNotificationDispatcher_DispatchDue_Call{Call: _e.mock.On("DispatchDue", ctx)}
+}
+
+func (_c *MockNotificationDispatcher_DispatchDue_Call) Run(run func(ctx context.Context)) *MockNotificationDispatcher_DispatchDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockNotificationDispatcher_DispatchDue_Call) Return(_a0 int, _a1 error) *MockNotificationDispatcher_DispatchDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotificationDispatcher_DispatchDue_Call) RunAndReturn(run func(context.Context) (int, error)) *MockNotificationDispatcher_DispatchDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationDispatcher creates a new instance of MockNotificationDispatcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationDispatcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationDispatcher {
+	mock := &MockNotificationDispatcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	model "github.com/morinonusi421/cupid/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,17 +22,17 @@ func (_m *MockNotificationService) EXPECT() *MockNotificationService_Expecter {
 	return &MockNotificationService_Expecter{mock: &_m.Mock}
 }
 
-// SendCrushRegistrationComplete provides a mock function with given fields: ctx, toUserLineID, isFirstRegistration
-func (_m *MockNotificationService) SendCrushRegistrationComplete(ctx context.Context, toUserLineID string, isFirstRegistration bool) error {
-	ret := _m.Called(ctx, toUserLineID, isFirstRegistration)
+// DiscardPendingNotifications provides a mock function with given fields: ctx, toUserLineID
+func (_m *MockNotificationService) DiscardPendingNotifications(ctx context.Context, toUserLineID string) error {
+	ret := _m.Called(ctx, toUserLineID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendCrushRegistrationComplete")
+		panic("no return value specified for DiscardPendingNotifications")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
-		r0 = rf(ctx, toUserLineID, isFirstRegistration)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, toUserLineID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -39,47 +40,46 @@ func (_m *MockNotificationService) SendCrushRegistrationComplete(ctx context.Con
 	return r0
 }
 
-// MockNotificationService_SendCrushRegistrationComplete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendCrushRegistrationComplete'
-type MockNotificationService_SendCrushRegistrationComplete_Call struct {
+// MockNotificationService_DiscardPendingNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscardPendingNotifications'
+type MockNotificationService_DiscardPendingNotifications_Call struct {
 	*mock.Call
 }
 
-// SendCrushRegistrationComplete is a helper method to define mock.On call
+// DiscardPendingNotifications is a helper method to define mock.On call
 //   - ctx context.Context
 //   - toUserLineID string
-//   - isFirstRegistration bool
-func (_e *MockNotificationService_Expecter) SendCrushRegistrationComplete(ctx interface{}, toUserLineID interface{}, isFirstRegistration interface{}) *MockNotificationService_SendCrushRegistrationComplete_Call {
-	return &MockNotificationService_SendCrushRegistrationComplete_Call{Call: _e.mock.On("SendCrushRegistrationComplete", ctx, toUserLineID, isFirstRegistration)}
+func (_e *MockNotificationService_Expecter) DiscardPendingNotifications(ctx interface{}, toUserLineID interface{}) *MockNotificationService_DiscardPendingNotifications_Call {
+	return &MockNotificationService_DiscardPendingNotifications_Call{Call: _e.mock.On("DiscardPendingNotifications", ctx, toUserLineID)}
 }
 
-func (_c *MockNotificationService_SendCrushRegistrationComplete_Call) Run(run func(ctx context.Context, toUserLineID string, isFirstRegistration bool)) *MockNotificationService_SendCrushRegistrationComplete_Call {
+func (_c *MockNotificationService_DiscardPendingNotifications_Call) Run(run func(ctx context.Context, toUserLineID string)) *MockNotificationService_DiscardPendingNotifications_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockNotificationService_SendCrushRegistrationComplete_Call) Return(_a0 error) *MockNotificationService_SendCrushRegistrationComplete_Call {
+func (_c *MockNotificationService_DiscardPendingNotifications_Call) Return(_a0 error) *MockNotificationService_DiscardPendingNotifications_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockNotificationService_SendCrushRegistrationComplete_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockNotificationService_SendCrushRegistrationComplete_Call {
+func (_c *MockNotificationService_DiscardPendingNotifications_Call) RunAndReturn(run func(context.Context, string) error) *MockNotificationService_DiscardPendingNotifications_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendCrushRegistrationPrompt provides a mock function with given fields: ctx, toUserLineID, crushLiffURL
-func (_m *MockNotificationService) SendCrushRegistrationPrompt(ctx context.Context, toUserLineID string, crushLiffURL string) error {
-	ret := _m.Called(ctx, toUserLineID, crushLiffURL)
+// EnqueueAdminUnmatchNotification provides a mock function with given fields: ctx, toUserLineID, partnerUserName
+func (_m *MockNotificationService) EnqueueAdminUnmatchNotification(ctx context.Context, toUserLineID string, partnerUserName string) error {
+	ret := _m.Called(ctx, toUserLineID, partnerUserName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendCrushRegistrationPrompt")
+		panic("no return value specified for EnqueueAdminUnmatchNotification")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, toUserLineID, crushLiffURL)
+		r0 = rf(ctx, toUserLineID, partnerUserName)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -87,47 +87,47 @@ func (_m *MockNotificationService) SendCrushRegistrationPrompt(ctx context.Conte
 	return r0
 }
 
-// MockNotificationService_SendCrushRegistrationPrompt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendCrushRegistrationPrompt'
-type MockNotificationService_SendCrushRegistrationPrompt_Call struct {
+// MockNotificationService_EnqueueAdminUnmatchNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueAdminUnmatchNotification'
+type MockNotificationService_EnqueueAdminUnmatchNotification_Call struct {
 	*mock.Call
 }
 
-// SendCrushRegistrationPrompt is a helper method to define mock.On call
+// EnqueueAdminUnmatchNotification is a helper method to define mock.On call
 //   - ctx context.Context
 //   - toUserLineID string
-//   - crushLiffURL string
-func (_e *MockNotificationService_Expecter) SendCrushRegistrationPrompt(ctx interface{}, toUserLineID interface{}, crushLiffURL interface{}) *MockNotificationService_SendCrushRegistrationPrompt_Call {
-	return &MockNotificationService_SendCrushRegistrationPrompt_Call{Call: _e.mock.On("SendCrushRegistrationPrompt", ctx, toUserLineID, crushLiffURL)}
+//   - partnerUserName string
+func (_e *MockNotificationService_Expecter) EnqueueAdminUnmatchNotification(ctx interface{}, toUserLineID interface{}, partnerUserName interface{}) *MockNotificationService_EnqueueAdminUnmatchNotification_Call {
+	return &MockNotificationService_EnqueueAdminUnmatchNotification_Call{Call: _e.mock.On("EnqueueAdminUnmatchNotification", ctx, toUserLineID, partnerUserName)}
 }
 
-func (_c *MockNotificationService_SendCrushRegistrationPrompt_Call) Run(run func(ctx context.Context, toUserLineID string, crushLiffURL string)) *MockNotificationService_SendCrushRegistrationPrompt_Call {
+func (_c *MockNotificationService_EnqueueAdminUnmatchNotification_Call) Run(run func(ctx context.Context, toUserLineID string, partnerUserName string)) *MockNotificationService_EnqueueAdminUnmatchNotification_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockNotificationService_SendCrushRegistrationPrompt_Call) Return(_a0 error) *MockNotificationService_SendCrushRegistrationPrompt_Call {
+func (_c *MockNotificationService_EnqueueAdminUnmatchNotification_Call) Return(_a0 error) *MockNotificationService_EnqueueAdminUnmatchNotification_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockNotificationService_SendCrushRegistrationPrompt_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationService_SendCrushRegistrationPrompt_Call {
+func (_c *MockNotificationService_EnqueueAdminUnmatchNotification_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationService_EnqueueAdminUnmatchNotification_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendFollowGreeting provides a mock function with given fields: ctx, replyToken, userLiffURL
-func (_m *MockNotificationService) SendFollowGreeting(ctx context.Context, replyToken string, userLiffURL string) error {
-	ret := _m.Called(ctx, replyToken, userLiffURL)
+// EnqueueMatchNotification provides a mock function with given fields: ctx, toUserLineID, matchedUserName
+func (_m *MockNotificationService) EnqueueMatchNotification(ctx context.Context, toUserLineID string, matchedUserName string) error {
+	ret := _m.Called(ctx, toUserLineID, matchedUserName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendFollowGreeting")
+		panic("no return value specified for EnqueueMatchNotification")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, replyToken, userLiffURL)
+		r0 = rf(ctx, toUserLineID, matchedUserName)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -135,47 +135,47 @@ func (_m *MockNotificationService) SendFollowGreeting(ctx context.Context, reply
 	return r0
 }
 
-// MockNotificationService_SendFollowGreeting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendFollowGreeting'
-type MockNotificationService_SendFollowGreeting_Call struct {
+// MockNotificationService_EnqueueMatchNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueMatchNotification'
+type MockNotificationService_EnqueueMatchNotification_Call struct {
 	*mock.Call
 }
 
-// SendFollowGreeting is a helper method to define mock.On call
+// EnqueueMatchNotification is a helper method to define mock.On call
 //   - ctx context.Context
-//   - replyToken string
-//   - userLiffURL string
-func (_e *MockNotificationService_Expecter) SendFollowGreeting(ctx interface{}, replyToken interface{}, userLiffURL interface{}) *MockNotificationService_SendFollowGreeting_Call {
-	return &MockNotificationService_SendFollowGreeting_Call{Call: _e.mock.On("SendFollowGreeting", ctx, replyToken, userLiffURL)}
+//   - toUserLineID string
+//   - matchedUserName string
+func (_e *MockNotificationService_Expecter) EnqueueMatchNotification(ctx interface{}, toUserLineID interface{}, matchedUserName interface{}) *MockNotificationService_EnqueueMatchNotification_Call {
+	return &MockNotificationService_EnqueueMatchNotification_Call{Call: _e.mock.On("EnqueueMatchNotification", ctx, toUserLineID, matchedUserName)}
 }
 
-func (_c *MockNotificationService_SendFollowGreeting_Call) Run(run func(ctx context.Context, replyToken string, userLiffURL string)) *MockNotificationService_SendFollowGreeting_Call {
+func (_c *MockNotificationService_EnqueueMatchNotification_Call) Run(run func(ctx context.Context, toUserLineID string, matchedUserName string)) *MockNotificationService_EnqueueMatchNotification_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockNotificationService_SendFollowGreeting_Call) Return(_a0 error) *MockNotificationService_SendFollowGreeting_Call {
+func (_c *MockNotificationService_EnqueueMatchNotification_Call) Return(_a0 error) *MockNotificationService_EnqueueMatchNotification_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockNotificationService_SendFollowGreeting_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationService_SendFollowGreeting_Call {
+func (_c *MockNotificationService_EnqueueMatchNotification_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationService_EnqueueMatchNotification_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendJoinGroupGreeting provides a mock function with given fields: ctx, replyToken
-func (_m *MockNotificationService) SendJoinGroupGreeting(ctx context.Context, replyToken string) error {
-	ret := _m.Called(ctx, replyToken)
+// EnqueuePartnerCrushWithdrawnNotification provides a mock function with given fields: ctx, toUserLineID, partnerUserName
+func (_m *MockNotificationService) EnqueuePartnerCrushWithdrawnNotification(ctx context.Context, toUserLineID string, partnerUserName string) error {
+	ret := _m.Called(ctx, toUserLineID, partnerUserName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendJoinGroupGreeting")
+		panic("no return value specified for EnqueuePartnerCrushWithdrawnNotification")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, replyToken)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, toUserLineID, partnerUserName)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -183,46 +183,47 @@ func (_m *MockNotificationService) SendJoinGroupGreeting(ctx context.Context, re
 	return r0
 }
 
-// MockNotificationService_SendJoinGroupGreeting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendJoinGroupGreeting'
-type MockNotificationService_SendJoinGroupGreeting_Call struct {
+// MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueuePartnerCrushWithdrawnNotification'
+type MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call struct {
 	*mock.Call
 }
 
-// SendJoinGroupGreeting is a helper method to define mock.On call
+// EnqueuePartnerCrushWithdrawnNotification is a helper method to define mock.On call
 //   - ctx context.Context
-//   - replyToken string
-func (_e *MockNotificationService_Expecter) SendJoinGroupGreeting(ctx interface{}, replyToken interface{}) *MockNotificationService_SendJoinGroupGreeting_Call {
-	return &MockNotificationService_SendJoinGroupGreeting_Call{Call: _e.mock.On("SendJoinGroupGreeting", ctx, replyToken)}
+//   - toUserLineID string
+//   - partnerUserName string
+func (_e *MockNotificationService_Expecter) EnqueuePartnerCrushWithdrawnNotification(ctx interface{}, toUserLineID interface{}, partnerUserName interface{}) *MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call {
+	return &MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call{Call: _e.mock.On("EnqueuePartnerCrushWithdrawnNotification", ctx, toUserLineID, partnerUserName)}
 }
 
-func (_c *MockNotificationService_SendJoinGroupGreeting_Call) Run(run func(ctx context.Context, replyToken string)) *MockNotificationService_SendJoinGroupGreeting_Call {
+func (_c *MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call) Run(run func(ctx context.Context, toUserLineID string, partnerUserName string)) *MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockNotificationService_SendJoinGroupGreeting_Call) Return(_a0 error) *MockNotificationService_SendJoinGroupGreeting_Call {
+func (_c *MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call) Return(_a0 error) *MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockNotificationService_SendJoinGroupGreeting_Call) RunAndReturn(run func(context.Context, string) error) *MockNotificationService_SendJoinGroupGreeting_Call {
+func (_c *MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationService_EnqueuePartnerCrushWithdrawnNotification_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendMatchNotification provides a mock function with given fields: ctx, toUserLineID, matchedUserName
-func (_m *MockNotificationService) SendMatchNotification(ctx context.Context, toUserLineID string, matchedUserName string) error {
-	ret := _m.Called(ctx, toUserLineID, matchedUserName)
+// EnqueuePartnerUnmatchedNotification provides a mock function with given fields: ctx, toUserLineID, partnerUserName
+func (_m *MockNotificationService) EnqueuePartnerUnmatchedNotification(ctx context.Context, toUserLineID string, partnerUserName string) error {
+	ret := _m.Called(ctx, toUserLineID, partnerUserName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendMatchNotification")
+		panic("no return value specified for EnqueuePartnerUnmatchedNotification")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, toUserLineID, matchedUserName)
+		r0 = rf(ctx, toUserLineID, partnerUserName)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -230,42 +231,90 @@ func (_m *MockNotificationService) SendMatchNotification(ctx context.Context, to
 	return r0
 }
 
-// MockNotificationService_SendMatchNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMatchNotification'
-type MockNotificationService_SendMatchNotification_Call struct {
+// MockNotificationService_EnqueuePartnerUnmatchedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueuePartnerUnmatchedNotification'
+type MockNotificationService_EnqueuePartnerUnmatchedNotification_Call struct {
 	*mock.Call
 }
 
-// SendMatchNotification is a helper method to define mock.On call
+// EnqueuePartnerUnmatchedNotification is a helper method to define mock.On call
 //   - ctx context.Context
 //   - toUserLineID string
-//   - matchedUserName string
-func (_e *MockNotificationService_Expecter) SendMatchNotification(ctx interface{}, toUserLineID interface{}, matchedUserName interface{}) *MockNotificationService_SendMatchNotification_Call {
-	return &MockNotificationService_SendMatchNotification_Call{Call: _e.mock.On("SendMatchNotification", ctx, toUserLineID, matchedUserName)}
+//   - partnerUserName string
+func (_e *MockNotificationService_Expecter) EnqueuePartnerUnmatchedNotification(ctx interface{}, toUserLineID interface{}, partnerUserName interface{}) *MockNotificationService_EnqueuePartnerUnmatchedNotification_Call {
+	return &MockNotificationService_EnqueuePartnerUnmatchedNotification_Call{Call: _e.mock.On("EnqueuePartnerUnmatchedNotification", ctx, toUserLineID, partnerUserName)}
+}
+
+func (_c *MockNotificationService_EnqueuePartnerUnmatchedNotification_Call) Run(run func(ctx context.Context, toUserLineID string, partnerUserName string)) *MockNotificationService_EnqueuePartnerUnmatchedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_EnqueuePartnerUnmatchedNotification_Call) Return(_a0 error) *MockNotificationService_EnqueuePartnerUnmatchedNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_EnqueuePartnerUnmatchedNotification_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationService_EnqueuePartnerUnmatchedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueuePartnerWithdrawnNotification provides a mock function with given fields: ctx, toUserLineID, partnerUserName
+func (_m *MockNotificationService) EnqueuePartnerWithdrawnNotification(ctx context.Context, toUserLineID string, partnerUserName string) error {
+	ret := _m.Called(ctx, toUserLineID, partnerUserName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueuePartnerWithdrawnNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, toUserLineID, partnerUserName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_EnqueuePartnerWithdrawnNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueuePartnerWithdrawnNotification'
+type MockNotificationService_EnqueuePartnerWithdrawnNotification_Call struct {
+	*mock.Call
+}
+
+// EnqueuePartnerWithdrawnNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - toUserLineID string
+//   - partnerUserName string
+func (_e *MockNotificationService_Expecter) EnqueuePartnerWithdrawnNotification(ctx interface{}, toUserLineID interface{}, partnerUserName interface{}) *MockNotificationService_EnqueuePartnerWithdrawnNotification_Call {
+	return &MockNotificationService_EnqueuePartnerWithdrawnNotification_Call{Call: _e.mock.On("EnqueuePartnerWithdrawnNotification", ctx, toUserLineID, partnerUserName)}
 }
 
-func (_c *MockNotificationService_SendMatchNotification_Call) Run(run func(ctx context.Context, toUserLineID string, matchedUserName string)) *MockNotificationService_SendMatchNotification_Call {
+func (_c *MockNotificationService_EnqueuePartnerWithdrawnNotification_Call) Run(run func(ctx context.Context, toUserLineID string, partnerUserName string)) *MockNotificationService_EnqueuePartnerWithdrawnNotification_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockNotificationService_SendMatchNotification_Call) Return(_a0 error) *MockNotificationService_SendMatchNotification_Call {
+func (_c *MockNotificationService_EnqueuePartnerWithdrawnNotification_Call) Return(_a0 error) *MockNotificationService_EnqueuePartnerWithdrawnNotification_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockNotificationService_SendMatchNotification_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationService_SendMatchNotification_Call {
+func (_c *MockNotificationService_EnqueuePartnerWithdrawnNotification_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationService_EnqueuePartnerWithdrawnNotification_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendUnmatchNotification provides a mock function with given fields: ctx, toUserLineID, partnerUserName, isInitiator
-func (_m *MockNotificationService) SendUnmatchNotification(ctx context.Context, toUserLineID string, partnerUserName string, isInitiator bool) error {
+// EnqueueUnmatchNotification provides a mock function with given fields: ctx, toUserLineID, partnerUserName, isInitiator
+func (_m *MockNotificationService) EnqueueUnmatchNotification(ctx context.Context, toUserLineID string, partnerUserName string, isInitiator bool) error {
 	ret := _m.Called(ctx, toUserLineID, partnerUserName, isInitiator)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendUnmatchNotification")
+		panic("no return value specified for EnqueueUnmatchNotification")
 	}
 
 	var r0 error
@@ -278,33 +327,284 @@ func (_m *MockNotificationService) SendUnmatchNotification(ctx context.Context,
 	return r0
 }
 
-// MockNotificationService_SendUnmatchNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendUnmatchNotification'
-type MockNotificationService_SendUnmatchNotification_Call struct {
+// MockNotificationService_EnqueueUnmatchNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueUnmatchNotification'
+type MockNotificationService_EnqueueUnmatchNotification_Call struct {
 	*mock.Call
 }
 
-// SendUnmatchNotification is a helper method to define mock.On call
+// EnqueueUnmatchNotification is a helper method to define mock.On call
 //   - ctx context.Context
 //   - toUserLineID string
 //   - partnerUserName string
 //   - isInitiator bool
-func (_e *MockNotificationService_Expecter) SendUnmatchNotification(ctx interface{}, toUserLineID interface{}, partnerUserName interface{}, isInitiator interface{}) *MockNotificationService_SendUnmatchNotification_Call {
-	return &MockNotificationService_SendUnmatchNotification_Call{Call: _e.mock.On("SendUnmatchNotification", ctx, toUserLineID, partnerUserName, isInitiator)}
+func (_e *MockNotificationService_Expecter) EnqueueUnmatchNotification(ctx interface{}, toUserLineID interface{}, partnerUserName interface{}, isInitiator interface{}) *MockNotificationService_EnqueueUnmatchNotification_Call {
+	return &MockNotificationService_EnqueueUnmatchNotification_Call{Call: _e.mock.On("EnqueueUnmatchNotification", ctx, toUserLineID, partnerUserName, isInitiator)}
 }
 
-func (_c *MockNotificationService_SendUnmatchNotification_Call) Run(run func(ctx context.Context, toUserLineID string, partnerUserName string, isInitiator bool)) *MockNotificationService_SendUnmatchNotification_Call {
+func (_c *MockNotificationService_EnqueueUnmatchNotification_Call) Run(run func(ctx context.Context, toUserLineID string, partnerUserName string, isInitiator bool)) *MockNotificationService_EnqueueUnmatchNotification_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
 	})
 	return _c
 }
 
-func (_c *MockNotificationService_SendUnmatchNotification_Call) Return(_a0 error) *MockNotificationService_SendUnmatchNotification_Call {
+func (_c *MockNotificationService_EnqueueUnmatchNotification_Call) Return(_a0 error) *MockNotificationService_EnqueueUnmatchNotification_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockNotificationService_SendUnmatchNotification_Call) RunAndReturn(run func(context.Context, string, string, bool) error) *MockNotificationService_SendUnmatchNotification_Call {
+func (_c *MockNotificationService_EnqueueUnmatchNotification_Call) RunAndReturn(run func(context.Context, string, string, bool) error) *MockNotificationService_EnqueueUnmatchNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNotifications provides a mock function with given fields: ctx, toUserLineID
+func (_m *MockNotificationService) ListNotifications(ctx context.Context, toUserLineID string) ([]*model.Notification, error) {
+	ret := _m.Called(ctx, toUserLineID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotifications")
+	}
+
+	var r0 []*model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.Notification, error)); ok {
+		return rf(ctx, toUserLineID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Notification); ok {
+		r0 = rf(ctx, toUserLineID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, toUserLineID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotificationService_ListNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotifications'
+type MockNotificationService_ListNotifications_Call struct {
+	*mock.Call
+}
+
+// ListNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - toUserLineID string
+func (_e *MockNotificationService_Expecter) ListNotifications(ctx interface{}, toUserLineID interface{}) *MockNotificationService_ListNotifications_Call {
+	return &MockNotificationService_ListNotifications_Call{Call: _e.mock.On("ListNotifications", ctx, toUserLineID)}
+}
+
+func (_c *MockNotificationService_ListNotifications_Call) Run(run func(ctx context.Context, toUserLineID string)) *MockNotificationService_ListNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_ListNotifications_Call) Return(_a0 []*model.Notification, _a1 error) *MockNotificationService_ListNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotificationService_ListNotifications_Call) RunAndReturn(run func(context.Context, string) ([]*model.Notification, error)) *MockNotificationService_ListNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendCrushRegistrationComplete provides a mock function with given fields: ctx, toUserLineID, isFirstRegistration
+func (_m *MockNotificationService) SendCrushRegistrationComplete(ctx context.Context, toUserLineID string, isFirstRegistration bool) error {
+	ret := _m.Called(ctx, toUserLineID, isFirstRegistration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendCrushRegistrationComplete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, toUserLineID, isFirstRegistration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_SendCrushRegistrationComplete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendCrushRegistrationComplete'
+type MockNotificationService_SendCrushRegistrationComplete_Call struct {
+	*mock.Call
+}
+
+// SendCrushRegistrationComplete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - toUserLineID string
+//   - isFirstRegistration bool
+func (_e *MockNotificationService_Expecter) SendCrushRegistrationComplete(ctx interface{}, toUserLineID interface{}, isFirstRegistration interface{}) *MockNotificationService_SendCrushRegistrationComplete_Call {
+	return &MockNotificationService_SendCrushRegistrationComplete_Call{Call: _e.mock.On("SendCrushRegistrationComplete", ctx, toUserLineID, isFirstRegistration)}
+}
+
+func (_c *MockNotificationService_SendCrushRegistrationComplete_Call) Run(run func(ctx context.Context, toUserLineID string, isFirstRegistration bool)) *MockNotificationService_SendCrushRegistrationComplete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendCrushRegistrationComplete_Call) Return(_a0 error) *MockNotificationService_SendCrushRegistrationComplete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_SendCrushRegistrationComplete_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockNotificationService_SendCrushRegistrationComplete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendCrushRegistrationPrompt provides a mock function with given fields: ctx, toUserLineID, crushLiffURL
+func (_m *MockNotificationService) SendCrushRegistrationPrompt(ctx context.Context, toUserLineID string, crushLiffURL string) error {
+	ret := _m.Called(ctx, toUserLineID, crushLiffURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendCrushRegistrationPrompt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, toUserLineID, crushLiffURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_SendCrushRegistrationPrompt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendCrushRegistrationPrompt'
+type MockNotificationService_SendCrushRegistrationPrompt_Call struct {
+	*mock.Call
+}
+
+// SendCrushRegistrationPrompt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - toUserLineID string
+//   - crushLiffURL string
+func (_e *MockNotificationService_Expecter) SendCrushRegistrationPrompt(ctx interface{}, toUserLineID interface{}, crushLiffURL interface{}) *MockNotificationService_SendCrushRegistrationPrompt_Call {
+	return &MockNotificationService_SendCrushRegistrationPrompt_Call{Call: _e.mock.On("SendCrushRegistrationPrompt", ctx, toUserLineID, crushLiffURL)}
+}
+
+func (_c *MockNotificationService_SendCrushRegistrationPrompt_Call) Run(run func(ctx context.Context, toUserLineID string, crushLiffURL string)) *MockNotificationService_SendCrushRegistrationPrompt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendCrushRegistrationPrompt_Call) Return(_a0 error) *MockNotificationService_SendCrushRegistrationPrompt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_SendCrushRegistrationPrompt_Call) RunAndReturn(run func(context.Context, string, string) error) *MockNotificationService_SendCrushRegistrationPrompt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendFollowGreeting provides a mock function with given fields: ctx, replyToken, userLiffURL, isReturning
+func (_m *MockNotificationService) SendFollowGreeting(ctx context.Context, replyToken string, userLiffURL string, isReturning bool) error {
+	ret := _m.Called(ctx, replyToken, userLiffURL, isReturning)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendFollowGreeting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
+		r0 = rf(ctx, replyToken, userLiffURL, isReturning)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_SendFollowGreeting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendFollowGreeting'
+type MockNotificationService_SendFollowGreeting_Call struct {
+	*mock.Call
+}
+
+// SendFollowGreeting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - replyToken string
+//   - userLiffURL string
+//   - isReturning bool
+func (_e *MockNotificationService_Expecter) SendFollowGreeting(ctx interface{}, replyToken interface{}, userLiffURL interface{}, isReturning interface{}) *MockNotificationService_SendFollowGreeting_Call {
+	return &MockNotificationService_SendFollowGreeting_Call{Call: _e.mock.On("SendFollowGreeting", ctx, replyToken, userLiffURL, isReturning)}
+}
+
+func (_c *MockNotificationService_SendFollowGreeting_Call) Run(run func(ctx context.Context, replyToken string, userLiffURL string, isReturning bool)) *MockNotificationService_SendFollowGreeting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendFollowGreeting_Call) Return(_a0 error) *MockNotificationService_SendFollowGreeting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_SendFollowGreeting_Call) RunAndReturn(run func(context.Context, string, string, bool) error) *MockNotificationService_SendFollowGreeting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendJoinGroupGreeting provides a mock function with given fields: ctx, replyToken
+func (_m *MockNotificationService) SendJoinGroupGreeting(ctx context.Context, replyToken string) error {
+	ret := _m.Called(ctx, replyToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendJoinGroupGreeting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, replyToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotificationService_SendJoinGroupGreeting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendJoinGroupGreeting'
+type MockNotificationService_SendJoinGroupGreeting_Call struct {
+	*mock.Call
+}
+
+// SendJoinGroupGreeting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - replyToken string
+func (_e *MockNotificationService_Expecter) SendJoinGroupGreeting(ctx interface{}, replyToken interface{}) *MockNotificationService_SendJoinGroupGreeting_Call {
+	return &MockNotificationService_SendJoinGroupGreeting_Call{Call: _e.mock.On("SendJoinGroupGreeting", ctx, replyToken)}
+}
+
+func (_c *MockNotificationService_SendJoinGroupGreeting_Call) Run(run func(ctx context.Context, replyToken string)) *MockNotificationService_SendJoinGroupGreeting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SendJoinGroupGreeting_Call) Return(_a0 error) *MockNotificationService_SendJoinGroupGreeting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_SendJoinGroupGreeting_Call) RunAndReturn(run func(context.Context, string) error) *MockNotificationService_SendJoinGroupGreeting_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -356,6 +656,51 @@ func (_c *MockNotificationService_SendUserInfoUpdateConfirmation_Call) RunAndRet
 	return _c
 }
 
+// SkippedLowPriorityCount provides a mock function with no fields
+func (_m *MockNotificationService) SkippedLowPriorityCount() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkippedLowPriorityCount")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// MockNotificationService_SkippedLowPriorityCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SkippedLowPriorityCount'
+type MockNotificationService_SkippedLowPriorityCount_Call struct {
+	*mock.Call
+}
+
+// SkippedLowPriorityCount is a helper method to define mock.On call
+func (_e *MockNotificationService_Expecter) SkippedLowPriorityCount() *MockNotificationService_SkippedLowPriorityCount_Call {
+	return &MockNotificationService_SkippedLowPriorityCount_Call{Call: _e.mock.On("SkippedLowPriorityCount")}
+}
+
+func (_c *MockNotificationService_SkippedLowPriorityCount_Call) Run(run func()) *MockNotificationService_SkippedLowPriorityCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockNotificationService_SkippedLowPriorityCount_Call) Return(_a0 int64) *MockNotificationService_SkippedLowPriorityCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotificationService_SkippedLowPriorityCount_Call) RunAndReturn(run func() int64) *MockNotificationService_SkippedLowPriorityCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockNotificationService creates a new instance of MockNotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockNotificationService(t interface {
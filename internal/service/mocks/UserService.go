@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	model "github.com/morinonusi421/cupid/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,17 +22,241 @@ func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
 	return &MockUserService_Expecter{mock: &_m.Mock}
 }
 
-// ProcessFollowEvent provides a mock function with given fields: ctx, replyToken
-func (_m *MockUserService) ProcessFollowEvent(ctx context.Context, replyToken string) error {
-	ret := _m.Called(ctx, replyToken)
+// DeleteAccount provides a mock function with given fields: ctx, userID
+func (_m *MockUserService) DeleteAccount(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ProcessFollowEvent")
+		panic("no return value specified for DeleteAccount")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, replyToken)
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserService_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type MockUserService_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserService_Expecter) DeleteAccount(ctx interface{}, userID interface{}) *MockUserService_DeleteAccount_Call {
+	return &MockUserService_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, userID)}
+}
+
+func (_c *MockUserService_DeleteAccount_Call) Run(run func(ctx context.Context, userID string)) *MockUserService_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_DeleteAccount_Call) Return(_a0 error) *MockUserService_DeleteAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserService_DeleteAccount_Call) RunAndReturn(run func(context.Context, string) error) *MockUserService_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportData provides a mock function with given fields: ctx, userID
+func (_m *MockUserService) ExportData(ctx context.Context, userID string) (*model.DataExport, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportData")
+	}
+
+	var r0 *model.DataExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.DataExport, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.DataExport); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DataExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_ExportData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportData'
+type MockUserService_ExportData_Call struct {
+	*mock.Call
+}
+
+// ExportData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserService_Expecter) ExportData(ctx interface{}, userID interface{}) *MockUserService_ExportData_Call {
+	return &MockUserService_ExportData_Call{Call: _e.mock.On("ExportData", ctx, userID)}
+}
+
+func (_c *MockUserService_ExportData_Call) Run(run func(ctx context.Context, userID string)) *MockUserService_ExportData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_ExportData_Call) Return(_a0 *model.DataExport, _a1 error) *MockUserService_ExportData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_ExportData_Call) RunAndReturn(run func(context.Context, string) (*model.DataExport, error)) *MockUserService_ExportData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForceUnmatch provides a mock function with given fields: ctx, userID
+func (_m *MockUserService) ForceUnmatch(ctx context.Context, userID string) (*model.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceUnmatch")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_ForceUnmatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceUnmatch'
+type MockUserService_ForceUnmatch_Call struct {
+	*mock.Call
+}
+
+// ForceUnmatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserService_Expecter) ForceUnmatch(ctx interface{}, userID interface{}) *MockUserService_ForceUnmatch_Call {
+	return &MockUserService_ForceUnmatch_Call{Call: _e.mock.On("ForceUnmatch", ctx, userID)}
+}
+
+func (_c *MockUserService_ForceUnmatch_Call) Run(run func(ctx context.Context, userID string)) *MockUserService_ForceUnmatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_ForceUnmatch_Call) Return(partner *model.User, err error) *MockUserService_ForceUnmatch_Call {
+	_c.Call.Return(partner, err)
+	return _c
+}
+
+func (_c *MockUserService_ForceUnmatch_Call) RunAndReturn(run func(context.Context, string) (*model.User, error)) *MockUserService_ForceUnmatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatus provides a mock function with given fields: ctx, userID
+func (_m *MockUserService) GetStatus(ctx context.Context, userID string) (*model.UserStatus, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 *model.UserStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.UserStatus, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.UserStatus); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_GetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatus'
+type MockUserService_GetStatus_Call struct {
+	*mock.Call
+}
+
+// GetStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserService_Expecter) GetStatus(ctx interface{}, userID interface{}) *MockUserService_GetStatus_Call {
+	return &MockUserService_GetStatus_Call{Call: _e.mock.On("GetStatus", ctx, userID)}
+}
+
+func (_c *MockUserService_GetStatus_Call) Run(run func(ctx context.Context, userID string)) *MockUserService_GetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetStatus_Call) Return(_a0 *model.UserStatus, _a1 error) *MockUserService_GetStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_GetStatus_Call) RunAndReturn(run func(context.Context, string) (*model.UserStatus, error)) *MockUserService_GetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessFollowEvent provides a mock function with given fields: ctx, userID, replyToken
+func (_m *MockUserService) ProcessFollowEvent(ctx context.Context, userID string, replyToken string) error {
+	ret := _m.Called(ctx, userID, replyToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessFollowEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, replyToken)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,14 +271,15 @@ type MockUserService_ProcessFollowEvent_Call struct {
 
 // ProcessFollowEvent is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID string
 //   - replyToken string
-func (_e *MockUserService_Expecter) ProcessFollowEvent(ctx interface{}, replyToken interface{}) *MockUserService_ProcessFollowEvent_Call {
-	return &MockUserService_ProcessFollowEvent_Call{Call: _e.mock.On("ProcessFollowEvent", ctx, replyToken)}
+func (_e *MockUserService_Expecter) ProcessFollowEvent(ctx interface{}, userID interface{}, replyToken interface{}) *MockUserService_ProcessFollowEvent_Call {
+	return &MockUserService_ProcessFollowEvent_Call{Call: _e.mock.On("ProcessFollowEvent", ctx, userID, replyToken)}
 }
 
-func (_c *MockUserService_ProcessFollowEvent_Call) Run(run func(ctx context.Context, replyToken string)) *MockUserService_ProcessFollowEvent_Call {
+func (_c *MockUserService_ProcessFollowEvent_Call) Run(run func(ctx context.Context, userID string, replyToken string)) *MockUserService_ProcessFollowEvent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -63,7 +289,7 @@ func (_c *MockUserService_ProcessFollowEvent_Call) Return(_a0 error) *MockUserSe
 	return _c
 }
 
-func (_c *MockUserService_ProcessFollowEvent_Call) RunAndReturn(run func(context.Context, string) error) *MockUserService_ProcessFollowEvent_Call {
+func (_c *MockUserService_ProcessFollowEvent_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserService_ProcessFollowEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -115,9 +341,69 @@ func (_c *MockUserService_ProcessJoinEvent_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// ProcessTextMessage provides a mock function with given fields: ctx, userID
-func (_m *MockUserService) ProcessTextMessage(ctx context.Context, userID string) (string, string, string, error) {
-	ret := _m.Called(ctx, userID)
+// ProcessPostback provides a mock function with given fields: ctx, userID, data
+func (_m *MockUserService) ProcessPostback(ctx context.Context, userID string, data string) (*model.Reply, error) {
+	ret := _m.Called(ctx, userID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessPostback")
+	}
+
+	var r0 *model.Reply
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Reply, error)); ok {
+		return rf(ctx, userID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Reply); ok {
+		r0 = rf(ctx, userID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reply)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_ProcessPostback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessPostback'
+type MockUserService_ProcessPostback_Call struct {
+	*mock.Call
+}
+
+// ProcessPostback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - data string
+func (_e *MockUserService_Expecter) ProcessPostback(ctx interface{}, userID interface{}, data interface{}) *MockUserService_ProcessPostback_Call {
+	return &MockUserService_ProcessPostback_Call{Call: _e.mock.On("ProcessPostback", ctx, userID, data)}
+}
+
+func (_c *MockUserService_ProcessPostback_Call) Run(run func(ctx context.Context, userID string, data string)) *MockUserService_ProcessPostback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_ProcessPostback_Call) Return(_a0 *model.Reply, _a1 error) *MockUserService_ProcessPostback_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_ProcessPostback_Call) RunAndReturn(run func(context.Context, string, string) (*model.Reply, error)) *MockUserService_ProcessPostback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessTextMessage provides a mock function with given fields: ctx, userID, text
+func (_m *MockUserService) ProcessTextMessage(ctx context.Context, userID string, text string) (string, string, string, error) {
+	ret := _m.Called(ctx, userID, text)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProcessTextMessage")
@@ -127,29 +413,29 @@ func (_m *MockUserService) ProcessTextMessage(ctx context.Context, userID string
 	var r1 string
 	var r2 string
 	var r3 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, string, string, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, string, string, error)); ok {
+		return rf(ctx, userID, text)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, userID, text)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) string); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) string); ok {
+		r1 = rf(ctx, userID, text)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string) string); ok {
-		r2 = rf(ctx, userID)
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) string); ok {
+		r2 = rf(ctx, userID, text)
 	} else {
 		r2 = ret.Get(2).(string)
 	}
 
-	if rf, ok := ret.Get(3).(func(context.Context, string) error); ok {
-		r3 = rf(ctx, userID)
+	if rf, ok := ret.Get(3).(func(context.Context, string, string) error); ok {
+		r3 = rf(ctx, userID, text)
 	} else {
 		r3 = ret.Error(3)
 	}
@@ -165,13 +451,14 @@ type MockUserService_ProcessTextMessage_Call struct {
 // ProcessTextMessage is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *MockUserService_Expecter) ProcessTextMessage(ctx interface{}, userID interface{}) *MockUserService_ProcessTextMessage_Call {
-	return &MockUserService_ProcessTextMessage_Call{Call: _e.mock.On("ProcessTextMessage", ctx, userID)}
+//   - text string
+func (_e *MockUserService_Expecter) ProcessTextMessage(ctx interface{}, userID interface{}, text interface{}) *MockUserService_ProcessTextMessage_Call {
+	return &MockUserService_ProcessTextMessage_Call{Call: _e.mock.On("ProcessTextMessage", ctx, userID, text)}
 }
 
-func (_c *MockUserService_ProcessTextMessage_Call) Run(run func(ctx context.Context, userID string)) *MockUserService_ProcessTextMessage_Call {
+func (_c *MockUserService_ProcessTextMessage_Call) Run(run func(ctx context.Context, userID string, text string)) *MockUserService_ProcessTextMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -181,14 +468,70 @@ func (_c *MockUserService_ProcessTextMessage_Call) Return(replyText string, quic
 	return _c
 }
 
-func (_c *MockUserService_ProcessTextMessage_Call) RunAndReturn(run func(context.Context, string) (string, string, string, error)) *MockUserService_ProcessTextMessage_Call {
+func (_c *MockUserService_ProcessTextMessage_Call) RunAndReturn(run func(context.Context, string, string) (string, string, string, error)) *MockUserService_ProcessTextMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterCrush provides a mock function with given fields: ctx, userID, crushName, crushBirthday, confirmUnmatch
-func (_m *MockUserService) RegisterCrush(ctx context.Context, userID string, crushName string, crushBirthday string, confirmUnmatch bool) (bool, bool, error) {
-	ret := _m.Called(ctx, userID, crushName, crushBirthday, confirmUnmatch)
+// PruneWithdrawnHistory provides a mock function with given fields: ctx
+func (_m *MockUserService) PruneWithdrawnHistory(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneWithdrawnHistory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_PruneWithdrawnHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneWithdrawnHistory'
+type MockUserService_PruneWithdrawnHistory_Call struct {
+	*mock.Call
+}
+
+// PruneWithdrawnHistory is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserService_Expecter) PruneWithdrawnHistory(ctx interface{}) *MockUserService_PruneWithdrawnHistory_Call {
+	return &MockUserService_PruneWithdrawnHistory_Call{Call: _e.mock.On("PruneWithdrawnHistory", ctx)}
+}
+
+func (_c *MockUserService_PruneWithdrawnHistory_Call) Run(run func(ctx context.Context)) *MockUserService_PruneWithdrawnHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUserService_PruneWithdrawnHistory_Call) Return(_a0 int64, _a1 error) *MockUserService_PruneWithdrawnHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_PruneWithdrawnHistory_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockUserService_PruneWithdrawnHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterCrush provides a mock function with given fields: ctx, userID, crushName, crushAltName, crushBirthday, confirmUnmatch
+func (_m *MockUserService) RegisterCrush(ctx context.Context, userID string, crushName string, crushAltName string, crushBirthday model.Birthday, confirmUnmatch bool) (bool, bool, error) {
+	ret := _m.Called(ctx, userID, crushName, crushAltName, crushBirthday, confirmUnmatch)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RegisterCrush")
@@ -197,23 +540,23 @@ func (_m *MockUserService) RegisterCrush(ctx context.Context, userID string, cru
 	var r0 bool
 	var r1 bool
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) (bool, bool, error)); ok {
-		return rf(ctx, userID, crushName, crushBirthday, confirmUnmatch)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, model.Birthday, bool) (bool, bool, error)); ok {
+		return rf(ctx, userID, crushName, crushAltName, crushBirthday, confirmUnmatch)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) bool); ok {
-		r0 = rf(ctx, userID, crushName, crushBirthday, confirmUnmatch)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, model.Birthday, bool) bool); ok {
+		r0 = rf(ctx, userID, crushName, crushAltName, crushBirthday, confirmUnmatch)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool) bool); ok {
-		r1 = rf(ctx, userID, crushName, crushBirthday, confirmUnmatch)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, model.Birthday, bool) bool); ok {
+		r1 = rf(ctx, userID, crushName, crushAltName, crushBirthday, confirmUnmatch)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, bool) error); ok {
-		r2 = rf(ctx, userID, crushName, crushBirthday, confirmUnmatch)
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, model.Birthday, bool) error); ok {
+		r2 = rf(ctx, userID, crushName, crushAltName, crushBirthday, confirmUnmatch)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -230,15 +573,16 @@ type MockUserService_RegisterCrush_Call struct {
 //   - ctx context.Context
 //   - userID string
 //   - crushName string
-//   - crushBirthday string
+//   - crushAltName string
+//   - crushBirthday model.Birthday
 //   - confirmUnmatch bool
-func (_e *MockUserService_Expecter) RegisterCrush(ctx interface{}, userID interface{}, crushName interface{}, crushBirthday interface{}, confirmUnmatch interface{}) *MockUserService_RegisterCrush_Call {
-	return &MockUserService_RegisterCrush_Call{Call: _e.mock.On("RegisterCrush", ctx, userID, crushName, crushBirthday, confirmUnmatch)}
+func (_e *MockUserService_Expecter) RegisterCrush(ctx interface{}, userID interface{}, crushName interface{}, crushAltName interface{}, crushBirthday interface{}, confirmUnmatch interface{}) *MockUserService_RegisterCrush_Call {
+	return &MockUserService_RegisterCrush_Call{Call: _e.mock.On("RegisterCrush", ctx, userID, crushName, crushAltName, crushBirthday, confirmUnmatch)}
 }
 
-func (_c *MockUserService_RegisterCrush_Call) Run(run func(ctx context.Context, userID string, crushName string, crushBirthday string, confirmUnmatch bool)) *MockUserService_RegisterCrush_Call {
+func (_c *MockUserService_RegisterCrush_Call) Run(run func(ctx context.Context, userID string, crushName string, crushAltName string, crushBirthday model.Birthday, confirmUnmatch bool)) *MockUserService_RegisterCrush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(bool))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(model.Birthday), args[5].(bool))
 	})
 	return _c
 }
@@ -248,14 +592,14 @@ func (_c *MockUserService_RegisterCrush_Call) Return(matched bool, isFirstCrushR
 	return _c
 }
 
-func (_c *MockUserService_RegisterCrush_Call) RunAndReturn(run func(context.Context, string, string, string, bool) (bool, bool, error)) *MockUserService_RegisterCrush_Call {
+func (_c *MockUserService_RegisterCrush_Call) RunAndReturn(run func(context.Context, string, string, string, model.Birthday, bool) (bool, bool, error)) *MockUserService_RegisterCrush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterUser provides a mock function with given fields: ctx, userID, name, birthday, confirmUnmatch
-func (_m *MockUserService) RegisterUser(ctx context.Context, userID string, name string, birthday string, confirmUnmatch bool) (bool, error) {
-	ret := _m.Called(ctx, userID, name, birthday, confirmUnmatch)
+// RegisterUser provides a mock function with given fields: ctx, userID, name, altName, birthday, confirmUnmatch
+func (_m *MockUserService) RegisterUser(ctx context.Context, userID string, name string, altName string, birthday model.Birthday, confirmUnmatch bool) (bool, error) {
+	ret := _m.Called(ctx, userID, name, altName, birthday, confirmUnmatch)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RegisterUser")
@@ -263,17 +607,17 @@ func (_m *MockUserService) RegisterUser(ctx context.Context, userID string, name
 
 	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) (bool, error)); ok {
-		return rf(ctx, userID, name, birthday, confirmUnmatch)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, model.Birthday, bool) (bool, error)); ok {
+		return rf(ctx, userID, name, altName, birthday, confirmUnmatch)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) bool); ok {
-		r0 = rf(ctx, userID, name, birthday, confirmUnmatch)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, model.Birthday, bool) bool); ok {
+		r0 = rf(ctx, userID, name, altName, birthday, confirmUnmatch)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool) error); ok {
-		r1 = rf(ctx, userID, name, birthday, confirmUnmatch)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, model.Birthday, bool) error); ok {
+		r1 = rf(ctx, userID, name, altName, birthday, confirmUnmatch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -290,15 +634,16 @@ type MockUserService_RegisterUser_Call struct {
 //   - ctx context.Context
 //   - userID string
 //   - name string
-//   - birthday string
+//   - altName string
+//   - birthday model.Birthday
 //   - confirmUnmatch bool
-func (_e *MockUserService_Expecter) RegisterUser(ctx interface{}, userID interface{}, name interface{}, birthday interface{}, confirmUnmatch interface{}) *MockUserService_RegisterUser_Call {
-	return &MockUserService_RegisterUser_Call{Call: _e.mock.On("RegisterUser", ctx, userID, name, birthday, confirmUnmatch)}
+func (_e *MockUserService_Expecter) RegisterUser(ctx interface{}, userID interface{}, name interface{}, altName interface{}, birthday interface{}, confirmUnmatch interface{}) *MockUserService_RegisterUser_Call {
+	return &MockUserService_RegisterUser_Call{Call: _e.mock.On("RegisterUser", ctx, userID, name, altName, birthday, confirmUnmatch)}
 }
 
-func (_c *MockUserService_RegisterUser_Call) Run(run func(ctx context.Context, userID string, name string, birthday string, confirmUnmatch bool)) *MockUserService_RegisterUser_Call {
+func (_c *MockUserService_RegisterUser_Call) Run(run func(ctx context.Context, userID string, name string, altName string, birthday model.Birthday, confirmUnmatch bool)) *MockUserService_RegisterUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(bool))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(model.Birthday), args[5].(bool))
 	})
 	return _c
 }
@@ -308,7 +653,171 @@ func (_c *MockUserService_RegisterUser_Call) Return(isFirstRegistration bool, er
 	return _c
 }
 
-func (_c *MockUserService_RegisterUser_Call) RunAndReturn(run func(context.Context, string, string, string, bool) (bool, error)) *MockUserService_RegisterUser_Call {
+func (_c *MockUserService_RegisterUser_Call) RunAndReturn(run func(context.Context, string, string, string, model.Birthday, bool) (bool, error)) *MockUserService_RegisterUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmatch provides a mock function with given fields: ctx, userID
+func (_m *MockUserService) Unmatch(ctx context.Context, userID string) (string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmatch")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_Unmatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmatch'
+type MockUserService_Unmatch_Call struct {
+	*mock.Call
+}
+
+// Unmatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockUserService_Expecter) Unmatch(ctx interface{}, userID interface{}) *MockUserService_Unmatch_Call {
+	return &MockUserService_Unmatch_Call{Call: _e.mock.On("Unmatch", ctx, userID)}
+}
+
+func (_c *MockUserService_Unmatch_Call) Run(run func(ctx context.Context, userID string)) *MockUserService_Unmatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_Unmatch_Call) Return(partnerName string, err error) *MockUserService_Unmatch_Call {
+	_c.Call.Return(partnerName, err)
+	return _c
+}
+
+func (_c *MockUserService_Unmatch_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockUserService_Unmatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLineDisplayName provides a mock function with given fields: ctx, userID, displayName
+func (_m *MockUserService) UpdateLineDisplayName(ctx context.Context, userID string, displayName string) error {
+	ret := _m.Called(ctx, userID, displayName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLineDisplayName")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, displayName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserService_UpdateLineDisplayName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLineDisplayName'
+type MockUserService_UpdateLineDisplayName_Call struct {
+	*mock.Call
+}
+
+// UpdateLineDisplayName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - displayName string
+func (_e *MockUserService_Expecter) UpdateLineDisplayName(ctx interface{}, userID interface{}, displayName interface{}) *MockUserService_UpdateLineDisplayName_Call {
+	return &MockUserService_UpdateLineDisplayName_Call{Call: _e.mock.On("UpdateLineDisplayName", ctx, userID, displayName)}
+}
+
+func (_c *MockUserService_UpdateLineDisplayName_Call) Run(run func(ctx context.Context, userID string, displayName string)) *MockUserService_UpdateLineDisplayName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_UpdateLineDisplayName_Call) Return(_a0 error) *MockUserService_UpdateLineDisplayName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserService_UpdateLineDisplayName_Call) RunAndReturn(run func(context.Context, string, string) error) *MockUserService_UpdateLineDisplayName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithdrawCrush provides a mock function with given fields: ctx, userID, crushID, confirmUnmatch
+func (_m *MockUserService) WithdrawCrush(ctx context.Context, userID string, crushID int64, confirmUnmatch bool) (string, error) {
+	ret := _m.Called(ctx, userID, crushID, confirmUnmatch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithdrawCrush")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, bool) (string, error)); ok {
+		return rf(ctx, userID, crushID, confirmUnmatch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, bool) string); ok {
+		r0 = rf(ctx, userID, crushID, confirmUnmatch)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, bool) error); ok {
+		r1 = rf(ctx, userID, crushID, confirmUnmatch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_WithdrawCrush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithdrawCrush'
+type MockUserService_WithdrawCrush_Call struct {
+	*mock.Call
+}
+
+// WithdrawCrush is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - crushID int64
+//   - confirmUnmatch bool
+func (_e *MockUserService_Expecter) WithdrawCrush(ctx interface{}, userID interface{}, crushID interface{}, confirmUnmatch interface{}) *MockUserService_WithdrawCrush_Call {
+	return &MockUserService_WithdrawCrush_Call{Call: _e.mock.On("WithdrawCrush", ctx, userID, crushID, confirmUnmatch)}
+}
+
+func (_c *MockUserService_WithdrawCrush_Call) Run(run func(ctx context.Context, userID string, crushID int64, confirmUnmatch bool)) *MockUserService_WithdrawCrush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUserService_WithdrawCrush_Call) Return(crushName string, err error) *MockUserService_WithdrawCrush_Call {
+	_c.Call.Return(crushName, err)
+	return _c
+}
+
+func (_c *MockUserService_WithdrawCrush_Call) RunAndReturn(run func(context.Context, string, int64, bool) (string, error)) *MockUserService_WithdrawCrush_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,198 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/morinonusi421/cupid/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWebhookEventService is an autogenerated mock type for the WebhookEventService type
+type MockWebhookEventService struct {
+	mock.Mock
+}
+
+type MockWebhookEventService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookEventService) EXPECT() *MockWebhookEventService_Expecter {
+	return &MockWebhookEventService_Expecter{mock: &_m.Mock}
+}
+
+// Begin provides a mock function with given fields: ctx, event
+func (_m *MockWebhookEventService) Begin(ctx context.Context, event *model.WebhookEvent) (bool, error) {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookEvent) (bool, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookEvent) bool); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.WebhookEvent) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookEventService_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type MockWebhookEventService_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *model.WebhookEvent
+func (_e *MockWebhookEventService_Expecter) Begin(ctx interface{}, event interface{}) *MockWebhookEventService_Begin_Call {
+	return &MockWebhookEventService_Begin_Call{Call: _e.mock.On("Begin", ctx, event)}
+}
+
+func (_c *MockWebhookEventService_Begin_Call) Run(run func(ctx context.Context, event *model.WebhookEvent)) *MockWebhookEventService_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.WebhookEvent))
+	})
+	return _c
+}
+
+func (_c *MockWebhookEventService_Begin_Call) Return(_a0 bool, _a1 error) *MockWebhookEventService_Begin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookEventService_Begin_Call) RunAndReturn(run func(context.Context, *model.WebhookEvent) (bool, error)) *MockWebhookEventService_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cleanup provides a mock function with given fields: ctx
+func (_m *MockWebhookEventService) Cleanup(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cleanup")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookEventService_Cleanup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cleanup'
+type MockWebhookEventService_Cleanup_Call struct {
+	*mock.Call
+}
+
+// Cleanup is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWebhookEventService_Expecter) Cleanup(ctx interface{}) *MockWebhookEventService_Cleanup_Call {
+	return &MockWebhookEventService_Cleanup_Call{Call: _e.mock.On("Cleanup", ctx)}
+}
+
+func (_c *MockWebhookEventService_Cleanup_Call) Run(run func(ctx context.Context)) *MockWebhookEventService_Cleanup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockWebhookEventService_Cleanup_Call) Return(_a0 int64, _a1 error) *MockWebhookEventService_Cleanup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookEventService_Cleanup_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockWebhookEventService_Cleanup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Complete provides a mock function with given fields: ctx, eventID, processErr
+func (_m *MockWebhookEventService) Complete(ctx context.Context, eventID string, processErr error) error {
+	ret := _m.Called(ctx, eventID, processErr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, error) error); ok {
+		r0 = rf(ctx, eventID, processErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWebhookEventService_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockWebhookEventService_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - processErr error
+func (_e *MockWebhookEventService_Expecter) Complete(ctx interface{}, eventID interface{}, processErr interface{}) *MockWebhookEventService_Complete_Call {
+	return &MockWebhookEventService_Complete_Call{Call: _e.mock.On("Complete", ctx, eventID, processErr)}
+}
+
+func (_c *MockWebhookEventService_Complete_Call) Run(run func(ctx context.Context, eventID string, processErr error)) *MockWebhookEventService_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(error))
+	})
+	return _c
+}
+
+func (_c *MockWebhookEventService_Complete_Call) Return(_a0 error) *MockWebhookEventService_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWebhookEventService_Complete_Call) RunAndReturn(run func(context.Context, string, error) error) *MockWebhookEventService_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookEventService creates a new instance of MockWebhookEventService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookEventService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookEventService {
+	mock := &MockWebhookEventService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
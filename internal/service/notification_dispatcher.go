@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
+	"github.com/morinonusi421/cupid/internal/linebot"
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/internal/repository"
+)
+
+// dispatchBatchSize は1回の DispatchDue で送信する通知の上限
+const dispatchBatchSize = 50
+
+// NotificationDispatcher は送信待ちのPush通知（notification_outbox）を送信するサービス
+type NotificationDispatcher interface {
+	// DispatchDue は送信時刻を過ぎた通知を送信し、処理した件数を返す
+	// 送信に失敗した通知は指数バックオフで再送を予約し、上限に達したものや再送しても成功しないものは dead として記録する
+	DispatchDue(ctx context.Context) (int, error)
+}
+
+// RetryPolicy はPush通知の再送方針
+type RetryPolicy struct {
+	MaxAttempts int           // 送信を試みる回数の上限（超えると dead）
+	BaseDelay   time.Duration // 1回目の再送までの待ち時間（以降は2倍ずつ延ばす）
+	MaxDelay    time.Duration // 再送までの待ち時間の上限
+}
+
+// backoff は attempts 回目の送信に失敗した後、次の再送までの待ち時間を返す
+func (p RetryPolicy) backoff(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+type notificationDispatcher struct {
+	notificationRepo repository.NotificationRepository
+	lineBotClient    linebot.Client
+	policy           RetryPolicy
+	now              func() time.Time
+}
+
+// NewNotificationDispatcher は NotificationDispatcher の新しいインスタンスを作成する
+func NewNotificationDispatcher(notificationRepo repository.NotificationRepository, lineBotClient linebot.Client, policy RetryPolicy) NotificationDispatcher {
+	return &notificationDispatcher{
+		notificationRepo: notificationRepo,
+		lineBotClient:    lineBotClient,
+		policy:           policy,
+		now:              time.Now,
+	}
+}
+
+// DispatchDue は送信時刻を過ぎた通知を古い順に送信する
+func (d *notificationDispatcher) DispatchDue(ctx context.Context) (int, error) {
+	notifications, err := d.notificationRepo.ListDue(ctx, d.now(), dispatchBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due notifications: %w", err)
+	}
+
+	for i, n := range notifications {
+		if err := d.dispatch(ctx, n); err != nil {
+			return i, err
+		}
+	}
+	return len(notifications), nil
+}
+
+// dispatch は1件の通知を送信し、結果を記録する
+//
+// 【重要】有償メッセージ（無料プランでは月200通まで）
+// 再送しても同じ retry key を使うため、LINE 側で受け付け済みのメッセージが二重に届くことはない
+func (d *notificationDispatcher) dispatch(ctx context.Context, n *model.Notification) error {
+	attempts := n.Attempts + 1
+	_, err := d.lineBotClient.PushMessageWithRetryKey(&messaging_api.PushMessageRequest{
+		To: n.ToUserID,
+		Messages: []messaging_api.MessageInterface{
+			messaging_api.TextMessage{
+				Text: n.Text,
+			},
+		},
+		NotificationDisabled: false,
+	}, n.RetryKey)
+
+	var apiErr *linebot.APIError
+	switch {
+	case err == nil, errors.As(err, &apiErr) && apiErr.IsAlreadyAccepted():
+		if err := d.notificationRepo.MarkDelivered(ctx, n.ID, attempts); err != nil {
+			return fmt.Errorf("failed to mark notification %d delivered: %w", n.ID, err)
+		}
+		return nil
+
+	case apiErr != nil && !apiErr.IsRetryable(), attempts >= d.policy.MaxAttempts:
+		log.Printf("[ERROR] Gave up sending %s notification %d to %s after %d attempts (paid message): %v", n.Kind, n.ID, n.ToUserID, attempts, err)
+		if err := d.notificationRepo.MarkDead(ctx, n.ID, attempts, err.Error()); err != nil {
+			return fmt.Errorf("failed to mark notification %d dead: %w", n.ID, err)
+		}
+		return nil
+
+	default:
+		next := d.now().Add(d.policy.backoff(attempts))
+		log.Printf("[WARN] Failed to send %s notification %d to %s (attempt %d, retrying at %s): %v", n.Kind, n.ID, n.ToUserID, attempts, next.Format(time.RFC3339), err)
+		if err := d.notificationRepo.ScheduleRetry(ctx, n.ID, attempts, next, err.Error()); err != nil {
+			return fmt.Errorf("failed to schedule retry of notification %d: %w", n.ID, err)
+		}
+		return nil
+	}
+}
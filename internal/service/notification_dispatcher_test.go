@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
+	"github.com/morinonusi421/cupid/internal/linebot"
+	"github.com/morinonusi421/cupid/internal/model"
+	repositorymocks "github.com/morinonusi421/cupid/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationDispatcher_DispatchDue(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: 30 * time.Second, MaxDelay: 10 * time.Minute}
+
+	tests := []struct {
+		name      string
+		attempts  int // これまでに送信を試みた回数
+		pushErr   error
+		mockSetup func(*repositorymocks.MockNotificationRepository)
+	}{
+		{
+			name:     "送信に成功したら送信済みにする",
+			attempts: 0,
+			mockSetup: func(m *repositorymocks.MockNotificationRepository) {
+				m.EXPECT().MarkDelivered(mock.Anything, int64(1), 1).Return(nil)
+			},
+		},
+		{
+			name:     "同じ retry key で受け付け済み（409）なら送信済みにする",
+			attempts: 2,
+			pushErr:  &linebot.APIError{StatusCode: 409, Err: errors.New("conflict")},
+			mockSetup: func(m *repositorymocks.MockNotificationRepository) {
+				m.EXPECT().MarkDelivered(mock.Anything, int64(1), 3).Return(nil)
+			},
+		},
+		{
+			name:     "429 は指数バックオフで再送を予約する",
+			attempts: 2,
+			pushErr:  &linebot.APIError{StatusCode: 429, Err: errors.New("monthly limit")},
+			mockSetup: func(m *repositorymocks.MockNotificationRepository) {
+				m.EXPECT().ScheduleRetry(mock.Anything, int64(1), 3, now.Add(2*time.Minute), "monthly limit").Return(nil)
+			},
+		},
+		{
+			name:     "通信エラーは再送を予約する",
+			attempts: 0,
+			pushErr:  errors.New("connection reset"),
+			mockSetup: func(m *repositorymocks.MockNotificationRepository) {
+				m.EXPECT().ScheduleRetry(mock.Anything, int64(1), 1, now.Add(30*time.Second), "connection reset").Return(nil)
+			},
+		},
+		{
+			name:     "再送しても成功しないエラー（400）は dead にする",
+			attempts: 0,
+			pushErr:  &linebot.APIError{StatusCode: 400, Err: errors.New("invalid user")},
+			mockSetup: func(m *repositorymocks.MockNotificationRepository) {
+				m.EXPECT().MarkDead(mock.Anything, int64(1), 1, "invalid user").Return(nil)
+			},
+		},
+		{
+			name:     "送信回数の上限に達したら dead にする",
+			attempts: 4,
+			pushErr:  &linebot.APIError{StatusCode: 500, Err: errors.New("internal error")},
+			mockSetup: func(m *repositorymocks.MockNotificationRepository) {
+				m.EXPECT().MarkDead(mock.Anything, int64(1), 5, "internal error").Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockNotificationRepository(t)
+			mockClient := new(MockLineBotClient)
+
+			notification := &model.Notification{ID: 1, ToUserID: "U-alice", Kind: model.NotificationMatch, Text: "マッチしました", RetryKey: "retry-key-1", Attempts: tt.attempts}
+			mockRepo.EXPECT().ListDue(mock.Anything, now, dispatchBatchSize).Return([]*model.Notification{notification}, nil)
+			mockClient.On("PushMessageWithRetryKey", mock.MatchedBy(func(req *messaging_api.PushMessageRequest) bool {
+				textMsg, ok := req.Messages[0].(messaging_api.TextMessage)
+				return req.To == "U-alice" && ok && textMsg.Text == "マッチしました"
+			}), "retry-key-1").Return(&messaging_api.PushMessageResponse{}, tt.pushErr)
+			tt.mockSetup(mockRepo)
+
+			dispatcher := NewNotificationDispatcher(mockRepo, mockClient, policy).(*notificationDispatcher)
+			dispatcher.now = func() time.Time { return now }
+
+			n, err := dispatcher.DispatchDue(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, BaseDelay: 30 * time.Second, MaxDelay: 5 * time.Minute}
+
+	assert.Equal(t, 30*time.Second, policy.backoff(1))
+	assert.Equal(t, time.Minute, policy.backoff(2))
+	assert.Equal(t, 4*time.Minute, policy.backoff(4))
+	assert.Equal(t, 5*time.Minute, policy.backoff(5))
+	assert.Equal(t, 5*time.Minute, policy.backoff(30))
+}
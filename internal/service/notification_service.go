@@ -2,68 +2,100 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sync/atomic"
 
+	"github.com/google/uuid"
 	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
 	"github.com/morinonusi421/cupid/internal/linebot"
 	"github.com/morinonusi421/cupid/internal/message"
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/internal/repository"
 )
 
 // NotificationService はLINE通知送信を担当するサービス
 type NotificationService interface {
-	// SendMatchNotification はマッチ成立時にLINE Push通知を送信する
-	SendMatchNotification(ctx context.Context, toUserLineID, matchedUserName string) error
+	// EnqueueMatchNotification はマッチ成立のPush通知を送信待ちとして記録する
+	// ctx のトランザクションに参加するため、マッチング成立と同時にコミット・ロールバックされる
+	EnqueueMatchNotification(ctx context.Context, toUserLineID, matchedUserName string) error
 
 	// SendCrushRegistrationPrompt はユーザー登録完了後に好きな人登録を促すメッセージを送信する
 	SendCrushRegistrationPrompt(ctx context.Context, toUserLineID, crushLiffURL string) error
 
 	// SendUserInfoUpdateConfirmation は情報更新完了のメッセージを送信する
+	// 低優先度: 今月の送信数が予算に達している場合は送信せずに捨てる（LIFF画面に完了が表示されるため）
 	SendUserInfoUpdateConfirmation(ctx context.Context, toUserLineID string) error
 
 	// SendCrushRegistrationComplete は好きな人登録完了時（マッチなし）のメッセージを送信する
+	// 低優先度: 今月の送信数が予算に達している場合は送信せずに捨てる（LIFF画面に完了が表示されるため）
 	SendCrushRegistrationComplete(ctx context.Context, toUserLineID string, isFirstRegistration bool) error
 
-	// SendUnmatchNotification はマッチング解除時にLINE Push通知を送信する
-	SendUnmatchNotification(ctx context.Context, toUserLineID, partnerUserName string, isInitiator bool) error
+	// EnqueueUnmatchNotification はマッチング解除のPush通知を送信待ちとして記録する
+	// ctx のトランザクションに参加するため、マッチング解除と同時にコミット・ロールバックされる
+	EnqueueUnmatchNotification(ctx context.Context, toUserLineID, partnerUserName string, isInitiator bool) error
+
+	// EnqueuePartnerWithdrawnNotification はマッチング相手が退会したことのPush通知を送信待ちとして記録する
+	// ctx のトランザクションに参加するため、退会と同時にコミット・ロールバックされる
+	EnqueuePartnerWithdrawnNotification(ctx context.Context, toUserLineID, partnerUserName string) error
+
+	// EnqueuePartnerUnmatchedNotification はマッチング相手がマッチングを解除したことのPush通知を送信待ちとして記録する
+	// ctx のトランザクションに参加するため、マッチング解除と同時にコミット・ロールバックされる
+	EnqueuePartnerUnmatchedNotification(ctx context.Context, toUserLineID, partnerUserName string) error
+
+	// EnqueuePartnerCrushWithdrawnNotification はマッチング相手が好きな人の登録を取り消したことのPush通知を送信待ちとして記録する
+	// ctx のトランザクションに参加するため、マッチング解除と同時にコミット・ロールバックされる
+	EnqueuePartnerCrushWithdrawnNotification(ctx context.Context, toUserLineID, partnerUserName string) error
+
+	// EnqueueAdminUnmatchNotification は運営がマッチングを解除したことのPush通知を送信待ちとして記録する
+	// ctx のトランザクションに参加するため、マッチング解除と同時にコミット・ロールバックされる
+	EnqueueAdminUnmatchNotification(ctx context.Context, toUserLineID, partnerUserName string) error
+
+	// DiscardPendingNotifications は退会したユーザー宛ての送信待ちのPush通知を送信しないようにする
+	DiscardPendingNotifications(ctx context.Context, toUserLineID string) error
+
+	// ListNotifications はユーザー宛てに記録したPush通知（送信待ちを含む）を古い順に返す
+	ListNotifications(ctx context.Context, toUserLineID string) ([]*model.Notification, error)
+
+	// SkippedLowPriorityCount は起動してから、予算に達していたため捨てた低優先度のメッセージの数を返す
+	SkippedLowPriorityCount() int64
 
 	// SendFollowGreeting はFollowイベント時の挨拶メッセージ（QuickReply付き）を送信する
-	SendFollowGreeting(ctx context.Context, replyToken, userLiffURL string) error
+	// isReturning: 退会したユーザーが友達追加し直した場合は true
+	SendFollowGreeting(ctx context.Context, replyToken, userLiffURL string, isReturning bool) error
 
 	// SendJoinGroupGreeting はグループに招待された時の挨拶メッセージを送信する
 	SendJoinGroupGreeting(ctx context.Context, replyToken string) error
 }
 
 type notificationService struct {
-	lineBotClient linebot.Client
+	lineBotClient      linebot.Client
+	notificationRepo   repository.NotificationRepository
+	lowPriorityLimit   int
+	skippedLowPriority atomic.Int64
 }
 
 // NewNotificationService は NotificationService の新しいインスタンスを作成する
-func NewNotificationService(lineBotClient linebot.Client) NotificationService {
+//
+// マッチング成立・解除の通知は notificationRepo（notification_outbox）に記録し、
+// NotificationDispatcher がバックグラウンドで送信する
+//
+// lowPriorityLimit: 今月の送信数がこの値に達したら低優先度のメッセージは送信しない
+// （マッチング成立・解除の通知のために月間の送信上限を残しておく。捨てた数は SkippedLowPriorityCount で確認できる）
+func NewNotificationService(lineBotClient linebot.Client, notificationRepo repository.NotificationRepository, lowPriorityLimit int) NotificationService {
 	return &notificationService{
-		lineBotClient: lineBotClient,
+		lineBotClient:    lineBotClient,
+		notificationRepo: notificationRepo,
+		lowPriorityLimit: lowPriorityLimit,
 	}
 }
 
-// SendMatchNotification はマッチ成立時にLINE Push通知を送信する
+// EnqueueMatchNotification はマッチ成立のPush通知を送信待ちとして記録する
 //
 // 【重要】有償メッセージ（無料プランでは月200通まで）
-// Push APIを使用するため、LINE Messaging APIの有償カウント対象
-func (s *notificationService) SendMatchNotification(ctx context.Context, toUserLineID, matchedUserName string) error {
-	request := &messaging_api.PushMessageRequest{
-		To: toUserLineID,
-		Messages: []messaging_api.MessageInterface{
-			messaging_api.TextMessage{
-				Text: message.MatchNotification(matchedUserName),
-			},
-		},
-		NotificationDisabled: false,
-	}
-
-	_, err := s.lineBotClient.PushMessage(request)
-	if err != nil {
-		log.Printf("[ERROR] Failed to send match notification (paid message): %v", err)
-	}
-	return err
+// 送信時にPush APIを使用するため、LINE Messaging APIの有償カウント対象
+func (s *notificationService) EnqueueMatchNotification(ctx context.Context, toUserLineID, matchedUserName string) error {
+	return s.enqueue(ctx, toUserLineID, model.NotificationMatch, message.MatchNotification(matchedUserName))
 }
 
 // SendCrushRegistrationPrompt はユーザー登録完了後に好きな人登録を促すメッセージを送信する
@@ -104,6 +136,10 @@ func (s *notificationService) SendCrushRegistrationPrompt(ctx context.Context, t
 // 【重要】有償メッセージ（無料プランでは月200通まで）
 // Push APIを使用するため、LINE Messaging APIの有償カウント対象
 func (s *notificationService) SendUserInfoUpdateConfirmation(ctx context.Context, toUserLineID string) error {
+	if !s.withinLowPriorityBudget(ctx, "user info update confirmation") {
+		return nil
+	}
+
 	request := &messaging_api.PushMessageRequest{
 		To: toUserLineID,
 		Messages: []messaging_api.MessageInterface{
@@ -126,6 +162,10 @@ func (s *notificationService) SendUserInfoUpdateConfirmation(ctx context.Context
 // 【重要】有償メッセージ（無料プランでは月200通まで）
 // Push APIを使用するため、LINE Messaging APIの有償カウント対象
 func (s *notificationService) SendCrushRegistrationComplete(ctx context.Context, toUserLineID string, isFirstRegistration bool) error {
+	if !s.withinLowPriorityBudget(ctx, "crush registration complete") {
+		return nil
+	}
+
 	var messageText string
 	if isFirstRegistration {
 		messageText = message.CrushRegistrationCompleteFirst
@@ -150,11 +190,11 @@ func (s *notificationService) SendCrushRegistrationComplete(ctx context.Context,
 	return err
 }
 
-// SendUnmatchNotification はマッチング解除時にLINE Push通知を送信する
+// EnqueueUnmatchNotification はマッチング解除のPush通知を送信待ちとして記録する
 //
 // 【重要】有償メッセージ（無料プランでは月200通まで）
-// Push APIを使用するため、LINE Messaging APIの有償カウント対象
-func (s *notificationService) SendUnmatchNotification(ctx context.Context, toUserLineID, partnerUserName string, isInitiator bool) error {
+// 送信時にPush APIを使用するため、LINE Messaging APIの有償カウント対象
+func (s *notificationService) EnqueueUnmatchNotification(ctx context.Context, toUserLineID, partnerUserName string, isInitiator bool) error {
 	var messageText string
 	if isInitiator {
 		messageText = message.UnmatchNotificationInitiator(partnerUserName)
@@ -162,30 +202,112 @@ func (s *notificationService) SendUnmatchNotification(ctx context.Context, toUse
 		messageText = message.UnmatchNotificationPartner(partnerUserName)
 	}
 
-	request := &messaging_api.PushMessageRequest{
-		To: toUserLineID,
-		Messages: []messaging_api.MessageInterface{
-			messaging_api.TextMessage{
-				Text: messageText,
-			},
-		},
-		NotificationDisabled: false,
+	return s.enqueue(ctx, toUserLineID, model.NotificationUnmatch, messageText)
+}
+
+// EnqueuePartnerWithdrawnNotification はマッチング相手が退会したことのPush通知を送信待ちとして記録する
+//
+// 【重要】有償メッセージ（無料プランでは月200通まで）
+// 送信時にPush APIを使用するため、LINE Messaging APIの有償カウント対象
+func (s *notificationService) EnqueuePartnerWithdrawnNotification(ctx context.Context, toUserLineID, partnerUserName string) error {
+	return s.enqueue(ctx, toUserLineID, model.NotificationUnmatch, message.UnmatchNotificationPartnerWithdrawn(partnerUserName))
+}
+
+// EnqueuePartnerUnmatchedNotification はマッチング相手がマッチングを解除したことのPush通知を送信待ちとして記録する
+//
+// 【重要】有償メッセージ（無料プランでは月200通まで）
+// 送信時にPush APIを使用するため、LINE Messaging APIの有償カウント対象
+func (s *notificationService) EnqueuePartnerUnmatchedNotification(ctx context.Context, toUserLineID, partnerUserName string) error {
+	return s.enqueue(ctx, toUserLineID, model.NotificationUnmatch, message.UnmatchNotificationPartnerUnmatched(partnerUserName))
+}
+
+// EnqueuePartnerCrushWithdrawnNotification はマッチング相手が好きな人の登録を取り消したことのPush通知を送信待ちとして記録する
+//
+// 【重要】有償メッセージ（無料プランでは月200通まで）
+// 送信時にPush APIを使用するため、LINE Messaging APIの有償カウント対象
+func (s *notificationService) EnqueuePartnerCrushWithdrawnNotification(ctx context.Context, toUserLineID, partnerUserName string) error {
+	return s.enqueue(ctx, toUserLineID, model.NotificationUnmatch, message.UnmatchNotificationPartnerCrushWithdrawn(partnerUserName))
+}
+
+// EnqueueAdminUnmatchNotification は運営がマッチングを解除したことのPush通知を送信待ちとして記録する
+//
+// 【重要】有償メッセージ（無料プランでは月200通まで）
+// 送信時にPush APIを使用するため、LINE Messaging APIの有償カウント対象
+func (s *notificationService) EnqueueAdminUnmatchNotification(ctx context.Context, toUserLineID, partnerUserName string) error {
+	return s.enqueue(ctx, toUserLineID, model.NotificationUnmatch, message.UnmatchNotificationByAdmin(partnerUserName))
+}
+
+// DiscardPendingNotifications は退会したユーザー宛ての送信待ちのPush通知を dead にする
+// ブロックされたユーザーへの送信は失敗するため、再送を繰り返さないようにする
+func (s *notificationService) DiscardPendingNotifications(ctx context.Context, toUserLineID string) error {
+	if err := s.notificationRepo.DiscardPending(ctx, toUserLineID, "user deleted"); err != nil {
+		return fmt.Errorf("failed to discard pending notifications: %w", err)
 	}
+	return nil
+}
 
-	_, err := s.lineBotClient.PushMessage(request)
+// ListNotifications はユーザー宛てに記録したPush通知を古い順に返す（データのエクスポート用）
+// notification_outbox に記録しない返信メッセージや低優先度のPushメッセージは含まない
+func (s *notificationService) ListNotifications(ctx context.Context, toUserLineID string) ([]*model.Notification, error) {
+	notifications, err := s.notificationRepo.ListByUserID(ctx, toUserLineID)
 	if err != nil {
-		log.Printf("[ERROR] Failed to send unmatch notification (paid message): %v", err)
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
 	}
-	return err
+	return notifications, nil
+}
+
+// SkippedLowPriorityCount は起動してから、予算に達していたため捨てた低優先度のメッセージの数を返す（管理APIの集計用）
+func (s *notificationService) SkippedLowPriorityCount() int64 {
+	return s.skippedLowPriority.Load()
+}
+
+// withinLowPriorityBudget は低優先度のメッセージを送信してよいか判定する
+// 今月の送信数を取得できなかった場合は送信する
+//
+// 予算に達している場合、メッセージは notification_outbox に記録せずに捨てる（翌月に送り直さない）。
+// 低優先度のメッセージは操作の完了の確認で、完了は LIFF 画面に表示済みのため、後から届いても意味がない。
+// 捨てたことはログに残し、数を SkippedLowPriorityCount で数える
+func (s *notificationService) withinLowPriorityBudget(ctx context.Context, what string) bool {
+	usage, err := s.lineBotClient.MonthlyUsage(ctx)
+	if err != nil {
+		log.Printf("[WARN] Failed to get monthly push usage, sending %s anyway: %v", what, err)
+		return true
+	}
+	if usage >= s.lowPriorityLimit {
+		skipped := s.skippedLowPriority.Add(1)
+		log.Printf("[WARN] Monthly push usage %d reached low-priority budget %d, dropped %s (%d dropped since start)", usage, s.lowPriorityLimit, what, skipped)
+		return false
+	}
+	return true
+}
+
+// enqueue は通知を送信待ちとして記録する
+// retry key は記録時に決め、再送のたびに同じ値を使う（LINE 側で二重送信が防がれる）
+func (s *notificationService) enqueue(ctx context.Context, toUserLineID string, kind model.NotificationKind, text string) error {
+	notification := &model.Notification{
+		ToUserID: toUserLineID,
+		Kind:     kind,
+		Text:     text,
+		RetryKey: uuid.NewString(),
+	}
+	if err := s.notificationRepo.Enqueue(ctx, notification); err != nil {
+		return fmt.Errorf("failed to enqueue %s notification: %w", kind, err)
+	}
+	return nil
 }
 
 // SendFollowGreeting はFollowイベント時の挨拶メッセージ（QuickReply付き）を送信する
-func (s *notificationService) SendFollowGreeting(ctx context.Context, replyToken, userLiffURL string) error {
+// 退会したユーザーが友達追加し直した場合は、登録し直すよう案内する
+func (s *notificationService) SendFollowGreeting(ctx context.Context, replyToken, userLiffURL string, isReturning bool) error {
+	text := message.FollowGreeting
+	if isReturning {
+		text = message.FollowBackGreeting
+	}
 	request := &messaging_api.ReplyMessageRequest{
 		ReplyToken: replyToken,
 		Messages: []messaging_api.MessageInterface{
 			messaging_api.TextMessage{
-				Text: message.FollowGreeting,
+				Text: text,
 				QuickReply: &messaging_api.QuickReply{
 					Items: []messaging_api.QuickReplyItem{
 						{
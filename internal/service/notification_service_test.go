@@ -6,11 +6,17 @@ import (
 	"testing"
 
 	"github.com/line/line-bot-sdk-go/v8/linebot/messaging_api"
+	"github.com/morinonusi421/cupid/internal/linebot"
 	"github.com/morinonusi421/cupid/internal/message"
+	"github.com/morinonusi421/cupid/internal/model"
+	repositorymocks "github.com/morinonusi421/cupid/internal/repository/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+// testLowPriorityLimit は低優先度のメッセージを送信する月間の上限
+const testLowPriorityLimit = 160
+
 // MockLineBotClient は linebot.Client の手動mock
 type MockLineBotClient struct {
 	mock.Mock
@@ -32,51 +38,74 @@ func (m *MockLineBotClient) PushMessage(request *messaging_api.PushMessageReques
 	return args.Get(0).(*messaging_api.PushMessageResponse), args.Error(1)
 }
 
+func (m *MockLineBotClient) PushMessageWithRetryKey(request *messaging_api.PushMessageRequest, retryKey string) (*messaging_api.PushMessageResponse, error) {
+	args := m.Called(request, retryKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*messaging_api.PushMessageResponse), args.Error(1)
+}
+
+func (m *MockLineBotClient) MonthlyUsage(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockLineBotClient) Quota(ctx context.Context) (*linebot.Quota, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*linebot.Quota), args.Error(1)
+}
+
 // ========================================
-// SendMatchNotification のテスト
+// EnqueueMatchNotification のテスト
 // ========================================
 
-func TestNotificationService_SendMatchNotification(t *testing.T) {
+func TestNotificationService_EnqueueMatchNotification(t *testing.T) {
 	tests := []struct {
 		name             string
 		toUserLineID     string
 		matchedUserName  string
-		mockSetup        func(*MockLineBotClient)
+		mockSetup        func(*repositorymocks.MockNotificationRepository)
 		expectedError    bool
 		expectedErrorMsg string
 	}{
 		{
-			name:            "正常系 - マッチ通知送信成功",
+			name:            "正常系 - マッチ通知を送信待ちとして記録",
 			toUserLineID:    "U-alice",
 			matchedUserName: "ボブ",
-			mockSetup: func(m *MockLineBotClient) {
-				m.On("PushMessage", mock.MatchedBy(func(req *messaging_api.PushMessageRequest) bool {
-					return req.To == "U-alice" &&
-						len(req.Messages) == 1 &&
-						!req.NotificationDisabled
-				})).Return(&messaging_api.PushMessageResponse{}, nil)
+			mockSetup: func(m *repositorymocks.MockNotificationRepository) {
+				m.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+					return n.ToUserID == "U-alice" &&
+						n.Kind == model.NotificationMatch &&
+						n.Text == message.MatchNotification("ボブ") &&
+						n.RetryKey != ""
+				})).Return(nil)
 			},
 			expectedError: false,
 		},
 		{
-			name:            "異常系 - Push API呼び出し失敗",
+			name:            "異常系 - 記録に失敗",
 			toUserLineID:    "U-alice",
 			matchedUserName: "ボブ",
-			mockSetup: func(m *MockLineBotClient) {
-				m.On("PushMessage", mock.Anything).Return(nil, errors.New("api error"))
+			mockSetup: func(m *repositorymocks.MockNotificationRepository) {
+				m.EXPECT().Enqueue(mock.Anything, mock.Anything).Return(errors.New("db error"))
 			},
 			expectedError:    true,
-			expectedErrorMsg: "api error",
+			expectedErrorMsg: "db error",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := new(MockLineBotClient)
-			tt.mockSetup(mockClient)
+			mockRepo := repositorymocks.NewMockNotificationRepository(t)
+			tt.mockSetup(mockRepo)
 
-			service := NewNotificationService(mockClient)
-			err := service.SendMatchNotification(context.Background(), tt.toUserLineID, tt.matchedUserName)
+			service := NewNotificationService(mockClient, mockRepo, testLowPriorityLimit)
+			err := service.EnqueueMatchNotification(context.Background(), tt.toUserLineID, tt.matchedUserName)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -85,7 +114,8 @@ func TestNotificationService_SendMatchNotification(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			mockClient.AssertExpectations(t)
+			// 記録するだけで送信はしない
+			mockClient.AssertNotCalled(t, "PushMessage", mock.Anything)
 		})
 	}
 }
@@ -140,7 +170,7 @@ func TestNotificationService_SendCrushRegistrationPrompt(t *testing.T) {
 			mockClient := new(MockLineBotClient)
 			tt.mockSetup(mockClient)
 
-			service := NewNotificationService(mockClient)
+			service := NewNotificationService(mockClient, repositorymocks.NewMockNotificationRepository(t), testLowPriorityLimit)
 			err := service.SendCrushRegistrationPrompt(context.Background(), tt.toUserLineID, tt.crushLiffURL)
 
 			if tt.expectedError {
@@ -181,6 +211,23 @@ func TestNotificationService_SendUserInfoUpdateConfirmation(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name:         "正常系 - 今月の送信数が予算に達している場合は送信しない",
+			toUserLineID: "U-alice",
+			mockSetup: func(m *MockLineBotClient) {
+				m.On("MonthlyUsage", mock.Anything).Return(testLowPriorityLimit, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name:         "正常系 - 今月の送信数を取得できない場合は送信する",
+			toUserLineID: "U-alice",
+			mockSetup: func(m *MockLineBotClient) {
+				m.On("MonthlyUsage", mock.Anything).Return(0, errors.New("db error"))
+				m.On("PushMessage", mock.Anything).Return(&messaging_api.PushMessageResponse{}, nil)
+			},
+			expectedError: false,
+		},
 		{
 			name:         "異常系 - Push API呼び出し失敗",
 			toUserLineID: "U-alice",
@@ -196,8 +243,9 @@ func TestNotificationService_SendUserInfoUpdateConfirmation(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := new(MockLineBotClient)
 			tt.mockSetup(mockClient)
+			mockClient.On("MonthlyUsage", mock.Anything).Return(0, nil).Maybe()
 
-			service := NewNotificationService(mockClient)
+			service := NewNotificationService(mockClient, repositorymocks.NewMockNotificationRepository(t), testLowPriorityLimit)
 			err := service.SendUserInfoUpdateConfirmation(context.Background(), tt.toUserLineID)
 
 			if tt.expectedError {
@@ -212,6 +260,24 @@ func TestNotificationService_SendUserInfoUpdateConfirmation(t *testing.T) {
 	}
 }
 
+func TestNotificationService_SkippedLowPriorityCount(t *testing.T) {
+	// 予算に達していて捨てた低優先度のメッセージだけを数える
+	mockClient := new(MockLineBotClient)
+	mockClient.On("MonthlyUsage", mock.Anything).Return(testLowPriorityLimit, nil).Twice()
+	mockClient.On("MonthlyUsage", mock.Anything).Return(0, nil).Once()
+	mockClient.On("PushMessage", mock.Anything).Return(&messaging_api.PushMessageResponse{}, nil).Once()
+
+	service := NewNotificationService(mockClient, repositorymocks.NewMockNotificationRepository(t), testLowPriorityLimit)
+	assert.Equal(t, int64(0), service.SkippedLowPriorityCount())
+
+	assert.NoError(t, service.SendUserInfoUpdateConfirmation(context.Background(), "U-alice"))
+	assert.NoError(t, service.SendCrushRegistrationComplete(context.Background(), "U-alice", true))
+	assert.NoError(t, service.SendUserInfoUpdateConfirmation(context.Background(), "U-alice"))
+
+	assert.Equal(t, int64(2), service.SkippedLowPriorityCount())
+	mockClient.AssertExpectations(t)
+}
+
 // ========================================
 // SendCrushRegistrationComplete のテスト
 // ========================================
@@ -258,6 +324,16 @@ func TestNotificationService_SendCrushRegistrationComplete(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name:                "正常系 - 今月の送信数が予算を超えている場合は送信しない",
+			toUserLineID:        "U-alice",
+			isFirstRegistration: true,
+			expectedMessage:     message.CrushRegistrationCompleteFirst,
+			mockSetup: func(m *MockLineBotClient, expectedMsg string) {
+				m.On("MonthlyUsage", mock.Anything).Return(testLowPriorityLimit+10, nil)
+			},
+			expectedError: false,
+		},
 		{
 			name:                "異常系 - Push API呼び出し失敗",
 			toUserLineID:        "U-alice",
@@ -275,8 +351,9 @@ func TestNotificationService_SendCrushRegistrationComplete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := new(MockLineBotClient)
 			tt.mockSetup(mockClient, tt.expectedMessage)
+			mockClient.On("MonthlyUsage", mock.Anything).Return(0, nil).Maybe()
 
-			service := NewNotificationService(mockClient)
+			service := NewNotificationService(mockClient, repositorymocks.NewMockNotificationRepository(t), testLowPriorityLimit)
 			err := service.SendCrushRegistrationComplete(context.Background(), tt.toUserLineID, tt.isFirstRegistration)
 
 			if tt.expectedError {
@@ -292,88 +369,105 @@ func TestNotificationService_SendCrushRegistrationComplete(t *testing.T) {
 }
 
 // ========================================
-// SendUnmatchNotification のテスト
+// EnqueueUnmatchNotification のテスト
 // ========================================
 
-func TestNotificationService_SendUnmatchNotification(t *testing.T) {
+func TestNotificationService_EnqueueUnmatchNotification(t *testing.T) {
 	tests := []struct {
-		name             string
-		toUserLineID     string
-		partnerUserName  string
-		isInitiator      bool
-		mockSetup        func(*MockLineBotClient, string)
-		expectedError    bool
-		expectedErrorMsg string
+		name            string
+		toUserLineID    string
+		partnerUserName string
+		isInitiator     bool
+		expectedText    string
 	}{
 		{
 			name:            "正常系 - 解除開始者へのメッセージ",
 			toUserLineID:    "U-alice",
 			partnerUserName: "ボブ",
 			isInitiator:     true,
-			mockSetup: func(m *MockLineBotClient, expectedMsg string) {
-				m.On("PushMessage", mock.MatchedBy(func(req *messaging_api.PushMessageRequest) bool {
-					if req.To != "U-alice" || len(req.Messages) != 1 {
-						return false
-					}
-					textMsg, ok := req.Messages[0].(messaging_api.TextMessage)
-					return ok && textMsg.Text == expectedMsg
-				})).Return(&messaging_api.PushMessageResponse{}, nil)
-			},
-			expectedError: false,
+			expectedText:    message.UnmatchNotificationInitiator("ボブ"),
 		},
 		{
 			name:            "正常系 - 解除される側へのメッセージ",
 			toUserLineID:    "U-bob",
 			partnerUserName: "アリス",
 			isInitiator:     false,
-			mockSetup: func(m *MockLineBotClient, expectedMsg string) {
-				m.On("PushMessage", mock.MatchedBy(func(req *messaging_api.PushMessageRequest) bool {
-					if req.To != "U-bob" || len(req.Messages) != 1 {
-						return false
-					}
-					textMsg, ok := req.Messages[0].(messaging_api.TextMessage)
-					return ok && textMsg.Text == expectedMsg
-				})).Return(&messaging_api.PushMessageResponse{}, nil)
+			expectedText:    message.UnmatchNotificationPartner("アリス"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockNotificationRepository(t)
+			mockRepo.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+				return n.ToUserID == tt.toUserLineID &&
+					n.Kind == model.NotificationUnmatch &&
+					n.Text == tt.expectedText &&
+					n.RetryKey != ""
+			})).Return(nil)
+
+			service := NewNotificationService(new(MockLineBotClient), mockRepo, testLowPriorityLimit)
+			err := service.EnqueueUnmatchNotification(context.Background(), tt.toUserLineID, tt.partnerUserName, tt.isInitiator)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNotificationService_EnqueuePartnerWithdrawnNotification(t *testing.T) {
+	mockRepo := repositorymocks.NewMockNotificationRepository(t)
+	mockRepo.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+		return n.ToUserID == "U-bob" &&
+			n.Kind == model.NotificationUnmatch &&
+			n.Text == message.UnmatchNotificationPartnerWithdrawn("アリス") &&
+			n.RetryKey != ""
+	})).Return(nil)
+
+	service := NewNotificationService(new(MockLineBotClient), mockRepo, testLowPriorityLimit)
+	err := service.EnqueuePartnerWithdrawnNotification(context.Background(), "U-bob", "アリス")
+	assert.NoError(t, err)
+}
+
+func TestNotificationService_EnqueuePartnerUnmatchNotifications(t *testing.T) {
+	tests := []struct {
+		name         string
+		enqueue      func(NotificationService) error
+		expectedText string
+	}{
+		{
+			name: "相手がマッチングを解除した",
+			enqueue: func(s NotificationService) error {
+				return s.EnqueuePartnerUnmatchedNotification(context.Background(), "U-bob", "アリス")
 			},
-			expectedError: false,
+			expectedText: message.UnmatchNotificationPartnerUnmatched("アリス"),
 		},
 		{
-			name:            "異常系 - Push API呼び出し失敗",
-			toUserLineID:    "U-alice",
-			partnerUserName: "ボブ",
-			isInitiator:     true,
-			mockSetup: func(m *MockLineBotClient, expectedMsg string) {
-				m.On("PushMessage", mock.Anything).Return(nil, errors.New("api error"))
+			name: "相手が好きな人の登録を取り消した",
+			enqueue: func(s NotificationService) error {
+				return s.EnqueuePartnerCrushWithdrawnNotification(context.Background(), "U-bob", "アリス")
 			},
-			expectedError:    true,
-			expectedErrorMsg: "api error",
+			expectedText: message.UnmatchNotificationPartnerCrushWithdrawn("アリス"),
+		},
+		{
+			name: "運営がマッチングを解除した",
+			enqueue: func(s NotificationService) error {
+				return s.EnqueueAdminUnmatchNotification(context.Background(), "U-bob", "アリス")
+			},
+			expectedText: message.UnmatchNotificationByAdmin("アリス"),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockClient := new(MockLineBotClient)
-
-			var expectedMsg string
-			if tt.isInitiator {
-				expectedMsg = message.UnmatchNotificationInitiator(tt.partnerUserName)
-			} else {
-				expectedMsg = message.UnmatchNotificationPartner(tt.partnerUserName)
-			}
-
-			tt.mockSetup(mockClient, expectedMsg)
-
-			service := NewNotificationService(mockClient)
-			err := service.SendUnmatchNotification(context.Background(), tt.toUserLineID, tt.partnerUserName, tt.isInitiator)
-
-			if tt.expectedError {
-				assert.Error(t, err)
-				assert.Contains(t, err.Error(), tt.expectedErrorMsg)
-			} else {
-				assert.NoError(t, err)
-			}
-
-			mockClient.AssertExpectations(t)
+			mockRepo := repositorymocks.NewMockNotificationRepository(t)
+			mockRepo.EXPECT().Enqueue(mock.Anything, mock.MatchedBy(func(n *model.Notification) bool {
+				return n.ToUserID == "U-bob" &&
+					n.Kind == model.NotificationUnmatch &&
+					n.Text == tt.expectedText &&
+					n.RetryKey != ""
+			})).Return(nil)
+
+			service := NewNotificationService(new(MockLineBotClient), mockRepo, testLowPriorityLimit)
+			assert.NoError(t, tt.enqueue(service))
 		})
 	}
 }
@@ -387,6 +481,7 @@ func TestNotificationService_SendFollowGreeting(t *testing.T) {
 		name             string
 		replyToken       string
 		userLiffURL      string
+		isReturning      bool
 		mockSetup        func(*MockLineBotClient)
 		expectedError    bool
 		expectedErrorMsg string
@@ -411,6 +506,19 @@ func TestNotificationService_SendFollowGreeting(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name:        "正常系 - 退会したユーザーが友達追加し直した",
+			replyToken:  "reply-token-123",
+			userLiffURL: "https://liff.example.com/user",
+			isReturning: true,
+			mockSetup: func(m *MockLineBotClient) {
+				m.On("ReplyMessage", mock.MatchedBy(func(req *messaging_api.ReplyMessageRequest) bool {
+					textMsg, ok := req.Messages[0].(messaging_api.TextMessage)
+					return ok && textMsg.Text == message.FollowBackGreeting && textMsg.QuickReply != nil
+				})).Return(&messaging_api.ReplyMessageResponse{}, nil)
+			},
+			expectedError: false,
+		},
 		{
 			name:        "異常系 - Reply API呼び出し失敗",
 			replyToken:  "reply-token-123",
@@ -428,8 +536,8 @@ func TestNotificationService_SendFollowGreeting(t *testing.T) {
 			mockClient := new(MockLineBotClient)
 			tt.mockSetup(mockClient)
 
-			service := NewNotificationService(mockClient)
-			err := service.SendFollowGreeting(context.Background(), tt.replyToken, tt.userLiffURL)
+			service := NewNotificationService(mockClient, repositorymocks.NewMockNotificationRepository(t), testLowPriorityLimit)
+			err := service.SendFollowGreeting(context.Background(), tt.replyToken, tt.userLiffURL, tt.isReturning)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -485,7 +593,7 @@ func TestNotificationService_SendJoinGroupGreeting(t *testing.T) {
 			mockClient := new(MockLineBotClient)
 			tt.mockSetup(mockClient)
 
-			service := NewNotificationService(mockClient)
+			service := NewNotificationService(mockClient, repositorymocks.NewMockNotificationRepository(t), testLowPriorityLimit)
 			err := service.SendJoinGroupGreeting(context.Background(), tt.replyToken)
 
 			if tt.expectedError {
@@ -2,10 +2,17 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
 
-	"github.com/aarondl/null/v8"
 	"github.com/morinonusi421/cupid/internal/message"
 	"github.com/morinonusi421/cupid/internal/model"
 	"github.com/morinonusi421/cupid/internal/repository"
@@ -13,35 +20,100 @@ import (
 
 // UserService はユーザーのビジネスロジック層のインターフェース
 type UserService interface {
-	ProcessTextMessage(ctx context.Context, userID string) (replyText string, quickReplyURL string, quickReplyLabel string, err error)
-	RegisterUser(ctx context.Context, userID, name, birthday string, confirmUnmatch bool) (isFirstRegistration bool, err error)
-	RegisterCrush(ctx context.Context, userID, crushName, crushBirthday string, confirmUnmatch bool) (matched bool, isFirstCrushRegistration bool, err error)
-	ProcessFollowEvent(ctx context.Context, replyToken string) error
+	ProcessTextMessage(ctx context.Context, userID, text string) (replyText string, quickReplyURL string, quickReplyLabel string, err error)
+	RegisterUser(ctx context.Context, userID, name, altName string, birthday model.Birthday, confirmUnmatch bool) (isFirstRegistration bool, err error)
+	RegisterCrush(ctx context.Context, userID, crushName, crushAltName string, crushBirthday model.Birthday, confirmUnmatch bool) (matched bool, isFirstCrushRegistration bool, err error)
+	UpdateLineDisplayName(ctx context.Context, userID, displayName string) error
+	DeleteAccount(ctx context.Context, userID string) error
+	PruneWithdrawnHistory(ctx context.Context) (int64, error)
+	ExportData(ctx context.Context, userID string) (*model.DataExport, error)
+	GetStatus(ctx context.Context, userID string) (*model.UserStatus, error)
+	Unmatch(ctx context.Context, userID string) (partnerName string, err error)
+	ForceUnmatch(ctx context.Context, userID string) (partner *model.User, err error)
+	WithdrawCrush(ctx context.Context, userID string, crushID int64, confirmUnmatch bool) (crushName string, err error)
+	ProcessPostback(ctx context.Context, userID, data string) (*model.Reply, error)
+	ProcessFollowEvent(ctx context.Context, userID, replyToken string) error
 	ProcessJoinEvent(ctx context.Context, replyToken string) error
 }
 
 type userService struct {
 	userRepo            repository.UserRepository
+	crushRepo           repository.CrushRepository
+	crushChangeRepo     repository.CrushChangeRepository
 	userLiffURL         string
 	crushLiffURL        string
+	maxCrushesPerUser   int
+	crushChangePolicy   CrushChangePolicy
+	agePolicy           AgePolicy
 	matchingService     MatchingService
 	notificationService NotificationService
+	now                 func() time.Time
+}
+
+// CrushChangePolicy は好きな人を登録し直すことの制限
+//
+// 好きな人を登録するたびにマッチング判定を行うため、登録し直しを繰り返すと
+// 誰が自分を登録しているかを総当たりで探られてしまう。登録できる回数・人数・間隔を制限して防ぐ。
+type CrushChangePolicy struct {
+	DailyLimit  int           // 1日（日本時間）に好きな人を登録できる回数
+	MaxDistinct int           // Window の間に登録できる人数（同じ相手を登録し直した場合は1人と数え、別の表記は1人と数える）
+	Window      time.Duration // MaxDistinct を数える期間
+	Cooldown    time.Duration // 好きな人を登録してから次に登録できるまでの間隔（0なら制限しない）
+}
+
+// historyRetention は制限の判定に使う登録の履歴の期間
+func (p CrushChangePolicy) historyRetention() time.Duration {
+	return max(p.Window, p.Cooldown)
 }
 
 // NewUserService は UserService の新しいインスタンスを作成する
-func NewUserService(userRepo repository.UserRepository, userLiffURL string, crushLiffURL string, matchingService MatchingService, notificationService NotificationService) UserService {
+//
+// maxCrushesPerUser: 1人のユーザーが登録できる好きな人の上限
+// crushChangePolicy: 1人のユーザーが好きな人を登録し直すことの制限
+// agePolicy: 年齢による登録の制限
+func NewUserService(userRepo repository.UserRepository, crushRepo repository.CrushRepository, crushChangeRepo repository.CrushChangeRepository, userLiffURL string, crushLiffURL string, maxCrushesPerUser int, crushChangePolicy CrushChangePolicy, agePolicy AgePolicy, matchingService MatchingService, notificationService NotificationService) UserService {
 	return &userService{
 		userRepo:            userRepo,
+		crushRepo:           crushRepo,
+		crushChangeRepo:     crushChangeRepo,
 		userLiffURL:         userLiffURL,
 		crushLiffURL:        crushLiffURL,
+		maxCrushesPerUser:   maxCrushesPerUser,
+		crushChangePolicy:   crushChangePolicy,
+		agePolicy:           agePolicy,
 		matchingService:     matchingService,
 		notificationService: notificationService,
+		now:                 time.Now,
 	}
 }
 
+// 退会のコマンド（「退会」で確認メッセージを返し、「退会する」で退会する）
+const (
+	withdrawCommand        = "退会"
+	withdrawConfirmCommand = "退会する"
+)
+
+// withdrawConfirmWindow は「退会」と送信してから「退会する」で退会できる時間
+const withdrawConfirmWindow = 10 * time.Minute
+
+// exportCommand は登録データを JSON で返信するコマンド
+const exportCommand = "データ確認"
+
+// maxTextMessageLength はLINEのテキストメッセージの最大文字数（UTF-16 で数える）
+const maxTextMessageLength = 5000
+
 // ProcessTextMessage はLINEでuserから何かしらチャットが送られてきたの応答メッセージを決定する。
-// 現在は、相手からのメッセージ内容に関係なく、登録状況に応じたメッセージを返信。
-func (s *userService) ProcessTextMessage(ctx context.Context, userID string) (replyText string, quickReplyURL string, quickReplyLabel string, err error) {
+// 退会・データ確認のコマンド以外は、相手からのメッセージ内容に関係なく、登録状況に応じたメッセージを返信。
+func (s *userService) ProcessTextMessage(ctx context.Context, userID, text string) (replyText string, quickReplyURL string, quickReplyLabel string, err error) {
+	switch strings.TrimSpace(text) {
+	case withdrawCommand:
+		return s.processWithdrawCommand(ctx, userID, false)
+	case withdrawConfirmCommand:
+		return s.processWithdrawCommand(ctx, userID, true)
+	case exportCommand:
+		return s.processExportCommand(ctx, userID)
+	}
+
 	// DBからユーザーを検索
 	user, err := s.userRepo.FindByLineID(ctx, userID)
 	if err != nil {
@@ -55,7 +127,11 @@ func (s *userService) ProcessTextMessage(ctx context.Context, userID string) (re
 	}
 
 	// ユーザー登録してるけど、好きな人の登録はまだの場合
-	if !user.HasCrush() {
+	crushes, err := s.crushRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to list crushes: %w", err)
+	}
+	if len(crushes) == 0 {
 		// ユーザー登録完了済み - 好きな人の登録フォームを案内
 		return message.RegistrationStep1Prompt, s.crushLiffURL, "好きな人を登録", nil
 	}
@@ -64,90 +140,274 @@ func (s *userService) ProcessTextMessage(ctx context.Context, userID string) (re
 	return message.AlreadyRegisteredMessage, "", "", nil
 }
 
-// RegisterUser はLIFFフォームから送信されたユーザー登録情報を保存する
-//
-// confirmUnmatch: マッチング中の場合、trueならマッチング解除して更新、falseならエラーを返す
-func (s *userService) RegisterUser(ctx context.Context, userID, name, birthday string, confirmUnmatch bool) (isFirstRegistration bool, err error) {
-	// 1. バリデーション
-	if ok, errMsg := model.IsValidName(name); !ok {
-		return false, &ValidationError{Message: errMsg}
+// processWithdrawCommand は退会のコマンドへの応答メッセージを決定する
+// 「退会」と送信した日時を記録して確認メッセージを返し、withdrawConfirmWindow 以内に「退会する」と送信された場合だけ退会する
+// confirmed: true でも、先に「退会」と送信されていなければ（または時間が経っていれば）確認メッセージを返す
+func (s *userService) processWithdrawCommand(ctx context.Context, userID string, confirmed bool) (replyText string, quickReplyURL string, quickReplyLabel string, err error) {
+	user, err := s.userRepo.FindByLineID(ctx, userID)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return message.WithdrawNotRegistered, "", "", nil
 	}
 
-	// 2. 重複チェック（既存ユーザーと名前・誕生日が被っていないか）
-	existingUser, err := s.userRepo.FindByNameAndBirthday(ctx, name, birthday)
-	if err != nil {
-		return false, fmt.Errorf("failed to check duplicate user: %w", err)
+	now := s.now()
+	if confirmed {
+		requested, err := s.userRepo.WithdrawalRequestedSince(ctx, userID, now.Add(-withdrawConfirmWindow))
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to check withdrawal request: %w", err)
+		}
+		if requested {
+			if err := s.DeleteAccount(ctx, userID); err != nil {
+				if errors.Is(err, ErrUserNotFound) {
+					return message.WithdrawNotRegistered, "", "", nil
+				}
+				return "", "", "", err
+			}
+			return message.WithdrawComplete, "", "", nil
+		}
 	}
-	// 見つかったユーザーが他人（LineIDが違う）の場合はエラー
-	if existingUser != nil && existingUser.LineID != userID {
-		return false, ErrDuplicateUser
+
+	if err := s.userRepo.RequestWithdrawal(ctx, userID, now); err != nil {
+		return "", "", "", fmt.Errorf("failed to record withdrawal request: %w", err)
 	}
+	return message.WithdrawConfirmPrompt, "", "", nil
+}
 
-	// 3. ユーザー検索
-	user, err := s.userRepo.FindByLineID(ctx, userID)
+// processExportCommand は登録データを JSON で返信する
+// LINEのメッセージに収まらない場合は、登録画面から確認するよう案内する
+func (s *userService) processExportCommand(ctx context.Context, userID string) (replyText string, quickReplyURL string, quickReplyLabel string, err error) {
+	export, err := s.ExportData(ctx, userID)
 	if err != nil {
-		return false, fmt.Errorf("failed to find user: %w", err)
+		if errors.Is(err, ErrUserNotFound) {
+			return message.DataExportNotRegistered, "", "", nil
+		}
+		return "", "", "", err
 	}
 
-	// 4. 初回登録 vs 再登録で分岐
-	if user == nil {
-		// 初回登録
-		if err := s.registerNewUser(ctx, userID, name, birthday); err != nil {
-			return false, err
-		}
-		return true, nil
-	} else {
-		// 再登録（情報更新）
-		if err := s.updateUserInfo(ctx, user, name, birthday, confirmUnmatch); err != nil {
-			return false, err
-		}
-		return false, nil
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to marshal data export: %w", err)
+	}
+	if len(utf16.Encode([]rune(string(data)))) > maxTextMessageLength {
+		return message.DataExportTooLong, s.userLiffURL, "登録データを確認", nil
 	}
+	return string(data), "", "", nil
 }
 
-// RegisterCrush は好きな人を登録し、マッチング判定を行う
+// RegisterUser はLIFFフォームから送信されたユーザー登録情報を保存する
 //
+// altName: 名前の別の表記（任意、空なら登録しない）。名前・別の表記のどちらかが一致すれば同じ人として扱う
 // confirmUnmatch: マッチング中の場合、trueならマッチング解除して更新、falseならエラーを返す
-func (s *userService) RegisterCrush(ctx context.Context, userID, crushName, crushBirthday string, confirmUnmatch bool) (matched bool, isFirstCrushRegistration bool, err error) {
-	// 1. 現在のユーザー情報を取得
-	currentUser, err := s.userRepo.FindByLineID(ctx, userID)
-	if err != nil {
-		return false, false, err
+//
+// 重複チェック・マッチング解除・更新・マッチング判定とその通知の記録は1つのトランザクション内で行い、
+// それ以外のLINE通知はコミット後に送信する。
+func (s *userService) RegisterUser(ctx context.Context, userID, name, altName string, birthday model.Birthday, confirmUnmatch bool) (isFirstRegistration bool, err error) {
+	// 1. 名前の表記を揃えてからバリデーション（ひらがな・半角カナ・漢字・ローマ字などで入力されても登録できる）
+	name, altName = normalizeNames(name, altName)
+	if err := validateNames(name, altName); err != nil {
+		return false, err
 	}
-	if currentUser == nil {
-		return false, false, ErrUserNotFound
+	if err := s.agePolicy.checkUser(birthday, s.now()); err != nil {
+		return false, err
 	}
 
-	// 2. マッチング中チェックと解除処理
-	if err := s.handleMatchedStateBeforeUpdate(ctx, currentUser, confirmUnmatch); err != nil {
-		return false, false, err
+	var (
+		user   *model.User
+		result registrationResult
+	)
+	err = s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		// 2. 重複チェック（既存ユーザーと名前・誕生日が被っていないか）
+		existingUser, err := s.userRepo.FindByNameAndBirthday(ctx, model.NewIdentityKey(name, altName), birthday)
+		if err != nil {
+			return fmt.Errorf("failed to check duplicate user: %w", err)
+		}
+		// 見つかったユーザーが他人（LineIDが違う）の場合はエラー
+		if existingUser != nil && existingUser.LineID != userID {
+			return ErrDuplicateUser
+		}
+
+		// 3. ユーザー検索
+		user, err = s.userRepo.FindByLineID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to find user: %w", err)
+		}
+
+		// 4. 初回登録 vs 再登録で分岐
+		if user == nil {
+			// 初回登録
+			isFirstRegistration = true
+			user, err = s.registerNewUser(ctx, userID, name, altName, birthday)
+			return err
+		}
+
+		// 再登録（情報更新）
+		result, err = s.updateUserInfo(ctx, user, name, altName, birthday, confirmUnmatch)
+		if err != nil {
+			return err
+		}
+
+		// マッチング解除・成立の通知を同じトランザクションで記録する
+		return s.enqueueRegistrationResult(ctx, user, result)
+	})
+	if err != nil {
+		return false, err
 	}
 
-	// 3. 自己登録チェック（domain method使用）
-	if currentUser.IsSamePerson(crushName, crushBirthday) {
-		return false, false, ErrCannotRegisterYourself
+	// 5. コミット後に通知を送信
+	if isFirstRegistration {
+		// 好きな人登録を促すメッセージを送信
+		if err := s.notificationService.SendCrushRegistrationPrompt(ctx, user.LineID, s.crushLiffURL); err != nil {
+			log.Printf("Failed to send crush registration prompt to %s: %v", user.LineID, err)
+			// エラーをログに記録するが、登録処理は成功として扱う
+		}
+		return true, nil
 	}
 
-	// 4. 名前のバリデーション
-	if valid, errMsg := model.IsValidName(crushName); !valid {
-		return false, false, &ValidationError{Message: errMsg}
+	if result.matchedUser == nil {
+		// 更新完了メッセージを送信（マッチした場合はマッチ通知を優先するため送信しない）
+		if err := s.notificationService.SendUserInfoUpdateConfirmation(ctx, user.LineID); err != nil {
+			log.Printf("Failed to send update confirmation to %s: %v", user.LineID, err)
+			// エラーをログに記録するが、更新処理は成功として扱う
+		}
 	}
 
-	// 5. 初回登録か再登録かを判定（好きな人を登録する前に）
-	isFirstCrushRegistration = !currentUser.HasCrush()
+	return false, nil
+}
+
+// RegisterCrush は好きな人を登録し、マッチング判定を行う
+//
+// 好きな人は maxCrushesPerUser 人まで追加で登録できる。既に登録済みの相手を再度送信した場合は
+// 追加せず、マッチング判定のみ行う（別の表記が未登録なら、送信された別の表記を追加する）。登録済みの好きな人の誰かと相互に登録し合っていればマッチング成立。
+//
+// crushAltName: 好きな人の名前の別の表記（任意、空なら登録しない）。名前・別の表記のどちらかが一致すれば同じ人として扱う
+// confirmUnmatch: マッチング中の場合、trueならマッチング解除して更新、falseならエラーを返す
+//
+// マッチング解除・登録・マッチング判定とその通知の記録は1つのトランザクション内で行い、
+// それ以外のLINE通知はコミット後に送信する。
+func (s *userService) RegisterCrush(ctx context.Context, userID, crushName, crushAltName string, crushBirthday model.Birthday, confirmUnmatch bool) (matched bool, isFirstCrushRegistration bool, err error) {
+	// 名前の表記を揃えてから登録する（ひらがな・半角カナ・漢字・ローマ字などで入力されても登録できる）
+	crushName, crushAltName = normalizeNames(crushName, crushAltName)
+	crushKey := model.NewIdentityKey(crushName, crushAltName)
+
+	var (
+		currentUser *model.User
+		result      registrationResult
+	)
+	err = s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		// 1. 現在のユーザー情報を取得（トランザクション内で最新の状態を読む）
+		var err error
+		currentUser, err = s.userRepo.FindByLineID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if currentUser == nil {
+			return ErrUserNotFound
+		}
+
+		// 2. マッチング中チェックと解除処理
+		result.unmatchedPartner, err = s.handleMatchedStateBeforeUpdate(ctx, currentUser, confirmUnmatch)
+		if err != nil {
+			return err
+		}
 
-	// 6. 好きな人を登録（usersテーブルに直接保存）
-	currentUser.CrushName = null.StringFrom(crushName)
-	currentUser.CrushBirthday = null.StringFrom(crushBirthday)
+		// 3. 自己登録チェック（domain method使用）
+		if currentUser.IsSamePerson(crushKey, crushBirthday) {
+			return ErrCannotRegisterYourself
+		}
 
-	if err := s.userRepo.Update(ctx, currentUser); err != nil {
+		// 4. 名前のバリデーションと年齢による制限
+		if err := validateNames(crushName, crushAltName); err != nil {
+			return err
+		}
+		if err := s.agePolicy.checkCrush(currentUser.Birthday, crushBirthday, s.now()); err != nil {
+			return err
+		}
+
+		// 5. 初回登録か再登録かを判定（好きな人を登録する前に）
+		crushes, err := s.crushRepo.ListByUserID(ctx, currentUser.LineID)
+		if err != nil {
+			return fmt.Errorf("failed to list crushes: %w", err)
+		}
+		isFirstCrushRegistration = len(crushes) == 0
+
+		// 6. 好きな人を登録（登録済みの相手なら追加しない）
+		// 総当たりで登録状況を探られないよう、登録できる回数・人数・間隔も制限し、登録の履歴を残す
+		existing := model.FindCrush(crushes, crushKey, crushBirthday)
+		switch {
+		case existing != nil && crushAltName != "" && existing.AltName == "" && existing.IdentityKey().Primary == crushKey.Primary:
+			// 登録済みの好きな人に別の表記を追加する（名前の候補（model.SuggestNames）を選んだ場合など）
+			// 別の表記で判定できる名前が増えるため、新しい好きな人の登録と同じく間隔・人数の制限を確認し、
+			// 1日の登録回数に数えて履歴を残す（追加できるのは1回だけ）
+			if err := s.checkCrushChangePolicy(ctx, currentUser.LineID, crushKey, crushBirthday); err != nil {
+				return err
+			}
+			if err := s.countCrushChange(ctx, currentUser.LineID); err != nil {
+				return err
+			}
+			if err := s.crushRepo.SetAltName(ctx, currentUser.LineID, existing.ID, crushAltName); err != nil {
+				return fmt.Errorf("failed to set crush alt name: %w", err)
+			}
+			if err := s.crushChangeRepo.RecordHistory(ctx, &model.CrushHistory{
+				UserLineID: currentUser.LineID,
+				Name:       existing.Name,
+				AltName:    crushAltName,
+				Birthday:   existing.Birthday,
+				Action:     model.CrushAdded,
+				ChangedAt:  s.now(),
+			}); err != nil {
+				return fmt.Errorf("failed to record crush history: %w", err)
+			}
+			existing.AltName = crushAltName
+		case existing == nil:
+			if len(crushes) >= s.maxCrushesPerUser {
+				return &CrushLimitReachedError{Limit: s.maxCrushesPerUser}
+			}
+			if err := s.checkCrushChangePolicy(ctx, currentUser.LineID, crushKey, crushBirthday); err != nil {
+				return err
+			}
+			if err := s.countCrushChange(ctx, currentUser.LineID); err != nil {
+				return err
+			}
+
+			crush := &model.Crush{
+				UserLineID: currentUser.LineID,
+				Name:       crushName,
+				AltName:    crushAltName,
+				Birthday:   crushBirthday,
+			}
+			if err := s.crushRepo.Add(ctx, crush); err != nil {
+				return fmt.Errorf("failed to add crush: %w", err)
+			}
+			if err := s.crushChangeRepo.RecordHistory(ctx, &model.CrushHistory{
+				UserLineID: currentUser.LineID,
+				Name:       crushName,
+				AltName:    crushAltName,
+				Birthday:   crushBirthday,
+				Action:     model.CrushAdded,
+				ChangedAt:  s.now(),
+			}); err != nil {
+				return fmt.Errorf("failed to record crush history: %w", err)
+			}
+			crushes = append(crushes, crush)
+		}
+
+		// 7. マッチング判定
+		result.matchedUser, err = s.checkMatch(ctx, currentUser, crushes)
+		if err != nil {
+			return err
+		}
+
+		// 8. マッチング解除・成立の通知を同じトランザクションで記録する
+		return s.enqueueRegistrationResult(ctx, currentUser, result)
+	})
+	if err != nil {
 		return false, false, err
 	}
 
-	// 7. マッチング判定と通知
-	matched, _, _ = s.checkAndNotifyMatch(ctx, currentUser)
-
-	// マッチしなかった場合は登録完了を通知
+	// 9. コミット後、マッチしなかった場合は登録完了を通知
+	matched = result.matchedUser != nil
 	if !matched {
 		if err := s.notificationService.SendCrushRegistrationComplete(ctx, currentUser.LineID, isFirstCrushRegistration); err != nil {
 			log.Printf("Failed to send crush registration complete notification to %s: %v", currentUser.LineID, err)
@@ -157,12 +417,112 @@ func (s *userService) RegisterCrush(ctx context.Context, userID, crushName, crus
 	return matched, isFirstCrushRegistration, nil
 }
 
+// jst は1日の登録回数を数えるタイムゾーン（日本時間）
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// countCrushChange は今日（日本時間）の好きな人の登録回数を1増やす
+// 上限に達している場合は CrushChangeLimitReachedError を返す
+func (s *userService) countCrushChange(ctx context.Context, userID string) error {
+	now := s.now().In(jst)
+	day := now.Format("2006-01-02")
+	count, err := s.crushChangeRepo.CountOn(ctx, userID, day)
+	if err != nil {
+		return fmt.Errorf("failed to count crush changes: %w", err)
+	}
+	if count >= s.crushChangePolicy.DailyLimit {
+		tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, jst)
+		return &CrushChangeLimitReachedError{Limit: s.crushChangePolicy.DailyLimit, RetryAt: tomorrow}
+	}
+	if err := s.crushChangeRepo.Increment(ctx, userID, day); err != nil {
+		return fmt.Errorf("failed to count crush changes: %w", err)
+	}
+	return nil
+}
+
+// checkCrushChangePolicy は登録の履歴から、新しい好きな人を登録できるかを確認する
+// 前回の登録から Cooldown が経っていない場合や、Window の間に MaxDistinct 人を登録している場合は
+// CrushChangeRestrictedError を返す（Window の間に登録したことのある相手は人数を増やさないため登録できる）
+//
+// 別の表記を付けて登録すると2つの名前で判定できるため、人数は表記ごとに数える
+// （MaxDistinct が1の場合だけ、別の表記を付けた登録も1人と数える）。
+// 登録したことのある相手とみなすのは、名前のキーと誕生日が一致し、別の表記も新しくない場合だけ
+func (s *userService) checkCrushChangePolicy(ctx context.Context, userID string, crushKey model.IdentityKey, crushBirthday model.Birthday) error {
+	policy := s.crushChangePolicy
+	now := s.now()
+	histories, err := s.crushChangeRepo.ListHistorySince(ctx, userID, now.Add(-policy.historyRetention()))
+	if err != nil {
+		return fmt.Errorf("failed to list crush history: %w", err)
+	}
+
+	var added []*model.CrushHistory
+	for _, h := range histories {
+		if h.Action == model.CrushAdded {
+			added = append(added, h)
+		}
+	}
+
+	// 1. 前回の登録からの間隔
+	if policy.Cooldown > 0 && len(added) > 0 {
+		if retryAt := added[len(added)-1].ChangedAt.Add(policy.Cooldown); now.Before(retryAt) {
+			return &CrushChangeRestrictedError{Reason: CrushChangeCooldown, RetryAt: retryAt}
+		}
+	}
+
+	// 2. Window の間に登録した表記の数（表記ごとに最後に登録した時刻を数える）
+	windowStart := now.Add(-policy.Window)
+	lastAdded := make(map[[2]string]time.Time)
+	for _, h := range added {
+		if h.ChangedAt.Before(windowStart) {
+			continue
+		}
+		hKey := h.IdentityKey()
+		if h.IsSamePerson(crushKey, crushBirthday) && (crushKey.Secondary == "" || crushKey.Secondary == hKey.Secondary) {
+			return nil
+		}
+		for _, k := range []string{hKey.Primary, hKey.Secondary} {
+			if k != "" {
+				lastAdded[[2]string{k, h.Birthday.String()}] = h.ChangedAt
+			}
+		}
+	}
+	needed := 0
+	for _, k := range []string{crushKey.Primary, crushKey.Secondary} {
+		if _, ok := lastAdded[[2]string{k, crushBirthday.String()}]; k != "" && !ok {
+			needed++
+		}
+	}
+	needed = min(needed, policy.MaxDistinct)
+	if len(lastAdded)+needed <= policy.MaxDistinct {
+		return nil
+	}
+
+	// 古い順に期間外になり、登録する表記を加えても MaxDistinct 以下になった時点で登録できる
+	times := make([]time.Time, 0, len(lastAdded))
+	for _, t := range lastAdded {
+		times = append(times, t)
+	}
+	slices.SortFunc(times, time.Time.Compare)
+	return &CrushChangeRestrictedError{
+		Reason:  CrushChangeDistinctLimit,
+		Limit:   policy.MaxDistinct,
+		Window:  policy.Window,
+		RetryAt: times[len(times)+needed-policy.MaxDistinct-1].Add(policy.Window),
+	}
+}
+
+// registrationResult はトランザクション内で発生した、通知すべき出来事
+type registrationResult struct {
+	unmatchedPartner *model.User // マッチング解除した相手（解除していなければnil）
+	matchedUser      *model.User // 新たにマッチングした相手（マッチしなければnil）
+}
+
 // registerNewUser は初回登録時に新規ユーザーを作成する
-func (s *userService) registerNewUser(ctx context.Context, userID, name, birthday string) error {
+func (s *userService) registerNewUser(ctx context.Context, userID, name, altName string, birthday model.Birthday) (*model.User, error) {
 	// 1. 完全なユーザーオブジェクトを作成
 	user := &model.User{
 		LineID:       userID,
 		Name:         name,
+		AltName:      altName,
 		Birthday:     birthday,
 		RegisteredAt: "", // DBのDEFAULT（現在時刻）を使用
 		UpdatedAt:    "", // DBのDEFAULT（現在時刻）を使用
@@ -170,62 +530,506 @@ func (s *userService) registerNewUser(ctx context.Context, userID, name, birthda
 
 	// 2. DBに保存
 	if err := s.userRepo.Create(ctx, user); err != nil {
-		return fmt.Errorf("failed to create user: %w", err)
+		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	// 3. 好きな人登録を促すメッセージを送信
-	if err := s.notificationService.SendCrushRegistrationPrompt(ctx, user.LineID, s.crushLiffURL); err != nil {
-		log.Printf("Failed to send crush registration prompt to %s: %v", user.LineID, err)
-		// エラーをログに記録するが、登録処理は成功として扱う
-	}
-
-	return nil
+	return user, nil
 }
 
 // updateUserInfo は再登録時に既存ユーザーの情報を更新する
 //
 // confirmUnmatch: マッチング中の場合、trueならマッチング解除して更新、falseならエラーを返す
-func (s *userService) updateUserInfo(ctx context.Context, user *model.User, name, birthday string, confirmUnmatch bool) error {
-	// 1. 自己登録チェック（好きな人と同じ名前・誕生日にならないか）
-	if user.HasCrush() {
-		if user.CrushName.String == name && user.CrushBirthday.String == birthday {
-			return ErrCannotRegisterYourself
-		}
+func (s *userService) updateUserInfo(ctx context.Context, user *model.User, name, altName string, birthday model.Birthday, confirmUnmatch bool) (registrationResult, error) {
+	var result registrationResult
+
+	// 1. 自己登録チェック（好きな人のいずれかと同じ名前・誕生日にならないか）
+	crushes, err := s.crushRepo.ListByUserID(ctx, user.LineID)
+	if err != nil {
+		return result, fmt.Errorf("failed to list crushes: %w", err)
+	}
+	if model.FindCrush(crushes, model.NewIdentityKey(name, altName), birthday) != nil {
+		return result, ErrCannotRegisterYourself
+	}
+	// 誕生日を変更すると、登録済みの好きな人との年齢の組み合わせが制限に当てはまることがある
+	if err := s.agePolicy.checkCrushes(birthday, crushes, s.now()); err != nil {
+		return result, err
 	}
 
 	// 2. マッチング中チェックと解除処理
-	if err := s.handleMatchedStateBeforeUpdate(ctx, user, confirmUnmatch); err != nil {
-		return err
+	result.unmatchedPartner, err = s.handleMatchedStateBeforeUpdate(ctx, user, confirmUnmatch)
+	if err != nil {
+		return result, err
 	}
 
 	// 3. ユーザー情報を更新
 	user.Name = name
+	user.AltName = altName
 	user.Birthday = birthday
 
 	// 4. DBに保存
 	if err := s.userRepo.Update(ctx, user); err != nil {
-		return fmt.Errorf("failed to update user: %w", err)
+		return result, fmt.Errorf("failed to update user: %w", err)
 	}
 
-	// 5. マッチング判定と通知
-	matched, _, _ := s.checkAndNotifyMatch(ctx, user)
-	if matched {
-		// マッチした場合は更新完了メッセージは送信しない（マッチ通知を優先）
+	// 5. マッチング判定
+	result.matchedUser, err = s.checkMatch(ctx, user, crushes)
+	return result, err
+}
+
+// UpdateLineDisplayName は登録済みユーザーの LINE 表示名を記録する
+// 表示名は管理者が問い合わせ対応などでユーザーを確認するための参考情報で、マッチングには使わない
+// 表示名が空（profile スコープがない）の場合は何もしない
+func (s *userService) UpdateLineDisplayName(ctx context.Context, userID, displayName string) error {
+	if displayName == "" {
 		return nil
 	}
+	if err := s.userRepo.UpdateLineDisplayName(ctx, userID, displayName); err != nil {
+		return fmt.Errorf("failed to update LINE display name: %w", err)
+	}
+	return nil
+}
 
-	// 6. 更新完了メッセージを送信（マッチしなかった場合）
-	if err := s.notificationService.SendUserInfoUpdateConfirmation(ctx, user.LineID); err != nil {
-		log.Printf("Failed to send update confirmation to %s: %v", user.LineID, err)
-		// エラーをログに記録するが、更新処理は成功として扱う
+// DeleteAccount はユーザーを退会させる（LINE のブロック・「退会」コマンド・退会API）
+//
+// マッチング中の場合は解除して相手に通知し、好きな人の登録・マッチングの履歴を削除してから、
+// ユーザーの名前・誕生日を消して退会済みにする。他のユーザーが好きな人として登録した名前・誕生日とは
+// もう一致しないため、退会したユーザーがマッチングしたり、登録していたことが分かったりすることはない。
+// 退会したユーザー宛ての送信待ちの通知は送信しない。すべて1つのトランザクション内で行う。
+//
+// 好きな人の登録の履歴と1日あたりの登録回数は、退会と登録し直しで登録の制限を回避できないよう、
+// 制限の判定に使う期間の分だけ残す（残した履歴は PruneWithdrawnHistory で期間を過ぎてから削除する）。
+//
+// 登録されていない場合は ErrUserNotFound を返す
+func (s *userService) DeleteAccount(ctx context.Context, userID string) error {
+	return s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.FindByLineID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to find user: %w", err)
+		}
+		if user == nil {
+			return ErrUserNotFound
+		}
+
+		// 1. マッチング中なら解除して相手に通知
+		if user.IsMatched() {
+			_, partner, err := s.matchingService.UnmatchUsers(ctx, user.LineID, user.MatchedWithUserID.String)
+			if err != nil {
+				return fmt.Errorf("failed to unmatch users: %w", err)
+			}
+			if err := s.notificationService.EnqueuePartnerWithdrawnNotification(ctx, partner.LineID, user.Name); err != nil {
+				return err
+			}
+		}
+
+		// 2. 好きな人の登録と、制限の判定に使わない古い登録の履歴を削除
+		if err := s.crushRepo.RemoveAll(ctx, user.LineID); err != nil {
+			return fmt.Errorf("failed to remove crushes: %w", err)
+		}
+		if err := s.crushChangeRepo.DeleteHistoryBefore(ctx, user.LineID, s.now().Add(-s.crushChangePolicy.historyRetention())); err != nil {
+			return fmt.Errorf("failed to delete crush history: %w", err)
+		}
+
+		// 3. マッチングの履歴を削除（相手側の履歴は残す）
+		if err := s.matchingService.DeleteMatchHistory(ctx, user.LineID); err != nil {
+			return err
+		}
+
+		// 4. 送信待ちの通知を送信しないようにする
+		if err := s.notificationService.DiscardPendingNotifications(ctx, user.LineID); err != nil {
+			return err
+		}
+
+		// 5. 名前・誕生日を消して退会済みにする
+		if err := s.userRepo.Delete(ctx, user.LineID); err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		return nil
+	})
+}
+
+// PruneWithdrawnHistory は退会したユーザーの登録の履歴のうち、制限の判定に使う期間を過ぎたものを削除し、削除した件数を返す
+func (s *userService) PruneWithdrawnHistory(ctx context.Context) (int64, error) {
+	n, err := s.crushChangeRepo.PruneWithdrawnHistory(ctx, s.now().Add(-s.crushChangePolicy.historyRetention()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune crush history: %w", err)
+	}
+	return n, nil
+}
+
+// ExportData はユーザー本人の登録データ（ユーザー情報・好きな人・登録の履歴・マッチングの履歴・Push通知）を返す
+//
+// 1つのトランザクション内で読み出し、途中で更新された状態が混ざらないようにする
+// 退会済み・未登録の場合は ErrUserNotFound を返す
+func (s *userService) ExportData(ctx context.Context, userID string) (*model.DataExport, error) {
+	var export *model.DataExport
+	err := s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.FindByLineID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to find user: %w", err)
+		}
+		if user == nil {
+			return ErrUserNotFound
+		}
+
+		crushes, err := s.crushRepo.ListByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to list crushes: %w", err)
+		}
+		crushHistory, err := s.crushChangeRepo.ListHistorySince(ctx, userID, time.Time{})
+		if err != nil {
+			return fmt.Errorf("failed to list crush history: %w", err)
+		}
+		matchHistory, err := s.matchingService.ListMatchHistory(ctx, userID)
+		if err != nil {
+			return err
+		}
+		notifications, err := s.notificationService.ListNotifications(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		export = model.NewDataExport(user, crushes, crushHistory, matchHistory, notifications, s.now())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// GetStatus はユーザーの登録・好きな人・マッチングの状況を返す
+// 未登録の場合もエラーにはせず、User が nil の UserStatus を返す
+func (s *userService) GetStatus(ctx context.Context, userID string) (*model.UserStatus, error) {
+	user, err := s.userRepo.FindByLineID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return &model.UserStatus{}, nil
+	}
+
+	crushes, err := s.crushRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list crushes: %w", err)
+	}
+
+	status := &model.UserStatus{User: user, Crushes: crushes}
+	if user.IsMatched() {
+		partner, err := s.userRepo.FindByLineID(ctx, user.MatchedWithUserID.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find matched user: %w", err)
+		}
+		if partner != nil {
+			status.MatchedUserName = partner.Name
+		}
+	}
+	return status, nil
+}
+
+// Unmatch はマッチングを解除し、マッチング相手の好きな人の登録を取り消す
+//
+// 登録を残すと、次に登録した時にまたマッチングしてしまうため取り消す。
+// 相手には解除の通知を送信する（解除した本人には返信・LIFF画面で伝えるため送信しない）。
+// すべて1つのトランザクション内で行う。
+//
+// 登録されていない場合は ErrUserNotFound、マッチングしていない場合は ErrNotMatched を返す
+func (s *userService) Unmatch(ctx context.Context, userID string) (partnerName string, err error) {
+	err = s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.FindByLineID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to find user: %w", err)
+		}
+		if user == nil {
+			return ErrUserNotFound
+		}
+		if !user.IsMatched() {
+			return ErrNotMatched
+		}
+
+		_, partner, err := s.matchingService.UnmatchUsers(ctx, user.LineID, user.MatchedWithUserID.String)
+		if err != nil {
+			return fmt.Errorf("failed to unmatch users: %w", err)
+		}
+		partnerName = partner.Name
+
+		crushes, err := s.crushRepo.ListByUserID(ctx, user.LineID)
+		if err != nil {
+			return fmt.Errorf("failed to list crushes: %w", err)
+		}
+		if crush := model.FindCrush(crushes, partner.IdentityKey(), partner.Birthday); crush != nil {
+			if err := s.removeCrush(ctx, crush); err != nil {
+				return err
+			}
+		}
+
+		return s.notificationService.EnqueuePartnerUnmatchedNotification(ctx, partner.LineID, user.Name)
+	})
+	if err != nil {
+		return "", err
+	}
+	return partnerName, nil
+}
+
+// ForceUnmatch は運営（管理API）がマッチングを解除し、2人がお互いを登録した好きな人の登録を取り消す
+//
+// 登録を残すと、次に登録した時にまたマッチングしてしまうため両方とも取り消す。
+// 両方のユーザーに解除の通知を送信する。すべて1つのトランザクション内で行う。
+//
+// 登録されていない場合は ErrUserNotFound、マッチングしていない場合は ErrNotMatched を返す
+func (s *userService) ForceUnmatch(ctx context.Context, userID string) (partner *model.User, err error) {
+	err = s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.FindByLineID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to find user: %w", err)
+		}
+		if user == nil {
+			return ErrUserNotFound
+		}
+		if !user.IsMatched() {
+			return ErrNotMatched
+		}
+
+		user, partner, err = s.matchingService.UnmatchUsers(ctx, user.LineID, user.MatchedWithUserID.String)
+		if err != nil {
+			return fmt.Errorf("failed to unmatch users: %w", err)
+		}
+
+		for _, pair := range [][2]*model.User{{user, partner}, {partner, user}} {
+			owner, other := pair[0], pair[1]
+			crushes, err := s.crushRepo.ListByUserID(ctx, owner.LineID)
+			if err != nil {
+				return fmt.Errorf("failed to list crushes: %w", err)
+			}
+			if crush := model.FindCrush(crushes, other.IdentityKey(), other.Birthday); crush != nil {
+				if err := s.removeCrush(ctx, crush); err != nil {
+					return err
+				}
+			}
+			if err := s.notificationService.EnqueueAdminUnmatchNotification(ctx, owner.LineID, other.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return partner, nil
+}
+
+// WithdrawCrush は好きな人の登録を取り消す
+//
+// confirmUnmatch: マッチング中の相手の登録を取り消す場合、trueならマッチング解除して取り消し、falseならエラーを返す
+// マッチングを解除した場合は相手に解除の通知を送信する。すべて1つのトランザクション内で行う。
+//
+// 登録されていない場合は ErrUserNotFound、好きな人が見つからない場合は ErrCrushNotFound を返す
+func (s *userService) WithdrawCrush(ctx context.Context, userID string, crushID int64, confirmUnmatch bool) (crushName string, err error) {
+	err = s.userRepo.WithTx(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.FindByLineID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to find user: %w", err)
+		}
+		if user == nil {
+			return ErrUserNotFound
+		}
+
+		crushes, err := s.crushRepo.ListByUserID(ctx, user.LineID)
+		if err != nil {
+			return fmt.Errorf("failed to list crushes: %w", err)
+		}
+		i := slices.IndexFunc(crushes, func(c *model.Crush) bool { return c.ID == crushID })
+		if i < 0 {
+			return ErrCrushNotFound
+		}
+		crush := crushes[i]
+		crushName = crush.Name
+
+		// マッチング中の相手の登録を取り消す場合はマッチングも解除する
+		if user.IsMatched() {
+			partner, err := s.userRepo.FindByLineID(ctx, user.MatchedWithUserID.String)
+			if err != nil {
+				return fmt.Errorf("failed to find matched user: %w", err)
+			}
+			if partner != nil && crush.IsSamePerson(partner.IdentityKey(), partner.Birthday) {
+				if !confirmUnmatch {
+					return &MatchedUserExistsError{MatchedUserName: partner.Name}
+				}
+				if _, _, err := s.matchingService.UnmatchUsers(ctx, user.LineID, partner.LineID); err != nil {
+					return fmt.Errorf("failed to unmatch users: %w", err)
+				}
+				if err := s.notificationService.EnqueuePartnerCrushWithdrawnNotification(ctx, partner.LineID, user.Name); err != nil {
+					return err
+				}
+			}
+		}
+
+		return s.removeCrush(ctx, crush)
+	})
+	if err != nil {
+		return "", err
+	}
+	return crushName, nil
+}
+
+// リッチメニュー・クイックリプライのポストバックのアクション
+// データはクエリ文字列の形式（例: action=withdraw_crush&crush_id=1&confirm=1）
+const (
+	postbackActionUnmatch       = "unmatch"
+	postbackActionWithdrawCrush = "withdraw_crush"
+)
+
+// maxQuickReplyItems はLINEのクイックリプライの最大件数
+const maxQuickReplyItems = 13
+
+// ProcessPostback はリッチメニュー・クイックリプライのポストバックへの応答メッセージを決定する
+// 未対応のアクションの場合は nil を返す（返信しない）
+func (s *userService) ProcessPostback(ctx context.Context, userID, data string) (*model.Reply, error) {
+	values, err := url.ParseQuery(data)
+	if err != nil {
+		log.Printf("Invalid postback data %q: %v", data, err)
+		return nil, nil
+	}
+	confirmed := values.Get("confirm") == "1"
+
+	switch values.Get("action") {
+	case postbackActionUnmatch:
+		return s.processUnmatchPostback(ctx, userID, confirmed)
+	case postbackActionWithdrawCrush:
+		if values.Has("crush_id") {
+			crushID, err := strconv.ParseInt(values.Get("crush_id"), 10, 64)
+			if err != nil {
+				log.Printf("Invalid crush_id in postback data %q: %v", data, err)
+				return &model.Reply{Text: message.CrushNotFound}, nil
+			}
+			return s.processWithdrawCrushPostback(ctx, userID, crushID, confirmed)
+		}
+		return s.processWithdrawCrushSelectPostback(ctx, userID)
+	default:
+		log.Printf("Unknown postback data %q", data)
+		return nil, nil
+	}
+}
+
+// processUnmatchPostback はマッチング解除のポストバックへの応答メッセージを決定する
+// confirmed: false なら確認メッセージを返し、true ならマッチングを解除する
+func (s *userService) processUnmatchPostback(ctx context.Context, userID string, confirmed bool) (*model.Reply, error) {
+	if !confirmed {
+		status, err := s.GetStatus(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if status.User == nil {
+			return s.unregisteredReply(), nil
+		}
+		if !status.User.IsMatched() {
+			return &model.Reply{Text: message.NotMatched}, nil
+		}
+		return &model.Reply{
+			Text: message.UnmatchConfirmPrompt(status.MatchedUserName),
+			QuickReplies: []model.QuickReply{
+				{Label: "解除する", PostbackData: "action=" + postbackActionUnmatch + "&confirm=1"},
+			},
+		}, nil
+	}
+
+	partnerName, err := s.Unmatch(ctx, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrUserNotFound):
+			return s.unregisteredReply(), nil
+		case errors.Is(err, ErrNotMatched):
+			return &model.Reply{Text: message.NotMatched}, nil
+		}
+		return nil, err
+	}
+	return &model.Reply{Text: message.UnmatchComplete(partnerName)}, nil
+}
+
+// processWithdrawCrushSelectPostback は取り消す好きな人をクイックリプライで選んでもらう
+func (s *userService) processWithdrawCrushSelectPostback(ctx context.Context, userID string) (*model.Reply, error) {
+	status, err := s.GetStatus(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if status.User == nil {
+		return s.unregisteredReply(), nil
+	}
+	if len(status.Crushes) == 0 {
+		return &model.Reply{Text: message.NoCrushToWithdraw}, nil
+	}
+
+	reply := &model.Reply{Text: message.CrushWithdrawSelectPrompt}
+	for _, crush := range status.Crushes {
+		if len(reply.QuickReplies) == maxQuickReplyItems {
+			break
+		}
+		reply.QuickReplies = append(reply.QuickReplies, model.QuickReply{
+			Label:        crush.Name,
+			PostbackData: fmt.Sprintf("action=%s&crush_id=%d", postbackActionWithdrawCrush, crush.ID),
+		})
 	}
+	return reply, nil
+}
 
+// processWithdrawCrushPostback は選ばれた好きな人の登録を取り消す
+// マッチング中の相手の場合は、確認してからマッチング解除して取り消す
+func (s *userService) processWithdrawCrushPostback(ctx context.Context, userID string, crushID int64, confirmed bool) (*model.Reply, error) {
+	crushName, err := s.WithdrawCrush(ctx, userID, crushID, confirmed)
+	if err != nil {
+		var matchedErr *MatchedUserExistsError
+		switch {
+		case errors.As(err, &matchedErr):
+			return &model.Reply{
+				Text: message.CrushWithdrawUnmatchWarning(matchedErr.MatchedUserName),
+				QuickReplies: []model.QuickReply{
+					{Label: "取り消す", PostbackData: fmt.Sprintf("action=%s&crush_id=%d&confirm=1", postbackActionWithdrawCrush, crushID)},
+				},
+			}, nil
+		case errors.Is(err, ErrUserNotFound):
+			return s.unregisteredReply(), nil
+		case errors.Is(err, ErrCrushNotFound):
+			return &model.Reply{Text: message.CrushNotFound}, nil
+		}
+		return nil, err
+	}
+	return &model.Reply{Text: message.CrushWithdrawn(crushName)}, nil
+}
+
+// unregisteredReply は未登録のユーザーへの、ユーザー登録フォームの案内
+func (s *userService) unregisteredReply() *model.Reply {
+	return &model.Reply{
+		Text:         message.UnregisteredUserPrompt,
+		QuickReplies: []model.QuickReply{{Label: "登録する", URL: s.userLiffURL}},
+	}
+}
+
+// removeCrush は好きな人の登録を削除し、取り消しの履歴を残す
+func (s *userService) removeCrush(ctx context.Context, crush *model.Crush) error {
+	if err := s.crushRepo.Remove(ctx, crush.UserLineID, crush.ID); err != nil {
+		return fmt.Errorf("failed to remove crush: %w", err)
+	}
+	if err := s.crushChangeRepo.RecordHistory(ctx, &model.CrushHistory{
+		UserLineID: crush.UserLineID,
+		Name:       crush.Name,
+		AltName:    crush.AltName,
+		Birthday:   crush.Birthday,
+		Action:     model.CrushRemoved,
+		ChangedAt:  s.now(),
+	}); err != nil {
+		return fmt.Errorf("failed to record crush history: %w", err)
+	}
 	return nil
 }
 
 // ProcessFollowEvent はFollowイベント時の挨拶メッセージ（QuickReply付き）を送信する
-func (s *userService) ProcessFollowEvent(ctx context.Context, replyToken string) error {
-	return s.notificationService.SendFollowGreeting(ctx, replyToken, s.userLiffURL)
+// 退会したユーザーが友達追加し直した場合は、登録し直すよう案内する
+func (s *userService) ProcessFollowEvent(ctx context.Context, userID, replyToken string) error {
+	isReturning, err := s.userRepo.IsDeleted(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to check whether %s has withdrawn: %v", userID, err)
+		isReturning = false
+	}
+	return s.notificationService.SendFollowGreeting(ctx, replyToken, s.userLiffURL, isReturning)
 }
 
 // ProcessJoinEvent はグループに招待された時の挨拶メッセージを送信する
@@ -236,91 +1040,129 @@ func (s *userService) ProcessJoinEvent(ctx context.Context, replyToken string) e
 // handleMatchedStateBeforeUpdate はマッチング中チェックと解除処理を行う
 //
 // confirmUnmatch: マッチング中の場合、trueならマッチング解除、falseならエラーを返す
-// 戻り値: マッチング解除が必要かつ実行された場合はtrue
-func (s *userService) handleMatchedStateBeforeUpdate(ctx context.Context, user *model.User, confirmUnmatch bool) error {
+// 戻り値: マッチング解除した場合は解除した相手（通知は enqueueRegistrationResult で記録する）
+func (s *userService) handleMatchedStateBeforeUpdate(ctx context.Context, user *model.User, confirmUnmatch bool) (*model.User, error) {
+	if !user.IsMatched() {
+		return nil, nil
+	}
+
 	// マッチング中かチェック
-	if user.IsMatched() && !confirmUnmatch {
+	if !confirmUnmatch {
 		// 相手のユーザー情報を取得
 		matchedUser, err := s.userRepo.FindByLineID(ctx, user.MatchedWithUserID.String)
 		if err != nil {
 			log.Printf("Failed to find matched user: %v", err)
-			return ErrMatchedUserExists
+			return nil, ErrMatchedUserExists
 		}
 		if matchedUser == nil {
 			log.Printf("Matched user not found: %s", user.MatchedWithUserID.String)
-			return ErrMatchedUserExists
+			return nil, ErrMatchedUserExists
 		}
 		// 相手の名前を含むカスタムエラーを返す
-		return &MatchedUserExistsError{
+		return nil, &MatchedUserExistsError{
 			MatchedUserName: matchedUser.Name,
 		}
 	}
 
-	// マッチング解除処理
-	if user.IsMatched() && confirmUnmatch {
-		if err := s.unmatchUsers(ctx, user, user.MatchedWithUserID.String); err != nil {
-			log.Printf("Failed to unmatch users: %v", err)
-			// エラーをログに記録するが、処理は継続
-		}
+	// マッチング解除処理（呼び出し元のトランザクションに参加する）
+	updatedInitiator, updatedPartner, err := s.matchingService.UnmatchUsers(ctx, user.LineID, user.MatchedWithUserID.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmatch users: %w", err)
 	}
 
-	return nil
+	// user を更新された値で上書き（呼び出し元が保持しているポインタを更新）
+	*user = *updatedInitiator
+
+	return updatedPartner, nil
 }
 
-// checkAndNotifyMatch はマッチング判定を行い、マッチした場合は両方に通知を送信する
-//
-// 戻り値:
-//   - matched: マッチングが成立したかどうか
-//   - matchedUser: マッチング相手のUserオブジェクト（マッチング成立時のみ）
-//   - err: エラー（あれば）
-func (s *userService) checkAndNotifyMatch(ctx context.Context, user *model.User) (matched bool, matchedUser *model.User, err error) {
+// checkMatch はマッチング判定を行い、マッチした場合は相手を返す（通知は送信しない）
+func (s *userService) checkMatch(ctx context.Context, user *model.User, crushes []*model.Crush) (*model.User, error) {
 	// 好きな人が登録されていない場合はスキップ
-	if !user.HasCrush() {
-		return false, nil, nil
+	if len(crushes) == 0 {
+		return nil, nil
 	}
 
-	// マッチング判定
-	matched, matchedUser, err = s.matchingService.CheckAndUpdateMatch(ctx, user)
+	matched, matchedUser, err := s.matchingService.CheckAndUpdateMatch(ctx, user)
 	if err != nil {
-		log.Printf("Matching check failed for %s: %v", user.LineID, err)
-		return false, nil, nil
+		return nil, fmt.Errorf("failed to check match: %w", err)
+	}
+	if !matched {
+		return nil, nil
+	}
+	return matchedUser, nil
+}
+
+// enqueueRegistrationResult はマッチング解除・成立の通知を送信待ちとして記録する
+//
+// 呼び出し元のトランザクションに参加するため、記録に失敗した場合は登録ごとロールバックされる
+// （マッチングしたのに通知されない状態を作らない）。送信は NotificationDispatcher が行う。
+func (s *userService) enqueueRegistrationResult(ctx context.Context, user *model.User, result registrationResult) error {
+	// マッチング解除した場合、両方のユーザーに解除通知を送信
+	if partner := result.unmatchedPartner; partner != nil {
+		if err := s.notificationService.EnqueueUnmatchNotification(ctx, user.LineID, partner.Name, true); err != nil {
+			return err
+		}
+		if err := s.notificationService.EnqueueUnmatchNotification(ctx, partner.LineID, user.Name, false); err != nil {
+			return err
+		}
 	}
 
 	// マッチした場合、両方のユーザーにLINE通知を送信
-	if matched {
+	if matchedUser := result.matchedUser; matchedUser != nil {
 		// 現在のユーザーに通知
-		if err := s.notificationService.SendMatchNotification(ctx, user.LineID, matchedUser.Name); err != nil {
-			log.Printf("Failed to send match notification to %s: %v", user.LineID, err)
+		if err := s.notificationService.EnqueueMatchNotification(ctx, user.LineID, matchedUser.Name); err != nil {
+			return err
 		}
-
 		// 相手ユーザーに通知
-		if err := s.notificationService.SendMatchNotification(ctx, matchedUser.LineID, user.Name); err != nil {
-			log.Printf("Failed to send match notification to %s: %v", matchedUser.LineID, err)
+		if err := s.notificationService.EnqueueMatchNotification(ctx, matchedUser.LineID, user.Name); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	return matched, matchedUser, nil
+// normalizeNames は名前と別の表記をそれぞれの表記のネームポリシーで揃える
+// 別の表記が名前と同じ人の名前（同じキー）になる場合は登録する意味がないため空にする
+func normalizeNames(name, altName string) (string, string) {
+	name = model.NormalizeName(name)
+	altName = model.NormalizeName(altName)
+	if altName != "" && model.SameName(name, altName) {
+		altName = ""
+	}
+	return name, altName
 }
 
-// unmatchUsers はマッチングを解除し、両方のユーザーに通知を送信する
-func (s *userService) unmatchUsers(ctx context.Context, initiatorUser *model.User, partnerUserID string) error {
-	// MatchingService でマッチング解除
-	updatedInitiator, updatedPartner, err := s.matchingService.UnmatchUsers(ctx, initiatorUser.LineID, partnerUserID)
-	if err != nil {
-		return err
+// validateNames は名前と別の表記（空なら検証しない）を検証し、無効なら表記に合うメッセージの ValidationError を返す
+func validateNames(name, altName string) error {
+	if err := model.ValidateName(name); err != nil {
+		return &ValidationError{Message: nameErrorMessage(err)}
 	}
+	if altName == "" {
+		return nil
+	}
+	if err := model.ValidateName(altName); err != nil {
+		return &ValidationError{Message: message.AltNameError(nameErrorMessage(err))}
+	}
+	return nil
+}
 
-	// initiatorUser を更新された値で上書き（UserService が保持しているポインタを更新）
-	*initiatorUser = *updatedInitiator
+// nameErrorMessages は表記ごとの名前のバリデーションエラーメッセージ
+var nameErrorMessages = map[model.NameScript]struct{ length, chars string }{
+	model.NameScriptKatakana: {message.KatakanaNameLengthError, message.KatakanaNameCharsError},
+	model.NameScriptKanji:    {message.KanjiNameLengthError, message.KanjiNameCharsError},
+	model.NameScriptLatin:    {message.LatinNameLengthError, message.LatinNameCharsError},
+}
 
-	// 両方のユーザーに解除通知を送信
-	if err := s.notificationService.SendUnmatchNotification(ctx, updatedInitiator.LineID, updatedPartner.Name, true); err != nil {
-		log.Printf("Failed to send unmatch notification to initiator %s: %v", updatedInitiator.LineID, err)
+// nameErrorMessage は名前のバリデーションエラーを、表記と違反した規則に合うメッセージに変換する
+func nameErrorMessage(err error) string {
+	var nameErr *model.NameError
+	if !errors.As(err, &nameErr) {
+		return err.Error()
 	}
-
-	if err := s.notificationService.SendUnmatchNotification(ctx, updatedPartner.LineID, updatedInitiator.Name, false); err != nil {
-		log.Printf("Failed to send unmatch notification to partner %s: %v", updatedPartner.LineID, err)
+	messages := nameErrorMessages[nameErr.Script]
+	if nameErr.Violation == model.NameInvalidChars {
+		return messages.chars
 	}
-
-	return nil
+	return messages.length
 }
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/morinonusi421/cupid/internal/message"
@@ -18,11 +21,34 @@ import (
 // ProcessTextMessage のテスト
 // ========================================
 
+// testCrushChangePolicy はテストで使う好きな人の登録し直しの制限
+var testCrushChangePolicy = CrushChangePolicy{
+	DailyLimit:  10,
+	MaxDistinct: 3,
+	Window:      30 * 24 * time.Hour,
+	Cooldown:    time.Minute,
+}
+
+// testAgePolicy はテストで使う年齢による登録の制限
+var testAgePolicy = AgePolicy{
+	MinAge:   13,
+	MaxGap:   10,
+	AdultAge: 18,
+}
+
+// allowCrushChanges は好きな人の登録し直しが制限されていない状態にする（登録の履歴なし）
+func allowCrushChanges(m *repositorymocks.MockCrushChangeRepository) {
+	m.EXPECT().CountOn(mock.Anything, mock.Anything, mock.Anything).Return(0, nil).Maybe()
+	m.EXPECT().Increment(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	m.EXPECT().ListHistorySince(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	m.EXPECT().RecordHistory(mock.Anything, mock.Anything).Return(nil).Maybe()
+}
+
 func TestUserService_ProcessTextMessage(t *testing.T) {
 	tests := []struct {
 		name              string
 		userID            string
-		mockSetup         func(*repositorymocks.MockUserRepository)
+		mockSetup         func(*repositorymocks.MockUserRepository, *repositorymocks.MockCrushRepository)
 		expectedReplyText string
 		expectedQuickURL  string
 		expectedQuickLabel string
@@ -31,7 +57,7 @@ func TestUserService_ProcessTextMessage(t *testing.T) {
 		{
 			name:   "ユーザー未登録 - ユーザー登録フォームを案内",
 			userID: "U-new",
-			mockSetup: func(m *repositorymocks.MockUserRepository) {
+			mockSetup: func(m *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository) {
 				m.EXPECT().FindByLineID(mock.Anything, "U-new").Return(nil, nil)
 			},
 			expectedReplyText:  message.UnregisteredUserPrompt,
@@ -42,13 +68,14 @@ func TestUserService_ProcessTextMessage(t *testing.T) {
 		{
 			name:   "ユーザー登録済み、好きな人未登録 - 好きな人登録フォームを案内",
 			userID: "U-alice",
-			mockSetup: func(m *repositorymocks.MockUserRepository) {
+			mockSetup: func(m *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository) {
 				m.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
 					LineID:   "U-alice",
 					Name:     "アリス",
 					Birthday: "1990-01-01",
-					// CrushName未設定
 				}, nil)
+				// 好きな人未登録
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{}, nil)
 			},
 			expectedReplyText:  message.RegistrationStep1Prompt,
 			expectedQuickURL:   "https://liff.example.com/crush",
@@ -58,13 +85,14 @@ func TestUserService_ProcessTextMessage(t *testing.T) {
 		{
 			name:   "全て登録済み - 登録完了メッセージ",
 			userID: "U-alice",
-			mockSetup: func(m *repositorymocks.MockUserRepository) {
+			mockSetup: func(m *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository) {
 				m.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
-					LineID:        "U-alice",
-					Name:          "アリス",
-					Birthday:      "1990-01-01",
-					CrushName:     null.StringFrom("ボブ"),
-					CrushBirthday: null.StringFrom("1995-05-05"),
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
 				}, nil)
 			},
 			expectedReplyText:  message.AlreadyRegisteredMessage,
@@ -75,7 +103,7 @@ func TestUserService_ProcessTextMessage(t *testing.T) {
 		{
 			name:   "DBエラー",
 			userID: "U-alice",
-			mockSetup: func(m *repositorymocks.MockUserRepository) {
+			mockSetup: func(m *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository) {
 				m.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, errors.New("db error"))
 			},
 			expectedReplyText:  "",
@@ -88,20 +116,28 @@ func TestUserService_ProcessTextMessage(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
 			mockMatchingService := servicemocks.NewMockMatchingService(t)
 			mockNotificationService := servicemocks.NewMockNotificationService(t)
 
-			tt.mockSetup(mockRepo)
+			tt.mockSetup(mockRepo, mockCrushRepo)
 
 			service := NewUserService(
 				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
 				"https://liff.example.com/user",
 				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
 				mockMatchingService,
 				mockNotificationService,
 			)
 
-			replyText, quickURL, quickLabel, err := service.ProcessTextMessage(context.Background(), tt.userID)
+			replyText, quickURL, quickLabel, err := service.ProcessTextMessage(context.Background(), tt.userID, "こんにちは")
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -120,13 +156,17 @@ func TestUserService_ProcessTextMessage(t *testing.T) {
 // ========================================
 
 func TestUserService_RegisterUser(t *testing.T) {
+	// 今日の時点で10歳（最低年齢未満）の誕生日
+	childBirthday := model.Birthday(time.Now().AddDate(-10, 0, 0).Format(model.BirthdayLayout))
+
 	tests := []struct {
 		name                  string
 		userID                string
 		userName              string
-		birthday              string
+		altName               string
+		birthday              model.Birthday
 		confirmUnmatch        bool
-		mockSetup             func(*repositorymocks.MockUserRepository, *servicemocks.MockMatchingService, *servicemocks.MockNotificationService)
+		mockSetup             func(*repositorymocks.MockUserRepository, *repositorymocks.MockCrushRepository, *servicemocks.MockMatchingService, *servicemocks.MockNotificationService)
 		expectedIsFirstReg    bool
 		expectedError         bool
 		expectedErrorContains string
@@ -137,9 +177,9 @@ func TestUserService_RegisterUser(t *testing.T) {
 			userName:       "アリス",
 			birthday:       "1990-01-01",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				// 重複チェック
-				repo.EXPECT().FindByNameAndBirthday(mock.Anything, "アリス", "1990-01-01").Return(nil, nil)
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("アリス", ""), model.Birthday("1990-01-01")).Return(nil, nil)
 				// ユーザー検索（未登録）
 				repo.EXPECT().FindByLineID(mock.Anything, "U-new").Return(nil, nil)
 				// 新規作成
@@ -153,27 +193,106 @@ func TestUserService_RegisterUser(t *testing.T) {
 			expectedError:      false,
 		},
 		{
-			name:           "バリデーションエラー - 名前が不正（漢字）",
+			name:           "初回登録 - ひらがな・空白はカタカナに揃えて登録する",
+			userID:         "U-new",
+			userName:       "あり す",
+			birthday:       "1990-01-01",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("アリス", ""), model.Birthday("1990-01-01")).Return(nil, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-new").Return(nil, nil)
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
+					return u.LineID == "U-new" && u.Name == "アリス"
+				})).Return(nil)
+				notif.EXPECT().SendCrushRegistrationPrompt(mock.Anything, "U-new", "https://liff.example.com/crush").Return(nil)
+			},
+			expectedIsFirstReg: true,
+			expectedError:      false,
+		},
+		{
+			name:           "初回登録 - 漢字の名前とローマ字の別の表記",
+			userID:         "U-new",
+			userName:       "山田 太郎",
+			altName:        "Taro  Yamada",
+			birthday:       "1990-01-01",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				// 重複チェックはどちらの表記でも行う
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("山田太郎", "Taro Yamada"), model.Birthday("1990-01-01")).Return(nil, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-new").Return(nil, nil)
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
+					return u.Name == "山田太郎" && u.AltName == "Taro Yamada"
+				})).Return(nil)
+				notif.EXPECT().SendCrushRegistrationPrompt(mock.Anything, "U-new", "https://liff.example.com/crush").Return(nil)
+			},
+			expectedIsFirstReg: true,
+			expectedError:      false,
+		},
+		{
+			name:           "初回登録 - 名前と同じ別の表記は登録しない",
+			userID:         "U-new",
+			userName:       "アリス",
+			altName:        "ありす",
+			birthday:       "1990-01-01",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("アリス", ""), model.Birthday("1990-01-01")).Return(nil, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-new").Return(nil, nil)
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
+					return u.Name == "アリス" && u.AltName == ""
+				})).Return(nil)
+				notif.EXPECT().SendCrushRegistrationPrompt(mock.Anything, "U-new", "https://liff.example.com/crush").Return(nil)
+			},
+			expectedIsFirstReg: true,
+			expectedError:      false,
+		},
+		{
+			name:           "バリデーションエラー - 名前が不正（数字）",
 			userID:         "U-new",
-			userName:       "山田太郎",
+			userName:       "ヤマダ123",
 			birthday:       "1990-01-01",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				// バリデーションで弾かれるため、DB操作は行われない
 			},
 			expectedIsFirstReg:    false,
 			expectedError:         true,
 			expectedErrorContains: "名前は全角カタカナ",
 		},
+		{
+			name:           "バリデーションエラー - 漢字の名前が不正（記号）",
+			userID:         "U-new",
+			userName:       "山田★",
+			birthday:       "1990-01-01",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			},
+			expectedIsFirstReg:    false,
+			expectedError:         true,
+			expectedErrorContains: "漢字の名前は漢字・ひらがな・カタカナで入力してください",
+		},
+		{
+			name:           "バリデーションエラー - 別の表記が不正（ローマ字の数字）",
+			userID:         "U-new",
+			userName:       "ヤマダタロウ",
+			altName:        "Taro2",
+			birthday:       "1990-01-01",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			},
+			expectedIsFirstReg:    false,
+			expectedError:         true,
+			expectedErrorContains: "別の表記：ローマ字の名前は英字で入力してください",
+		},
 		{
 			name:           "重複エラー - 他人が同じ名前・誕生日",
 			userID:         "U-new",
 			userName:       "アリス",
 			birthday:       "1990-01-01",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				// 他人が見つかる
-				repo.EXPECT().FindByNameAndBirthday(mock.Anything, "アリス", "1990-01-01").Return(&model.User{
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("アリス", ""), model.Birthday("1990-01-01")).Return(&model.User{
 					LineID:   "U-other",
 					Name:     "アリス",
 					Birthday: "1990-01-01",
@@ -189,15 +308,17 @@ func TestUserService_RegisterUser(t *testing.T) {
 			userName:       "アリスタロウ",
 			birthday:       "1990-12-25",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				// 重複チェック
-				repo.EXPECT().FindByNameAndBirthday(mock.Anything, "アリスタロウ", "1990-12-25").Return(nil, nil)
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("アリスタロウ", ""), model.Birthday("1990-12-25")).Return(nil, nil)
 				// ユーザー検索（既存）
 				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
 					LineID:   "U-alice",
 					Name:     "アリス",
 					Birthday: "1990-01-01",
 				}, nil)
+				// 好きな人未登録
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(nil, nil)
 				// 更新
 				repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
 					return u.LineID == "U-alice" && u.Name == "アリスタロウ" && u.Birthday == "1990-12-25"
@@ -209,15 +330,39 @@ func TestUserService_RegisterUser(t *testing.T) {
 			expectedIsFirstReg: false,
 			expectedError:      false,
 		},
+		{
+			name:           "更新 - マッチング判定に失敗した場合は更新もエラーにする",
+			userID:         "U-alice",
+			userName:       "アリスタロウ",
+			birthday:       "1990-12-25",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("アリスタロウ", ""), model.Birthday("1990-12-25")).Return(nil, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+				}, nil)
+				repo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+				// 判定のエラーはログに残して無視せず、更新ごとロールバックする（完了メッセージは送信しない）
+				matching.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(false, nil, errors.New("db error"))
+			},
+			expectedIsFirstReg:    false,
+			expectedError:         true,
+			expectedErrorContains: "failed to check match",
+		},
 		{
 			name:           "更新 - マッチング中エラー（confirmUnmatch=false）",
 			userID:         "U-alice",
 			userName:       "アリスタロウ",
 			birthday:       "1990-12-25",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				// 重複チェック
-				repo.EXPECT().FindByNameAndBirthday(mock.Anything, "アリスタロウ", "1990-12-25").Return(nil, nil)
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("アリスタロウ", ""), model.Birthday("1990-12-25")).Return(nil, nil)
 				// ユーザー検索（マッチング中）
 				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
 					LineID:            "U-alice",
@@ -225,6 +370,9 @@ func TestUserService_RegisterUser(t *testing.T) {
 					Birthday:          "1990-01-01",
 					MatchedWithUserID: null.StringFrom("U-bob"),
 				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+				}, nil)
 				// 相手の情報を取得
 				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(&model.User{
 					LineID:   "U-bob",
@@ -236,25 +384,89 @@ func TestUserService_RegisterUser(t *testing.T) {
 			expectedError:         true,
 			expectedErrorContains: "matched user exists",
 		},
+		{
+			name:           "更新 - 自己登録エラー（好きな人の1人と同じ名前・誕生日）",
+			userID:         "U-alice",
+			userName:       "チャーリー",
+			birthday:       "1992-03-15",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("チャーリー", ""), model.Birthday("1992-03-15")).Return(nil, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+					{ID: 2, UserLineID: "U-alice", Name: "チャーリー", Birthday: "1992-03-15"},
+				}, nil)
+			},
+			expectedIsFirstReg:    false,
+			expectedError:         true,
+			expectedErrorContains: "cannot register yourself",
+		},
+		{
+			name:           "年齢エラー - 最低年齢未満",
+			userID:         "U-new",
+			userName:       "アリス",
+			birthday:       childBirthday,
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				// 年齢の確認で弾かれるため、DB操作は行われない
+			},
+			expectedIsFirstReg:    false,
+			expectedError:         true,
+			expectedErrorContains: "under_minimum_age",
+		},
+		{
+			name:           "更新 - 年齢エラー（登録済みの好きな人との年齢差が上限を超える誕生日に変更）",
+			userID:         "U-alice",
+			userName:       "アリス",
+			birthday:       "1975-01-01",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByNameAndBirthday(mock.Anything, model.NewIdentityKey("アリス", ""), model.Birthday("1975-01-01")).Return(nil, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+				}, nil)
+			},
+			expectedIsFirstReg:    false,
+			expectedError:         true,
+			expectedErrorContains: "age_gap_too_large",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
 			mockMatchingService := servicemocks.NewMockMatchingService(t)
 			mockNotificationService := servicemocks.NewMockNotificationService(t)
 
-			tt.mockSetup(mockRepo, mockMatchingService, mockNotificationService)
+			tt.mockSetup(mockRepo, mockCrushRepo, mockMatchingService, mockNotificationService)
 
 			service := NewUserService(
 				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
 				"https://liff.example.com/user",
 				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
 				mockMatchingService,
 				mockNotificationService,
 			)
 
-			isFirst, err := service.RegisterUser(context.Background(), tt.userID, tt.userName, tt.birthday, tt.confirmUnmatch)
+			isFirst, err := service.RegisterUser(context.Background(), tt.userID, tt.userName, tt.altName, tt.birthday, tt.confirmUnmatch)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -274,13 +486,17 @@ func TestUserService_RegisterUser(t *testing.T) {
 // ========================================
 
 func TestUserService_RegisterCrush(t *testing.T) {
+	// 今日の時点で15歳（未成年）の誕生日
+	minorBirthday := model.Birthday(time.Now().AddDate(-15, 0, 0).Format(model.BirthdayLayout))
+
 	tests := []struct {
 		name                       string
 		userID                     string
 		crushName                  string
-		crushBirthday              string
+		crushAltName               string
+		crushBirthday              model.Birthday
 		confirmUnmatch             bool
-		mockSetup                  func(*repositorymocks.MockUserRepository, *servicemocks.MockMatchingService, *servicemocks.MockNotificationService)
+		mockSetup                  func(*repositorymocks.MockUserRepository, *repositorymocks.MockCrushRepository, *servicemocks.MockMatchingService, *servicemocks.MockNotificationService)
 		expectedMatched            bool
 		expectedIsFirstCrushReg    bool
 		expectedError              bool
@@ -292,17 +508,18 @@ func TestUserService_RegisterCrush(t *testing.T) {
 			crushName:      "ボブ",
 			crushBirthday:  "1995-05-05",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				// ユーザー検索
 				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
 					LineID:   "U-alice",
 					Name:     "アリス",
 					Birthday: "1990-01-01",
-					// CrushName未設定（初回登録）
 				}, nil)
-				// 更新
-				repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
-					return u.LineID == "U-alice" && u.CrushName.String == "ボブ" && u.CrushBirthday.String == "1995-05-05"
+				// 好きな人未登録（初回登録）
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{}, nil)
+				// 追加
+				crush.EXPECT().Add(mock.Anything, mock.MatchedBy(func(c *model.Crush) bool {
+					return c.UserLineID == "U-alice" && c.Name == "ボブ" && c.Birthday == "1995-05-05"
 				})).Return(nil)
 				// マッチング判定（マッチなし）
 				matching.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(false, nil, nil)
@@ -314,23 +531,50 @@ func TestUserService_RegisterCrush(t *testing.T) {
 			expectedError:           false,
 		},
 		{
-			name:           "再登録 - 正常系（マッチなし）",
+			name:           "初回登録 - 漢字の名前とカタカナの別の表記",
+			userID:         "U-alice",
+			crushName:      "山田花子",
+			crushAltName:   "やまだはなこ",
+			crushBirthday:  "1995-05-05",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{}, nil)
+				// 別の表記はその表記のネームポリシーで揃えて登録する
+				crush.EXPECT().Add(mock.Anything, mock.MatchedBy(func(c *model.Crush) bool {
+					return c.Name == "山田花子" && c.AltName == "ヤマダハナコ"
+				})).Return(nil)
+				matching.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(false, nil, nil)
+				notif.EXPECT().SendCrushRegistrationComplete(mock.Anything, "U-alice", true).Return(nil)
+			},
+			expectedMatched:         false,
+			expectedIsFirstCrushReg: true,
+			expectedError:           false,
+		},
+		{
+			name:           "追加登録 - 正常系（マッチなし）",
 			userID:         "U-alice",
 			crushName:      "チャーリー",
 			crushBirthday:  "1992-03-15",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
-				// ユーザー検索（既に好きな人登録済み）
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				// ユーザー検索
 				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
-					LineID:        "U-alice",
-					Name:          "アリス",
-					Birthday:      "1990-01-01",
-					CrushName:     null.StringFrom("ボブ"),
-					CrushBirthday: null.StringFrom("1995-05-05"),
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
 				}, nil)
-				// 更新
-				repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
-					return u.LineID == "U-alice" && u.CrushName.String == "チャーリー"
+				// 既に好きな人登録済み
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+				}, nil)
+				// 追加
+				crush.EXPECT().Add(mock.Anything, mock.MatchedBy(func(c *model.Crush) bool {
+					return c.UserLineID == "U-alice" && c.Name == "チャーリー"
 				})).Return(nil)
 				// マッチング判定（マッチなし）
 				matching.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(false, nil, nil)
@@ -347,15 +591,16 @@ func TestUserService_RegisterCrush(t *testing.T) {
 			crushName:      "ボブ",
 			crushBirthday:  "1995-05-05",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				// ユーザー検索
 				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
 					LineID:   "U-alice",
 					Name:     "アリス",
 					Birthday: "1990-01-01",
 				}, nil)
-				// 更新
-				repo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{}, nil)
+				// 追加
+				crush.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
 				// マッチング判定（マッチあり）
 				matching.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(true, &model.User{
 					LineID:   "U-bob",
@@ -363,26 +608,78 @@ func TestUserService_RegisterCrush(t *testing.T) {
 					Birthday: "1995-05-05",
 				}, nil)
 				// マッチ通知（両方）
-				notif.EXPECT().SendMatchNotification(mock.Anything, "U-alice", "ボブ").Return(nil)
-				notif.EXPECT().SendMatchNotification(mock.Anything, "U-bob", "アリス").Return(nil)
+				notif.EXPECT().EnqueueMatchNotification(mock.Anything, "U-alice", "ボブ").Return(nil)
+				notif.EXPECT().EnqueueMatchNotification(mock.Anything, "U-bob", "アリス").Return(nil)
 			},
 			expectedMatched:         true,
 			expectedIsFirstCrushReg: true,
 			expectedError:           false,
 		},
 		{
-			name:           "バリデーションエラー - 名前が不正（ひらがな）",
+			name:           "マッチング中 - confirmUnmatch=trueで解除して再登録",
+			userID:         "U-alice",
+			crushName:      "チャーリー",
+			crushBirthday:  "1992-03-15",
+			confirmUnmatch: true,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				// ユーザー検索（マッチング中）
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:            "U-alice",
+					Name:              "アリス",
+					Birthday:          "1990-01-01",
+					MatchedWithUserID: null.StringFrom("U-bob"),
+				}, nil)
+				// マッチング解除
+				matching.EXPECT().UnmatchUsers(mock.Anything, "U-alice", "U-bob").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, &model.User{
+					LineID:   "U-bob",
+					Name:     "ボブ",
+					Birthday: "1995-05-05",
+				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+				}, nil)
+				// 追加
+				crush.EXPECT().Add(mock.Anything, mock.MatchedBy(func(c *model.Crush) bool {
+					return c.UserLineID == "U-alice" && c.Name == "チャーリー"
+				})).Return(nil)
+				// マッチング判定（マッチなし）
+				matching.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(false, nil, nil)
+				// 解除通知（両方）の記録と登録完了メッセージ
+				notif.EXPECT().EnqueueUnmatchNotification(mock.Anything, "U-alice", "ボブ", true).Return(nil)
+				notif.EXPECT().EnqueueUnmatchNotification(mock.Anything, "U-bob", "アリス", false).Return(nil)
+				notif.EXPECT().SendCrushRegistrationComplete(mock.Anything, "U-alice", false).Return(nil)
+			},
+			expectedMatched:         false,
+			expectedIsFirstCrushReg: false,
+			expectedError:           false,
+		},
+		{
+			name:           "マッチング解除後のバリデーションエラー - ロールバックされ通知は送信しない",
 			userID:         "U-alice",
-			crushName:      "やまだはなこ",
+			crushName:      "ハナコ123",
 			crushBirthday:  "1995-05-05",
-			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
-				// ユーザー検索
+			confirmUnmatch: true,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:            "U-alice",
+					Name:              "アリス",
+					Birthday:          "1990-01-01",
+					MatchedWithUserID: null.StringFrom("U-bob"),
+				}, nil)
+				matching.EXPECT().UnmatchUsers(mock.Anything, "U-alice", "U-bob").Return(&model.User{
 					LineID:   "U-alice",
 					Name:     "アリス",
 					Birthday: "1990-01-01",
+				}, &model.User{
+					LineID:   "U-bob",
+					Name:     "ボブ",
+					Birthday: "1995-05-05",
 				}, nil)
+				// 解除通知は送信されない（mockに期待値を設定しない）
 			},
 			expectedMatched:         false,
 			expectedIsFirstCrushReg: false,
@@ -390,110 +687,1385 @@ func TestUserService_RegisterCrush(t *testing.T) {
 			expectedErrorContains:   "名前は全角カタカナ",
 		},
 		{
-			name:           "自己登録エラー",
+			name:           "登録済みの相手を再送信 - 追加せずマッチング判定のみ",
 			userID:         "U-alice",
-			crushName:      "アリス",
-			crushBirthday:  "1990-01-01",
+			crushName:      "ボブ",
+			crushBirthday:  "1995-05-05",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
-				// ユーザー検索
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
 					LineID:   "U-alice",
 					Name:     "アリス",
 					Birthday: "1990-01-01",
 				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+				}, nil)
+				// Add は呼ばれない
+				matching.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(false, nil, nil)
+				notif.EXPECT().SendCrushRegistrationComplete(mock.Anything, "U-alice", false).Return(nil)
 			},
 			expectedMatched:         false,
 			expectedIsFirstCrushReg: false,
-			expectedError:           true,
-			expectedErrorContains:   "cannot register yourself",
+			expectedError:           false,
 		},
 		{
-			name:           "マッチング中エラー（confirmUnmatch=false）",
+			name:           "マッチング判定に失敗した場合は登録もエラーにする",
 			userID:         "U-alice",
 			crushName:      "チャーリー",
 			crushBirthday:  "1992-03-15",
 			confirmUnmatch: false,
-			mockSetup: func(repo *repositorymocks.MockUserRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
-				// ユーザー検索（マッチング中）
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
 				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
-					LineID:            "U-alice",
-					Name:              "アリス",
-					Birthday:          "1990-01-01",
-					CrushName:         null.StringFrom("ボブ"),
-					CrushBirthday:     null.StringFrom("1995-05-05"),
-					MatchedWithUserID: null.StringFrom("U-bob"),
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
 				}, nil)
-				// 相手の情報を取得
-				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(&model.User{
-					LineID:   "U-bob",
-					Name:     "ボブ",
-					Birthday: "1995-05-05",
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
 				}, nil)
+				crush.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+				// 判定のエラーはログに残して無視せず、追加ごとロールバックする（完了メッセージは送信しない）
+				matching.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(false, nil, errors.New("db error"))
 			},
 			expectedMatched:         false,
 			expectedIsFirstCrushReg: false,
 			expectedError:           true,
-			expectedErrorContains:   "matched user exists",
+			expectedErrorContains:   "failed to check match",
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			mockRepo := repositorymocks.NewMockUserRepository(t)
-			mockMatchingService := servicemocks.NewMockMatchingService(t)
-			mockNotificationService := servicemocks.NewMockNotificationService(t)
-
-			tt.mockSetup(mockRepo, mockMatchingService, mockNotificationService)
-
-			service := NewUserService(
-				mockRepo,
-				"https://liff.example.com/user",
-				"https://liff.example.com/crush",
-				mockMatchingService,
-				mockNotificationService,
-			)
-
-			matched, isFirstCrushReg, err := service.RegisterCrush(context.Background(), tt.userID, tt.crushName, tt.crushBirthday, tt.confirmUnmatch)
-
-			if tt.expectedError {
-				assert.Error(t, err)
-				if tt.expectedErrorContains != "" {
-					assert.Contains(t, err.Error(), tt.expectedErrorContains)
-				}
-			} else {
-				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedMatched, matched)
-				assert.Equal(t, tt.expectedIsFirstCrushReg, isFirstCrushReg)
-			}
-		})
-	}
-}
-
-// ========================================
-// ProcessFollowEvent のテスト
-// ========================================
-
-func TestUserService_ProcessFollowEvent(t *testing.T) {
-	tests := []struct {
-		name          string
-		replyToken    string
-		mockSetup     func(*servicemocks.MockNotificationService)
-		expectedError bool
-	}{
 		{
-			name:       "正常系",
-			replyToken: "reply-token-123",
-			mockSetup: func(notif *servicemocks.MockNotificationService) {
-				notif.EXPECT().SendFollowGreeting(mock.Anything, "reply-token-123", "https://liff.example.com/user").Return(nil)
+			name:           "上限エラー - 好きな人の登録数が上限に達している",
+			userID:         "U-alice",
+			crushName:      "デイブ",
+			crushBirthday:  "1993-04-04",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+					{ID: 2, UserLineID: "U-alice", Name: "チャーリー", Birthday: "1992-03-15"},
+					{ID: 3, UserLineID: "U-alice", Name: "エレン", Birthday: "1994-06-06"},
+				}, nil)
+			},
+			expectedMatched:         false,
+			expectedIsFirstCrushReg: false,
+			expectedError:           true,
+			expectedErrorContains:   "crush limit reached",
+		},
+		{
+			name:           "バリデーションエラー - 名前が不正（数字）",
+			userID:         "U-alice",
+			crushName:      "ハナコ123",
+			crushBirthday:  "1995-05-05",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				// ユーザー検索
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+			},
+			expectedMatched:         false,
+			expectedIsFirstCrushReg: false,
+			expectedError:           true,
+			expectedErrorContains:   "名前は全角カタカナ",
+		},
+		{
+			name:           "自己登録エラー - 別の表記が自分の別の表記と一致",
+			userID:         "U-alice",
+			crushName:      "有栖",
+			crushAltName:   "ALICE",
+			crushBirthday:  "1990-01-01",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					AltName:  "Alice",
+					Birthday: "1990-01-01",
+				}, nil)
+			},
+			expectedMatched:         false,
+			expectedIsFirstCrushReg: false,
+			expectedError:           true,
+			expectedErrorContains:   "cannot register yourself",
+		},
+		{
+			name:           "自己登録エラー",
+			userID:         "U-alice",
+			crushName:      "アリス",
+			crushBirthday:  "1990-01-01",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				// ユーザー検索
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+			},
+			expectedMatched:         false,
+			expectedIsFirstCrushReg: false,
+			expectedError:           true,
+			expectedErrorContains:   "cannot register yourself",
+		},
+		{
+			name:           "年齢エラー - 年齢差が上限を超える",
+			userID:         "U-alice",
+			crushName:      "ボブ",
+			crushBirthday:  "1970-01-01",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+			},
+			expectedMatched:         false,
+			expectedIsFirstCrushReg: false,
+			expectedError:           true,
+			expectedErrorContains:   "age_gap_too_large",
+		},
+		{
+			name:           "年齢エラー - 成人が未成年を登録",
+			userID:         "U-alice",
+			crushName:      "ボブ",
+			crushBirthday:  minorBirthday,
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:   "U-alice",
+					Name:     "アリス",
+					Birthday: "1990-01-01",
+				}, nil)
+			},
+			expectedMatched:         false,
+			expectedIsFirstCrushReg: false,
+			expectedError:           true,
+			expectedErrorContains:   "minor_adult_crush",
+		},
+		{
+			name:           "マッチング中エラー（confirmUnmatch=false）",
+			userID:         "U-alice",
+			crushName:      "チャーリー",
+			crushBirthday:  "1992-03-15",
+			confirmUnmatch: false,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				// ユーザー検索（マッチング中）
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:            "U-alice",
+					Name:              "アリス",
+					Birthday:          "1990-01-01",
+					MatchedWithUserID: null.StringFrom("U-bob"),
+				}, nil)
+				// 相手の情報を取得
+				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(&model.User{
+					LineID:   "U-bob",
+					Name:     "ボブ",
+					Birthday: "1995-05-05",
+				}, nil)
+			},
+			expectedMatched:         false,
+			expectedIsFirstCrushReg: false,
+			expectedError:           true,
+			expectedErrorContains:   "matched user exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			allowCrushChanges(mockCrushChangeRepo)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			tt.mockSetup(mockRepo, mockCrushRepo, mockMatchingService, mockNotificationService)
+
+			service := NewUserService(
+				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
+				"https://liff.example.com/user",
+				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
+				mockMatchingService,
+				mockNotificationService,
+			)
+
+			matched, isFirstCrushReg, err := service.RegisterCrush(context.Background(), tt.userID, tt.crushName, tt.crushAltName, tt.crushBirthday, tt.confirmUnmatch)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				if tt.expectedErrorContains != "" {
+					assert.Contains(t, err.Error(), tt.expectedErrorContains)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedMatched, matched)
+				assert.Equal(t, tt.expectedIsFirstCrushReg, isFirstCrushReg)
+			}
+		})
+	}
+}
+
+func TestUserService_RegisterCrush_DailyChangeLimit(t *testing.T) {
+	// 日本時間 2026-03-01 23:30
+	now := time.Date(2026, 3, 1, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		countToday    int
+		expectedError bool
+	}{
+		{name: "上限未満なら登録して回数を増やす", countToday: testCrushChangePolicy.DailyLimit - 1},
+		{name: "上限に達している場合は登録しない", countToday: testCrushChangePolicy.DailyLimit, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			mockRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}, nil)
+			mockCrushRepo.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{}, nil)
+			mockCrushChangeRepo.EXPECT().ListHistorySince(mock.Anything, "U-alice", mock.Anything).Return(nil, nil)
+			mockCrushChangeRepo.EXPECT().CountOn(mock.Anything, "U-alice", "2026-03-01").Return(tt.countToday, nil)
+			if !tt.expectedError {
+				mockCrushChangeRepo.EXPECT().Increment(mock.Anything, "U-alice", "2026-03-01").Return(nil)
+				mockCrushChangeRepo.EXPECT().RecordHistory(mock.Anything, mock.Anything).Return(nil)
+				mockCrushRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+				mockMatchingService.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(false, nil, nil)
+				mockNotificationService.EXPECT().SendCrushRegistrationComplete(mock.Anything, "U-alice", true).Return(nil)
+			}
+
+			s := NewUserService(
+				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
+				"https://liff.example.com/user",
+				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
+				mockMatchingService,
+				mockNotificationService,
+			).(*userService)
+			s.now = func() time.Time { return now }
+
+			_, _, err := s.RegisterCrush(context.Background(), "U-alice", "ボブ", "", "1995-05-05", false)
+
+			if tt.expectedError {
+				var limitErr *CrushChangeLimitReachedError
+				assert.ErrorAs(t, err, &limitErr)
+				assert.ErrorIs(t, err, ErrCrushChangeLimitReached)
+				assert.Equal(t, testCrushChangePolicy.DailyLimit, limitErr.Limit)
+				// 日本時間の翌日0時から登録できる
+				assert.True(t, limitErr.RetryAt.Equal(time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUserService_RegisterCrush_AddAltName(t *testing.T) {
+	// 日本時間 2026-03-01 21:00
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	added := func(name string, birthday model.Birthday, ago time.Duration) *model.CrushHistory {
+		return &model.CrushHistory{UserLineID: "U-alice", Name: name, Birthday: birthday, Action: model.CrushAdded, ChangedAt: now.Add(-ago)}
+	}
+
+	tests := []struct {
+		name            string
+		existingAltName string
+		histories       []*model.CrushHistory
+		expectSetAlt    bool
+		expectedReason  CrushChangeRestriction
+	}{
+		{
+			name:         "別の表記が未登録なら、制限を確認して追加し、登録回数に数えて履歴を残す",
+			histories:    []*model.CrushHistory{added("キヨウコ", "1995-05-05", 10*24*time.Hour)},
+			expectSetAlt: true,
+		},
+		{
+			name:            "別の表記が登録済みなら変更しない",
+			existingAltName: "Kyoko",
+		},
+		{
+			// 別の表記は新しい名前として数える（制限を避けて別の名前を試せないようにする）
+			name: "期間内に上限の人数を登録している場合は追加しない",
+			histories: []*model.CrushHistory{
+				added("キヨウコ", "1995-05-05", 10*24*time.Hour),
+				added("デイブ", "1997-07-07", 5*24*time.Hour),
+				added("エレン", "1998-08-08", 24*time.Hour),
+			},
+			expectedReason: CrushChangeDistinctLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			mockRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}, nil)
+			mockCrushRepo.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+				{ID: 7, UserLineID: "U-alice", Name: "キヨウコ", AltName: tt.existingAltName, Birthday: "1995-05-05"},
+			}, nil)
+			if tt.existingAltName == "" {
+				mockCrushChangeRepo.EXPECT().ListHistorySince(mock.Anything, "U-alice", now.Add(-testCrushChangePolicy.Window)).Return(tt.histories, nil)
+			}
+			if tt.expectSetAlt {
+				mockCrushChangeRepo.EXPECT().CountOn(mock.Anything, "U-alice", "2026-03-01").Return(1, nil)
+				mockCrushChangeRepo.EXPECT().Increment(mock.Anything, "U-alice", "2026-03-01").Return(nil)
+				mockCrushRepo.EXPECT().SetAltName(mock.Anything, "U-alice", int64(7), "キョウコ").Return(nil)
+				mockCrushChangeRepo.EXPECT().RecordHistory(mock.Anything, &model.CrushHistory{
+					UserLineID: "U-alice",
+					Name:       "キヨウコ",
+					AltName:    "キョウコ",
+					Birthday:   "1995-05-05",
+					Action:     model.CrushAdded,
+					ChangedAt:  now,
+				}).Return(nil)
+			}
+			if tt.expectedReason == "" {
+				mockMatchingService.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
+					return u.LineID == "U-alice"
+				})).Return(false, nil, nil)
+				mockNotificationService.EXPECT().SendCrushRegistrationComplete(mock.Anything, "U-alice", false).Return(nil)
+			}
+
+			s := NewUserService(
+				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
+				"https://liff.example.com/user",
+				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
+				mockMatchingService,
+				mockNotificationService,
+			).(*userService)
+			s.now = func() time.Time { return now }
+
+			matched, _, err := s.RegisterCrush(context.Background(), "U-alice", "キヨウコ", "キョウコ", "1995-05-05", false)
+
+			if tt.expectedReason != "" {
+				var restrictedErr *CrushChangeRestrictedError
+				assert.ErrorAs(t, err, &restrictedErr)
+				assert.Equal(t, tt.expectedReason, restrictedErr.Reason)
+				return
+			}
+			assert.NoError(t, err)
+			assert.False(t, matched)
+		})
+	}
+}
+
+func TestUserService_RegisterCrush_ChangePolicy(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	added := func(name string, birthday model.Birthday, ago time.Duration) *model.CrushHistory {
+		return &model.CrushHistory{UserLineID: "U-alice", Name: name, Birthday: birthday, Action: model.CrushAdded, ChangedAt: now.Add(-ago)}
+	}
+	withAlt := func(h *model.CrushHistory, altName string) *model.CrushHistory {
+		h.AltName = altName
+		return h
+	}
+
+	tests := []struct {
+		name            string
+		crushAltName    string
+		histories       []*model.CrushHistory
+		expectedReason  CrushChangeRestriction
+		expectedRetryAt time.Time
+	}{
+		{
+			name:      "履歴がなければ登録できる",
+			histories: nil,
+		},
+		{
+			name:            "前回の登録から間隔が空いていない",
+			histories:       []*model.CrushHistory{added("キャロル", "1996-06-06", 30*time.Second)},
+			expectedReason:  CrushChangeCooldown,
+			expectedRetryAt: now.Add(30 * time.Second),
+		},
+		{
+			name: "期間内に上限の人数を登録している",
+			histories: []*model.CrushHistory{
+				added("キャロル", "1996-06-06", 10*24*time.Hour),
+				added("デイブ", "1997-07-07", 5*24*time.Hour),
+				added("キャロル", "1996-06-06", 3*24*time.Hour),
+				added("エレン", "1998-08-08", 24*time.Hour),
+			},
+			expectedReason: CrushChangeDistinctLimit,
+			// 最も前に登録した相手（デイブ、キャロルは登録し直している）が期間外になれば登録できる
+			expectedRetryAt: now.Add(-5*24*time.Hour + testCrushChangePolicy.Window),
+		},
+		{
+			name: "期間内に登録したことのある相手なら人数を増やさない",
+			histories: []*model.CrushHistory{
+				added("ボブ", "1995-05-05", 10*24*time.Hour),
+				added("デイブ", "1997-07-07", 5*24*time.Hour),
+				added("エレン", "1998-08-08", 24*time.Hour),
+			},
+		},
+		{
+			name:         "前に登録した名前を別の表記に入れても、別の人として数える",
+			crushAltName: "キャロル",
+			histories: []*model.CrushHistory{
+				added("キャロル", "1995-05-05", 10*24*time.Hour),
+				added("デイブ", "1997-07-07", 5*24*time.Hour),
+				added("エレン", "1998-08-08", 24*time.Hour),
+			},
+			expectedReason:  CrushChangeDistinctLimit,
+			expectedRetryAt: now.Add(-10*24*time.Hour + testCrushChangePolicy.Window),
+		},
+		{
+			name:         "別の表記を付けた登録は表記ごとに数える",
+			crushAltName: "Bob",
+			histories: []*model.CrushHistory{
+				added("キャロル", "1996-06-06", 10*24*time.Hour),
+				added("デイブ", "1997-07-07", 5*24*time.Hour),
+			},
+			expectedReason:  CrushChangeDistinctLimit,
+			expectedRetryAt: now.Add(-10*24*time.Hour + testCrushChangePolicy.Window),
+		},
+		{
+			name:         "登録したことのある相手でも、新しい別の表記は数える",
+			crushAltName: "Robert",
+			histories: []*model.CrushHistory{
+				withAlt(added("ボブ", "1995-05-05", 10*24*time.Hour), "Bob"),
+				added("デイブ", "1997-07-07", 5*24*time.Hour),
+			},
+			expectedReason:  CrushChangeDistinctLimit,
+			expectedRetryAt: now.Add(-10*24*time.Hour + testCrushChangePolicy.Window),
+		},
+		{
+			name:         "登録したことのある相手を同じ別の表記で登録し直すなら人数を増やさない",
+			crushAltName: "Bob",
+			histories: []*model.CrushHistory{
+				withAlt(added("ボブ", "1995-05-05", 10*24*time.Hour), "Bob"),
+				added("デイブ", "1997-07-07", 5*24*time.Hour),
+				added("エレン", "1998-08-08", 24*time.Hour),
+			},
+		},
+		{
+			name: "取り消しの履歴は人数に数えない",
+			histories: []*model.CrushHistory{
+				added("キャロル", "1996-06-06", 10*24*time.Hour),
+				added("デイブ", "1997-07-07", 5*24*time.Hour),
+				{UserLineID: "U-alice", Name: "エレン", Birthday: "1998-08-08", Action: model.CrushRemoved, ChangedAt: now.Add(-time.Second)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			mockRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}, nil)
+			mockCrushRepo.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{}, nil)
+			mockCrushChangeRepo.EXPECT().ListHistorySince(mock.Anything, "U-alice", now.Add(-testCrushChangePolicy.Window)).Return(tt.histories, nil)
+			if tt.expectedReason == "" {
+				mockCrushChangeRepo.EXPECT().CountOn(mock.Anything, "U-alice", mock.Anything).Return(0, nil)
+				mockCrushChangeRepo.EXPECT().Increment(mock.Anything, "U-alice", mock.Anything).Return(nil)
+				mockCrushRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+				mockCrushChangeRepo.EXPECT().RecordHistory(mock.Anything, &model.CrushHistory{
+					UserLineID: "U-alice",
+					Name:       "ボブ",
+					AltName:    tt.crushAltName,
+					Birthday:   "1995-05-05",
+					Action:     model.CrushAdded,
+					ChangedAt:  now,
+				}).Return(nil)
+				mockMatchingService.EXPECT().CheckAndUpdateMatch(mock.Anything, mock.Anything).Return(false, nil, nil)
+				mockNotificationService.EXPECT().SendCrushRegistrationComplete(mock.Anything, "U-alice", true).Return(nil)
+			}
+
+			s := NewUserService(
+				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
+				"https://liff.example.com/user",
+				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
+				mockMatchingService,
+				mockNotificationService,
+			).(*userService)
+			s.now = func() time.Time { return now }
+
+			_, _, err := s.RegisterCrush(context.Background(), "U-alice", "ボブ", tt.crushAltName, "1995-05-05", false)
+
+			if tt.expectedReason == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var restrictedErr *CrushChangeRestrictedError
+			assert.ErrorAs(t, err, &restrictedErr)
+			assert.ErrorIs(t, err, ErrCrushChangeRestricted)
+			assert.Equal(t, tt.expectedReason, restrictedErr.Reason)
+			assert.True(t, restrictedErr.RetryAt.Equal(tt.expectedRetryAt), "expected retry at %v, got %v", tt.expectedRetryAt, restrictedErr.RetryAt)
+		})
+	}
+}
+
+// ========================================
+// UpdateLineDisplayName のテスト
+// ========================================
+
+func TestUserService_UpdateLineDisplayName(t *testing.T) {
+	tests := []struct {
+		name          string
+		displayName   string
+		mockSetup     func(*repositorymocks.MockUserRepository)
+		expectedError bool
+	}{
+		{
+			name:        "正常系",
+			displayName: "たろう",
+			mockSetup: func(repo *repositorymocks.MockUserRepository) {
+				repo.EXPECT().UpdateLineDisplayName(mock.Anything, "U-test", "たろう").Return(nil)
+			},
+		},
+		{
+			name:        "表示名が空の場合は更新しない",
+			displayName: "",
+			mockSetup:   func(repo *repositorymocks.MockUserRepository) {},
+		},
+		{
+			name:        "異常系 - DBエラー",
+			displayName: "たろう",
+			mockSetup: func(repo *repositorymocks.MockUserRepository) {
+				repo.EXPECT().UpdateLineDisplayName(mock.Anything, "U-test", "たろう").Return(errors.New("db error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			tt.mockSetup(mockRepo)
+
+			service := NewUserService(
+				mockRepo,
+				repositorymocks.NewMockCrushRepository(t),
+				repositorymocks.NewMockCrushChangeRepository(t),
+				"https://liff.example.com/user",
+				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
+				servicemocks.NewMockMatchingService(t),
+				servicemocks.NewMockNotificationService(t),
+			)
+
+			err := service.UpdateLineDisplayName(context.Background(), "U-test", tt.displayName)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// ========================================
+// DeleteAccount のテスト
+// ========================================
+
+func TestUserService_DeleteAccount(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(*repositorymocks.MockUserRepository, *repositorymocks.MockCrushRepository, *repositorymocks.MockCrushChangeRepository, *servicemocks.MockMatchingService, *servicemocks.MockNotificationService)
+		expectedError error
+	}{
+		{
+			name: "正常系 - マッチングしていない",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}, nil)
+				crush.EXPECT().RemoveAll(mock.Anything, "U-alice").Return(nil)
+				changes.EXPECT().DeleteHistoryBefore(mock.Anything, "U-alice", mock.Anything).Return(nil)
+				matching.EXPECT().DeleteMatchHistory(mock.Anything, "U-alice").Return(nil)
+				notif.EXPECT().DiscardPendingNotifications(mock.Anything, "U-alice").Return(nil)
+				repo.EXPECT().Delete(mock.Anything, "U-alice").Return(nil)
+			},
+		},
+		{
+			name: "正常系 - マッチング中なら解除して相手に通知する",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:            "U-alice",
+					Name:              "アリス",
+					Birthday:          "1990-01-01",
+					MatchedWithUserID: null.StringFrom("U-bob"),
+				}, nil)
+				matching.EXPECT().UnmatchUsers(mock.Anything, "U-alice", "U-bob").Return(
+					&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"},
+					&model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"},
+					nil,
+				)
+				notif.EXPECT().EnqueuePartnerWithdrawnNotification(mock.Anything, "U-bob", "アリス").Return(nil)
+				crush.EXPECT().RemoveAll(mock.Anything, "U-alice").Return(nil)
+				changes.EXPECT().DeleteHistoryBefore(mock.Anything, "U-alice", mock.Anything).Return(nil)
+				matching.EXPECT().DeleteMatchHistory(mock.Anything, "U-alice").Return(nil)
+				notif.EXPECT().DiscardPendingNotifications(mock.Anything, "U-alice").Return(nil)
+				repo.EXPECT().Delete(mock.Anything, "U-alice").Return(nil)
+			},
+		},
+		{
+			name: "異常系 - 未登録",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, nil)
+			},
+			expectedError: ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			tt.mockSetup(mockRepo, mockCrushRepo, mockCrushChangeRepo, mockMatchingService, mockNotificationService)
+
+			service := NewUserService(
+				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
+				"https://liff.example.com/user",
+				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
+				mockMatchingService,
+				mockNotificationService,
+			)
+
+			err := service.DeleteAccount(context.Background(), "U-alice")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUserService_ProcessTextMessage_Withdraw(t *testing.T) {
+	tests := []struct {
+		name              string
+		text              string
+		registered        bool
+		requested         bool // withdrawConfirmWindow 以内に「退会」と送信済みか
+		expectedReplyText string
+	}{
+		{name: "「退会」は確認メッセージを返す", text: "退会", registered: true, expectedReplyText: message.WithdrawConfirmPrompt},
+		{name: "「退会」の後の「退会する」で退会する", text: " 退会する\n", registered: true, requested: true, expectedReplyText: message.WithdrawComplete},
+		{name: "「退会」と送信せずに「退会する」と送信したら確認メッセージを返す", text: "退会する", registered: true, expectedReplyText: message.WithdrawConfirmPrompt},
+		{name: "未登録なら退会の必要はない", text: "退会", expectedReplyText: message.WithdrawNotRegistered},
+		{name: "未登録で「退会する」", text: "退会する", expectedReplyText: message.WithdrawNotRegistered},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+			if tt.registered {
+				mockRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}, nil)
+			} else {
+				mockRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, nil)
+			}
+			if tt.registered && tt.text != "退会" {
+				mockRepo.EXPECT().WithdrawalRequestedSince(mock.Anything, "U-alice", now.Add(-withdrawConfirmWindow)).Return(tt.requested, nil)
+			}
+			if tt.expectedReplyText == message.WithdrawConfirmPrompt {
+				mockRepo.EXPECT().RequestWithdrawal(mock.Anything, "U-alice", now).Return(nil)
+			}
+			if tt.expectedReplyText == message.WithdrawComplete {
+				mockCrushRepo.EXPECT().RemoveAll(mock.Anything, "U-alice").Return(nil)
+				mockCrushChangeRepo.EXPECT().DeleteHistoryBefore(mock.Anything, "U-alice", now.Add(-testCrushChangePolicy.historyRetention())).Return(nil)
+				mockMatchingService.EXPECT().DeleteMatchHistory(mock.Anything, "U-alice").Return(nil)
+				mockNotificationService.EXPECT().DiscardPendingNotifications(mock.Anything, "U-alice").Return(nil)
+				mockRepo.EXPECT().Delete(mock.Anything, "U-alice").Return(nil)
+			}
+
+			service := NewUserService(
+				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
+				"https://liff.example.com/user",
+				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
+				mockMatchingService,
+				mockNotificationService,
+			).(*userService)
+			service.now = func() time.Time { return now }
+
+			replyText, quickURL, _, err := service.ProcessTextMessage(context.Background(), "U-alice", tt.text)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedReplyText, replyText)
+			assert.Empty(t, quickURL)
+		})
+	}
+}
+
+func TestUserService_PruneWithdrawnHistory(t *testing.T) {
+	mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockCrushChangeRepo.EXPECT().PruneWithdrawnHistory(mock.Anything, now.Add(-testCrushChangePolicy.Window)).Return(2, nil)
+
+	s := NewUserService(nil, nil, mockCrushChangeRepo, "", "", 3, testCrushChangePolicy, testAgePolicy, nil, nil).(*userService)
+	s.now = func() time.Time { return now }
+
+	n, err := s.PruneWithdrawnHistory(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+}
+
+// ========================================
+// GetStatus のテスト
+// ========================================
+
+func TestUserService_GetStatus(t *testing.T) {
+	tests := []struct {
+		name                    string
+		mockSetup               func(*repositorymocks.MockUserRepository, *repositorymocks.MockCrushRepository)
+		expectedRegistered      bool
+		expectedCrushes         int
+		expectedMatchedUserName string
+	}{
+		{
+			name: "未登録",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, nil)
+			},
+		},
+		{
+			name: "登録済み - マッチングしていない",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{{Name: "ボブ", Birthday: "1995-05-05"}}, nil)
+			},
+			expectedRegistered: true,
+			expectedCrushes:    1,
+		},
+		{
+			name: "登録済み - マッチング中は相手の名前を返す",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+					LineID:            "U-alice",
+					Name:              "アリス",
+					Birthday:          "1990-01-01",
+					MatchedWithUserID: null.StringFrom("U-bob"),
+				}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{{Name: "ボブ", Birthday: "1995-05-05"}}, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(&model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}, nil)
+			},
+			expectedRegistered:      true,
+			expectedCrushes:         1,
+			expectedMatchedUserName: "ボブ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			tt.mockSetup(mockRepo, mockCrushRepo)
+
+			service := NewUserService(mockRepo, mockCrushRepo, repositorymocks.NewMockCrushChangeRepository(t), "https://liff.example.com/user", "https://liff.example.com/crush", 3, testCrushChangePolicy, testAgePolicy, servicemocks.NewMockMatchingService(t), servicemocks.NewMockNotificationService(t))
+
+			status, err := service.GetStatus(context.Background(), "U-alice")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRegistered, status.User != nil)
+			assert.Len(t, status.Crushes, tt.expectedCrushes)
+			assert.Equal(t, tt.expectedMatchedUserName, status.MatchedUserName)
+		})
+	}
+}
+
+// ========================================
+// ExportData のテスト
+// ========================================
+
+func TestUserService_ExportData(t *testing.T) {
+	exportedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("正常系 - 登録データ一式を返す", func(t *testing.T) {
+		mockRepo := repositorymocks.NewMockUserRepository(t)
+		allowWithTx(mockRepo)
+		mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+		mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+		mockMatchingService := servicemocks.NewMockMatchingService(t)
+		mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+		mockRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{
+			LineID:            "U-alice",
+			Name:              "アリス",
+			Birthday:          "1990-01-01",
+			MatchedWithUserID: null.StringFrom("U-bob"),
+			LineDisplayName:   "alice",
+		}, nil)
+		mockCrushRepo.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+			{UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+		}, nil)
+		mockCrushChangeRepo.EXPECT().ListHistorySince(mock.Anything, "U-alice", time.Time{}).Return([]*model.CrushHistory{
+			{UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05", Action: model.CrushAdded, ChangedAt: exportedAt.Add(-time.Hour)},
+		}, nil)
+		mockMatchingService.EXPECT().ListMatchHistory(mock.Anything, "U-alice").Return([]*model.MatchHistory{
+			{UserLineID: "U-alice", PartnerLineID: "U-bob", PartnerName: "ボブ", Action: model.MatchStarted, ChangedAt: exportedAt.Add(-time.Minute)},
+		}, nil)
+		mockNotificationService.EXPECT().ListNotifications(mock.Anything, "U-alice").Return([]*model.Notification{
+			{ToUserID: "U-alice", Kind: model.NotificationMatch, Text: "マッチしました", Status: model.NotificationDelivered},
+		}, nil)
+
+		service := NewUserService(mockRepo, mockCrushRepo, mockCrushChangeRepo, "https://liff.example.com/user", "https://liff.example.com/crush", 3, testCrushChangePolicy, testAgePolicy, mockMatchingService, mockNotificationService)
+		service.(*userService).now = func() time.Time { return exportedAt }
+
+		export, err := service.ExportData(context.Background(), "U-alice")
+
+		assert.NoError(t, err)
+		assert.Equal(t, exportedAt, export.ExportedAt)
+		assert.Equal(t, "アリス", export.User.Name)
+		assert.Equal(t, "alice", export.User.LineDisplayName)
+		assert.True(t, export.User.Matched)
+		assert.Equal(t, []model.ExportedCrush{{Name: "ボブ", Birthday: "1995-05-05"}}, export.Crushes)
+		assert.Len(t, export.CrushHistory, 1)
+		assert.Equal(t, []model.ExportedMatchHistory{
+			{PartnerName: "ボブ", Action: model.MatchStarted, ChangedAt: exportedAt.Add(-time.Minute)},
+		}, export.MatchHistory)
+		assert.Len(t, export.Notifications, 1)
+
+		// 相手のLINE IDは含まない
+		data, err := json.Marshal(export)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "U-bob")
+	})
+
+	t.Run("異常系 - 未登録", func(t *testing.T) {
+		mockRepo := repositorymocks.NewMockUserRepository(t)
+		allowWithTx(mockRepo)
+		mockRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, nil)
+
+		service := NewUserService(mockRepo, repositorymocks.NewMockCrushRepository(t), repositorymocks.NewMockCrushChangeRepository(t), "https://liff.example.com/user", "https://liff.example.com/crush", 3, testCrushChangePolicy, testAgePolicy, servicemocks.NewMockMatchingService(t), servicemocks.NewMockNotificationService(t))
+
+		_, err := service.ExportData(context.Background(), "U-alice")
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestUserService_ProcessTextMessage_Export(t *testing.T) {
+	tests := []struct {
+		name              string
+		registered        bool
+		notificationText  string
+		expectedReplyText string
+		expectedQuickURL  string
+	}{
+		{name: "登録データを JSON で返す", registered: true, notificationText: "マッチしました"},
+		{name: "長すぎる場合は登録画面を案内する", registered: true, notificationText: strings.Repeat("あ", 5000), expectedReplyText: message.DataExportTooLong, expectedQuickURL: "https://liff.example.com/user"},
+		{name: "未登録", expectedReplyText: message.DataExportNotRegistered},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			if tt.registered {
+				mockRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}, nil)
+				mockCrushRepo.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(nil, nil)
+				mockCrushChangeRepo.EXPECT().ListHistorySince(mock.Anything, "U-alice", time.Time{}).Return(nil, nil)
+				mockMatchingService.EXPECT().ListMatchHistory(mock.Anything, "U-alice").Return(nil, nil)
+				mockNotificationService.EXPECT().ListNotifications(mock.Anything, "U-alice").Return([]*model.Notification{
+					{ToUserID: "U-alice", Kind: model.NotificationMatch, Text: tt.notificationText, Status: model.NotificationDelivered},
+				}, nil)
+			} else {
+				mockRepo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, nil)
+			}
+
+			service := NewUserService(mockRepo, mockCrushRepo, mockCrushChangeRepo, "https://liff.example.com/user", "https://liff.example.com/crush", 3, testCrushChangePolicy, testAgePolicy, mockMatchingService, mockNotificationService)
+
+			replyText, quickURL, _, err := service.ProcessTextMessage(context.Background(), "U-alice", "データ確認")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedQuickURL, quickURL)
+			if tt.expectedReplyText != "" {
+				assert.Equal(t, tt.expectedReplyText, replyText)
+				return
+			}
+			var export model.DataExport
+			assert.NoError(t, json.Unmarshal([]byte(replyText), &export))
+			assert.Equal(t, "アリス", export.User.Name)
+			assert.Empty(t, export.Crushes)
+			assert.NotNil(t, export.Crushes)
+			assert.Equal(t, tt.notificationText, export.Notifications[0].Text)
+		})
+	}
+}
+
+// ========================================
+// Unmatch・WithdrawCrush のテスト
+// ========================================
+
+// isCrushRemoved は好きな人の取り消しの履歴かどうかを判定する
+func isCrushRemoved(name string) func(*model.CrushHistory) bool {
+	return func(h *model.CrushHistory) bool {
+		return h.UserLineID == "U-alice" && h.Name == name && h.Action == model.CrushRemoved
+	}
+}
+
+func TestUserService_Unmatch(t *testing.T) {
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01", MatchedWithUserID: null.StringFrom("U-bob")}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+
+	tests := []struct {
+		name                string
+		mockSetup           func(*repositorymocks.MockUserRepository, *repositorymocks.MockCrushRepository, *repositorymocks.MockCrushChangeRepository, *servicemocks.MockMatchingService, *servicemocks.MockNotificationService)
+		expectedPartnerName string
+		expectedError       error
+	}{
+		{
+			name: "正常系 - 解除して相手の登録を取り消し、相手に通知する",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				matching.EXPECT().UnmatchUsers(mock.Anything, "U-alice", "U-bob").Return(alice, bob, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "キャロル", Birthday: "1992-02-02"},
+					{ID: 2, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+				}, nil)
+				crush.EXPECT().Remove(mock.Anything, "U-alice", int64(2)).Return(nil)
+				changes.EXPECT().RecordHistory(mock.Anything, mock.MatchedBy(isCrushRemoved("ボブ"))).Return(nil)
+				notif.EXPECT().EnqueuePartnerUnmatchedNotification(mock.Anything, "U-bob", "アリス").Return(nil)
+			},
+			expectedPartnerName: "ボブ",
+		},
+		{
+			name: "異常系 - マッチングしていない",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}, nil)
+			},
+			expectedError: ErrNotMatched,
+		},
+		{
+			name: "異常系 - 未登録",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, nil)
+			},
+			expectedError: ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			tt.mockSetup(mockRepo, mockCrushRepo, mockCrushChangeRepo, mockMatchingService, mockNotificationService)
+
+			service := NewUserService(mockRepo, mockCrushRepo, mockCrushChangeRepo, "https://liff.example.com/user", "https://liff.example.com/crush", 3, testCrushChangePolicy, testAgePolicy, mockMatchingService, mockNotificationService)
+
+			partnerName, err := service.Unmatch(context.Background(), "U-alice")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPartnerName, partnerName)
+		})
+	}
+}
+
+func TestUserService_ForceUnmatch(t *testing.T) {
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01", MatchedWithUserID: null.StringFrom("U-bob")}
+	unmatchedAlice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+
+	tests := []struct {
+		name            string
+		mockSetup       func(*repositorymocks.MockUserRepository, *repositorymocks.MockCrushRepository, *repositorymocks.MockCrushChangeRepository, *servicemocks.MockMatchingService, *servicemocks.MockNotificationService)
+		expectedPartner *model.User
+		expectedError   error
+	}{
+		{
+			name: "正常系 - 解除して2人の登録を取り消し、両方に通知する",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				matching.EXPECT().UnmatchUsers(mock.Anything, "U-alice", "U-bob").Return(unmatchedAlice, bob, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return([]*model.Crush{
+					{ID: 1, UserLineID: "U-alice", Name: "キャロル", Birthday: "1992-02-02"},
+					{ID: 2, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+				}, nil)
+				crush.EXPECT().Remove(mock.Anything, "U-alice", int64(2)).Return(nil)
+				changes.EXPECT().RecordHistory(mock.Anything, mock.MatchedBy(isCrushRemoved("ボブ"))).Return(nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-bob").Return([]*model.Crush{
+					{ID: 3, UserLineID: "U-bob", Name: "アリス", Birthday: "1990-01-01"},
+				}, nil)
+				crush.EXPECT().Remove(mock.Anything, "U-bob", int64(3)).Return(nil)
+				changes.EXPECT().RecordHistory(mock.Anything, mock.MatchedBy(func(h *model.CrushHistory) bool {
+					return h.UserLineID == "U-bob" && h.Name == "アリス" && h.Action == model.CrushRemoved
+				})).Return(nil)
+				notif.EXPECT().EnqueueAdminUnmatchNotification(mock.Anything, "U-alice", "ボブ").Return(nil)
+				notif.EXPECT().EnqueueAdminUnmatchNotification(mock.Anything, "U-bob", "アリス").Return(nil)
+			},
+			expectedPartner: bob,
+		},
+		{
+			name: "異常系 - マッチングしていない",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(unmatchedAlice, nil)
+			},
+			expectedError: ErrNotMatched,
+		},
+		{
+			name: "異常系 - 未登録",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, nil)
+			},
+			expectedError: ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			tt.mockSetup(mockRepo, mockCrushRepo, mockCrushChangeRepo, mockMatchingService, mockNotificationService)
+
+			service := NewUserService(mockRepo, mockCrushRepo, mockCrushChangeRepo, "https://liff.example.com/user", "https://liff.example.com/crush", 3, testCrushChangePolicy, testAgePolicy, mockMatchingService, mockNotificationService)
+
+			partner, err := service.ForceUnmatch(context.Background(), "U-alice")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPartner, partner)
+		})
+	}
+}
+
+func TestUserService_WithdrawCrush(t *testing.T) {
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01", MatchedWithUserID: null.StringFrom("U-bob")}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+	crushes := []*model.Crush{
+		{ID: 1, UserLineID: "U-alice", Name: "キャロル", Birthday: "1992-02-02"},
+		{ID: 2, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"},
+	}
+
+	tests := []struct {
+		name              string
+		crushID           int64
+		confirmUnmatch    bool
+		mockSetup         func(*repositorymocks.MockUserRepository, *repositorymocks.MockCrushRepository, *repositorymocks.MockCrushChangeRepository, *servicemocks.MockMatchingService, *servicemocks.MockNotificationService)
+		expectedCrushName string
+		expectedError     error
+		expectMatchedErr  bool
+	}{
+		{
+			name:    "正常系 - マッチング相手以外の取り消し",
+			crushID: 1,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(bob, nil)
+				crush.EXPECT().Remove(mock.Anything, "U-alice", int64(1)).Return(nil)
+				changes.EXPECT().RecordHistory(mock.Anything, mock.MatchedBy(isCrushRemoved("キャロル"))).Return(nil)
+			},
+			expectedCrushName: "キャロル",
+		},
+		{
+			name:    "異常系 - マッチング相手の取り消しは確認が必要",
+			crushID: 2,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(bob, nil)
+			},
+			expectMatchedErr: true,
+		},
+		{
+			name:           "正常系 - マッチング相手の取り消し（確認済み）は解除して相手に通知する",
+			crushID:        2,
+			confirmUnmatch: true,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(bob, nil)
+				matching.EXPECT().UnmatchUsers(mock.Anything, "U-alice", "U-bob").Return(alice, bob, nil)
+				notif.EXPECT().EnqueuePartnerCrushWithdrawnNotification(mock.Anything, "U-bob", "アリス").Return(nil)
+				crush.EXPECT().Remove(mock.Anything, "U-alice", int64(2)).Return(nil)
+				changes.EXPECT().RecordHistory(mock.Anything, mock.MatchedBy(isCrushRemoved("ボブ"))).Return(nil)
+			},
+			expectedCrushName: "ボブ",
+		},
+		{
+			name:    "異常系 - 他のユーザーの好きな人・存在しない好きな人",
+			crushID: 99,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+			},
+			expectedError: ErrCrushNotFound,
+		},
+		{
+			name:    "異常系 - 未登録",
+			crushID: 1,
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, nil)
+			},
+			expectedError: ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			tt.mockSetup(mockRepo, mockCrushRepo, mockCrushChangeRepo, mockMatchingService, mockNotificationService)
+
+			service := NewUserService(mockRepo, mockCrushRepo, mockCrushChangeRepo, "https://liff.example.com/user", "https://liff.example.com/crush", 3, testCrushChangePolicy, testAgePolicy, mockMatchingService, mockNotificationService)
+
+			crushName, err := service.WithdrawCrush(context.Background(), "U-alice", tt.crushID, tt.confirmUnmatch)
+
+			if tt.expectMatchedErr {
+				var matchedErr *MatchedUserExistsError
+				assert.ErrorAs(t, err, &matchedErr)
+				assert.Equal(t, "ボブ", matchedErr.MatchedUserName)
+				return
+			}
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCrushName, crushName)
+		})
+	}
+}
+
+func TestUserService_ProcessPostback(t *testing.T) {
+	alice := &model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01", MatchedWithUserID: null.StringFrom("U-bob")}
+	bob := &model.User{LineID: "U-bob", Name: "ボブ", Birthday: "1995-05-05"}
+	crushes := []*model.Crush{{ID: 2, UserLineID: "U-alice", Name: "ボブ", Birthday: "1995-05-05"}}
+
+	tests := []struct {
+		name                 string
+		data                 string
+		mockSetup            func(*repositorymocks.MockUserRepository, *repositorymocks.MockCrushRepository, *repositorymocks.MockCrushChangeRepository, *servicemocks.MockMatchingService, *servicemocks.MockNotificationService)
+		expectedText         string
+		expectedQuickReplies []model.QuickReply
+		expectNoReply        bool
+	}{
+		{
+			name: "マッチング解除は確認してから",
+			data: "action=unmatch",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(bob, nil)
+			},
+			expectedText:         message.UnmatchConfirmPrompt("ボブ"),
+			expectedQuickReplies: []model.QuickReply{{Label: "解除する", PostbackData: "action=unmatch&confirm=1"}},
+		},
+		{
+			name: "マッチング解除（確認済み）",
+			data: "action=unmatch&confirm=1",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				matching.EXPECT().UnmatchUsers(mock.Anything, "U-alice", "U-bob").Return(alice, bob, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+				crush.EXPECT().Remove(mock.Anything, "U-alice", int64(2)).Return(nil)
+				changes.EXPECT().RecordHistory(mock.Anything, mock.MatchedBy(isCrushRemoved("ボブ"))).Return(nil)
+				notif.EXPECT().EnqueuePartnerUnmatchedNotification(mock.Anything, "U-bob", "アリス").Return(nil)
+			},
+			expectedText: message.UnmatchComplete("ボブ"),
+		},
+		{
+			name: "マッチングしていない",
+			data: "action=unmatch",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(&model.User{LineID: "U-alice", Name: "アリス", Birthday: "1990-01-01"}, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(nil, nil)
+			},
+			expectedText: message.NotMatched,
+		},
+		{
+			name: "好きな人の取り消しは選んでもらう",
+			data: "action=withdraw_crush",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(bob, nil)
+			},
+			expectedText:         message.CrushWithdrawSelectPrompt,
+			expectedQuickReplies: []model.QuickReply{{Label: "ボブ", PostbackData: "action=withdraw_crush&crush_id=2"}},
+		},
+		{
+			name: "マッチング相手の取り消しは確認してから",
+			data: "action=withdraw_crush&crush_id=2",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+				repo.EXPECT().FindByLineID(mock.Anything, "U-bob").Return(bob, nil)
+			},
+			expectedText:         message.CrushWithdrawUnmatchWarning("ボブ"),
+			expectedQuickReplies: []model.QuickReply{{Label: "取り消す", PostbackData: "action=withdraw_crush&crush_id=2&confirm=1"}},
+		},
+		{
+			name: "取り消し済みの好きな人",
+			data: "action=withdraw_crush&crush_id=9",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(alice, nil)
+				crush.EXPECT().ListByUserID(mock.Anything, "U-alice").Return(crushes, nil)
+			},
+			expectedText: message.CrushNotFound,
+		},
+		{
+			name: "未登録なら登録を案内する",
+			data: "action=withdraw_crush",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().FindByLineID(mock.Anything, "U-alice").Return(nil, nil)
+			},
+			expectedText:         message.UnregisteredUserPrompt,
+			expectedQuickReplies: []model.QuickReply{{Label: "登録する", URL: "https://liff.example.com/user"}},
+		},
+		{
+			name: "未対応のアクションには返信しない",
+			data: "action=unknown",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, crush *repositorymocks.MockCrushRepository, changes *repositorymocks.MockCrushChangeRepository, matching *servicemocks.MockMatchingService, notif *servicemocks.MockNotificationService) {
+			},
+			expectNoReply: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
+			mockMatchingService := servicemocks.NewMockMatchingService(t)
+			mockNotificationService := servicemocks.NewMockNotificationService(t)
+
+			tt.mockSetup(mockRepo, mockCrushRepo, mockCrushChangeRepo, mockMatchingService, mockNotificationService)
+
+			service := NewUserService(mockRepo, mockCrushRepo, mockCrushChangeRepo, "https://liff.example.com/user", "https://liff.example.com/crush", 3, testCrushChangePolicy, testAgePolicy, mockMatchingService, mockNotificationService)
+
+			reply, err := service.ProcessPostback(context.Background(), "U-alice", tt.data)
+
+			assert.NoError(t, err)
+			if tt.expectNoReply {
+				assert.Nil(t, reply)
+				return
+			}
+			assert.Equal(t, tt.expectedText, reply.Text)
+			assert.Equal(t, tt.expectedQuickReplies, reply.QuickReplies)
+		})
+	}
+}
+
+// ========================================
+// ProcessFollowEvent のテスト
+// ========================================
+
+func TestUserService_ProcessFollowEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		replyToken    string
+		mockSetup     func(*repositorymocks.MockUserRepository, *servicemocks.MockNotificationService)
+		expectedError bool
+	}{
+		{
+			name:       "正常系",
+			replyToken: "reply-token-123",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().IsDeleted(mock.Anything, "U-alice").Return(false, nil)
+				notif.EXPECT().SendFollowGreeting(mock.Anything, "reply-token-123", "https://liff.example.com/user", false).Return(nil)
+			},
+			expectedError: false,
+		},
+		{
+			name:       "正常系 - 退会したユーザーが友達追加し直した",
+			replyToken: "reply-token-123",
+			mockSetup: func(repo *repositorymocks.MockUserRepository, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().IsDeleted(mock.Anything, "U-alice").Return(true, nil)
+				notif.EXPECT().SendFollowGreeting(mock.Anything, "reply-token-123", "https://liff.example.com/user", true).Return(nil)
 			},
 			expectedError: false,
 		},
 		{
 			name:       "異常系 - 通知送信失敗",
 			replyToken: "reply-token-123",
-			mockSetup: func(notif *servicemocks.MockNotificationService) {
-				notif.EXPECT().SendFollowGreeting(mock.Anything, "reply-token-123", "https://liff.example.com/user").Return(errors.New("api error"))
+			mockSetup: func(repo *repositorymocks.MockUserRepository, notif *servicemocks.MockNotificationService) {
+				repo.EXPECT().IsDeleted(mock.Anything, "U-alice").Return(false, nil)
+				notif.EXPECT().SendFollowGreeting(mock.Anything, "reply-token-123", "https://liff.example.com/user", false).Return(errors.New("api error"))
 			},
 			expectedError: true,
 		},
@@ -502,20 +2074,28 @@ func TestUserService_ProcessFollowEvent(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
 			mockMatchingService := servicemocks.NewMockMatchingService(t)
 			mockNotificationService := servicemocks.NewMockNotificationService(t)
 
-			tt.mockSetup(mockNotificationService)
+			tt.mockSetup(mockRepo, mockNotificationService)
 
 			service := NewUserService(
 				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
 				"https://liff.example.com/user",
 				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
 				mockMatchingService,
 				mockNotificationService,
 			)
 
-			err := service.ProcessFollowEvent(context.Background(), tt.replyToken)
+			err := service.ProcessFollowEvent(context.Background(), "U-alice", tt.replyToken)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -558,6 +2138,9 @@ func TestUserService_ProcessJoinEvent(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := repositorymocks.NewMockUserRepository(t)
+			allowWithTx(mockRepo)
+			mockCrushRepo := repositorymocks.NewMockCrushRepository(t)
+			mockCrushChangeRepo := repositorymocks.NewMockCrushChangeRepository(t)
 			mockMatchingService := servicemocks.NewMockMatchingService(t)
 			mockNotificationService := servicemocks.NewMockNotificationService(t)
 
@@ -565,8 +2148,13 @@ func TestUserService_ProcessJoinEvent(t *testing.T) {
 
 			service := NewUserService(
 				mockRepo,
+				mockCrushRepo,
+				mockCrushChangeRepo,
 				"https://liff.example.com/user",
 				"https://liff.example.com/crush",
+				3,
+				testCrushChangePolicy,
+				testAgePolicy,
 				mockMatchingService,
 				mockNotificationService,
 			)
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/morinonusi421/cupid/internal/model"
+	"github.com/morinonusi421/cupid/internal/repository"
+)
+
+// WebhookEventService は LINE の再送による Webhook イベントの重複処理を防ぐサービス
+type WebhookEventService interface {
+	// Begin はイベントの処理開始を記録し、処理してよい場合に true を返す
+	// 処理中・処理済みのイベント（再送による重複）の場合は false を返す
+	// 処理中のまま processingLease が経ったイベントは、処理が中断されたとみなして true を返す
+	Begin(ctx context.Context, event *model.WebhookEvent) (bool, error)
+
+	// Complete はイベントの処理結果を記録する（processErr が nil でなければ失敗として記録し、再送時に再処理する）
+	Complete(ctx context.Context, eventID string, processErr error) error
+
+	// Cleanup は保持期間を過ぎたイベントの記録を削除し、削除件数を返す
+	Cleanup(ctx context.Context) (int64, error)
+}
+
+type webhookEventService struct {
+	webhookEventRepo repository.WebhookEventRepository
+	ttl              time.Duration
+	processingLease  time.Duration
+	now              func() time.Time
+}
+
+// NewWebhookEventService は WebhookEventService の新しいインスタンスを作成する
+// ttl は処理済みイベントを保持する期間（LINE の再送が届く期間より長くする）
+// processingLease は処理中のイベントを中断されたとみなすまでの時間（1件の処理にかかる時間より十分長くする）
+func NewWebhookEventService(webhookEventRepo repository.WebhookEventRepository, ttl, processingLease time.Duration) WebhookEventService {
+	return &webhookEventService{
+		webhookEventRepo: webhookEventRepo,
+		ttl:              ttl,
+		processingLease:  processingLease,
+		now:              time.Now,
+	}
+}
+
+// Begin はイベントの処理開始を記録する
+func (s *webhookEventService) Begin(ctx context.Context, event *model.WebhookEvent) (bool, error) {
+	return s.webhookEventRepo.Claim(ctx, event, s.now().Add(-s.processingLease))
+}
+
+// Complete はイベントの処理結果を記録する
+func (s *webhookEventService) Complete(ctx context.Context, eventID string, processErr error) error {
+	if processErr != nil {
+		return s.webhookEventRepo.Finish(ctx, eventID, model.WebhookEventFailed, processErr.Error())
+	}
+	return s.webhookEventRepo.Finish(ctx, eventID, model.WebhookEventSucceeded, "")
+}
+
+// Cleanup は保持期間を過ぎたイベントの記録を削除する
+func (s *webhookEventService) Cleanup(ctx context.Context) (int64, error) {
+	return s.webhookEventRepo.DeleteReceivedBefore(ctx, s.now().Add(-s.ttl))
+}
@@ -3,15 +3,21 @@ package database
 import (
 	"database/sql"
 	"log"
-	"os"
 
 	_ "modernc.org/sqlite"
 )
 
-// InitDB はデータベース接続を初期化し、必要に応じてスキーマを作成する
-func InitDB(dbPath string) (*sql.DB, error) {
-	// データベース接続
-	db, err := sql.Open("sqlite", dbPath)
+// connectionParams は全コネクションに適用する SQLite の接続パラメータ
+//
+//   - foreign_keys: 外部キー制約を有効化（PRAGMA はコネクション単位のため DSN で指定する）
+//   - busy_timeout: 他のトランザクションが書き込み中の場合に最大5秒待機する
+//   - _txlock=immediate: BEGIN IMMEDIATE で開始し、トランザクション開始時点で書き込みロックを取得する
+//     （読み取り→書き込みの昇格で SQLITE_BUSY になるのを防ぎ、同時登録を直列化する）
+const connectionParams = "_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate"
+
+// Open は SQLite データベースに接続する（スキーマの作成は行わない）
+func Open(dbPath string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", dbPath+"?"+connectionParams)
 	if err != nil {
 		return nil, err
 	}
@@ -22,55 +28,33 @@ func InitDB(dbPath string) (*sql.DB, error) {
 		return nil, err
 	}
 
-	// 外部キー制約を有効化
-	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
+	return db, nil
+}
+
+// InitDB はデータベース接続を初期化し、未適用のマイグレーション（goMigrations を含む）を適用する
+func InitDB(dbPath string, goMigrations ...Migration) (*sql.DB, error) {
+	// データベース接続
+	db, err := Open(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := LoadMigrations(goMigrations...)
+	if err != nil {
 		db.Close()
 		return nil, err
 	}
 
-	// スキーマが存在しない場合は作成
-	if err := ensureSchema(db); err != nil {
+	// DBがバイナリより新しい場合は DatabaseAheadError となり起動しない
+	applied, err := Migrate(db, migrations, false)
+	if err != nil {
 		db.Close()
 		return nil, err
 	}
+	for _, id := range applied {
+		log.Printf("Applied migration %s", id)
+	}
 
 	log.Println("Database connected")
 	return db, nil
 }
-
-// ensureSchema はスキーマが存在しない場合に作成する
-func ensureSchema(db *sql.DB) error {
-	// usersテーブルの存在確認
-	var tableName string
-	err := db.QueryRow("SELECT name FROM sqlite_master WHERE type='table' AND name='users'").Scan(&tableName)
-	if err == sql.ErrNoRows {
-		// テーブルが存在しない場合、スキーマを作成
-		log.Println("Creating database schema...")
-
-		// スキーマファイルのパスを複数試す（実行ディレクトリによってパスが変わるため）
-		schemaPaths := []string{
-			"db/schema.sql",         // アプリケーションルートから実行
-			"../../db/schema.sql",   // pkg/database/ から実行（テスト）
-		}
-
-		var schema []byte
-		var readErr error
-		for _, path := range schemaPaths {
-			schema, readErr = os.ReadFile(path)
-			if readErr == nil {
-				break
-			}
-		}
-		if readErr != nil {
-			return readErr
-		}
-
-		if _, err := db.Exec(string(schema)); err != nil {
-			return err
-		}
-		log.Println("Database schema created successfully")
-	} else if err != nil {
-		return err
-	}
-	return nil
-}
@@ -33,3 +33,65 @@ func TestInitDB(t *testing.T) {
 		t.Errorf("Expected foreign_keys=1, got %d", foreignKeys)
 	}
 }
+
+func TestInitDB_MigratesCrushes(t *testing.T) {
+	testDBPath := "test_cupid_migrate.db"
+	defer os.Remove(testDBPath)
+
+	// 好きな人が users テーブルにあった頃のスキーマでDBを作成
+	oldDB, err := Open(testDBPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	_, err = oldDB.Exec(`
+CREATE TABLE users (
+  line_user_id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  birthday TEXT NOT NULL,
+  crush_name TEXT,
+  crush_birthday TEXT,
+  matched_with_user_id TEXT,
+  registered_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (matched_with_user_id) REFERENCES users(line_user_id)
+);
+CREATE INDEX idx_users_name_birthday ON users(name, birthday);
+CREATE INDEX idx_users_crush ON users(crush_name, crush_birthday);
+INSERT INTO users (line_user_id, name, birthday, crush_name, crush_birthday) VALUES
+  ('U-alice', 'アリス', '1990-01-01', 'ボブ', '1995-05-05'),
+  ('U-carol', 'キャロル', '1992-02-02', NULL, NULL);
+`)
+	oldDB.Close()
+	if err != nil {
+		t.Fatalf("Failed to create old schema: %v", err)
+	}
+
+	// InitDB で移行される
+	db, err := InitDB(testDBPath)
+	if err != nil {
+		t.Fatalf("InitDB failed: %v", err)
+	}
+	defer db.Close()
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM crushes").Scan(&count); err != nil {
+		t.Fatalf("Failed to count crushes: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 migrated crush, got %d", count)
+	}
+
+	var userLineID, crushName, crushBirthday string
+	err = db.QueryRow("SELECT user_line_id, crush_name, crush_birthday FROM crushes").Scan(&userLineID, &crushName, &crushBirthday)
+	if err != nil {
+		t.Fatalf("Failed to read crush: %v", err)
+	}
+	if userLineID != "U-alice" || crushName != "ボブ" || crushBirthday != "1995-05-05" {
+		t.Errorf("Unexpected crush: %s %s %s", userLineID, crushName, crushBirthday)
+	}
+
+	// users テーブルから好きな人カラムが削除されていること
+	if _, err := db.Exec("SELECT crush_name FROM users"); err == nil {
+		t.Error("Expected users.crush_name to be dropped")
+	}
+}
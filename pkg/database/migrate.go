@@ -0,0 +1,277 @@
+package database
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// migrationFiles はバイナリに埋め込まれたマイグレーションファイル
+//
+// ファイル名は "0001_create_users.sql" の形式で、先頭の番号順に適用される
+// 書式は sql-migrate と同じく "-- +migrate Up" 以降を適用する（Down は使用しない）
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationFileName はマイグレーションファイル名の形式
+var migrationFileName = regexp.MustCompile(`^(\d+)_[a-z0-9_]+\.sql$`)
+
+// createMigrationsTable は適用済みマイグレーションを記録するテーブル（sql-migrate 互換）
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS schema_migrations (id text not null primary key, applied_at datetime)`
+
+// Migration は1つのスキーマ変更を表す
+//
+// SQLで書けない変換（アプリケーションの規則で既存の行を作り直すなど）は、Up の代わりに UpFunc を持つ
+// Go のマイグレーションとして LoadMigrations に渡す。番号は SQL のファイルと同じ順番で適用される
+type Migration struct {
+	ID      string                 // ファイル名（schema_migrations.id に記録される。Go のマイグレーションは "0016_rebuild_name_keys.go" の形式）
+	Version int                    // ファイル名先頭の番号
+	Up      string                 // 適用するSQL
+	UpFunc  func(tx *sql.Tx) error // Go で適用する処理（SQL の場合は nil）
+}
+
+// DatabaseAheadError はバイナリが知らないマイグレーションがDBに適用済みの場合のエラー
+// 新しいバイナリで移行したDBを古いバイナリで開こうとした場合に発生する
+type DatabaseAheadError struct {
+	Unknown []string
+}
+
+func (e *DatabaseAheadError) Error() string {
+	return fmt.Sprintf("database is ahead of this binary: unknown migrations applied: %s", strings.Join(e.Unknown, ", "))
+}
+
+// LoadMigrations は埋め込まれたマイグレーションと goMigrations を合わせて番号順に返す
+func LoadMigrations(goMigrations ...Migration) ([]Migration, error) {
+	migrations, err := loadMigrations(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return mergeMigrations(migrations, goMigrations)
+}
+
+// mergeMigrations は SQL のマイグレーションに Go のマイグレーションを加え、番号順に並べる
+func mergeMigrations(migrations, goMigrations []Migration) ([]Migration, error) {
+	versions := make(map[int]string, len(migrations))
+	for _, m := range migrations {
+		versions[m.Version] = m.ID
+	}
+	for _, m := range goMigrations {
+		if m.ID == "" || m.UpFunc == nil {
+			return nil, fmt.Errorf("invalid go migration %d: ID and UpFunc are required", m.Version)
+		}
+		if other, ok := versions[m.Version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %d: %s, %s", m.Version, other, m.ID)
+		}
+		versions[m.Version] = m.ID
+	}
+
+	merged := append(append([]Migration{}, migrations...), goMigrations...)
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Version < merged[j].Version
+	})
+	return merged, nil
+}
+
+// loadMigrations は fsys の dir 配下からマイグレーションを番号順に読み込む
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []Migration
+	versions := make(map[int]string)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		m := migrationFileName.FindStringSubmatch(name)
+		if m == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", name)
+		}
+		version, _ := strconv.Atoi(m[1])
+		if other, ok := versions[version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %d: %s, %s", version, other, name)
+		}
+		versions[version] = name
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		up, err := parseUp(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		migrations = append(migrations, Migration{ID: name, Version: version, Up: up})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// parseUp は sql-migrate 形式のファイルから Up セクションのSQLを取り出す
+func parseUp(content string) (string, error) {
+	var up []string
+	section := ""
+	for _, line := range strings.Split(content, "\n") {
+		if directive, ok := strings.CutPrefix(strings.TrimSpace(line), "-- +migrate "); ok {
+			switch strings.Fields(directive)[0] {
+			case "Up", "Down":
+				section = strings.Fields(directive)[0]
+			}
+			continue // StatementBegin / StatementEnd は1回の Exec で実行するため不要
+		}
+		if section == "Up" {
+			up = append(up, line)
+		}
+	}
+	joined := strings.TrimSpace(strings.Join(up, "\n"))
+	if joined == "" {
+		return "", fmt.Errorf("missing \"-- +migrate Up\" section")
+	}
+	return joined, nil
+}
+
+// Migrate は未適用のマイグレーションを番号順に適用し、適用した（dryRun の場合は適用予定の）IDを返す
+//
+// 各マイグレーションは schema_migrations への記録と合わせて1つのトランザクションで実行される
+// バイナリが知らないマイグレーションが適用済みの場合は何もせず DatabaseAheadError を返す
+func Migrate(db *sql.DB, migrations []Migration, dryRun bool) ([]string, error) {
+	applied, err := appliedMigrations(db, migrations, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	// DBがバイナリより新しい場合は起動しない
+	known := make(map[string]bool, len(migrations))
+	for _, m := range migrations {
+		known[m.ID] = true
+	}
+	var unknown []string
+	for id := range applied {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, &DatabaseAheadError{Unknown: unknown}
+	}
+
+	var pending []string
+	for _, m := range migrations {
+		if applied[m.ID] {
+			continue
+		}
+		pending = append(pending, m.ID)
+		if dryRun {
+			continue
+		}
+		if err := applyMigration(db, m); err != nil {
+			return pending[:len(pending)-1], fmt.Errorf("migration %s failed: %w", m.ID, err)
+		}
+	}
+	return pending, nil
+}
+
+// appliedMigrations は適用済みマイグレーションのIDを返す
+//
+// schema_migrations 導入前に作成されたDB（users テーブルのみ存在）は、
+// 最初のマイグレーションが適用済みとして扱う（dryRun でなければ記録する）
+func appliedMigrations(db *sql.DB, migrations []Migration, dryRun bool) (map[string]bool, error) {
+	hasMigrationsTable, err := tableExists(db, "schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	if !hasMigrationsTable && !dryRun {
+		if _, err := db.Exec(createMigrationsTable); err != nil {
+			return nil, err
+		}
+		hasMigrationsTable = true
+	}
+
+	applied := make(map[string]bool)
+	if hasMigrationsTable {
+		rows, err := db.Query("SELECT id FROM schema_migrations")
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return nil, err
+			}
+			applied[id] = true
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(applied) > 0 || len(migrations) == 0 {
+		return applied, nil
+	}
+	hasUsers, err := tableExists(db, "users")
+	if err != nil {
+		return nil, err
+	}
+	if hasUsers {
+		baseline := migrations[0].ID
+		applied[baseline] = true
+		if !dryRun {
+			if _, err := db.Exec("INSERT INTO schema_migrations (id, applied_at) VALUES (?, CURRENT_TIMESTAMP)", baseline); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return applied, nil
+}
+
+// applyMigration は1つのマイグレーションを適用し、schema_migrations に記録する
+func applyMigration(db *sql.DB, m Migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if m.Up != "" {
+		if _, err := tx.Exec(m.Up); err != nil {
+			return err
+		}
+	}
+	if m.UpFunc != nil {
+		if err := m.UpFunc(tx); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec("INSERT INTO schema_migrations (id, applied_at) VALUES (?, CURRENT_TIMESTAMP)", m.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// tableExists はテーブルが存在するかを返す
+func tableExists(db *sql.DB, name string) (bool, error) {
+	var tableName string
+	err := db.QueryRow("SELECT name FROM sqlite_master WHERE type='table' AND name=?", name).Scan(&tableName)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
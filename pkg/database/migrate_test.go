@@ -0,0 +1,390 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	cupiddb "github.com/morinonusi421/cupid/db"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		wantIDs []string
+		wantErr bool
+	}{
+		{
+			name: "番号順に並ぶ（桁数が違っても数値で比較）",
+			files: fstest.MapFS{
+				"migrations/10_add_index.sql":  {Data: []byte("-- +migrate Up\nCREATE INDEX idx ON t(a);")},
+				"migrations/2_create_t.sql":    {Data: []byte("-- +migrate Up\nCREATE TABLE t (a TEXT);")},
+				"migrations/1_create_base.sql": {Data: []byte("-- +migrate Up\nCREATE TABLE base (a TEXT);\n-- +migrate Down\nDROP TABLE base;")},
+			},
+			wantIDs: []string{"1_create_base.sql", "2_create_t.sql", "10_add_index.sql"},
+		},
+		{
+			name: "ファイル名が不正",
+			files: fstest.MapFS{
+				"migrations/create_t.sql": {Data: []byte("-- +migrate Up\nCREATE TABLE t (a TEXT);")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "番号が重複",
+			files: fstest.MapFS{
+				"migrations/1_a.sql":  {Data: []byte("-- +migrate Up\nCREATE TABLE a (a TEXT);")},
+				"migrations/01_b.sql": {Data: []byte("-- +migrate Up\nCREATE TABLE b (a TEXT);")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Up セクションがない",
+			files: fstest.MapFS{
+				"migrations/1_a.sql": {Data: []byte("CREATE TABLE a (a TEXT);")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := loadMigrations(tt.files, "migrations")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadMigrations failed: %v", err)
+			}
+
+			var ids []string
+			for _, m := range migrations {
+				ids = append(ids, m.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("Expected %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+}
+
+func TestLoadMigrations_Embedded(t *testing.T) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		t.Fatalf("LoadMigrations failed: %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("Expected embedded migrations")
+	}
+	for _, m := range migrations {
+		if m.Up == "" {
+			t.Errorf("Migration %s has empty Up section", m.ID)
+		}
+	}
+}
+
+func TestMergeMigrations(t *testing.T) {
+	noop := func(tx *sql.Tx) error { return nil }
+	files := []Migration{{ID: "1_a.sql", Version: 1, Up: "SELECT 1"}, {ID: "3_c.sql", Version: 3, Up: "SELECT 1"}}
+
+	t.Run("Go のマイグレーションも番号順に並ぶ", func(t *testing.T) {
+		merged, err := mergeMigrations(files, []Migration{{ID: "2_b.go", Version: 2, UpFunc: noop}})
+		if err != nil {
+			t.Fatalf("mergeMigrations failed: %v", err)
+		}
+		var ids []string
+		for _, m := range merged {
+			ids = append(ids, m.ID)
+		}
+		if want := []string{"1_a.sql", "2_b.go", "3_c.sql"}; !reflect.DeepEqual(ids, want) {
+			t.Errorf("Expected %v, got %v", want, ids)
+		}
+	})
+
+	t.Run("番号が SQL のファイルと重複", func(t *testing.T) {
+		if _, err := mergeMigrations(files, []Migration{{ID: "3_b.go", Version: 3, UpFunc: noop}}); err == nil {
+			t.Error("Expected error, got nil")
+		}
+	})
+
+	t.Run("UpFunc がない", func(t *testing.T) {
+		if _, err := mergeMigrations(files, []Migration{{ID: "2_b.go", Version: 2}}); err == nil {
+			t.Error("Expected error, got nil")
+		}
+	})
+}
+
+func TestMigrate_GoMigration(t *testing.T) {
+	testDBPath := "test_cupid_go_migrations.db"
+	defer os.Remove(testDBPath)
+
+	db, err := Open(testDBPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	fail := true
+	migrations := []Migration{
+		{ID: "1_create_t.sql", Version: 1, Up: "CREATE TABLE t (a TEXT);"},
+		{ID: "2_fill_t.go", Version: 2, UpFunc: func(tx *sql.Tx) error {
+			if _, err := tx.Exec("INSERT INTO t (a) VALUES ('x')"); err != nil {
+				return err
+			}
+			if fail {
+				return errors.New("fill failed")
+			}
+			return nil
+		}},
+	}
+
+	// 失敗した場合はロールバックされ、記録されない
+	applied, err := Migrate(db, migrations, false)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !reflect.DeepEqual(applied, []string{"1_create_t.sql"}) {
+		t.Errorf("Unexpected applied migrations: %v", applied)
+	}
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM t").Scan(&count); err != nil || count != 0 {
+		t.Errorf("Expected rollback, got %d rows (err=%v)", count, err)
+	}
+
+	// 成功すれば記録され、次からは適用しない
+	fail = false
+	applied, err = Migrate(db, migrations, false)
+	if err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	if !reflect.DeepEqual(applied, []string{"2_fill_t.go"}) {
+		t.Errorf("Unexpected applied migrations: %v", applied)
+	}
+	if err := db.QueryRow("SELECT COUNT(*) FROM t").Scan(&count); err != nil || count != 1 {
+		t.Errorf("Expected 1 row, got %d (err=%v)", count, err)
+	}
+	if applied, _ = Migrate(db, migrations, false); len(applied) != 0 {
+		t.Errorf("Expected no migrations to apply, got %v", applied)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	testDBPath := "test_cupid_migrations.db"
+	defer os.Remove(testDBPath)
+
+	db, err := Open(testDBPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	migrations, err := LoadMigrations()
+	if err != nil {
+		t.Fatalf("LoadMigrations failed: %v", err)
+	}
+
+	// dry run では何も変更されない
+	pending, err := Migrate(db, migrations, true)
+	if err != nil {
+		t.Fatalf("Migrate (dry run) failed: %v", err)
+	}
+	if len(pending) != len(migrations) {
+		t.Errorf("Expected %d pending migrations, got %v", len(migrations), pending)
+	}
+	if exists, _ := tableExists(db, "schema_migrations"); exists {
+		t.Error("Expected dry run not to create schema_migrations")
+	}
+
+	// すべて適用され、schema_migrations に記録される
+	applied, err := Migrate(db, migrations, false)
+	if err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	if len(applied) != len(migrations) {
+		t.Errorf("Expected %d applied migrations, got %v", len(migrations), applied)
+	}
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM schema_migrations WHERE applied_at IS NOT NULL").Scan(&count); err != nil {
+		t.Fatalf("Failed to count schema_migrations: %v", err)
+	}
+	if count != len(migrations) {
+		t.Errorf("Expected %d recorded migrations, got %d", len(migrations), count)
+	}
+
+	// 2回目は何も適用されない
+	applied, err = Migrate(db, migrations, false)
+	if err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	if len(applied) != 0 {
+		t.Errorf("Expected no migrations to apply, got %v", applied)
+	}
+}
+
+func TestMigrate_DatabaseAhead(t *testing.T) {
+	testDBPath := "test_cupid_ahead.db"
+	defer os.Remove(testDBPath)
+
+	db, err := InitDB(testDBPath)
+	if err != nil {
+		t.Fatalf("InitDB failed: %v", err)
+	}
+
+	// 新しいバイナリで適用されたマイグレーションがある
+	_, err = db.Exec("INSERT INTO schema_migrations (id, applied_at) VALUES ('9999_future.sql', CURRENT_TIMESTAMP)")
+	db.Close()
+	if err != nil {
+		t.Fatalf("Failed to insert future migration: %v", err)
+	}
+
+	// 古いバイナリでは起動しない
+	_, err = InitDB(testDBPath)
+	var aheadErr *DatabaseAheadError
+	if !errors.As(err, &aheadErr) {
+		t.Fatalf("Expected DatabaseAheadError, got %v", err)
+	}
+	if !reflect.DeepEqual(aheadErr.Unknown, []string{"9999_future.sql"}) {
+		t.Errorf("Unexpected unknown migrations: %v", aheadErr.Unknown)
+	}
+}
+
+// TestMigrate_MatchesSchemaFile はマイグレーションを全て適用した結果が db/schema.sql と一致することを確認する
+func TestMigrate_MatchesSchemaFile(t *testing.T) {
+	migratedPath := "test_cupid_migrated.db"
+	schemaPath := "test_cupid_schema.db"
+	defer os.Remove(migratedPath)
+	defer os.Remove(schemaPath)
+
+	migrated, err := InitDB(migratedPath)
+	if err != nil {
+		t.Fatalf("InitDB failed: %v", err)
+	}
+	defer migrated.Close()
+
+	fromSchema, err := Open(schemaPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer fromSchema.Close()
+	if _, err := fromSchema.Exec(cupiddb.Schema); err != nil {
+		t.Fatalf("Failed to execute schema: %v", err)
+	}
+
+	want := describeSchema(t, fromSchema)
+	got := describeSchema(t, migrated)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Migrated schema differs from db/schema.sql\nwant: %v\ngot:  %v", want, got)
+	}
+}
+
+// TestMigrate_BackfillNameKeys は既存の行の名前のキーが 0011 の時点の規則（小書きの仮名を大きい仮名に揃える）で埋められることを確認する
+// 現在の model.NameKey の規則には Go のマイグレーション 0016 で作り直す（repository.Migrations のテスト）
+func TestMigrate_BackfillNameKeys(t *testing.T) {
+	testDBPath := "test_cupid_name_keys.db"
+	defer os.Remove(testDBPath)
+
+	db, err := Open(testDBPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	migrations, err := LoadMigrations()
+	if err != nil {
+		t.Fatalf("LoadMigrations failed: %v", err)
+	}
+	var before []Migration
+	for _, m := range migrations {
+		if m.ID == "0011_add_name_keys.sql" {
+			break
+		}
+		before = append(before, m)
+	}
+	if _, err := Migrate(db, before, false); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+
+	names := map[string]string{
+		"ヤマダタロウ": "ヤマダタロウ",
+		"ヴァイオリン": "バイオリン",
+		"ヴィヴィアン": "ビビアン",
+		"キョウコ":   "キヨウコ",
+		"ハットリ":   "ハツトリ",
+		"ミカヅキ":   "ミカズキ",
+		"チヂミ":    "チジミ",
+		"マーサー":   "マーサー",
+	}
+	i := 0
+	for name := range names {
+		i++
+		lineID := fmt.Sprintf("U%d", i)
+		if _, err := db.Exec("INSERT INTO users (line_user_id, name, birthday) VALUES (?, ?, '1990-01-01')", lineID, name); err != nil {
+			t.Fatalf("Failed to insert user: %v", err)
+		}
+		if _, err := db.Exec("INSERT INTO crushes (user_line_id, crush_name, crush_birthday) VALUES (?, ?, '1990-01-01')", lineID, name); err != nil {
+			t.Fatalf("Failed to insert crush: %v", err)
+		}
+	}
+
+	if _, err := Migrate(db, migrations, false); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+
+	for name, want := range names {
+		var userKey, crushKey string
+		if err := db.QueryRow("SELECT name_key FROM users WHERE name = ?", name).Scan(&userKey); err != nil {
+			t.Fatalf("Failed to select user: %v", err)
+		}
+		if err := db.QueryRow("SELECT crush_name_key FROM crushes WHERE crush_name = ?", name).Scan(&crushKey); err != nil {
+			t.Fatalf("Failed to select crush: %v", err)
+		}
+		if userKey != want || crushKey != want {
+			t.Errorf("Expected key of %s to be %s, got users=%s crushes=%s", name, want, userKey, crushKey)
+		}
+	}
+}
+
+// describeSchema はテーブルごとのカラム・インデックス・外部キーを比較用の文字列にまとめる
+func describeSchema(t *testing.T, db *sql.DB) map[string][]string {
+	t.Helper()
+
+	tables := queryStrings(t, db, "SELECT name FROM sqlite_master WHERE type='table' AND name NOT LIKE 'sqlite_%'")
+	result := make(map[string][]string)
+	for _, table := range tables {
+		result[table] = append(result[table], queryStrings(t, db,
+			fmt.Sprintf("SELECT 'column ' || name || ' ' || type || ' ' || \"notnull\" || ' ' || IFNULL(dflt_value, '') || ' ' || pk FROM pragma_table_info('%s') ORDER BY name", table))...)
+		result[table] = append(result[table], queryStrings(t, db,
+			fmt.Sprintf("SELECT 'index ' || il.name || ' ' || il.\"unique\" || ' ' || group_concat(ii.name) FROM pragma_index_list('%s') il, pragma_index_info(il.name) ii GROUP BY il.name ORDER BY il.name", table))...)
+		result[table] = append(result[table], queryStrings(t, db,
+			fmt.Sprintf("SELECT 'fk ' || \"from\" || ' ' || \"table\" || '.' || \"to\" || ' ' || on_delete FROM pragma_foreign_key_list('%s') ORDER BY \"from\"", table))...)
+	}
+	return result
+}
+
+func queryStrings(t *testing.T, db *sql.DB, query string) []string {
+	t.Helper()
+
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		values = append(values, v)
+	}
+	return values
+}
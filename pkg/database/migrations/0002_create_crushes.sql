@@ -0,0 +1,23 @@
+-- +migrate Up
+-- 好きな人テーブル（1ユーザーにつき複数登録可能、上限はアプリケーション側で設定）
+CREATE TABLE crushes (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_line_id TEXT NOT NULL,
+  crush_name TEXT NOT NULL,
+  crush_birthday TEXT NOT NULL,
+  registered_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_line_id) REFERENCES users(line_user_id) ON DELETE CASCADE,
+  UNIQUE (user_line_id, crush_name, crush_birthday)
+);
+
+-- 好きな人の検索用インデックス（相互マッチングの判定に使用）
+CREATE INDEX idx_crushes_crush ON crushes(crush_name, crush_birthday);
+
+-- 1ユーザー1人だった登録を、そのまま crushes の1行として引き継ぐ
+INSERT INTO crushes (user_line_id, crush_name, crush_birthday, registered_at)
+  SELECT line_user_id, crush_name, crush_birthday, updated_at FROM users
+  WHERE crush_name IS NOT NULL AND crush_birthday IS NOT NULL;
+
+DROP INDEX idx_users_crush;
+ALTER TABLE users DROP COLUMN crush_name;
+ALTER TABLE users DROP COLUMN crush_birthday;
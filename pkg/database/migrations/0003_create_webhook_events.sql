@@ -0,0 +1,16 @@
+-- +migrate Up
+-- 処理済みWebhookイベント（LINE の再送による重複処理を防ぐ）
+-- 一定期間（WEBHOOK_EVENT_TTL）を過ぎた行は定期的に削除する
+CREATE TABLE webhook_events (
+  webhook_event_id TEXT PRIMARY KEY,
+  event_type TEXT NOT NULL,
+  source_id TEXT,
+  is_redelivery INTEGER NOT NULL DEFAULT 0,
+  status TEXT NOT NULL,
+  error TEXT,
+  received_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  processed_at TEXT
+);
+
+-- 期限切れの行を削除するためのインデックス
+CREATE INDEX idx_webhook_events_received_at ON webhook_events(received_at);
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- 送信待ちのPush通知（マッチング成立・解除と同じトランザクションで書き込み、バックグラウンドで送信する）
+-- status: pending（送信待ち・再送待ち）/ delivered（送信済み）/ dead（再送を諦めた）
+CREATE TABLE notification_outbox (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  to_user_id TEXT NOT NULL,
+  kind TEXT NOT NULL,
+  text TEXT NOT NULL,
+  retry_key TEXT NOT NULL UNIQUE,
+  status TEXT NOT NULL DEFAULT 'pending',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_error TEXT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  delivered_at TEXT
+);
+
+-- 送信対象の行を探すためのインデックス
+CREATE INDEX idx_notification_outbox_due ON notification_outbox(status, next_attempt_at);
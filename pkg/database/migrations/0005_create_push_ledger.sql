@@ -0,0 +1,11 @@
+-- +migrate Up
+-- 送信したPushメッセージの記録（月間の送信数を数え、無料プランの上限を超えないようにする）
+CREATE TABLE push_ledger (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  to_user_id TEXT NOT NULL,
+  retry_key TEXT,
+  sent_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 月間の送信数を数えるためのインデックス
+CREATE INDEX idx_push_ledger_sent_at ON push_ledger(sent_at);
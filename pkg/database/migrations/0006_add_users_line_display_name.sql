@@ -0,0 +1,3 @@
+-- +migrate Up
+-- LINE の表示名（管理者向けの参考情報、マッチングには使わない）
+ALTER TABLE users ADD COLUMN line_display_name TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,9 @@
+-- +migrate Up
+-- 好きな人の登録回数（日本時間の日ごと）。名前と誕生日の総当たりで登録状況を探られるのを防ぐため、1日の上限を設ける
+CREATE TABLE crush_change_counts (
+  user_line_id TEXT NOT NULL,
+  day TEXT NOT NULL,
+  changes INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (user_line_id, day),
+  FOREIGN KEY (user_line_id) REFERENCES users(line_user_id) ON DELETE CASCADE
+);
@@ -0,0 +1,15 @@
+-- +migrate Up
+-- 好きな人の登録の履歴。登録し直して総当たりで登録状況を探られないよう、一定期間に登録できる人数と登録の間隔を制限する
+-- action: add（登録）/ remove（取り消し）
+CREATE TABLE crush_history (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_line_id TEXT NOT NULL,
+  crush_name TEXT NOT NULL,
+  crush_birthday TEXT NOT NULL,
+  action TEXT NOT NULL,
+  changed_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_line_id) REFERENCES users(line_user_id) ON DELETE CASCADE
+);
+
+-- ユーザーごとに期間内の履歴を探すためのインデックス
+CREATE INDEX idx_crush_history_user_changed_at ON crush_history(user_line_id, changed_at);
@@ -0,0 +1,3 @@
+-- +migrate Up
+-- 退会した日時（NULL=利用中）。退会したユーザーは名前・誕生日などを消して残す
+ALTER TABLE users ADD COLUMN deleted_at TEXT;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- マッチングの成立・解除の履歴（ユーザー本人のデータとしてエクスポートできるよう、両方のユーザーに1行ずつ記録する）
+-- action: match（成立）/ unmatch（解除）
+-- partner_name はその時点の相手の名前（相手が名前を変更・退会しても残る）
+CREATE TABLE match_history (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_line_id TEXT NOT NULL,
+  partner_line_id TEXT NOT NULL,
+  partner_name TEXT NOT NULL,
+  action TEXT NOT NULL,
+  changed_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_line_id) REFERENCES users(line_user_id) ON DELETE CASCADE
+);
+
+-- ユーザーごとに履歴を探すためのインデックス
+CREATE INDEX idx_match_history_user_changed_at ON match_history(user_line_id, changed_at);
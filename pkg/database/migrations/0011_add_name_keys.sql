@@ -0,0 +1,67 @@
+-- +migrate Up
+-- マッチング用の名前のキー（model.NameKey で生成する）
+-- 表記ゆれ（ヴ/ブ・小書きの仮名・ヂ/ジ・ヅ/ズ・中黒）を無視して、同じ人の名前かを判定する
+ALTER TABLE users ADD COLUMN name_key TEXT NOT NULL DEFAULT '';
+ALTER TABLE crushes ADD COLUMN crush_name_key TEXT NOT NULL DEFAULT '';
+
+-- 既存の行のキーを埋める
+-- 登録済みの名前は全角カタカナと長音符のみのため、NameKey の置き換えだけを同じ順番で行えばよい
+UPDATE users SET name_key =
+REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(name
+  , 'ヴァ', 'バ')
+  , 'ヴィ', 'ビ')
+  , 'ヴゥ', 'ブ')
+  , 'ヴェ', 'ベ')
+  , 'ヴォ', 'ボ')
+  , 'ヴ', 'ブ')
+  , 'ァ', 'ア')
+  , 'ィ', 'イ')
+  , 'ゥ', 'ウ')
+  , 'ェ', 'エ')
+  , 'ォ', 'オ')
+  , 'ッ', 'ツ')
+  , 'ャ', 'ヤ')
+  , 'ュ', 'ユ')
+  , 'ョ', 'ヨ')
+  , 'ヮ', 'ワ')
+  , 'ヵ', 'カ')
+  , 'ヶ', 'ケ')
+  , 'ヂ', 'ジ')
+  , 'ヅ', 'ズ')
+  , 'ヰ', 'イ')
+  , 'ヱ', 'エ')
+  , 'ヲ', 'オ')
+  , '・', '');
+
+UPDATE crushes SET crush_name_key =
+REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(crush_name
+  , 'ヴァ', 'バ')
+  , 'ヴィ', 'ビ')
+  , 'ヴゥ', 'ブ')
+  , 'ヴェ', 'ベ')
+  , 'ヴォ', 'ボ')
+  , 'ヴ', 'ブ')
+  , 'ァ', 'ア')
+  , 'ィ', 'イ')
+  , 'ゥ', 'ウ')
+  , 'ェ', 'エ')
+  , 'ォ', 'オ')
+  , 'ッ', 'ツ')
+  , 'ャ', 'ヤ')
+  , 'ュ', 'ユ')
+  , 'ョ', 'ヨ')
+  , 'ヮ', 'ワ')
+  , 'ヵ', 'カ')
+  , 'ヶ', 'ケ')
+  , 'ヂ', 'ジ')
+  , 'ヅ', 'ズ')
+  , 'ヰ', 'イ')
+  , 'ヱ', 'エ')
+  , 'ヲ', 'オ')
+  , '・', '');
+
+-- マッチング・重複チェックはキーで検索する
+DROP INDEX idx_users_name_birthday;
+CREATE INDEX idx_users_name_key_birthday ON users(name_key, birthday);
+DROP INDEX idx_crushes_crush;
+CREATE INDEX idx_crushes_crush_key ON crushes(crush_name_key, crush_birthday);
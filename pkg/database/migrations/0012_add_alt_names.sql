@@ -0,0 +1,12 @@
+-- +migrate Up
+-- 名前の別の表記（例: カタカナの名前に対するローマ字）とそのマッチング用のキー（model.NameKey）
+-- 名前・別の表記のどちらかの組み合わせが一致すれば同じ人として扱う（model.IdentityKey）
+-- 既存の行は別の表記なし（空）とする
+ALTER TABLE users ADD COLUMN alt_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN alt_name_key TEXT NOT NULL DEFAULT '';
+ALTER TABLE crushes ADD COLUMN crush_alt_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE crushes ADD COLUMN crush_alt_name_key TEXT NOT NULL DEFAULT '';
+
+-- 別の表記のキーでも重複チェック・マッチングの検索をする
+CREATE INDEX idx_users_alt_name_key_birthday ON users(alt_name_key, birthday);
+CREATE INDEX idx_crushes_crush_alt_key ON crushes(crush_alt_name_key, crush_birthday);
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- 管理API（/admin/）の操作の記録（誰が・どこから・何をしたか）
+-- actor: 認証した管理者（token / basic:<ユーザー名>）
+-- action: user_lookup / match_list / force_unmatch / user_delete / stats
+-- result: 成功なら ok、失敗ならエラーの内容
+-- ユーザーを削除しても記録が残るよう、users は参照しない
+CREATE TABLE admin_audit_log (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  actor TEXT NOT NULL,
+  remote_ip TEXT NOT NULL,
+  action TEXT NOT NULL,
+  target TEXT NOT NULL DEFAULT '',
+  detail TEXT NOT NULL DEFAULT '',
+  result TEXT NOT NULL,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 期間で記録を探すためのインデックス
+CREATE INDEX idx_admin_audit_log_created_at ON admin_audit_log(created_at);
@@ -0,0 +1,3 @@
+-- +migrate Up
+-- 「退会」と送信した日時（NULL=未送信）。「退会する」はこの日時から一定時間内にだけ受け付ける
+ALTER TABLE users ADD COLUMN withdrawal_requested_at TEXT;
@@ -0,0 +1,5 @@
+-- +migrate Up
+-- 好きな人の登録の履歴に名前の別の表記を記録する（なければ空）
+-- 別の表記も登録できる人数の制限に数え、別の表記だけを変えて登録し直しても制限を避けられないようにする
+-- 既存の行は別の表記なし（空）とする
+ALTER TABLE crush_history ADD COLUMN crush_alt_name TEXT NOT NULL DEFAULT '';
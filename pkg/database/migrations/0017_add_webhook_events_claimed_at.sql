@@ -0,0 +1,7 @@
+-- +migrate Up
+-- 処理を始めた日時。processing のまま一定時間（WEBHOOK_PROCESSING_LEASE）が経った記録は、
+-- 処理が中断された（停止時に打ち切られた等）とみなし、再送されたイベントを再処理する
+ALTER TABLE webhook_events ADD COLUMN claimed_at TEXT;
+
+-- 既存の行は受信した日時に処理を始めたものとする
+UPDATE webhook_events SET claimed_at = received_at;
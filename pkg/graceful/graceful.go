@@ -0,0 +1,74 @@
+// Package graceful は HTTP サーバーの起動と、シグナル受信時の安全な停止を扱う
+package graceful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Drainer は停止時に処理中の作業を完了させる必要があるバックグラウンド処理
+// HTTP サーバーが新しいリクエストの受付を止めた後、登録順に Drain が呼ばれる
+type Drainer interface {
+	Drain(ctx context.Context) error
+}
+
+// DrainFunc は関数を Drainer として使うためのアダプタ
+type DrainFunc func(ctx context.Context) error
+
+// Drain は f(ctx) を呼び出す
+func (f DrainFunc) Drain(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Serve は srv を l で起動し、ctx がキャンセルされたら安全に停止する
+//
+// 停止時は新しいリクエストの受付を止め、処理中のリクエストの完了を待ってから
+// drainers のバックグラウンド処理を完了させる。全体で shutdownTimeout を超えた場合はエラーを返す
+func Serve(ctx context.Context, srv *http.Server, l net.Listener, shutdownTimeout time.Duration, drainers ...Drainer) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve(l)
+	}()
+
+	select {
+	case err := <-serveErr:
+		// シグナルを受ける前にサーバーが停止した
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Println("Shutting down server...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("http server shutdown: %w", err))
+	}
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		errs = append(errs, err)
+	}
+	for _, d := range drainers {
+		if err := d.Drain(shutdownCtx); err != nil {
+			errs = append(errs, fmt.Errorf("drain: %w", err))
+		}
+	}
+	if len(errs) == 0 {
+		log.Println("Server stopped gracefully")
+	}
+	return errors.Join(errs...)
+}
+
+// ListenAndServe は srv.Addr で待ち受けて Serve を呼び出す
+func ListenAndServe(ctx context.Context, srv *http.Server, shutdownTimeout time.Duration, drainers ...Drainer) error {
+	l, err := net.Listen("tcp", srv.Addr)
+	if err != nil {
+		return err
+	}
+	return Serve(ctx, srv, l, shutdownTimeout, drainers...)
+}
@@ -0,0 +1,130 @@
+package graceful
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServe_DrainsInFlightRequests(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var completed atomic.Bool
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		completed.Store(true)
+		io.WriteString(w, "done")
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &http.Server{Handler: mux}
+
+	// バックグラウンド処理は HTTP サーバー停止後に呼ばれる
+	var drainedAfterRequest atomic.Bool
+	drainer := DrainFunc(func(ctx context.Context) error {
+		drainedAfterRequest.Store(completed.Load())
+		return nil
+	})
+
+	ctx, stop := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- Serve(ctx, srv, l, 5*time.Second, drainer)
+	}()
+
+	// 処理中のリクエストがある状態で停止を開始
+	respCh := make(chan *http.Response, 1)
+	go func() {
+		resp, err := http.Get("http://" + l.Addr().String() + "/slow")
+		if err != nil {
+			t.Errorf("request failed: %v", err)
+			respCh <- nil
+			return
+		}
+		respCh <- resp
+	}()
+	<-started
+	stop()
+
+	// 停止中は新しい接続を受け付けない
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			return true
+		}
+		conn.Close()
+		return false
+	}, time.Second, 10*time.Millisecond)
+
+	select {
+	case <-done:
+		t.Fatal("Serve returned before in-flight request completed")
+	default:
+	}
+
+	// 処理中のリクエストは最後まで処理される
+	close(release)
+	resp := <-respCh
+	require.NotNil(t, resp)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "done", string(body))
+
+	require.NoError(t, <-done)
+	assert.True(t, drainedAfterRequest.Load(), "drainer should run after in-flight requests complete")
+}
+
+func TestServe_ShutdownTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/stuck", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, stop := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- Serve(ctx, &http.Server{Handler: mux}, l, 50*time.Millisecond)
+	}()
+
+	go http.Get("http://" + l.Addr().String() + "/stuck")
+	<-started
+	stop()
+
+	err = <-done
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "expected deadline exceeded, got %v", err)
+}
+
+func TestServe_DrainerError(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	errDrain := errors.New("drain failed")
+	ctx, stop := context.WithCancel(context.Background())
+	stop()
+
+	err = Serve(ctx, &http.Server{Handler: http.NewServeMux()}, l, time.Second, DrainFunc(func(ctx context.Context) error {
+		return errDrain
+	}))
+	assert.ErrorIs(t, err, errDrain)
+}
@@ -5,12 +5,13 @@ import (
 	"os"
 	"testing"
 
-	_ "modernc.org/sqlite"
+	cupiddb "github.com/morinonusi421/cupid/db"
+	"github.com/morinonusi421/cupid/pkg/database"
 )
 
 // SetupTestDB はテスト用のデータベースをセットアップする
-// schemaPath は db/schema.sql へのパス（相対パスまたは絶対パス）
-func SetupTestDB(t *testing.T, dbPath string, schemaPath string) *sql.DB {
+// スキーマはバイナリに埋め込まれた db/schema.sql を使用するため、実行ディレクトリに依存しない
+func SetupTestDB(t *testing.T, dbPath string) *sql.DB {
 	t.Helper()
 
 	// テスト終了時にDBファイルを削除
@@ -18,27 +19,14 @@ func SetupTestDB(t *testing.T, dbPath string, schemaPath string) *sql.DB {
 		os.Remove(dbPath)
 	})
 
-	// DB を作成
-	db, err := sql.Open("sqlite", dbPath)
+	// DB を作成（本番と同じ接続パラメータを使用）
+	db, err := database.Open(dbPath)
 	if err != nil {
 		t.Fatalf("Failed to open test database: %v", err)
 	}
 
-	// 外部キー制約を有効化
-	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
-		db.Close()
-		t.Fatalf("Failed to enable foreign keys: %v", err)
-	}
-
-	// スキーマファイルを読み込み
-	schema, err := os.ReadFile(schemaPath)
-	if err != nil {
-		db.Close()
-		t.Fatalf("Failed to read schema file: %v", err)
-	}
-
 	// スキーマを実行
-	if _, err := db.Exec(string(schema)); err != nil {
+	if _, err := db.Exec(cupiddb.Schema); err != nil {
 		db.Close()
 		t.Fatalf("Failed to execute schema: %v", err)
 	}
@@ -0,0 +1,343 @@
+package testutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// LINEIssuer は LINE が発行する IDトークンの iss
+const LINEIssuer = "https://access.line.me"
+
+// IDTokenClaims は FakeLINEOAuth が発行する IDトークンのクレーム
+// Aud・Iss・IssuedAt・ExpiresAt は省略時にチャネルID・LINEIssuer・現在時刻・その1時間後になる
+type IDTokenClaims struct {
+	Sub       string
+	Name      string
+	Picture   string
+	Nonce     string
+	Amr       []string
+	Aud       string
+	Iss       string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// FakeLINEOAuth は LINE の OAuth API（JWKS・IDトークン検証・アクセストークン検証・プロフィール）を模した httptest サーバー
+// 署名鍵を自前で生成し、IssueIDToken で本物と同じ形式（ES256）の IDトークンを発行する
+type FakeLINEOAuth struct {
+	URL       string
+	ChannelID string
+
+	mu           sync.Mutex
+	keys         []fakeSigningKey // 最後の鍵で署名する
+	accessTokens map[string]fakeProfile
+	failures     []int // 次のリクエストに返すステータスコード
+	jwksDown     bool
+	requests     map[string]int
+}
+
+type fakeSigningKey struct {
+	kid  string
+	priv *ecdsa.PrivateKey
+}
+
+type fakeProfile struct {
+	UserID      string `json:"userId"`
+	DisplayName string `json:"displayName"`
+	PictureURL  string `json:"pictureUrl,omitempty"`
+}
+
+// NewFakeLINEOAuth は channelID の LIFF チャネル用の FakeLINEOAuth を起動する（テスト終了時に停止する）
+func NewFakeLINEOAuth(t *testing.T, channelID string) *FakeLINEOAuth {
+	t.Helper()
+
+	f := &FakeLINEOAuth{
+		ChannelID:    channelID,
+		accessTokens: make(map[string]fakeProfile),
+		requests:     make(map[string]int),
+	}
+	f.RotateKey(t, "fake-key-1")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /oauth2/v2.1/certs", f.handleCerts)
+	mux.HandleFunc("POST /oauth2/v2.1/verify", f.handleVerifyIDToken)
+	mux.HandleFunc("GET /oauth2/v2.1/verify", f.handleVerifyAccessToken)
+	mux.HandleFunc("GET /v2/profile", f.handleProfile)
+
+	server := httptest.NewServer(f.intercept(mux))
+	t.Cleanup(server.Close)
+	f.URL = server.URL
+	return f
+}
+
+// RotateKey は新しい署名鍵を追加し、以降の IDトークンをその鍵で署名する（古い鍵も JWKS に残る）
+func (f *FakeLINEOAuth) RotateKey(t *testing.T, kid string) {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate signing key: %v", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keys = append(f.keys, fakeSigningKey{kid: kid, priv: priv})
+}
+
+// IssueIDToken は現在の署名鍵で署名した IDトークンを発行する
+func (f *FakeLINEOAuth) IssueIDToken(t *testing.T, claims IDTokenClaims) string {
+	t.Helper()
+
+	f.mu.Lock()
+	key := f.keys[len(f.keys)-1]
+	f.mu.Unlock()
+	return f.sign(t, key, claims)
+}
+
+// IssueForeignIDToken は JWKS にない鍵（同じ kid）で署名した、署名が不正な IDトークンを発行する
+func (f *FakeLINEOAuth) IssueForeignIDToken(t *testing.T, claims IDTokenClaims) string {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate signing key: %v", err)
+	}
+	f.mu.Lock()
+	kid := f.keys[len(f.keys)-1].kid
+	f.mu.Unlock()
+	return f.sign(t, fakeSigningKey{kid: kid, priv: priv}, claims)
+}
+
+// IssueAccessToken は userID のユーザーのアクセストークンを発行する
+func (f *FakeLINEOAuth) IssueAccessToken(userID, displayName string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	token := fmt.Sprintf("fake-access-token-%d", len(f.accessTokens)+1)
+	f.accessTokens[token] = fakeProfile{UserID: userID, DisplayName: displayName}
+	return token
+}
+
+// FailNext は次のリクエストから順に statuses のステータスコードを返す（LINE の一時的な障害を再現する）
+func (f *FakeLINEOAuth) FailNext(statuses ...int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, statuses...)
+}
+
+// SetJWKSUnavailable は JWKS の取得を失敗させるかどうかを設定する
+func (f *FakeLINEOAuth) SetJWKSUnavailable(down bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.jwksDown = down
+}
+
+// Requests は "METHOD /path" ごとのリクエスト数を返す（失敗させたリクエストも含む）
+func (f *FakeLINEOAuth) Requests(pattern string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[pattern]
+}
+
+// intercept はリクエスト数を記録し、FailNext で指定されたステータスコードを返す
+func (f *FakeLINEOAuth) intercept(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		f.requests[r.Method+" "+r.URL.Path]++
+		var status int
+		if len(f.failures) > 0 {
+			status, f.failures = f.failures[0], f.failures[1:]
+		}
+		f.mu.Unlock()
+
+		if status != 0 {
+			writeOAuthError(w, status, "server_error", "injected failure")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (f *FakeLINEOAuth) handleCerts(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.jwksDown {
+		writeOAuthError(w, http.StatusServiceUnavailable, "server_error", "JWKS unavailable")
+		return
+	}
+
+	keys := make([]map[string]string, 0, len(f.keys))
+	for _, k := range f.keys {
+		keys = append(keys, map[string]string{
+			"kty": "EC",
+			"alg": "ES256",
+			"use": "sig",
+			"crv": "P-256",
+			"kid": k.kid,
+			"x":   base64.RawURLEncoding.EncodeToString(k.priv.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(k.priv.Y.FillBytes(make([]byte, 32))),
+		})
+	}
+	w.Header().Set("Cache-Control", "max-age=3600")
+	writeJSON(w, http.StatusOK, map[string]any{"keys": keys})
+}
+
+// handleVerifyIDToken は LINE と同じ検証（署名・有効期限・client_id・nonce）を行い、クレームを返す
+func (f *FakeLINEOAuth) handleVerifyIDToken(w http.ResponseWriter, r *http.Request) {
+	claims, err := f.verify(r.FormValue("id_token"))
+	if err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	if aud, _ := claims["aud"].(string); aud != r.FormValue("client_id") {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "Invalid IdToken Audience.")
+		return
+	}
+	if nonce := r.FormValue("nonce"); nonce != "" && claims["nonce"] != nonce {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "Invalid IdToken Nonce.")
+		return
+	}
+	writeJSON(w, http.StatusOK, claims)
+}
+
+func (f *FakeLINEOAuth) handleVerifyAccessToken(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	_, ok := f.accessTokens[r.URL.Query().Get("access_token")]
+	f.mu.Unlock()
+	if !ok {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "access token expired")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"scope": "profile openid", "client_id": f.ChannelID, "expires_in": 2591659})
+}
+
+func (f *FakeLINEOAuth) handleProfile(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	profile, ok := f.accessTokens[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+	f.mu.Unlock()
+	if !ok {
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_token", "The access token expired")
+		return
+	}
+	writeJSON(w, http.StatusOK, profile)
+}
+
+func (f *FakeLINEOAuth) sign(t *testing.T, key fakeSigningKey, claims IDTokenClaims) string {
+	t.Helper()
+
+	if claims.Aud == "" {
+		claims.Aud = f.ChannelID
+	}
+	if claims.Iss == "" {
+		claims.Iss = LINEIssuer
+	}
+	if claims.IssuedAt.IsZero() {
+		claims.IssuedAt = time.Now()
+	}
+	if claims.ExpiresAt.IsZero() {
+		claims.ExpiresAt = claims.IssuedAt.Add(time.Hour)
+	}
+	payload := map[string]any{
+		"iss": claims.Iss,
+		"sub": claims.Sub,
+		"aud": claims.Aud,
+		"exp": claims.ExpiresAt.Unix(),
+		"iat": claims.IssuedAt.Unix(),
+		"amr": claims.Amr,
+	}
+	if claims.Nonce != "" {
+		payload["nonce"] = claims.Nonce
+	}
+	if claims.Name != "" {
+		payload["name"] = claims.Name
+	}
+	if claims.Picture != "" {
+		payload["picture"] = claims.Picture
+	}
+
+	header, err := json.Marshal(map[string]string{"typ": "JWT", "alg": "ES256", "kid": key.kid})
+	if err != nil {
+		t.Fatalf("Failed to encode ID token header: %v", err)
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to encode ID token payload: %v", err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(body)
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, key.priv, digest[:])
+	if err != nil {
+		t.Fatalf("Failed to sign ID token: %v", err)
+	}
+	sig := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// verify は自身の署名鍵で IDトークンの署名と有効期限を検証し、クレームを返す
+func (f *FakeLINEOAuth) verify(idToken string) (map[string]any, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid IdToken.")
+	}
+	var header struct {
+		Kid string `json:"kid"`
+	}
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("Invalid IdToken.")
+	}
+
+	f.mu.Lock()
+	var pub *ecdsa.PublicKey
+	for _, k := range f.keys {
+		if k.kid == header.Kid {
+			pub = &k.priv.PublicKey
+		}
+	}
+	f.mu.Unlock()
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if pub == nil || err != nil || len(sig) != 64 {
+		return nil, fmt.Errorf("Invalid IdToken Signature.")
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if !ecdsa.Verify(pub, digest[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])) {
+		return nil, fmt.Errorf("Invalid IdToken Signature.")
+	}
+
+	var claims map[string]any
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("Invalid IdToken.")
+	}
+	if exp, _ := claims["exp"].(float64); time.Now().Unix() > int64(exp) {
+		return nil, fmt.Errorf("IdToken expired.")
+	}
+	return claims, nil
+}
+
+func decodeJWTSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func writeOAuthError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, map[string]string{"error": code, "error_description": description})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
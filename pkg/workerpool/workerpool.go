@@ -0,0 +1,188 @@
+// Package workerpool は同じキーのタスクを順番に処理する、上限付きのワーカープール
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"log"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrClosed は Drain 開始後にタスクを追加しようとした場合のエラー
+var ErrClosed = errors.New("workerpool: pool is closed")
+
+// Task はワーカーで実行される処理
+// ctx はプールの生存期間に紐づき、リクエストの終了ではキャンセルされない
+type Task func(ctx context.Context)
+
+// Stats はバックプレッシャーの監視用の統計情報
+type Stats struct {
+	Workers   int   `json:"workers"`
+	Capacity  int   `json:"capacity"`  // キュー全体の上限
+	Queued    int   `json:"queued"`    // 待機中のタスク数
+	InFlight  int64 `json:"in_flight"` // 実行中のタスク数
+	Submitted int64 `json:"submitted"` // 受け付けたタスクの累計
+	Processed int64 `json:"processed"` // 完了したタスクの累計
+	Rejected  int64 `json:"rejected"`  // キューが満杯・停止中で受け付けられなかったタスクの累計
+	Panicked  int64 `json:"panicked"`  // panic したタスクの累計
+}
+
+// Pool はキーごとに順序を保証するワーカープール
+//
+// 同じキーのタスクは常に同じワーカーに割り当てられるため、追加した順に1つずつ実行される
+// 異なるキーのタスクは別のワーカーで並行に実行される
+type Pool struct {
+	queues []chan Task
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.RWMutex // closed と queues への送信を保護する
+	closed  bool
+	workers sync.WaitGroup
+
+	// pending は追加済みで完了していないタスク数（Wait で使用）
+	pendingMu   sync.Mutex
+	pendingCond *sync.Cond
+	pending     int
+
+	inFlight  atomic.Int64
+	submitted atomic.Int64
+	processed atomic.Int64
+	rejected  atomic.Int64
+	panicked  atomic.Int64
+}
+
+// New は workers 個のワーカーと、ワーカーごとに queueSize 個のキューを持つプールを起動する
+func New(workers, queueSize int) *Pool {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Pool{
+		queues: make([]chan Task, workers),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	p.pendingCond = sync.NewCond(&p.pendingMu)
+	for i := range p.queues {
+		p.queues[i] = make(chan Task, queueSize)
+		p.workers.Add(1)
+		go p.run(p.queues[i])
+	}
+	return p
+}
+
+// Submit は key のキューにタスクを追加する
+//
+// キューが満杯の場合は空きが出るか ctx が終了するまで待つ（バックプレッシャー）
+// ctx が終了した場合は ctx.Err() を、Drain 開始後は ErrClosed を返す
+func (p *Pool) Submit(ctx context.Context, key string, task Task) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		p.rejected.Add(1)
+		return ErrClosed
+	}
+
+	p.addPending(1)
+	select {
+	case p.queues[p.index(key)] <- task:
+		p.submitted.Add(1)
+		return nil
+	case <-ctx.Done():
+		p.addPending(-1)
+		p.rejected.Add(1)
+		return ctx.Err()
+	}
+}
+
+// Wait は追加済みのタスクがすべて完了するまで待つ（プールは停止しない）
+func (p *Pool) Wait() {
+	p.pendingMu.Lock()
+	defer p.pendingMu.Unlock()
+	for p.pending > 0 {
+		p.pendingCond.Wait()
+	}
+}
+
+// Drain は新しいタスクの受付を止め、キューに残っているタスクがすべて完了するまで待つ
+// ctx が先に終了した場合は実行中のタスクの ctx をキャンセルし、ctx.Err() を返す
+func (p *Pool) Drain(ctx context.Context) error {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		for _, q := range p.queues {
+			close(q)
+		}
+	}
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		p.cancel()
+		return nil
+	case <-ctx.Done():
+		p.cancel()
+		return ctx.Err()
+	}
+}
+
+// Stats は現在の統計情報を返す
+func (p *Pool) Stats() Stats {
+	s := Stats{
+		Workers:   len(p.queues),
+		InFlight:  p.inFlight.Load(),
+		Submitted: p.submitted.Load(),
+		Processed: p.processed.Load(),
+		Rejected:  p.rejected.Load(),
+		Panicked:  p.panicked.Load(),
+	}
+	for _, q := range p.queues {
+		s.Capacity += cap(q)
+		s.Queued += len(q)
+	}
+	return s
+}
+
+// index は key を担当するワーカーの番号を返す
+func (p *Pool) index(key string) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(len(p.queues)))
+}
+
+func (p *Pool) run(queue <-chan Task) {
+	defer p.workers.Done()
+	for task := range queue {
+		p.execute(task)
+	}
+}
+
+// execute はタスクを実行する。panic しても他のタスクの処理は続ける
+func (p *Pool) execute(task Task) {
+	p.inFlight.Add(1)
+	defer func() {
+		if r := recover(); r != nil {
+			p.panicked.Add(1)
+			log.Printf("[ERROR] workerpool: task panicked: %v", r)
+		}
+		p.inFlight.Add(-1)
+		p.processed.Add(1)
+		p.addPending(-1)
+	}()
+	task(p.ctx)
+}
+
+func (p *Pool) addPending(delta int) {
+	p.pendingMu.Lock()
+	p.pending += delta
+	if p.pending == 0 {
+		p.pendingCond.Broadcast()
+	}
+	p.pendingMu.Unlock()
+}
@@ -0,0 +1,168 @@
+package workerpool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_PerKeyOrdering(t *testing.T) {
+	p := New(4, 10)
+	defer p.Drain(context.Background())
+
+	const keys, perKey = 8, 50
+	var mu sync.Mutex
+	got := make(map[string][]int)
+
+	for i := 0; i < perKey; i++ {
+		for k := 0; k < keys; k++ {
+			key := fmt.Sprintf("U-%d", k)
+			i := i
+			require.NoError(t, p.Submit(context.Background(), key, func(ctx context.Context) {
+				mu.Lock()
+				got[key] = append(got[key], i)
+				mu.Unlock()
+			}))
+		}
+	}
+	p.Wait()
+
+	// 同じキーのタスクは追加した順に処理される
+	for k := 0; k < keys; k++ {
+		key := fmt.Sprintf("U-%d", k)
+		require.Len(t, got[key], perKey)
+		for i, v := range got[key] {
+			assert.Equal(t, i, v, "key %s out of order", key)
+		}
+	}
+	assert.Equal(t, int64(keys*perKey), p.Stats().Processed)
+}
+
+func TestPool_DifferentKeysRunConcurrently(t *testing.T) {
+	p := New(2, 1)
+	defer p.Drain(context.Background())
+
+	// 異なるワーカーに割り当てられる2つのキーを探す
+	keyA := "a"
+	keyB := ""
+	for i := 0; keyB == ""; i++ {
+		if k := fmt.Sprintf("b-%d", i); p.index(k) != p.index(keyA) {
+			keyB = k
+		}
+	}
+
+	release := make(chan struct{})
+	require.NoError(t, p.Submit(context.Background(), keyA, func(ctx context.Context) { <-release }))
+
+	done := make(chan struct{})
+	require.NoError(t, p.Submit(context.Background(), keyB, func(ctx context.Context) { close(done) }))
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("task for another key was blocked")
+	}
+	close(release)
+}
+
+func TestPool_Backpressure(t *testing.T) {
+	p := New(1, 1)
+	defer p.Drain(context.Background())
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.NoError(t, p.Submit(context.Background(), "U", func(ctx context.Context) {
+		close(started)
+		<-release
+	}))
+	<-started
+	// キューに1つ待機
+	require.NoError(t, p.Submit(context.Background(), "U", func(ctx context.Context) {}))
+
+	// キューが満杯のため、ctx の期限まで待って拒否される
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := p.Submit(ctx, "U", func(ctx context.Context) {})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	stats := p.Stats()
+	assert.Equal(t, 1, stats.Queued)
+	assert.Equal(t, int64(1), stats.InFlight)
+	assert.Equal(t, int64(1), stats.Rejected)
+	assert.Equal(t, 1, stats.Capacity)
+
+	close(release)
+	p.Wait()
+	assert.Equal(t, int64(2), p.Stats().Processed)
+}
+
+func TestPool_Drain(t *testing.T) {
+	p := New(2, 10)
+
+	var mu sync.Mutex
+	processed := 0
+	for i := 0; i < 10; i++ {
+		require.NoError(t, p.Submit(context.Background(), fmt.Sprint(i), func(ctx context.Context) {
+			time.Sleep(5 * time.Millisecond)
+			mu.Lock()
+			processed++
+			mu.Unlock()
+		}))
+	}
+
+	// キューに残っているタスクもすべて処理してから停止する
+	require.NoError(t, p.Drain(context.Background()))
+	assert.Equal(t, 10, processed)
+
+	// 停止後は受け付けない
+	err := p.Submit(context.Background(), "U", func(ctx context.Context) {})
+	assert.ErrorIs(t, err, ErrClosed)
+
+	// 2回呼んでも問題ない
+	assert.NoError(t, p.Drain(context.Background()))
+}
+
+func TestPool_DrainTimeoutCancelsTasks(t *testing.T) {
+	p := New(1, 1)
+
+	cancelled := make(chan struct{})
+	started := make(chan struct{})
+	require.NoError(t, p.Submit(context.Background(), "U", func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		close(cancelled)
+	}))
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, p.Drain(ctx), context.DeadlineExceeded)
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("task context was not cancelled")
+	}
+}
+
+func TestPool_RecoversFromPanic(t *testing.T) {
+	p := New(1, 10)
+	defer p.Drain(context.Background())
+
+	done := make(chan struct{})
+	require.NoError(t, p.Submit(context.Background(), "U", func(ctx context.Context) { panic("boom") }))
+	require.NoError(t, p.Submit(context.Background(), "U", func(ctx context.Context) { close(done) }))
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker stopped after panic")
+	}
+	p.Wait()
+	assert.Equal(t, int64(1), p.Stats().Panicked)
+}
@@ -53,36 +53,157 @@ function getBirthday() {
     return `${year}-${month}-${day}`;
 }
 
+// ハイフン・ダッシュ類（カタカナ・漢字では長音符、ローマ字ではハイフンとして扱う）と中点の類（中黒として扱う）
+const LONG_VOWEL_VARIANTS = /[-‐‑‒–—―−─━]/g;
+const MIDDLE_DOT_VARIANTS = /[·•‧∙⋅]/g;
+// アポストロフィの類（ローマ字の名前でアポストロフィとして扱う）
+const APOSTROPHE_VARIANTS = /[’‘ʼ`´]/g;
+
+// 表記ごとの名前の規則（サーバー側の model.NamePolicy と同じ）
+// 漢字を含む名前は漢字、英字を含む名前はローマ字、それ以外はカタカナとして扱う
+const NAME_RULES = {
+    katakana: {
+        min: 2,
+        max: 20,
+        pattern: /^[ァ-ヺー]+(・[ァ-ヺー]+)*$/,
+        lengthError: 'nameLengthError',
+        formatError: 'nameFormatError',
+    },
+    kanji: {
+        min: 2,
+        max: 20,
+        pattern: /^(?=.*\p{Script=Han})[\p{Script=Han}\p{Script=Hiragana}\p{Script=Katakana}ー]+(・[\p{Script=Han}\p{Script=Hiragana}\p{Script=Katakana}ー]+)*$/u,
+        lengthError: 'kanjiNameLengthError',
+        formatError: 'kanjiNameFormatError',
+    },
+    latin: {
+        min: 2,
+        max: 40,
+        pattern: /^\p{Script=Latin}+([ '-]\p{Script=Latin}+)*$/u,
+        lengthError: 'latinNameLengthError',
+        formatError: 'latinNameFormatError',
+    },
+};
+
 /**
- * 名前のバリデーション
+ * 名前の表記を判定する（サーバー側の model.NamePolicyFor と同じ判定）
+ * @param {string} name - 入力された名前
+ * @returns {string} 'kanji' | 'latin' | 'katakana'
+ */
+function detectNameScript(name) {
+    const normalized = name.normalize('NFKC');
+    if (/\p{Script=Han}/u.test(normalized)) {
+        return 'kanji';
+    }
+    if (/(?=\p{L})\p{Script=Latin}/u.test(normalized)) {
+        return 'latin';
+    }
+    return 'katakana';
+}
+
+/**
+ * 名前の表記を揃える（サーバー側の model.NormalizeName と同じ変換）
+ * カタカナ: NFKC 正規化（半角カナ→全角）、ひらがな→カタカナ、長音符・中黒の表記ゆれの統一、空白の除去
+ * 漢字: カタカナと同じ（ひらがなはそのまま）
+ * ローマ字: NFKC 正規化（全角英字→半角）、ハイフン・アポストロフィの表記ゆれの統一、連続する空白をまとめる
+ * @param {string} name - 入力された名前
+ * @returns {string} 揃えた名前
+ */
+function normalizeName(name) {
+    const script = detectNameScript(name);
+    const normalized = name.normalize('NFKC');
+
+    if (script === 'latin') {
+        return normalized
+            .replace(LONG_VOWEL_VARIANTS, '-')
+            .replace(APOSTROPHE_VARIANTS, "'")
+            .trim()
+            .replace(/\s+/g, ' ')
+            .normalize('NFC');
+    }
+
+    let result = normalized.replace(/\s/g, '');
+    if (script === 'katakana') {
+        result = result.replace(/[ぁ-ゖゝゞ]/g, c => String.fromCharCode(c.charCodeAt(0) + 0x60));
+    }
+    return result
+        .replace(LONG_VOWEL_VARIANTS, 'ー')
+        .replace(MIDDLE_DOT_VARIANTS, '・')
+        .normalize('NFC');
+}
+
+/**
+ * 名前のバリデーション（表記を揃えてから、表記ごとの規則で検証する）
  * @param {string} name - 検証する名前
  * @param {object} messages - エラーメッセージオブジェクト
  * @returns {{valid: boolean, message: string}} 検証結果
  */
 function validateName(name, messages) {
-    const trimmed = name.trim();
+    const trimmed = normalizeName(name);
+    const rule = NAME_RULES[detectNameScript(trimmed)];
     const length = [...trimmed].length;
 
-    // 長さチェック（2〜20文字）
-    if (length < 2 || length > 20) {
+    // 長さチェック
+    if (length < rule.min || length > rule.max) {
         return {
             valid: false,
-            message: messages.nameLengthError
+            message: messages[rule.lengthError]
         };
     }
 
-    // カタカナチェック
-    const katakanaRegex = /^[ァ-ヴー]+$/;
-    if (!katakanaRegex.test(trimmed)) {
+    // 文字チェック
+    if (!rule.pattern.test(trimmed)) {
         return {
             valid: false,
-            message: messages.nameFormatError
+            message: messages[rule.formatError]
         };
     }
 
     return { valid: true, message: '' };
 }
 
+/**
+ * 名前の別の表記のバリデーション（任意のため、空なら有効）
+ * @param {string} altName - 検証する別の表記
+ * @param {object} messages - エラーメッセージオブジェクト
+ * @returns {{valid: boolean, message: string}} 検証結果
+ */
+function validateAltName(altName, messages) {
+    if (!normalizeName(altName)) {
+        return { valid: true, message: '' };
+    }
+    const result = validateName(altName, messages);
+    if (!result.valid) {
+        result.message = messages.altNamePrefix + result.message;
+    }
+    return result;
+}
+
+/**
+ * 名前入力のblurイベント（リアルタイムバリデーション）を設定する
+ * 表記を揃えて登録される表記を見せてから検証し、エラーを表示する
+ * @param {HTMLInputElement} input - 名前の入力欄
+ * @param {HTMLElement} errorElement - エラーメッセージの表示先
+ * @param {function(string): {valid: boolean, message: string}} validate - 検証する関数
+ */
+function bindNameValidation(input, errorElement, validate) {
+    input.addEventListener('blur', () => {
+        const normalized = normalizeName(input.value);
+        if (normalized !== input.value) {
+            input.value = normalized;
+        }
+        const result = validate(input.value);
+        if (!result.valid) {
+            errorElement.textContent = result.message;
+            errorElement.style.display = 'block';
+            input.style.borderColor = 'red';
+        } else {
+            errorElement.style.display = 'none';
+            input.style.borderColor = '';
+        }
+    });
+}
+
 /**
  * プレビューモードの判定
  */
@@ -151,6 +272,21 @@ function handleAPIError(errorData, messages, onMatchedUserExists) {
         return messages.cannotRegisterYourself;
     }
 
+    // crush_limit_reachedの場合（上限人数はサーバー側の設定に依存するためメッセージをそのまま使う）
+    if (errorData.error === 'crush_limit_reached') {
+        return errorData.message;
+    }
+
+    // リクエスト数・登録回数・登録し直しの制限に達した場合（待ち時間はサーバー側の設定に依存するためメッセージをそのまま使う）
+    if (errorData.error === 'rate_limited' || errorData.error === 'crush_change_limit_reached' || errorData.error === 'crush_change_restricted') {
+        return errorData.message;
+    }
+
+    // 年齢による制限の場合（年齢はサーバー側の設定に依存するためメッセージをそのまま使う）
+    if (errorData.error === 'under_minimum_age' || errorData.error === 'age_gap_too_large' || errorData.error === 'minor_adult_crush') {
+        return errorData.message;
+    }
+
     // その他のエラー
     return errorData.error || '登録に失敗しました。';
 }
@@ -166,3 +302,103 @@ async function ensureMinimumLoadingTime(startTime, minLoadingTime) {
         await new Promise(resolve => setTimeout(resolve, minLoadingTime - elapsed));
     }
 }
+
+/**
+ * 再送防止用の nonce を取得して JSON を POST する
+ * nonce は IDトークンに紐づき、一度しか使えないため送信ごとに取得する
+ * IDトークンも状態の変更に一度成功すると使えなくなるため、使用済みと言われたらログインし直す
+ * @param {string} url - 送信先
+ * @param {string} idToken - LIFF IDトークン
+ * @param {object} body - 送信するオブジェクト
+ * @returns {Promise<Response>} レスポンス
+ */
+async function postWithNonce(url, idToken, body) {
+    const nonceResponse = await fetch('/api/nonce', {
+        headers: { 'Authorization': `Bearer ${idToken}` }
+    });
+    if (!nonceResponse.ok) {
+        await reloginIfTokenUsed(nonceResponse);
+        return nonceResponse;
+    }
+    const { nonce } = await nonceResponse.json();
+
+    const response = await fetch(url, {
+        method: 'POST',
+        headers: {
+            'Content-Type': 'application/json',
+            'Authorization': `Bearer ${idToken}`,
+            'X-Cupid-Nonce': nonce
+        },
+        body: JSON.stringify(body)
+    });
+    if (!response.ok) {
+        await reloginIfTokenUsed(response);
+    }
+    return response;
+}
+
+/**
+ * IDトークンが使用済み（id_token_used）なら、ログインし直して新しい IDトークンを取得する
+ * ページを読み込み直すと、LIFF の初期化で再びログインされる（登録済みの内容はフォームに入り直す）
+ * @param {Response} response - 失敗したレスポンス（本文は呼び出し元でも読めるよう複製して読む）
+ */
+async function reloginIfTokenUsed(response) {
+    const data = await response.clone().json().catch(() => null);
+    if (data && data.error === 'id_token_used') {
+        liff.logout();
+        window.location.reload();
+    }
+}
+
+/**
+ * ログイン中のユーザーの登録状況を取得する（GET /api/me）
+ * @param {string} idToken - LIFF IDトークン
+ * @returns {Promise<object|null>} 登録状況。取得できなかった場合は null（フォームは空のまま使える）
+ */
+async function fetchMyStatus(idToken) {
+    try {
+        const response = await fetch('/api/me', {
+            headers: { 'Authorization': `Bearer ${idToken}` }
+        });
+        if (!response.ok) {
+            return null;
+        }
+        return await response.json();
+    } catch (error) {
+        console.error('Failed to fetch status', error);
+        return null;
+    }
+}
+
+/**
+ * 誕生日セレクトに値を設定
+ * @param {string} birthday - 誕生日（YYYY-MM-DD形式）
+ */
+function setBirthday(birthday) {
+    const [year, month, day] = birthday.split('-');
+    document.getElementById('birth-year').value = String(Number(year));
+    document.getElementById('birth-month').value = String(Number(month));
+    document.getElementById('birth-day').value = String(Number(day));
+}
+
+/**
+ * 登録状況を画面に表示
+ * @param {string} text - 表示する文言（空なら非表示）
+ */
+function showStatus(text) {
+    const status = document.getElementById('status');
+    status.textContent = text;
+    status.style.display = text ? 'block' : 'none';
+}
+
+/**
+ * マッチング中の場合は、送信するとマッチングが解除されることを確認する
+ * @param {object|null} myStatus - fetchMyStatus で取得した登録状況
+ * @returns {boolean} 送信してよいかどうか（マッチングしていなければ確認せずに true）
+ */
+function confirmUnmatchIfMatched(myStatus) {
+    if (!myStatus || !myStatus.matched) {
+        return true;
+    }
+    return confirm(myStatus.matched_warning);
+}
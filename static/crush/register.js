@@ -4,7 +4,14 @@ const LIFF_ID = '2009070891-iIdvFKtI';
 // DOM要素
 const form = document.getElementById('register-form');
 const nameInput = document.getElementById('name');
+const altNameInput = document.getElementById('alt-name');
 const submitButton = document.getElementById('submit-button');
+const crushList = document.getElementById('crush-list');
+const unmatchButton = document.getElementById('unmatch-button');
+const suggestions = document.getElementById('suggestions');
+
+// 登録状況（GET /api/me）。取得できるまでは null
+let myStatus = null;
 
 // ページ読み込み時にLIFF初期化
 window.addEventListener('load', async () => {
@@ -37,24 +44,23 @@ function setupForm() {
     // 誕生日セレクトを初期化
     initBirthdaySelects();
 
+    // 登録中の好きな人とマッチング状況を表示する
+    if (!isPreviewMode()) {
+        loadMyStatus();
+    }
+
+    unmatchButton.addEventListener('click', unmatch);
+
     // 名前入力のblurイベント（リアルタイムバリデーション）
-    const nameError = document.getElementById('name-error');
-    nameInput.addEventListener('blur', () => {
-        const result = validateName(nameInput.value, MESSAGES.validation);
-        if (!result.valid) {
-            nameError.textContent = result.message;
-            nameError.style.display = 'block';
-            nameInput.style.borderColor = 'red';
-        } else {
-            nameError.style.display = 'none';
-            nameInput.style.borderColor = '';
-        }
-    });
+    // ひらがな・半角カナなどは表記に合わせて揃えて、登録される表記を見せる
+    bindNameValidation(nameInput, document.getElementById('name-error'), name => validateName(name, MESSAGES.validation));
+    bindNameValidation(altNameInput, document.getElementById('alt-name-error'), altName => validateAltName(altName, MESSAGES.validation));
 
     form.addEventListener('submit', async (e) => {
         e.preventDefault();
 
-        const name = nameInput.value.trim();
+        const name = normalizeName(nameInput.value);
+        const altName = normalizeName(altNameInput.value);
         const birthday = getBirthday();
 
         // バリデーション
@@ -69,25 +75,139 @@ function setupForm() {
             showMessage(nameValidation.message, 'error');
             return;
         }
+        const altNameValidation = validateAltName(altName, MESSAGES.validation);
+        if (!altNameValidation.valid) {
+            showMessage(altNameValidation.message, 'error');
+            return;
+        }
 
         if (!birthday) {
             showMessage(MESSAGES.crush.birthdayRequired, 'error');
             return;
         }
 
+        // マッチング中なら、送信前に解除されることを確認する
+        if (!confirmUnmatchIfMatched(myStatus)) {
+            return;
+        }
+
         // 登録処理
-        await registerCrush(name, birthday);
+        await registerCrush(name, altName, birthday, myStatus !== null && myStatus.matched);
     });
 }
 
+/**
+ * 登録状況を取得して、登録中の好きな人とマッチング状況を表示する
+ */
+async function loadMyStatus() {
+    const idToken = liff.getIDToken();
+    if (!idToken) {
+        return;
+    }
+
+    myStatus = await fetchMyStatus(idToken);
+    const registered = myStatus !== null && myStatus.registered;
+    showStatus(registered && myStatus.matched ? MESSAGES.status.matched(myStatus.matched_user_name) : '');
+    renderCrushList(registered ? myStatus.crushes : []);
+    unmatchButton.style.display = registered && myStatus.matched ? 'block' : 'none';
+}
+
+/**
+ * 登録中の好きな人を、取り消しボタン付きで表示する
+ * @param {Array<object>} crushes - 登録中の好きな人（GET /api/me の crushes）
+ */
+function renderCrushList(crushes) {
+    crushList.replaceChildren();
+    for (const crush of crushes) {
+        const item = document.createElement('li');
+        const name = document.createElement('span');
+        name.textContent = crush.alt_name ? `${crush.name}（${crush.alt_name}）` : crush.name;
+        const button = document.createElement('button');
+        button.type = 'button';
+        button.className = 'withdraw-button';
+        button.textContent = '取り消す';
+        button.addEventListener('click', () => withdrawCrush(crush));
+        item.append(name, button);
+        crushList.append(item);
+    }
+    document.getElementById('current-crushes').style.display = crushes.length > 0 ? 'block' : 'none';
+}
+
+/**
+ * 好きな人の登録を取り消す
+ * マッチング中の相手の場合は、確認してからマッチング解除して取り消す
+ * @param {object} crush - 取り消す好きな人（id, name）
+ * @param {boolean} confirmUnmatch - マッチング解除を確認済みかどうか
+ */
+async function withdrawCrush(crush, confirmUnmatch = false) {
+    if (!confirmUnmatch && !confirm(MESSAGES.crush.withdrawConfirm(crush.name))) {
+        return;
+    }
+
+    try {
+        const response = await postWithNonce('/api/withdraw-crush', liff.getIDToken(), {
+            crush_id: crush.id,
+            confirm_unmatch: confirmUnmatch
+        });
+        const data = await response.json();
+
+        if (!response.ok) {
+            if (data.error === 'matched_user_exists') {
+                if (confirm(data.message)) {
+                    await withdrawCrush(crush, true);
+                }
+                return;
+            }
+            showMessage(data.message || MESSAGES.crush.withdrawError, 'error');
+            return;
+        }
+
+        showMessage(data.message, 'success');
+        await loadMyStatus();
+    } catch (error) {
+        console.error('Withdraw crush failed', error);
+        showMessage(MESSAGES.crush.withdrawError, 'error');
+    }
+}
+
+/**
+ * マッチングを解除する（マッチング相手の登録も取り消される）
+ */
+async function unmatch() {
+    if (!myStatus || !confirm(MESSAGES.crush.unmatchConfirm(myStatus.matched_user_name))) {
+        return;
+    }
+
+    try {
+        unmatchButton.disabled = true;
+        const response = await postWithNonce('/api/unmatch', liff.getIDToken(), {});
+        const data = await response.json();
+
+        if (!response.ok) {
+            showMessage(data.message || MESSAGES.crush.unmatchError, 'error');
+            return;
+        }
+
+        showMessage(data.message, 'success');
+        await loadMyStatus();
+    } catch (error) {
+        console.error('Unmatch failed', error);
+        showMessage(MESSAGES.crush.unmatchError, 'error');
+    } finally {
+        unmatchButton.disabled = false;
+    }
+}
+
 /**
  * 好きな人登録
  * @param {string} name - 好きな人の名前
+ * @param {string} altName - 好きな人の名前の別の表記（空なら登録しない）
  * @param {string} birthday - 好きな人の誕生日
  * @param {boolean} confirmUnmatch - マッチング解除を確認済みかどうか
  */
-async function registerCrush(name, birthday, confirmUnmatch = false) {
+async function registerCrush(name, altName, birthday, confirmUnmatch = false) {
     try {
+        renderSuggestions(name, birthday, []);
         showLoading(true);
         submitButton.disabled = true;
 
@@ -109,18 +229,12 @@ async function registerCrush(name, birthday, confirmUnmatch = false) {
             throw new Error('認証情報が取得できませんでした');
         }
 
-        // API呼び出し
-        const response = await fetch('/api/register-crush', {
-            method: 'POST',
-            headers: {
-                'Content-Type': 'application/json',
-                'Authorization': `Bearer ${idToken}`
-            },
-            body: JSON.stringify({
-                crush_name: name,
-                crush_birthday: birthday,
-                confirm_unmatch: confirmUnmatch
-            })
+        // API呼び出し（再送防止のため、送信ごとに nonce を取得する）
+        const response = await postWithNonce('/api/register-crush', idToken, {
+            crush_name: name,
+            crush_alt_name: altName,
+            crush_birthday: birthday,
+            confirm_unmatch: confirmUnmatch
         });
 
         if (!response.ok) {
@@ -145,7 +259,7 @@ async function registerCrush(name, birthday, confirmUnmatch = false) {
             const errorMessage = handleAPIError(errorData, MESSAGES.crush, () => {
                 // matched_user_existsの場合の再試行コールバック
                 showLoading(false);
-                registerCrush(name, birthday, true);
+                registerCrush(name, altName, birthday, true);
             });
 
             if (errorMessage) {
@@ -169,6 +283,12 @@ async function registerCrush(name, birthday, confirmUnmatch = false) {
             showMessage(MESSAGES.crush.updateSuccess, 'success');
         }
 
+        // マッチングしなかった場合は、入力ミスの可能性がある表記を候補として表示する
+        renderSuggestions(name, birthday, data.suggestions || []);
+
+        // 登録した好きな人・マッチング状況を表示し直す
+        await loadMyStatus();
+
     } catch (error) {
         console.error('Registration failed', error);
         showMessage(error.message || MESSAGES.crush.registrationError, 'error');
@@ -177,3 +297,27 @@ async function registerCrush(name, birthday, confirmUnmatch = false) {
         showLoading(false);
     }
 }
+
+/**
+ * 名前の候補（POST /api/register-crush の suggestions）を、別の表記に追加するボタンとして表示する
+ * @param {string} name - 登録した好きな人の名前
+ * @param {string} birthday - 登録した好きな人の誕生日
+ * @param {Array<string>} names - 名前の候補（空なら非表示）
+ */
+function renderSuggestions(name, birthday, names) {
+    const buttons = document.getElementById('suggestion-buttons');
+    buttons.replaceChildren();
+    for (const suggestion of names) {
+        const button = document.createElement('button');
+        button.type = 'button';
+        button.className = 'withdraw-button';
+        button.textContent = MESSAGES.crush.suggestionButton(suggestion);
+        button.addEventListener('click', () => {
+            altNameInput.value = suggestion;
+            registerCrush(name, suggestion, birthday);
+        });
+        buttons.append(button);
+    }
+    document.getElementById('suggestions-note').textContent = names.length > 0 ? MESSAGES.crush.suggestionNote(name) : '';
+    suggestions.style.display = names.length > 0 ? 'block' : 'none';
+}
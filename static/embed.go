@@ -0,0 +1,9 @@
+// Package static は LIFF ページと共通JavaScriptをバイナリに埋め込む
+package static
+
+import "embed"
+
+// Files は /user/, /crush/ の LIFF ページと /common.js, /messages.js
+//
+//go:embed common.js messages.js user crush
+var Files embed.FS
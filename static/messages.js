@@ -6,6 +6,11 @@ const MESSAGES = {
     validation: {
         nameLengthError: 'あうぅ...名前は2〜20文字で入力してくださいっ💦',
         nameFormatError: '名前はカタカナフルネーム(空白なし)で入力してくださいねっ✨（例: ヤマダタロウ）',
+        kanjiNameLengthError: 'あうぅ...漢字の名前は2〜20文字で入力してくださいっ💦',
+        kanjiNameFormatError: '漢字の名前は漢字・ひらがな・カタカナ(空白なし)で入力してくださいねっ✨（例: 山田太郎）',
+        latinNameLengthError: 'あうぅ...ローマ字の名前は2〜40文字で入力してくださいっ💦',
+        latinNameFormatError: 'ローマ字の名前は英字で入力してくださいねっ✨ 区切りはスペース・ハイフン・アポストロフィを1つずつ（例: Taro Yamada）',
+        altNamePrefix: '別の表記：',
         liffAuthError: 'あうぅ...LINE認証に失敗しちゃいました💦 もう一度試してくださいっ',
     },
 
@@ -17,6 +22,13 @@ const MESSAGES = {
         updateSuccess: '完了ですっ✨ 情報を更新しましたよ♡ LINEに戻ってくださいねっ！',
         cannotRegisterYourself: 'あうぅ...自分自身は登録できませんっ💦',
         registrationError: 'あうぅ...登録に失敗しちゃいました💦 もう一度試してくださいっ',
+        exportError: 'あうぅ...登録データを取得できませんでした💦 もう一度試してくださいっ',
+    },
+
+    // 登録状況の表示
+    status: {
+        matched: (name) => `💘 ${name}さんとマッチング中ですっ♡`,
+        registered: '✨ 登録済みですっ！ 変更する場合は入力し直してくださいね',
     },
 
     // 好きな人登録
@@ -28,5 +40,11 @@ const MESSAGES = {
         userNotRegistered: 'あうぅ...先に自分の情報を登録してくださいっ💦',
         cannotRegisterYourself: 'あうぅ...自分自身を好きな人として登録することはできませんっ💦',
         registrationError: 'あうぅ...登録に失敗しちゃいました💦 もう一度試してくださいっ',
+        withdrawConfirm: (name) => `${name}さんの登録を取り消しますか？`,
+        withdrawError: 'あうぅ...取り消しに失敗しちゃいました💦 もう一度試してくださいっ',
+        unmatchConfirm: (name) => `本当に${name}さんとのマッチングを解除しますか？💦\n\n${name}さんの登録も取り消されて、お相手にお知らせが届きます。`,
+        unmatchError: 'あうぅ...マッチングの解除に失敗しちゃいました💦 もう一度試してくださいっ',
+        suggestionNote: (name) => `もしかして、${name}さんは別の表記で登録されているかも...？ 心当たりがあれば別の表記として追加できますよっ✨（1日の登録回数に数えます）`,
+        suggestionButton: (name) => `「${name}」を別の表記に追加する`,
     },
 };
@@ -4,7 +4,13 @@ const LIFF_ID = '2009059076-kBsUXYIC';
 // DOM要素
 const form = document.getElementById('register-form');
 const nameInput = document.getElementById('name');
+const altNameInput = document.getElementById('alt-name');
 const submitButton = document.getElementById('submit-button');
+const exportButton = document.getElementById('export-button');
+const exportOutput = document.getElementById('export-output');
+
+// 登録状況（GET /api/me）。取得できるまでは null
+let myStatus = null;
 
 // ページ読み込み時にLIFF初期化
 window.addEventListener('load', async () => {
@@ -37,24 +43,21 @@ function setupForm() {
     // 誕生日セレクトを初期化
     initBirthdaySelects();
 
+    // 登録済みなら現在の情報をフォームに入れる
+    if (!isPreviewMode()) {
+        loadMyStatus(true);
+    }
+
     // 名前入力のblurイベント（リアルタイムバリデーション）
-    const nameError = document.getElementById('name-error');
-    nameInput.addEventListener('blur', () => {
-        const result = validateName(nameInput.value, MESSAGES.validation);
-        if (!result.valid) {
-            nameError.textContent = result.message;
-            nameError.style.display = 'block';
-            nameInput.style.borderColor = 'red';
-        } else {
-            nameError.style.display = 'none';
-            nameInput.style.borderColor = '';
-        }
-    });
+    // ひらがな・半角カナなどは表記に合わせて揃えて、登録される表記を見せる
+    bindNameValidation(nameInput, document.getElementById('name-error'), name => validateName(name, MESSAGES.validation));
+    bindNameValidation(altNameInput, document.getElementById('alt-name-error'), altName => validateAltName(altName, MESSAGES.validation));
 
     form.addEventListener('submit', async (e) => {
         e.preventDefault();
 
-        const name = nameInput.value.trim();
+        const name = normalizeName(nameInput.value);
+        const altName = normalizeName(altNameInput.value);
         const birthday = getBirthday();
 
         // バリデーション
@@ -69,24 +72,96 @@ function setupForm() {
             showMessage(nameValidation.message, 'error');
             return;
         }
+        const altNameValidation = validateAltName(altName, MESSAGES.validation);
+        if (!altNameValidation.valid) {
+            showMessage(altNameValidation.message, 'error');
+            return;
+        }
 
         if (!birthday) {
             showMessage(MESSAGES.user.birthdayRequired, 'error');
             return;
         }
 
+        // マッチング中なら、送信前に解除されることを確認する
+        if (!confirmUnmatchIfMatched(myStatus)) {
+            return;
+        }
+
         // 登録処理
-        await registerUser(name, birthday);
+        await registerUser(name, altName, birthday, myStatus !== null && myStatus.matched);
     });
+
+    // 登録データの確認
+    exportButton.addEventListener('click', exportData);
+}
+
+/**
+ * 登録状況を取得して表示する
+ * @param {boolean} prefill - 登録済みの名前・誕生日をフォームに入れるかどうか
+ */
+async function loadMyStatus(prefill) {
+    const idToken = liff.getIDToken();
+    if (!idToken) {
+        return;
+    }
+
+    myStatus = await fetchMyStatus(idToken);
+    if (!myStatus || !myStatus.registered) {
+        showStatus('');
+        return;
+    }
+
+    if (prefill) {
+        nameInput.value = myStatus.name;
+        altNameInput.value = myStatus.alt_name || '';
+        setBirthday(myStatus.birthday);
+    }
+    showStatus(myStatus.matched ? MESSAGES.status.matched(myStatus.matched_user_name) : MESSAGES.status.registered);
+}
+
+/**
+ * 登録データを取得して表示する
+ */
+async function exportData() {
+    if (isPreviewMode()) {
+        return;
+    }
+
+    exportButton.disabled = true;
+    try {
+        const idToken = liff.getIDToken();
+        if (!idToken) {
+            throw new Error('認証情報が取得できませんでした');
+        }
+
+        const response = await fetch('/api/me/export', {
+            headers: { 'Authorization': `Bearer ${idToken}` }
+        });
+        const data = await response.json();
+        if (!response.ok) {
+            // 未登録・リクエスト数の制限はサーバーのメッセージをそのまま使う
+            throw new Error(data.message || MESSAGES.user.exportError);
+        }
+
+        exportOutput.textContent = JSON.stringify(data, null, 2);
+        exportOutput.style.display = 'block';
+    } catch (error) {
+        console.error('Export failed', error);
+        showMessage(error.message || MESSAGES.user.exportError, 'error');
+    } finally {
+        exportButton.disabled = false;
+    }
 }
 
 /**
  * ユーザー登録
  * @param {string} name - ユーザー名
+ * @param {string} altName - 名前の別の表記（空なら登録しない）
  * @param {string} birthday - 誕生日
  * @param {boolean} confirmUnmatch - マッチング解除を確認済みかどうか
  */
-async function registerUser(name, birthday, confirmUnmatch = false) {
+async function registerUser(name, altName, birthday, confirmUnmatch = false) {
     try {
         showLoading(true);
         submitButton.disabled = true;
@@ -109,18 +184,12 @@ async function registerUser(name, birthday, confirmUnmatch = false) {
             throw new Error('認証情報が取得できませんでした');
         }
 
-        // API呼び出し
-        const response = await fetch('/api/register-user', {
-            method: 'POST',
-            headers: {
-                'Content-Type': 'application/json',
-                'Authorization': `Bearer ${idToken}`
-            },
-            body: JSON.stringify({
-                name,
-                birthday,
-                confirm_unmatch: confirmUnmatch
-            })
+        // API呼び出し（再送防止のため、送信ごとに nonce を取得する）
+        const response = await postWithNonce('/api/register-user', idToken, {
+            name,
+            alt_name: altName,
+            birthday,
+            confirm_unmatch: confirmUnmatch
         });
 
         if (!response.ok) {
@@ -130,7 +199,7 @@ async function registerUser(name, birthday, confirmUnmatch = false) {
             const errorMessage = handleAPIError(errorData, MESSAGES.user, () => {
                 // matched_user_existsの場合の再試行コールバック
                 showLoading(false);
-                registerUser(name, birthday, true);
+                registerUser(name, altName, birthday, true);
             });
 
             if (errorMessage) {
@@ -154,6 +223,9 @@ async function registerUser(name, birthday, confirmUnmatch = false) {
             showMessage(MESSAGES.user.updateSuccess, 'success');
         }
 
+        // マッチングが解除された場合などに備えて登録状況を取り直す
+        await loadMyStatus(false);
+
     } catch (error) {
         console.error('Registration failed', error);
         showMessage(error.message || MESSAGES.user.registrationError, 'error');